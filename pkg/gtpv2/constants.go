@@ -0,0 +1,151 @@
+package gtpv2
+
+// GTPv2-C UDP port.
+const (
+	GTPV2_PORT_NUMBER = 2123
+)
+
+// Message type.
+const (
+	MSG_ECHO_REQUEST                                    = 1
+	MSG_ECHO_RESPONSE                                   = 2
+	MSG_VERSION_NOT_SUPPORTED                           = 3
+	MSG_CREATE_SESSION_REQUEST                          = 32
+	MSG_CREATE_SESSION_RESPONSE                         = 33
+	MSG_MODIFY_BEARER_REQUEST                           = 34
+	MSG_MODIFY_BEARER_RESPONSE                          = 35
+	MSG_DELETE_SESSION_REQUEST                          = 36
+	MSG_DELETE_SESSION_RESPONSE                         = 37
+	MSG_DELETE_BEARER_COMMAND                           = 66
+	MSG_DELETE_BEARER_FAILURE_INDICATION                = 67
+	MSG_CREATE_BEARER_REQUEST                           = 95
+	MSG_CREATE_BEARER_RESPONSE                          = 96
+	MSG_UPDATE_BEARER_REQUEST                           = 97
+	MSG_UPDATE_BEARER_RESPONSE                          = 98
+	MSG_DELETE_BEARER_REQUEST                           = 99
+	MSG_DELETE_BEARER_RESPONSE                          = 100
+	MSG_RELEASE_ACCESS_BEARERS_REQUEST                  = 170
+	MSG_RELEASE_ACCESS_BEARERS_RESPONSE                 = 171
+	MSG_DOWNLINK_DATA_NOTIFICATION                      = 176
+	MSG_DOWNLINK_DATA_NOTIFICATION_ACK                  = 177
+	MSG_DOWNLINK_DATA_NOTIFICATION_FAILURE              = 70
+	MSG_CREATE_INDIRECT_DATA_FORWARDING_TUNNEL_REQUEST  = 166
+	MSG_CREATE_INDIRECT_DATA_FORWARDING_TUNNEL_RESPONSE = 167
+	MSG_DELETE_INDIRECT_DATA_FORWARDING_TUNNEL_REQUEST  = 168
+	MSG_DELETE_INDIRECT_DATA_FORWARDING_TUNNEL_RESPONSE = 169
+)
+
+// IE type.
+const (
+	IE_IMSI              = 1
+	IE_CAUSE             = 2
+	IE_RECOVERY          = 3
+	IE_APN               = 71
+	IE_AMBR              = 72
+	IE_EBI               = 73
+	IE_MEI               = 75
+	IE_MSISDN            = 76
+	IE_INDICATION        = 77
+	IE_PCO               = 78
+	IE_PAA               = 79
+	IE_BEARER_QOS        = 80
+	IE_RAT_TYPE          = 82
+	IE_SERVING_NETWORK   = 83
+	IE_BEARER_TFT        = 84
+	IE_ULI               = 86
+	IE_F_TEID            = 87
+	IE_DELAY_VALUE       = 92
+	IE_BEARER_CONTEXT    = 93
+	IE_CHARGING_ID       = 94
+	IE_PDN_TYPE          = 99
+	IE_PTI               = 100
+	IE_UE_TIME_ZONE      = 114
+	IE_APN_RESTRICTION   = 127
+	IE_SELECTION_MODE    = 128
+	IE_NODE_TYPE         = 135
+	IE_ARP               = 155
+	IE_PRIVATE_EXTENSION = 255
+)
+
+// Cause value.
+const (
+	CAUSE_LOCAL_DETACH                   = 2
+	CAUSE_COMPLETE_DETACH                = 3
+	CAUSE_ISR_DEACTIVATION               = 5
+	CAUSE_REACTIVATION_REQUESTED         = 8
+	CAUSE_REQUEST_ACCEPTED               = 16
+	CAUSE_REQUEST_ACCEPTED_PARTIALLY     = 17
+	CAUSE_NEW_PDN_TYPE_NETWORK_PREF      = 18
+	CAUSE_NEW_PDN_TYPE_SINGLE_ADDR       = 19
+	CAUSE_CONTEXT_NOT_FOUND              = 64
+	CAUSE_INVALID_MESSAGE_FORMAT         = 65
+	CAUSE_VERSION_NOT_SUPPORTED          = 66
+	CAUSE_INVALID_LENGTH                 = 67
+	CAUSE_SERVICE_NOT_SUPPORTED          = 68
+	CAUSE_MANDATORY_IE_INCORRECT         = 69
+	CAUSE_MANDATORY_IE_MISSING           = 70
+	CAUSE_SYSTEM_FAILURE                 = 72
+	CAUSE_NO_RESOURCES_AVAILABLE         = 73
+	CAUSE_MISSING_OR_UNKNOWN_APN         = 78
+	CAUSE_UNKNOWN_PDN_TYPE               = 79
+	CAUSE_ALL_DYNAMIC_ADDRESSES_OCCUPIED = 84
+	CAUSE_UE_NOT_RESPONDING              = 87
+	CAUSE_UE_REFUSES                     = 88
+	CAUSE_SERVICE_DENIED                 = 89
+	CAUSE_UNABLE_TO_PAGE_UE              = 90
+	CAUSE_NO_MEMORY_AVAILABLE            = 91
+	CAUSE_REQUEST_REJECTED               = 94
+	CAUSE_UNABLE_TO_PAGE_UE_SUSPENSION   = 95
+	CAUSE_REMOTE_PEER_NOT_RESPONDING     = 100
+	CAUSE_SEMANTIC_ERROR_IN_TFT          = 103
+	CAUSE_SYNTACTIC_ERROR_IN_TFT         = 104
+	CAUSE_DENIED_IN_RAT                  = 107
+	CAUSE_TEMPORARILY_REJECTED_HO        = 110
+	CAUSE_UE_CONTEXT_WITHOUT_TFT         = 111
+	CAUSE_UE_ALREADY_RE_ATTACHED         = 113
+)
+
+// F-TEID interface type.
+const (
+	IF_TYPE_S1U_ENB           = 0
+	IF_TYPE_S1U_SGW           = 1
+	IF_TYPE_S12_RNC           = 2
+	IF_TYPE_S12_SGW           = 3
+	IF_TYPE_S5S8_SGW_GTPU     = 4
+	IF_TYPE_S5S8_PGW_GTPU     = 5
+	IF_TYPE_S5S8_SGW_GTPC     = 6
+	IF_TYPE_S5S8_PGW_GTPC     = 7
+	IF_TYPE_S11_MME           = 10
+	IF_TYPE_S11S4_SGW_GTPC    = 11
+	IF_TYPE_ENB_DL_FORWARDING = 19
+	IF_TYPE_SGW_DL_FORWARDING = 23
+)
+
+// RAT type.
+const (
+	RAT_TYPE_UTRAN  = 1
+	RAT_TYPE_GERAN  = 2
+	RAT_TYPE_EUTRAN = 6
+)
+
+// PDN type of PAA and PDN Type IE.
+const (
+	PDN_TYPE_IPV4   = 1
+	PDN_TYPE_IPV6   = 2
+	PDN_TYPE_IPV4V6 = 3
+	PDN_TYPE_NON_IP = 4
+)
+
+// Node type.
+const (
+	NODE_TYPE_MME  = 0
+	NODE_TYPE_SGSN = 1
+)
+
+// Selection mode.
+const (
+	SELECTION_MODE_VERIFIED_BY_NETWORK     = 0
+	SELECTION_MODE_PROVIDED_BY_MS          = 1
+	SELECTION_MODE_PROVIDED_BY_NETWORK     = 2
+	SELECTION_MODE_PROVIDED_BY_NETWORK_ALT = 3
+)
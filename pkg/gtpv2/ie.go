@@ -0,0 +1,615 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// IE is GTPv2-C information element of TS 29.274 8.2.
+type IE struct {
+	Type     uint8
+	Instance uint8
+	Value    []byte
+}
+
+// parseIEs splits the octets into IEs.
+func parseIEs(b []byte) ([]IE, error) {
+	ies := []IE{}
+	for len(b) > 0 {
+		if len(b) < 4 {
+			return nil, fmt.Errorf("short IE header %d", len(b))
+		}
+		n := int(binary.BigEndian.Uint16(b[1:]))
+		if len(b) < 4+n {
+			return nil, fmt.Errorf("IE %d length %d exceeds %d", b[0], n, len(b)-4)
+		}
+		ies = append(ies, IE{Type: b[0], Instance: b[3] & 0x0f, Value: b[4 : 4+n]})
+		b = b[4+n:]
+	}
+	return ies, nil
+}
+
+// ieEncoder accumulates IEs of a message or a grouped IE. The first error
+// is kept.
+type ieEncoder struct {
+	buf []byte
+	err error
+}
+
+func (e *ieEncoder) setError(format string, a ...interface{}) {
+	if e.err == nil {
+		e.err = fmt.Errorf(format, a...)
+	}
+}
+
+func (e *ieEncoder) add(typ, inst uint8, v []byte) {
+	if len(v) > 0xffff {
+		e.setError("IE %d length %d is too long", typ, len(v))
+		return
+	}
+	e.buf = append(e.buf, typ, byte(len(v)>>8), byte(len(v)), inst&0x0f)
+	e.buf = append(e.buf, v...)
+}
+
+// addOpt adds the IE when the value is not empty.
+func (e *ieEncoder) addOpt(typ, inst uint8, v []byte) {
+	if len(v) > 0 {
+		e.add(typ, inst, v)
+	}
+}
+
+func (e *ieEncoder) addUint8(typ, inst uint8, v uint8) {
+	e.add(typ, inst, []byte{v})
+}
+
+func (e *ieEncoder) addOptUint8(typ, inst uint8, v *uint8) {
+	if v != nil {
+		e.addUint8(typ, inst, *v)
+	}
+}
+
+func (e *ieEncoder) addOptUint32(typ, inst uint8, v *uint32) {
+	if v != nil {
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint32(b, *v)
+		e.add(typ, inst, b)
+	}
+}
+
+func (e *ieEncoder) addDigits(typ, inst uint8, digits string) {
+	if digits == "" {
+		return
+	}
+	b, err := encodeTBCD(digits)
+	if err != nil {
+		e.setError("IE %d: %v", typ, err)
+		return
+	}
+	e.add(typ, inst, b)
+}
+
+func (e *ieEncoder) addFTEID(inst uint8, f *FTEID) {
+	if f == nil {
+		return
+	}
+	b, err := f.bytes()
+	if err != nil {
+		e.setError("F-TEID: %v", err)
+		return
+	}
+	e.add(IE_F_TEID, inst, b)
+}
+
+func (e *ieEncoder) addPAA(p *PAA) {
+	if p != nil {
+		e.add(IE_PAA, 0, p.bytes())
+	}
+}
+
+func (e *ieEncoder) addBearerContexts(inst uint8, bcs []BearerContext) {
+	for i := range bcs {
+		sub := &ieEncoder{}
+		bcs[i].encodeIEs(sub)
+		if sub.err != nil {
+			e.setError("Bearer Context: %v", sub.err)
+			return
+		}
+		e.add(IE_BEARER_CONTEXT, inst, sub.buf)
+	}
+}
+
+func decodeUint8(b []byte) (uint8, error) {
+	if len(b) < 1 {
+		return 0, fmt.Errorf("empty value")
+	}
+	return b[0], nil
+}
+
+func decodeOptUint8(b []byte) (*uint8, error) {
+	v, err := decodeUint8(b)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func decodeOptUint32(b []byte) (*uint32, error) {
+	if len(b) < 4 {
+		return nil, fmt.Errorf("value length %d", len(b))
+	}
+	v := binary.BigEndian.Uint32(b)
+	return &v, nil
+}
+
+// encodeTBCD encodes the digits in TBCD. Odd number of digits is padded by
+// filler 0xf.
+func encodeTBCD(digits string) ([]byte, error) {
+	b := make([]byte, (len(digits)+1)/2)
+	for i, c := range digits {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid digit %q", c)
+		}
+		if i%2 == 0 {
+			b[i/2] = 0xf0 | byte(c-'0')
+		} else {
+			b[i/2] = b[i/2]&0x0f | byte(c-'0')<<4
+		}
+	}
+	return b, nil
+}
+
+func decodeTBCD(b []byte) string {
+	digits := make([]byte, 0, len(b)*2)
+	for _, v := range b {
+		for _, d := range []byte{v & 0x0f, v >> 4} {
+			if d > 9 {
+				return string(digits)
+			}
+			digits = append(digits, '0'+d)
+		}
+	}
+	return string(digits)
+}
+
+// encodeAPN encodes the APN in labels of TS 23.003 9.1.
+func encodeAPN(apn string) []byte {
+	b := []byte{}
+	if apn == "" {
+		return b
+	}
+	for _, label := range strings.Split(apn, ".") {
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return b
+}
+
+func decodeAPN(b []byte) (string, error) {
+	labels := []string{}
+	for len(b) > 0 {
+		n := int(b[0])
+		if len(b) < 1+n {
+			return "", fmt.Errorf("APN label length %d", n)
+		}
+		labels = append(labels, string(b[1:1+n]))
+		b = b[1+n:]
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// PLMNIdentity is MCC and MNC in BCD as in TS 24.008 10.5.1.3.
+type PLMNIdentity [3]byte
+
+func decodePLMNIdentity(b []byte) (PLMNIdentity, error) {
+	var p PLMNIdentity
+	if len(b) < 3 {
+		return p, fmt.Errorf("PLMN identity length %d", len(b))
+	}
+	copy(p[:], b)
+	return p, nil
+}
+
+// Cause is cause of the response. Flags are PCE, BCE and CS bits in the
+// lower three bits.
+type Cause struct {
+	Value uint8
+	Flags uint8
+}
+
+// Cause flags.
+const (
+	CAUSE_FLAG_CS  = 0x01
+	CAUSE_FLAG_BCE = 0x02
+	CAUSE_FLAG_PCE = 0x04
+)
+
+func (c Cause) bytes() []byte {
+	return []byte{c.Value, c.Flags & 0x07}
+}
+
+func decodeCause(b []byte) (Cause, error) {
+	if len(b) < 2 {
+		return Cause{}, fmt.Errorf("Cause length %d", len(b))
+	}
+	return Cause{Value: b[0], Flags: b[1] & 0x07}, nil
+}
+
+// Accepted returns true when the request is accepted.
+func (c Cause) Accepted() bool {
+	return c.Value >= CAUSE_REQUEST_ACCEPTED && c.Value < CAUSE_CONTEXT_NOT_FOUND
+}
+
+func (c Cause) String() string {
+	return fmt.Sprintf("Cause %d", c.Value)
+}
+
+// AMBR is aggregate maximum bit rate in kbps.
+type AMBR struct {
+	UL uint32
+	DL uint32
+}
+
+func (a AMBR) bytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint32(b[0:], a.UL)
+	binary.BigEndian.PutUint32(b[4:], a.DL)
+	return b
+}
+
+func decodeAMBR(b []byte) (AMBR, error) {
+	if len(b) < 8 {
+		return AMBR{}, fmt.Errorf("AMBR length %d", len(b))
+	}
+	return AMBR{UL: binary.BigEndian.Uint32(b[0:]), DL: binary.BigEndian.Uint32(b[4:])}, nil
+}
+
+// PAA is PDN address allocation. IPv6 is the prefix of PrefixLength bits.
+type PAA struct {
+	Type         uint8
+	IPv4         net.IP
+	IPv6         net.IP
+	PrefixLength uint8
+}
+
+func (p PAA) bytes() []byte {
+	b := []byte{p.Type & 0x7}
+	if p.Type == PDN_TYPE_IPV6 || p.Type == PDN_TYPE_IPV4V6 {
+		v6 := p.IPv6.To16()
+		if v6 == nil {
+			v6 = net.IPv6zero
+		}
+		b = append(b, p.PrefixLength)
+		b = append(b, v6...)
+	}
+	if p.Type == PDN_TYPE_IPV4 || p.Type == PDN_TYPE_IPV4V6 {
+		v4 := p.IPv4.To4()
+		if v4 == nil {
+			v4 = net.IPv4zero.To4()
+		}
+		b = append(b, v4...)
+	}
+	return b
+}
+
+func decodePAA(b []byte) (PAA, error) {
+	if len(b) < 1 {
+		return PAA{}, fmt.Errorf("empty PAA")
+	}
+	p := PAA{Type: b[0] & 0x7}
+	b = b[1:]
+	switch p.Type {
+	case PDN_TYPE_IPV4:
+		if len(b) < 4 {
+			return p, fmt.Errorf("IPv4 PAA length %d", len(b))
+		}
+		p.IPv4 = net.IP(b[:4])
+	case PDN_TYPE_IPV6:
+		if len(b) < 17 {
+			return p, fmt.Errorf("IPv6 PAA length %d", len(b))
+		}
+		p.PrefixLength = b[0]
+		p.IPv6 = net.IP(b[1:17])
+	case PDN_TYPE_IPV4V6:
+		if len(b) < 21 {
+			return p, fmt.Errorf("IPv4v6 PAA length %d", len(b))
+		}
+		p.PrefixLength = b[0]
+		p.IPv6 = net.IP(b[1:17])
+		p.IPv4 = net.IP(b[17:21])
+	}
+	return p, nil
+}
+
+// BearerQoS is bearer level QoS. PCI and PVI are 1 when pre-emption
+// capability and vulnerability are disabled. Bit rates are in kbps.
+type BearerQoS struct {
+	PCI   uint8
+	PL    uint8
+	PVI   uint8
+	QCI   uint8
+	MBRUL uint64
+	MBRDL uint64
+	GBRUL uint64
+	GBRDL uint64
+}
+
+func (q BearerQoS) bytes() []byte {
+	b := []byte{(q.PCI&1)<<6 | (q.PL&0xf)<<2 | q.PVI&1, q.QCI}
+	for _, r := range []uint64{q.MBRUL, q.MBRDL, q.GBRUL, q.GBRDL} {
+		b = append(b, byte(r>>32), byte(r>>24), byte(r>>16), byte(r>>8), byte(r))
+	}
+	return b
+}
+
+func decodeBearerQoS(b []byte) (BearerQoS, error) {
+	if len(b) < 22 {
+		return BearerQoS{}, fmt.Errorf("Bearer QoS length %d", len(b))
+	}
+	rate := func(p []byte) uint64 {
+		return uint64(p[0])<<32 | uint64(binary.BigEndian.Uint32(p[1:]))
+	}
+	return BearerQoS{
+		PCI:   b[0] >> 6 & 1,
+		PL:    b[0] >> 2 & 0xf,
+		PVI:   b[0] & 1,
+		QCI:   b[1],
+		MBRUL: rate(b[2:]),
+		MBRDL: rate(b[7:]),
+		GBRUL: rate(b[12:]),
+		GBRDL: rate(b[17:]),
+	}, nil
+}
+
+// ARP is allocation/retention priority. PCI and PVI are 1 when pre-emption
+// capability and vulnerability are disabled.
+type ARP struct {
+	PCI uint8
+	PL  uint8
+	PVI uint8
+}
+
+func (a ARP) bytes() []byte {
+	return []byte{(a.PCI&1)<<6 | (a.PL&0xf)<<2 | a.PVI&1}
+}
+
+func decodeARP(b []byte) (ARP, error) {
+	if len(b) < 1 {
+		return ARP{}, fmt.Errorf("ARP length %d", len(b))
+	}
+	return ARP{PCI: b[0] >> 6 & 1, PL: b[0] >> 2 & 0xf, PVI: b[0] & 1}, nil
+}
+
+// TAI is tracking area identity in ULI.
+type TAI struct {
+	PLMNIdentity PLMNIdentity
+	TAC          uint16
+}
+
+// ECGI is E-UTRAN cell global identifier in ULI. ECI is 28 bits.
+type ECGI struct {
+	PLMNIdentity PLMNIdentity
+	ECI          uint32
+}
+
+// ULI is user location information. Only TAI and ECGI are supported.
+type ULI struct {
+	TAI  *TAI
+	ECGI *ECGI
+}
+
+// ULI flags.
+const (
+	ULI_FLAG_CGI  = 0x01
+	ULI_FLAG_SAI  = 0x02
+	ULI_FLAG_RAI  = 0x04
+	ULI_FLAG_TAI  = 0x08
+	ULI_FLAG_ECGI = 0x10
+	ULI_FLAG_LAI  = 0x20
+)
+
+func (u ULI) bytes() []byte {
+	b := []byte{0}
+	if u.TAI != nil {
+		b[0] |= ULI_FLAG_TAI
+		b = append(b, u.TAI.PLMNIdentity[:]...)
+		b = append(b, byte(u.TAI.TAC>>8), byte(u.TAI.TAC))
+	}
+	if u.ECGI != nil {
+		b[0] |= ULI_FLAG_ECGI
+		eci := u.ECGI.ECI & 0x0fffffff
+		b = append(b, u.ECGI.PLMNIdentity[:]...)
+		b = append(b, byte(eci>>24), byte(eci>>16), byte(eci>>8), byte(eci))
+	}
+	return b
+}
+
+func decodeULI(b []byte) (ULI, error) {
+	u := ULI{}
+	if len(b) < 1 {
+		return u, fmt.Errorf("empty ULI")
+	}
+	flags := b[0]
+	b = b[1:]
+	// CGI, SAI and RAI are 7 octets each which precede TAI.
+	for _, f := range []uint8{ULI_FLAG_CGI, ULI_FLAG_SAI, ULI_FLAG_RAI} {
+		if flags&f != 0 {
+			if len(b) < 7 {
+				return u, fmt.Errorf("short ULI")
+			}
+			b = b[7:]
+		}
+	}
+	if flags&ULI_FLAG_TAI != 0 {
+		if len(b) < 5 {
+			return u, fmt.Errorf("short ULI TAI")
+		}
+		t := &TAI{TAC: binary.BigEndian.Uint16(b[3:])}
+		copy(t.PLMNIdentity[:], b)
+		u.TAI = t
+		b = b[5:]
+	}
+	if flags&ULI_FLAG_ECGI != 0 {
+		if len(b) < 7 {
+			return u, fmt.Errorf("short ULI ECGI")
+		}
+		c := &ECGI{ECI: binary.BigEndian.Uint32(b[3:]) & 0x0fffffff}
+		copy(c.PLMNIdentity[:], b)
+		u.ECGI = c
+	}
+	return u, nil
+}
+
+// FTEID is fully qualified TEID. Instance is the IE instance which tells
+// the role of the F-TEID in the message or the grouped IE.
+type FTEID struct {
+	Instance  uint8
+	Interface uint8
+	TEID      uint32
+	IPv4      net.IP
+	IPv6      net.IP
+}
+
+// F-TEID flags.
+const (
+	FTEID_FLAG_V4 = 0x80
+	FTEID_FLAG_V6 = 0x40
+)
+
+func (f FTEID) bytes() ([]byte, error) {
+	b := make([]byte, 5)
+	b[0] = f.Interface & 0x3f
+	binary.BigEndian.PutUint32(b[1:], f.TEID)
+	if v4 := f.IPv4.To4(); v4 != nil {
+		b[0] |= FTEID_FLAG_V4
+		b = append(b, v4...)
+	}
+	if f.IPv6 != nil && f.IPv6.To4() == nil {
+		b[0] |= FTEID_FLAG_V6
+		b = append(b, f.IPv6.To16()...)
+	}
+	if b[0]&(FTEID_FLAG_V4|FTEID_FLAG_V6) == 0 {
+		return nil, fmt.Errorf("no address of interface %d", f.Interface)
+	}
+	return b, nil
+}
+
+func decodeFTEID(inst uint8, b []byte) (FTEID, error) {
+	if len(b) < 5 {
+		return FTEID{}, fmt.Errorf("F-TEID length %d", len(b))
+	}
+	f := FTEID{
+		Instance:  inst,
+		Interface: b[0] & 0x3f,
+		TEID:      binary.BigEndian.Uint32(b[1:]),
+	}
+	rest := b[5:]
+	if b[0]&FTEID_FLAG_V4 != 0 {
+		if len(rest) < 4 {
+			return f, fmt.Errorf("short F-TEID IPv4 address")
+		}
+		f.IPv4 = net.IP(rest[:4])
+		rest = rest[4:]
+	}
+	if b[0]&FTEID_FLAG_V6 != 0 {
+		if len(rest) < 16 {
+			return f, fmt.Errorf("short F-TEID IPv6 address")
+		}
+		f.IPv6 = net.IP(rest[:16])
+	}
+	return f, nil
+}
+
+// IP returns IPv4 address of the F-TEID or IPv6 address when IPv4 address
+// is not present.
+func (f *FTEID) IP() net.IP {
+	if f.IPv4 != nil {
+		return f.IPv4
+	}
+	return f.IPv6
+}
+
+// BearerContext is the grouped IE of EPS bearer. FTEIDs keep the instance
+// so that the same interface type can be carried in different roles.
+type BearerContext struct {
+	EBI        uint8
+	TFT        []byte
+	FTEIDs     []FTEID
+	BearerQoS  *BearerQoS
+	Cause      *Cause
+	ChargingID *uint32
+}
+
+func (bc *BearerContext) encodeIEs(e *ieEncoder) {
+	e.addUint8(IE_EBI, 0, bc.EBI&0x0f)
+	e.addOpt(IE_BEARER_TFT, 0, bc.TFT)
+	for i := range bc.FTEIDs {
+		e.addFTEID(bc.FTEIDs[i].Instance, &bc.FTEIDs[i])
+	}
+	if bc.BearerQoS != nil {
+		e.add(IE_BEARER_QOS, 0, bc.BearerQoS.bytes())
+	}
+	if bc.Cause != nil {
+		e.add(IE_CAUSE, 0, bc.Cause.bytes())
+	}
+	e.addOptUint32(IE_CHARGING_ID, 0, bc.ChargingID)
+}
+
+func decodeBearerContext(b []byte) (BearerContext, error) {
+	bc := BearerContext{}
+	ies, err := parseIEs(b)
+	if err != nil {
+		return bc, err
+	}
+	hasEBI := false
+	for _, ie := range ies {
+		switch ie.Type {
+		case IE_EBI:
+			v, err := decodeUint8(ie.Value)
+			if err != nil {
+				return bc, fmt.Errorf("EBI: %v", err)
+			}
+			bc.EBI = v & 0x0f
+			hasEBI = true
+		case IE_BEARER_TFT:
+			bc.TFT = ie.Value
+		case IE_F_TEID:
+			f, err := decodeFTEID(ie.Instance, ie.Value)
+			if err != nil {
+				return bc, err
+			}
+			bc.FTEIDs = append(bc.FTEIDs, f)
+		case IE_BEARER_QOS:
+			q, err := decodeBearerQoS(ie.Value)
+			if err != nil {
+				return bc, err
+			}
+			bc.BearerQoS = &q
+		case IE_CAUSE:
+			c, err := decodeCause(ie.Value)
+			if err != nil {
+				return bc, err
+			}
+			bc.Cause = &c
+		case IE_CHARGING_ID:
+			if bc.ChargingID, err = decodeOptUint32(ie.Value); err != nil {
+				return bc, fmt.Errorf("Charging ID: %v", err)
+			}
+		}
+	}
+	if !hasEBI {
+		return bc, fmt.Errorf("Bearer Context without EBI")
+	}
+	return bc, nil
+}
+
+// FTEID returns F-TEID of the interface type.
+func (bc *BearerContext) FTEID(iface uint8) *FTEID {
+	for i := range bc.FTEIDs {
+		if bc.FTEIDs[i].Interface == iface {
+			return &bc.FTEIDs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Message is GTPv2-C message. Decode returns one of the message types
+// defined in this package so that the caller can type switch on it.
+type Message interface {
+	MessageType() uint8
+
+	encodeIEs(e *ieEncoder)
+	decodeIE(ie *IE) error
+	mandatoryIEs() []uint8
+}
+
+// Header is GTPv2-C message header. TEID is not present in Echo and Version
+// Not Supported messages.
+type Header struct {
+	Type     uint8
+	TEID     uint32
+	Sequence uint32
+}
+
+const (
+	headerVersion = 2 << 5
+	headerFlagP   = 0x10
+	headerFlagT   = 0x08
+)
+
+// MAX_SEQUENCE is the largest 24 bits sequence number.
+const MAX_SEQUENCE = 0xffffff
+
+// teidPresent returns true when the message has TEID in the header.
+func teidPresent(typ uint8) bool {
+	switch typ {
+	case MSG_ECHO_REQUEST, MSG_ECHO_RESPONSE, MSG_VERSION_NOT_SUPPORTED:
+		return false
+	}
+	return true
+}
+
+// messageTypes is the registry of supported messages.
+var messageTypes = map[uint8]func() Message{}
+
+func registerMessage(f func() Message) {
+	messageTypes[f().MessageType()] = f
+}
+
+var messageStr = map[uint8]string{
+	MSG_ECHO_REQUEST:                                    "Echo Request",
+	MSG_ECHO_RESPONSE:                                   "Echo Response",
+	MSG_VERSION_NOT_SUPPORTED:                           "Version Not Supported Indication",
+	MSG_CREATE_SESSION_REQUEST:                          "Create Session Request",
+	MSG_CREATE_SESSION_RESPONSE:                         "Create Session Response",
+	MSG_MODIFY_BEARER_REQUEST:                           "Modify Bearer Request",
+	MSG_MODIFY_BEARER_RESPONSE:                          "Modify Bearer Response",
+	MSG_DELETE_SESSION_REQUEST:                          "Delete Session Request",
+	MSG_DELETE_SESSION_RESPONSE:                         "Delete Session Response",
+	MSG_DELETE_BEARER_COMMAND:                           "Delete Bearer Command",
+	MSG_DELETE_BEARER_FAILURE_INDICATION:                "Delete Bearer Failure Indication",
+	MSG_CREATE_BEARER_REQUEST:                           "Create Bearer Request",
+	MSG_CREATE_BEARER_RESPONSE:                          "Create Bearer Response",
+	MSG_UPDATE_BEARER_REQUEST:                           "Update Bearer Request",
+	MSG_UPDATE_BEARER_RESPONSE:                          "Update Bearer Response",
+	MSG_DELETE_BEARER_REQUEST:                           "Delete Bearer Request",
+	MSG_DELETE_BEARER_RESPONSE:                          "Delete Bearer Response",
+	MSG_RELEASE_ACCESS_BEARERS_REQUEST:                  "Release Access Bearers Request",
+	MSG_RELEASE_ACCESS_BEARERS_RESPONSE:                 "Release Access Bearers Response",
+	MSG_DOWNLINK_DATA_NOTIFICATION:                      "Downlink Data Notification",
+	MSG_DOWNLINK_DATA_NOTIFICATION_ACK:                  "Downlink Data Notification Acknowledge",
+	MSG_DOWNLINK_DATA_NOTIFICATION_FAILURE:              "Downlink Data Notification Failure Indication",
+	MSG_CREATE_INDIRECT_DATA_FORWARDING_TUNNEL_REQUEST:  "Create Indirect Data Forwarding Tunnel Request",
+	MSG_CREATE_INDIRECT_DATA_FORWARDING_TUNNEL_RESPONSE: "Create Indirect Data Forwarding Tunnel Response",
+	MSG_DELETE_INDIRECT_DATA_FORWARDING_TUNNEL_REQUEST:  "Delete Indirect Data Forwarding Tunnel Request",
+	MSG_DELETE_INDIRECT_DATA_FORWARDING_TUNNEL_RESPONSE: "Delete Indirect Data Forwarding Tunnel Response",
+}
+
+// MessageName returns name of the message.
+func MessageName(m Message) string {
+	if str, ok := messageStr[m.MessageType()]; ok {
+		return str
+	}
+	return fmt.Sprintf("Unknown(%d)", m.MessageType())
+}
+
+// UnknownMessage is a message which type is not supported by this package.
+// The IEs are kept as they are so that the caller can report it.
+type UnknownMessage struct {
+	Type uint8
+	IEs  []IE
+}
+
+func (m *UnknownMessage) MessageType() uint8 { return m.Type }
+
+func (m *UnknownMessage) encodeIEs(e *ieEncoder) {
+	for _, ie := range m.IEs {
+		e.add(ie.Type, ie.Instance, ie.Value)
+	}
+}
+
+func (m *UnknownMessage) decodeIE(ie *IE) error {
+	m.IEs = append(m.IEs, *ie)
+	return nil
+}
+
+func (m *UnknownMessage) mandatoryIEs() []uint8 { return nil }
+
+// Encode encodes the message with the TEID and the sequence number. TEID
+// is ignored for the messages without TEID.
+func Encode(teid, seq uint32, m Message) ([]byte, error) {
+	if seq > MAX_SEQUENCE {
+		return nil, fmt.Errorf("%s: sequence number %d is too large", MessageName(m), seq)
+	}
+	e := &ieEncoder{}
+	m.encodeIEs(e)
+	if e.err != nil {
+		return nil, fmt.Errorf("%s: %v", MessageName(m), e.err)
+	}
+
+	flags := uint8(headerVersion)
+	hlen := 8
+	if teidPresent(m.MessageType()) {
+		flags |= headerFlagT
+		hlen = 12
+	}
+	buf := make([]byte, hlen, hlen+len(e.buf))
+	buf[0] = flags
+	buf[1] = m.MessageType()
+	if hlen+len(e.buf)-4 > 0xffff {
+		return nil, fmt.Errorf("%s: message length %d is too long", MessageName(m), len(e.buf))
+	}
+	binary.BigEndian.PutUint16(buf[2:], uint16(hlen+len(e.buf)-4))
+	p := 4
+	if hlen == 12 {
+		binary.BigEndian.PutUint32(buf[4:], teid)
+		p = 8
+	}
+	buf[p] = byte(seq >> 16)
+	buf[p+1] = byte(seq >> 8)
+	buf[p+2] = byte(seq)
+	return append(buf, e.buf...), nil
+}
+
+// Decode decodes GTPv2-C message. When the message type is not supported,
+// *UnknownMessage is returned. Piggybacked message is ignored.
+func Decode(buf []byte) (*Header, Message, error) {
+	if len(buf) < 8 {
+		return nil, nil, fmt.Errorf("short GTPv2-C header %d", len(buf))
+	}
+	if buf[0]>>5 != 2 {
+		return nil, nil, fmt.Errorf("unsupported GTP version %d", buf[0]>>5)
+	}
+	h := &Header{Type: buf[1]}
+	length := int(binary.BigEndian.Uint16(buf[2:])) + 4
+	if length > len(buf) {
+		return nil, nil, fmt.Errorf("GTPv2-C message length %d exceeds %d", length, len(buf))
+	}
+	p := 4
+	if buf[0]&headerFlagT != 0 {
+		if length < 12 {
+			return nil, nil, fmt.Errorf("short GTPv2-C header %d", length)
+		}
+		h.TEID = binary.BigEndian.Uint32(buf[4:])
+		p = 8
+	}
+	h.Sequence = uint32(buf[p])<<16 | uint32(buf[p+1])<<8 | uint32(buf[p+2])
+	p += 4
+
+	ies, err := parseIEs(buf[p:length])
+	if err != nil {
+		return h, nil, err
+	}
+	var m Message
+	if f, ok := messageTypes[h.Type]; ok {
+		m = f()
+	} else {
+		m = &UnknownMessage{Type: h.Type}
+	}
+	seen := map[uint8]bool{}
+	for i := range ies {
+		ie := &ies[i]
+		if err := m.decodeIE(ie); err != nil {
+			return h, nil, fmt.Errorf("%s: IE %d: %v", MessageName(m), ie.Type, err)
+		}
+		if ie.Instance == 0 {
+			seen[ie.Type] = true
+		}
+	}
+	for _, typ := range m.mandatoryIEs() {
+		if !seen[typ] {
+			return h, nil, fmt.Errorf("%s: mandatory IE %d is missing", MessageName(m), typ)
+		}
+	}
+	return h, m, nil
+}
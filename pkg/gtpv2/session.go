@@ -0,0 +1,1028 @@
+package gtpv2
+
+// EchoRequest is TS 29.274 7.1.1.
+type EchoRequest struct {
+	Recovery uint8
+}
+
+func (*EchoRequest) MessageType() uint8 { return MSG_ECHO_REQUEST }
+
+func (m *EchoRequest) encodeIEs(e *ieEncoder) {
+	e.addUint8(IE_RECOVERY, 0, m.Recovery)
+}
+
+func (m *EchoRequest) decodeIE(ie *IE) (err error) {
+	if ie.Type == IE_RECOVERY {
+		m.Recovery, err = decodeUint8(ie.Value)
+	}
+	return err
+}
+
+func (*EchoRequest) mandatoryIEs() []uint8 { return []uint8{IE_RECOVERY} }
+
+// EchoResponse is TS 29.274 7.1.2.
+type EchoResponse struct {
+	Recovery uint8
+}
+
+func (*EchoResponse) MessageType() uint8 { return MSG_ECHO_RESPONSE }
+
+func (m *EchoResponse) encodeIEs(e *ieEncoder) {
+	e.addUint8(IE_RECOVERY, 0, m.Recovery)
+}
+
+func (m *EchoResponse) decodeIE(ie *IE) (err error) {
+	if ie.Type == IE_RECOVERY {
+		m.Recovery, err = decodeUint8(ie.Value)
+	}
+	return err
+}
+
+func (*EchoResponse) mandatoryIEs() []uint8 { return []uint8{IE_RECOVERY} }
+
+// CreateSessionRequest is TS 29.274 7.2.1. SenderFTEID is the S11 MME
+// F-TEID and PGWFTEID is the S5/S8 PGW F-TEID of instance 1.
+// BearerContexts are the bearer contexts to be created.
+type CreateSessionRequest struct {
+	IMSI           string
+	MSISDN         string
+	MEI            string
+	ULI            *ULI
+	ServingNetwork *PLMNIdentity
+	RATType        uint8
+	Indication     []byte
+	SenderFTEID    FTEID
+	PGWFTEID       *FTEID
+	APN            string
+	SelectionMode  *uint8
+	PDNType        *uint8
+	PAA            *PAA
+	APNRestriction *uint8
+	AMBR           *AMBR
+	PCO            []byte
+	BearerContexts []BearerContext
+	Recovery       *uint8
+	UETimeZone     []byte
+}
+
+func (*CreateSessionRequest) MessageType() uint8 { return MSG_CREATE_SESSION_REQUEST }
+
+func (m *CreateSessionRequest) encodeIEs(e *ieEncoder) {
+	e.addDigits(IE_IMSI, 0, m.IMSI)
+	e.addDigits(IE_MSISDN, 0, m.MSISDN)
+	e.addDigits(IE_MEI, 0, m.MEI)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+	if m.ServingNetwork != nil {
+		e.add(IE_SERVING_NETWORK, 0, m.ServingNetwork[:])
+	}
+	e.addUint8(IE_RAT_TYPE, 0, m.RATType)
+	e.addOpt(IE_INDICATION, 0, m.Indication)
+	e.addFTEID(0, &m.SenderFTEID)
+	e.addFTEID(1, m.PGWFTEID)
+	e.add(IE_APN, 0, encodeAPN(m.APN))
+	e.addOptUint8(IE_SELECTION_MODE, 0, m.SelectionMode)
+	e.addOptUint8(IE_PDN_TYPE, 0, m.PDNType)
+	e.addPAA(m.PAA)
+	e.addOptUint8(IE_APN_RESTRICTION, 0, m.APNRestriction)
+	if m.AMBR != nil {
+		e.add(IE_AMBR, 0, m.AMBR.bytes())
+	}
+	e.addOpt(IE_PCO, 0, m.PCO)
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	e.addOpt(IE_UE_TIME_ZONE, 0, m.UETimeZone)
+}
+
+func (m *CreateSessionRequest) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 && ie.Type != IE_F_TEID {
+		return nil
+	}
+	switch ie.Type {
+	case IE_IMSI:
+		m.IMSI = decodeTBCD(ie.Value)
+	case IE_MSISDN:
+		m.MSISDN = decodeTBCD(ie.Value)
+	case IE_MEI:
+		m.MEI = decodeTBCD(ie.Value)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	case IE_SERVING_NETWORK:
+		var p PLMNIdentity
+		p, err = decodePLMNIdentity(ie.Value)
+		m.ServingNetwork = &p
+	case IE_RAT_TYPE:
+		m.RATType, err = decodeUint8(ie.Value)
+	case IE_INDICATION:
+		m.Indication = ie.Value
+	case IE_F_TEID:
+		var f FTEID
+		f, err = decodeFTEID(ie.Instance, ie.Value)
+		switch ie.Instance {
+		case 0:
+			m.SenderFTEID = f
+		case 1:
+			m.PGWFTEID = &f
+		}
+	case IE_APN:
+		m.APN, err = decodeAPN(ie.Value)
+	case IE_SELECTION_MODE:
+		m.SelectionMode, err = decodeOptUint8(ie.Value)
+	case IE_PDN_TYPE:
+		m.PDNType, err = decodeOptUint8(ie.Value)
+	case IE_PAA:
+		var p PAA
+		p, err = decodePAA(ie.Value)
+		m.PAA = &p
+	case IE_APN_RESTRICTION:
+		m.APNRestriction, err = decodeOptUint8(ie.Value)
+	case IE_AMBR:
+		var a AMBR
+		a, err = decodeAMBR(ie.Value)
+		m.AMBR = &a
+	case IE_PCO:
+		m.PCO = ie.Value
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_UE_TIME_ZONE:
+		m.UETimeZone = ie.Value
+	}
+	return err
+}
+
+func (*CreateSessionRequest) mandatoryIEs() []uint8 {
+	return []uint8{IE_RAT_TYPE, IE_F_TEID, IE_APN, IE_BEARER_CONTEXT}
+}
+
+// CreateSessionResponse is TS 29.274 7.2.2. SenderFTEID is the S11 SGW
+// F-TEID and PGWFTEID is the S5/S8 PGW F-TEID of instance 1.
+// BearerContexts are the bearer contexts created.
+type CreateSessionResponse struct {
+	Cause          Cause
+	SenderFTEID    *FTEID
+	PGWFTEID       *FTEID
+	PAA            *PAA
+	APNRestriction *uint8
+	AMBR           *AMBR
+	LinkedEBI      *uint8
+	PCO            []byte
+	BearerContexts []BearerContext
+	Recovery       *uint8
+}
+
+func (*CreateSessionResponse) MessageType() uint8 { return MSG_CREATE_SESSION_RESPONSE }
+
+func (m *CreateSessionResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addFTEID(0, m.SenderFTEID)
+	e.addFTEID(1, m.PGWFTEID)
+	e.addPAA(m.PAA)
+	e.addOptUint8(IE_APN_RESTRICTION, 0, m.APNRestriction)
+	if m.AMBR != nil {
+		e.add(IE_AMBR, 0, m.AMBR.bytes())
+	}
+	e.addOptUint8(IE_EBI, 0, m.LinkedEBI)
+	e.addOpt(IE_PCO, 0, m.PCO)
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+}
+
+func (m *CreateSessionResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 && ie.Type != IE_F_TEID {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_F_TEID:
+		var f FTEID
+		f, err = decodeFTEID(ie.Instance, ie.Value)
+		switch ie.Instance {
+		case 0:
+			m.SenderFTEID = &f
+		case 1:
+			m.PGWFTEID = &f
+		}
+	case IE_PAA:
+		var p PAA
+		p, err = decodePAA(ie.Value)
+		m.PAA = &p
+	case IE_APN_RESTRICTION:
+		m.APNRestriction, err = decodeOptUint8(ie.Value)
+	case IE_AMBR:
+		var a AMBR
+		a, err = decodeAMBR(ie.Value)
+		m.AMBR = &a
+	case IE_EBI:
+		m.LinkedEBI, err = decodeOptUint8(ie.Value)
+	case IE_PCO:
+		m.PCO = ie.Value
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	}
+	return err
+}
+
+func (*CreateSessionResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// ModifyBearerRequest is TS 29.274 7.2.7. BearerContexts are the bearer
+// contexts to be modified.
+type ModifyBearerRequest struct {
+	MEI            string
+	ULI            *ULI
+	ServingNetwork *PLMNIdentity
+	RATType        *uint8
+	Indication     []byte
+	SenderFTEID    *FTEID
+	AMBR           *AMBR
+	BearerContexts []BearerContext
+	Recovery       *uint8
+	UETimeZone     []byte
+}
+
+func (*ModifyBearerRequest) MessageType() uint8 { return MSG_MODIFY_BEARER_REQUEST }
+
+func (m *ModifyBearerRequest) encodeIEs(e *ieEncoder) {
+	e.addDigits(IE_MEI, 0, m.MEI)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+	if m.ServingNetwork != nil {
+		e.add(IE_SERVING_NETWORK, 0, m.ServingNetwork[:])
+	}
+	e.addOptUint8(IE_RAT_TYPE, 0, m.RATType)
+	e.addOpt(IE_INDICATION, 0, m.Indication)
+	e.addFTEID(0, m.SenderFTEID)
+	if m.AMBR != nil {
+		e.add(IE_AMBR, 0, m.AMBR.bytes())
+	}
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	e.addOpt(IE_UE_TIME_ZONE, 0, m.UETimeZone)
+}
+
+func (m *ModifyBearerRequest) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_MEI:
+		m.MEI = decodeTBCD(ie.Value)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	case IE_SERVING_NETWORK:
+		var p PLMNIdentity
+		p, err = decodePLMNIdentity(ie.Value)
+		m.ServingNetwork = &p
+	case IE_RAT_TYPE:
+		m.RATType, err = decodeOptUint8(ie.Value)
+	case IE_INDICATION:
+		m.Indication = ie.Value
+	case IE_F_TEID:
+		var f FTEID
+		f, err = decodeFTEID(ie.Instance, ie.Value)
+		m.SenderFTEID = &f
+	case IE_AMBR:
+		var a AMBR
+		a, err = decodeAMBR(ie.Value)
+		m.AMBR = &a
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_UE_TIME_ZONE:
+		m.UETimeZone = ie.Value
+	}
+	return err
+}
+
+func (*ModifyBearerRequest) mandatoryIEs() []uint8 { return nil }
+
+// ModifyBearerResponse is TS 29.274 7.2.8. BearerContexts are the bearer
+// contexts modified.
+type ModifyBearerResponse struct {
+	Cause          Cause
+	MSISDN         string
+	LinkedEBI      *uint8
+	BearerContexts []BearerContext
+	Recovery       *uint8
+}
+
+func (*ModifyBearerResponse) MessageType() uint8 { return MSG_MODIFY_BEARER_RESPONSE }
+
+func (m *ModifyBearerResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addDigits(IE_MSISDN, 0, m.MSISDN)
+	e.addOptUint8(IE_EBI, 0, m.LinkedEBI)
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+}
+
+func (m *ModifyBearerResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_MSISDN:
+		m.MSISDN = decodeTBCD(ie.Value)
+	case IE_EBI:
+		m.LinkedEBI, err = decodeOptUint8(ie.Value)
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	}
+	return err
+}
+
+func (*ModifyBearerResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// DeleteSessionRequest is TS 29.274 7.2.9. LinkedEBI is the default bearer
+// of the PDN connection.
+type DeleteSessionRequest struct {
+	Cause       *Cause
+	LinkedEBI   *uint8
+	ULI         *ULI
+	Indication  []byte
+	SenderFTEID *FTEID
+	UETimeZone  []byte
+}
+
+func (*DeleteSessionRequest) MessageType() uint8 { return MSG_DELETE_SESSION_REQUEST }
+
+func (m *DeleteSessionRequest) encodeIEs(e *ieEncoder) {
+	if m.Cause != nil {
+		e.add(IE_CAUSE, 0, m.Cause.bytes())
+	}
+	e.addOptUint8(IE_EBI, 0, m.LinkedEBI)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+	e.addOpt(IE_INDICATION, 0, m.Indication)
+	e.addFTEID(0, m.SenderFTEID)
+	e.addOpt(IE_UE_TIME_ZONE, 0, m.UETimeZone)
+}
+
+func (m *DeleteSessionRequest) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		var c Cause
+		c, err = decodeCause(ie.Value)
+		m.Cause = &c
+	case IE_EBI:
+		m.LinkedEBI, err = decodeOptUint8(ie.Value)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	case IE_INDICATION:
+		m.Indication = ie.Value
+	case IE_F_TEID:
+		var f FTEID
+		f, err = decodeFTEID(ie.Instance, ie.Value)
+		m.SenderFTEID = &f
+	case IE_UE_TIME_ZONE:
+		m.UETimeZone = ie.Value
+	}
+	return err
+}
+
+func (*DeleteSessionRequest) mandatoryIEs() []uint8 { return nil }
+
+// DeleteSessionResponse is TS 29.274 7.2.10.
+type DeleteSessionResponse struct {
+	Cause    Cause
+	Recovery *uint8
+	PCO      []byte
+}
+
+func (*DeleteSessionResponse) MessageType() uint8 { return MSG_DELETE_SESSION_RESPONSE }
+
+func (m *DeleteSessionResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	e.addOpt(IE_PCO, 0, m.PCO)
+}
+
+func (m *DeleteSessionResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_PCO:
+		m.PCO = ie.Value
+	}
+	return err
+}
+
+func (*DeleteSessionResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// ReleaseAccessBearersRequest is TS 29.274 7.2.21. S1-U bearers of all
+// PDN connections of the UE are released when ListOfRABs is empty.
+type ReleaseAccessBearersRequest struct {
+	ListOfRABs []uint8
+	Indication []byte
+}
+
+func (*ReleaseAccessBearersRequest) MessageType() uint8 { return MSG_RELEASE_ACCESS_BEARERS_REQUEST }
+
+func (m *ReleaseAccessBearersRequest) encodeIEs(e *ieEncoder) {
+	for _, ebi := range m.ListOfRABs {
+		e.add(IE_EBI, 0, []byte{ebi})
+	}
+	e.addOpt(IE_INDICATION, 0, m.Indication)
+}
+
+func (m *ReleaseAccessBearersRequest) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_EBI:
+		var ebi uint8
+		if ebi, err = decodeUint8(ie.Value); err == nil {
+			m.ListOfRABs = append(m.ListOfRABs, ebi)
+		}
+	case IE_INDICATION:
+		m.Indication = ie.Value
+	}
+	return err
+}
+
+func (*ReleaseAccessBearersRequest) mandatoryIEs() []uint8 { return nil }
+
+// ReleaseAccessBearersResponse is TS 29.274 7.2.22.
+type ReleaseAccessBearersResponse struct {
+	Cause    Cause
+	Recovery *uint8
+}
+
+func (*ReleaseAccessBearersResponse) MessageType() uint8 {
+	return MSG_RELEASE_ACCESS_BEARERS_RESPONSE
+}
+
+func (m *ReleaseAccessBearersResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+}
+
+func (m *ReleaseAccessBearersResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	}
+	return err
+}
+
+func (*ReleaseAccessBearersResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// DownlinkDataNotification is TS 29.274 7.2.11.1 sent by SGW when
+// downlink data arrives for the UE in ECM-IDLE. EBI and ARP are of the
+// bearer which received the data.
+type DownlinkDataNotification struct {
+	Cause       *Cause
+	EBI         *uint8
+	ARP         *ARP
+	IMSI        string
+	SenderFTEID *FTEID
+	Indication  []byte
+}
+
+func (*DownlinkDataNotification) MessageType() uint8 { return MSG_DOWNLINK_DATA_NOTIFICATION }
+
+func (m *DownlinkDataNotification) encodeIEs(e *ieEncoder) {
+	if m.Cause != nil {
+		e.add(IE_CAUSE, 0, m.Cause.bytes())
+	}
+	e.addOptUint8(IE_EBI, 0, m.EBI)
+	if m.ARP != nil {
+		e.add(IE_ARP, 0, m.ARP.bytes())
+	}
+	e.addDigits(IE_IMSI, 0, m.IMSI)
+	e.addFTEID(0, m.SenderFTEID)
+	e.addOpt(IE_INDICATION, 0, m.Indication)
+}
+
+func (m *DownlinkDataNotification) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		var c Cause
+		c, err = decodeCause(ie.Value)
+		m.Cause = &c
+	case IE_EBI:
+		m.EBI, err = decodeOptUint8(ie.Value)
+	case IE_ARP:
+		var a ARP
+		a, err = decodeARP(ie.Value)
+		m.ARP = &a
+	case IE_IMSI:
+		m.IMSI = decodeTBCD(ie.Value)
+	case IE_F_TEID:
+		var f FTEID
+		f, err = decodeFTEID(ie.Instance, ie.Value)
+		m.SenderFTEID = &f
+	case IE_INDICATION:
+		m.Indication = ie.Value
+	}
+	return err
+}
+
+func (*DownlinkDataNotification) mandatoryIEs() []uint8 { return nil }
+
+// DownlinkDataNotificationAck is TS 29.274 7.2.11.2. DelayValue is in
+// multiples of 50 milliseconds.
+type DownlinkDataNotificationAck struct {
+	Cause      Cause
+	DelayValue *uint8
+	Recovery   *uint8
+	IMSI       string
+}
+
+func (*DownlinkDataNotificationAck) MessageType() uint8 {
+	return MSG_DOWNLINK_DATA_NOTIFICATION_ACK
+}
+
+func (m *DownlinkDataNotificationAck) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addOptUint8(IE_DELAY_VALUE, 0, m.DelayValue)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	e.addDigits(IE_IMSI, 0, m.IMSI)
+}
+
+func (m *DownlinkDataNotificationAck) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_DELAY_VALUE:
+		m.DelayValue, err = decodeOptUint8(ie.Value)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_IMSI:
+		m.IMSI = decodeTBCD(ie.Value)
+	}
+	return err
+}
+
+func (*DownlinkDataNotificationAck) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// DownlinkDataNotificationFailureIndication is TS 29.274 7.2.11.3 sent by
+// MME when paging of the UE fails. It has no response.
+type DownlinkDataNotificationFailureIndication struct {
+	Cause           Cause
+	OriginatingNode *uint8
+	IMSI            string
+}
+
+func (*DownlinkDataNotificationFailureIndication) MessageType() uint8 {
+	return MSG_DOWNLINK_DATA_NOTIFICATION_FAILURE
+}
+
+func (m *DownlinkDataNotificationFailureIndication) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addOptUint8(IE_NODE_TYPE, 0, m.OriginatingNode)
+	e.addDigits(IE_IMSI, 0, m.IMSI)
+}
+
+func (m *DownlinkDataNotificationFailureIndication) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_NODE_TYPE:
+		m.OriginatingNode, err = decodeOptUint8(ie.Value)
+	case IE_IMSI:
+		m.IMSI = decodeTBCD(ie.Value)
+	}
+	return err
+}
+
+func (*DownlinkDataNotificationFailureIndication) mandatoryIEs() []uint8 {
+	return []uint8{IE_CAUSE}
+}
+
+// CreateBearerRequest is TS 29.274 7.2.3 sent by the SGW to create
+// dedicated bearers of the PDN connection of LinkedEBI. EBI of the bearer
+// contexts is zero since it is allocated by the MME.
+type CreateBearerRequest struct {
+	PTI            *uint8
+	LinkedEBI      uint8
+	PCO            []byte
+	BearerContexts []BearerContext
+}
+
+func (*CreateBearerRequest) MessageType() uint8 { return MSG_CREATE_BEARER_REQUEST }
+
+func (m *CreateBearerRequest) encodeIEs(e *ieEncoder) {
+	e.addOptUint8(IE_PTI, 0, m.PTI)
+	e.addUint8(IE_EBI, 0, m.LinkedEBI)
+	e.addOpt(IE_PCO, 0, m.PCO)
+	e.addBearerContexts(0, m.BearerContexts)
+}
+
+func (m *CreateBearerRequest) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_PTI:
+		m.PTI, err = decodeOptUint8(ie.Value)
+	case IE_EBI:
+		m.LinkedEBI, err = decodeUint8(ie.Value)
+	case IE_PCO:
+		m.PCO = ie.Value
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	}
+	return err
+}
+
+func (*CreateBearerRequest) mandatoryIEs() []uint8 {
+	return []uint8{IE_EBI, IE_BEARER_CONTEXT}
+}
+
+// CreateBearerResponse is TS 29.274 7.2.4. Each bearer context has the
+// cause of the bearer and S1-U F-TEIDs of the eNB and the SGW when it is
+// created.
+type CreateBearerResponse struct {
+	Cause          Cause
+	BearerContexts []BearerContext
+	Recovery       *uint8
+	ULI            *ULI
+	UETimeZone     []byte
+}
+
+func (*CreateBearerResponse) MessageType() uint8 { return MSG_CREATE_BEARER_RESPONSE }
+
+func (m *CreateBearerResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+	e.addOpt(IE_UE_TIME_ZONE, 0, m.UETimeZone)
+}
+
+func (m *CreateBearerResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	case IE_UE_TIME_ZONE:
+		m.UETimeZone = ie.Value
+	}
+	return err
+}
+
+func (*CreateBearerResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// UpdateBearerRequest is TS 29.274 7.2.15 sent by the SGW to modify QoS or
+// TFT of the bearers. AMBR is APN-AMBR of the PDN connection.
+type UpdateBearerRequest struct {
+	BearerContexts []BearerContext
+	PTI            *uint8
+	PCO            []byte
+	AMBR           AMBR
+}
+
+func (*UpdateBearerRequest) MessageType() uint8 { return MSG_UPDATE_BEARER_REQUEST }
+
+func (m *UpdateBearerRequest) encodeIEs(e *ieEncoder) {
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_PTI, 0, m.PTI)
+	e.addOpt(IE_PCO, 0, m.PCO)
+	e.add(IE_AMBR, 0, m.AMBR.bytes())
+}
+
+func (m *UpdateBearerRequest) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_PTI:
+		m.PTI, err = decodeOptUint8(ie.Value)
+	case IE_PCO:
+		m.PCO = ie.Value
+	case IE_AMBR:
+		m.AMBR, err = decodeAMBR(ie.Value)
+	}
+	return err
+}
+
+func (*UpdateBearerRequest) mandatoryIEs() []uint8 {
+	return []uint8{IE_BEARER_CONTEXT, IE_AMBR}
+}
+
+// UpdateBearerResponse is TS 29.274 7.2.16. Each bearer context has the
+// cause of the bearer.
+type UpdateBearerResponse struct {
+	Cause          Cause
+	BearerContexts []BearerContext
+	Recovery       *uint8
+	ULI            *ULI
+	UETimeZone     []byte
+}
+
+func (*UpdateBearerResponse) MessageType() uint8 { return MSG_UPDATE_BEARER_RESPONSE }
+
+func (m *UpdateBearerResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+	e.addOpt(IE_UE_TIME_ZONE, 0, m.UETimeZone)
+}
+
+func (m *UpdateBearerResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	case IE_UE_TIME_ZONE:
+		m.UETimeZone = ie.Value
+	}
+	return err
+}
+
+func (*UpdateBearerResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// DeleteBearerRequest is TS 29.274 7.2.9.2 sent by the SGW to delete the
+// dedicated bearers of EBIs, or the PDN connection of LinkedEBI with all
+// of its bearers. EBIs are instance 1.
+type DeleteBearerRequest struct {
+	LinkedEBI      *uint8
+	EBIs           []uint8
+	BearerContexts []BearerContext
+	PTI            *uint8
+	PCO            []byte
+	Cause          *Cause
+}
+
+func (*DeleteBearerRequest) MessageType() uint8 { return MSG_DELETE_BEARER_REQUEST }
+
+func (m *DeleteBearerRequest) encodeIEs(e *ieEncoder) {
+	e.addOptUint8(IE_EBI, 0, m.LinkedEBI)
+	for _, ebi := range m.EBIs {
+		e.addUint8(IE_EBI, 1, ebi&0x0f)
+	}
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_PTI, 0, m.PTI)
+	e.addOpt(IE_PCO, 0, m.PCO)
+	if m.Cause != nil {
+		e.add(IE_CAUSE, 0, m.Cause.bytes())
+	}
+}
+
+func (m *DeleteBearerRequest) decodeIE(ie *IE) (err error) {
+	if ie.Type == IE_EBI && ie.Instance == 1 {
+		var ebi uint8
+		ebi, err = decodeUint8(ie.Value)
+		m.EBIs = append(m.EBIs, ebi&0x0f)
+		return err
+	}
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_EBI:
+		m.LinkedEBI, err = decodeOptUint8(ie.Value)
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_PTI:
+		m.PTI, err = decodeOptUint8(ie.Value)
+	case IE_PCO:
+		m.PCO = ie.Value
+	case IE_CAUSE:
+		var c Cause
+		c, err = decodeCause(ie.Value)
+		m.Cause = &c
+	}
+	return err
+}
+
+func (*DeleteBearerRequest) mandatoryIEs() []uint8 { return nil }
+
+// DeleteBearerResponse is TS 29.274 7.2.10.2. LinkedEBI is present when
+// the PDN connection is deleted, otherwise the bearer contexts have the
+// cause of each bearer.
+type DeleteBearerResponse struct {
+	Cause          Cause
+	LinkedEBI      *uint8
+	BearerContexts []BearerContext
+	Recovery       *uint8
+	ULI            *ULI
+	UETimeZone     []byte
+}
+
+func (*DeleteBearerResponse) MessageType() uint8 { return MSG_DELETE_BEARER_RESPONSE }
+
+func (m *DeleteBearerResponse) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addOptUint8(IE_EBI, 0, m.LinkedEBI)
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+	e.addOpt(IE_UE_TIME_ZONE, 0, m.UETimeZone)
+}
+
+func (m *DeleteBearerResponse) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_EBI:
+		m.LinkedEBI, err = decodeOptUint8(ie.Value)
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	case IE_UE_TIME_ZONE:
+		m.UETimeZone = ie.Value
+	}
+	return err
+}
+
+func (*DeleteBearerResponse) mandatoryIEs() []uint8 { return []uint8{IE_CAUSE} }
+
+// DeleteBearerCommand is TS 29.274 7.2.17.1 sent by the MME to request
+// deletion of the bearers released by the eNB. The SGW answers it by
+// Delete Bearer Request with the same sequence number or by Delete Bearer
+// Failure Indication.
+type DeleteBearerCommand struct {
+	BearerContexts []BearerContext
+	ULI            *ULI
+}
+
+func (*DeleteBearerCommand) MessageType() uint8 { return MSG_DELETE_BEARER_COMMAND }
+
+func (m *DeleteBearerCommand) encodeIEs(e *ieEncoder) {
+	e.addBearerContexts(0, m.BearerContexts)
+	if m.ULI != nil {
+		e.add(IE_ULI, 0, m.ULI.bytes())
+	}
+}
+
+func (m *DeleteBearerCommand) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_ULI:
+		var u ULI
+		u, err = decodeULI(ie.Value)
+		m.ULI = &u
+	}
+	return err
+}
+
+func (*DeleteBearerCommand) mandatoryIEs() []uint8 { return []uint8{IE_BEARER_CONTEXT} }
+
+// DeleteBearerFailureIndication is TS 29.274 7.2.18.1. Each bearer context
+// has the cause of the bearer which could not be deleted.
+type DeleteBearerFailureIndication struct {
+	Cause          Cause
+	BearerContexts []BearerContext
+	Recovery       *uint8
+}
+
+func (*DeleteBearerFailureIndication) MessageType() uint8 {
+	return MSG_DELETE_BEARER_FAILURE_INDICATION
+}
+
+func (m *DeleteBearerFailureIndication) encodeIEs(e *ieEncoder) {
+	e.add(IE_CAUSE, 0, m.Cause.bytes())
+	e.addBearerContexts(0, m.BearerContexts)
+	e.addOptUint8(IE_RECOVERY, 0, m.Recovery)
+}
+
+func (m *DeleteBearerFailureIndication) decodeIE(ie *IE) (err error) {
+	if ie.Instance != 0 {
+		return nil
+	}
+	switch ie.Type {
+	case IE_CAUSE:
+		m.Cause, err = decodeCause(ie.Value)
+	case IE_BEARER_CONTEXT:
+		var bc BearerContext
+		bc, err = decodeBearerContext(ie.Value)
+		m.BearerContexts = append(m.BearerContexts, bc)
+	case IE_RECOVERY:
+		m.Recovery, err = decodeOptUint8(ie.Value)
+	}
+	return err
+}
+
+func (*DeleteBearerFailureIndication) mandatoryIEs() []uint8 {
+	return []uint8{IE_CAUSE, IE_BEARER_CONTEXT}
+}
+
+func init() {
+	registerMessage(func() Message { return &EchoRequest{} })
+	registerMessage(func() Message { return &EchoResponse{} })
+	registerMessage(func() Message { return &CreateSessionRequest{} })
+	registerMessage(func() Message { return &CreateSessionResponse{} })
+	registerMessage(func() Message { return &ModifyBearerRequest{} })
+	registerMessage(func() Message { return &ModifyBearerResponse{} })
+	registerMessage(func() Message { return &DeleteSessionRequest{} })
+	registerMessage(func() Message { return &DeleteSessionResponse{} })
+	registerMessage(func() Message { return &ReleaseAccessBearersRequest{} })
+	registerMessage(func() Message { return &ReleaseAccessBearersResponse{} })
+	registerMessage(func() Message { return &DownlinkDataNotification{} })
+	registerMessage(func() Message { return &DownlinkDataNotificationAck{} })
+	registerMessage(func() Message { return &DownlinkDataNotificationFailureIndication{} })
+	registerMessage(func() Message { return &CreateBearerRequest{} })
+	registerMessage(func() Message { return &CreateBearerResponse{} })
+	registerMessage(func() Message { return &UpdateBearerRequest{} })
+	registerMessage(func() Message { return &UpdateBearerResponse{} })
+	registerMessage(func() Message { return &DeleteBearerRequest{} })
+	registerMessage(func() Message { return &DeleteBearerResponse{} })
+	registerMessage(func() Message { return &DeleteBearerCommand{} })
+	registerMessage(func() Message { return &DeleteBearerFailureIndication{} })
+}
@@ -1,6 +1,12 @@
 package mme
 
-import "net"
+import (
+	"fmt"
+	"net"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
 
 func (*Server) ListenAddrAdd(ips []net.IP) {
 
@@ -16,3 +22,113 @@ func (*Server) ListenAddrDel(ips []net.IP) {
 func (*Server) ListenAddrSet(ips []net.IP) {
 
 }
+
+// MMENameSet set MME name which is sent in S1 Setup Response. Empty name
+// omits the IE.
+func (s *Server) MMENameSet(name string) {
+	s.conf.mmeName = name
+}
+
+// ServedGUMMEIsSet set served PLMNs, MME group IDs and MME codes of the MME.
+func (s *Server) ServedGUMMEIsSet(gummeis s1ap.ServedGUMMEIs) {
+	s.conf.servedGUMMEIs = gummeis
+}
+
+// RelativeCapacitySet set relative MME capacity for MME load balancing.
+func (s *Server) RelativeCapacitySet(capacity uint8) {
+	s.conf.relativeCapacity = capacity
+}
+
+// RelaySupportSet set whether the MME supports relay node.
+func (s *Server) RelaySupportSet(support bool) {
+	s.conf.relaySupport = support
+}
+
+// TACsSet set served TACs. When tacs is empty slice, all of TACs are served.
+func (s *Server) TACsSet(tacs []uint16) {
+	s.conf.tacs = tacs
+}
+
+// S11AddrSet set local address of S11 interface. It takes effect when the
+// server is started.
+func (s *Server) S11AddrSet(ip net.IP) {
+	s.conf.s11Addr = ip
+}
+
+// SGWAddrSet set S11 address of the SGW. It takes effect when the server is
+// started.
+func (s *Server) SGWAddrSet(ip net.IP) {
+	s.conf.sgwAddr = ip
+}
+
+// PGWAddrSet set S5/S8 address of the PGW which is sent to the SGW in
+// Create Session Request. When ip is nil, SGW address is used.
+func (s *Server) PGWAddrSet(ip net.IP) {
+	s.conf.pgwAddr = ip
+}
+
+// ENBLookup returns the eNB which completed S1 Setup with the Global-eNB-ID.
+func (s *Server) ENBLookup(id s1ap.GlobalENBID) *ENB {
+	return s.enbs.lookupByID(id)
+}
+
+// ENBList returns eNBs which completed S1 Setup.
+func (s *Server) ENBList() []*ENB {
+	return s.enbs.list()
+}
+
+// UELookup returns UE context of the IMSI.
+func (s *Server) UELookup(imsi string) *UEContext {
+	return s.ues.lookupByIMSI(imsi)
+}
+
+// GUTIReallocate starts GUTI reallocation of the UE of the IMSI. The UE
+// must be registered and in ECM-CONNECTED.
+func (s *Server) GUTIReallocate(imsi string) error {
+	ue := s.ues.lookupByIMSI(imsi)
+	if ue == nil {
+		return fmt.Errorf("Unknown IMSI %s", imsi)
+	}
+	if s.events == nil {
+		return fmt.Errorf("MME is not started")
+	}
+	s.inject(&Event{Type: EVENT_GUTI_REALLOCATION, UE: ue})
+	return nil
+}
+
+// Detach detaches the UE of the IMSI. The UE in ECM-CONNECTED is requested
+// to detach and is asked to re-attach when reattach is true. The UE in
+// ECM-IDLE is detached locally.
+func (s *Server) Detach(imsi string, reattach bool) error {
+	ue := s.ues.lookupByIMSI(imsi)
+	if ue == nil {
+		return fmt.Errorf("Unknown IMSI %s", imsi)
+	}
+	if s.events == nil {
+		return fmt.Errorf("MME is not started")
+	}
+	typ := uint8(nas.DETACH_TYPE_NO_RE_ATTACH)
+	if reattach {
+		typ = nas.DETACH_TYPE_RE_ATTACH
+	}
+	s.inject(&Event{Type: EVENT_DETACH, UE: ue, Msg: typ})
+	return nil
+}
+
+// Page pages the UE of the IMSI. The UE must be registered and in ECM-IDLE.
+func (s *Server) Page(imsi string) error {
+	ue := s.ues.lookupByIMSI(imsi)
+	if ue == nil {
+		return fmt.Errorf("Unknown IMSI %s", imsi)
+	}
+	if s.events == nil {
+		return fmt.Errorf("MME is not started")
+	}
+	s.inject(&Event{Type: EVENT_PAGING, UE: ue})
+	return nil
+}
+
+// UECount returns number of UE contexts.
+func (s *Server) UECount() int {
+	return s.ues.count()
+}
@@ -0,0 +1,796 @@
+package mme
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/gtpv2"
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// bearerProcedureType is the dedicated bearer procedure requested by the
+// SGW.
+type bearerProcedureType int
+
+const (
+	BEARER_PROC_ACTIVATE bearerProcedureType = iota
+	BEARER_PROC_MODIFY
+	BEARER_PROC_DEACTIVATE
+)
+
+var bearerProcedureStr = map[bearerProcedureType]string{
+	BEARER_PROC_ACTIVATE:   "Dedicated Bearer Activation",
+	BEARER_PROC_MODIFY:     "Bearer Modification",
+	BEARER_PROC_DEACTIVATE: "Bearer Deactivation",
+}
+
+func (t bearerProcedureType) String() string {
+	if str, ok := bearerProcedureStr[t]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// bearerTimer is the ESM timer which supervises the procedure.
+var bearerTimer = map[bearerProcedureType]EventType{
+	BEARER_PROC_ACTIVATE:   EVENT_TIMER_T3485,
+	BEARER_PROC_MODIFY:     EVENT_TIMER_T3486,
+	BEARER_PROC_DEACTIVATE: EVENT_TIMER_T3495,
+}
+
+// bearerOp is a bearer of the procedure. erab and esm are set when the eNB
+// and the UE have answered for the bearer. cause is the GTP cause of the
+// bearer which is set by the first failure. qos and tft are the new QoS
+// and TFT of the modification. pdu is the ESM message retransmitted on the
+// ESM timer expiry.
+type bearerOp struct {
+	bearer     *Bearer
+	qos        *gtpv2.BearerQoS
+	tft        []byte
+	pdu        []byte
+	erab       bool
+	esm        bool
+	ueAccepted bool
+	cause      uint8
+}
+
+func (op *bearerOp) fail(cause uint8) {
+	if op.cause == gtpv2.CAUSE_REQUEST_ACCEPTED {
+		op.cause = cause
+	}
+}
+
+func (op *bearerOp) accepted() bool {
+	return op.cause == gtpv2.CAUSE_REQUEST_ACCEPTED
+}
+
+func (op *bearerOp) done() bool {
+	return op.erab && op.esm
+}
+
+// bearerProcedure is the dedicated bearer procedure of the UE. The SGW is
+// answered when the eNB and the UE have answered for all of the bearers.
+// The procedure of the UE in ECM-IDLE is started when the UE answers
+// paging. ambr is APN-AMBR of the modification.
+type bearerProcedure struct {
+	typ     bearerProcedureType
+	req     *s11Request
+	ops     []*bearerOp
+	ambr    *gtpv2.AMBR
+	pti     uint8
+	pco     []byte
+	started bool
+	retrans int
+}
+
+func (p *bearerProcedure) op(ebi uint8) *bearerOp {
+	for _, op := range p.ops {
+		if op.bearer.EBI == ebi && ebi != 0 {
+			return op
+		}
+	}
+	return nil
+}
+
+// allocEBI allocates EPS bearer identity which is used neither by the
+// bearers of the UE nor by the bearers being activated.
+func (p *bearerProcedure) allocEBI(ue *UEContext) (uint8, error) {
+	for ebi := uint8(EBI_MIN); ebi <= EBI_MAX; ebi++ {
+		if _, ok := ue.Bearers[ebi]; !ok && p.op(ebi) == nil {
+			return ebi, nil
+		}
+	}
+	return 0, fmt.Errorf("EPS bearer identity exhausted")
+}
+
+// epsQoS returns NAS EPS QoS of the bearer.
+func epsQoS(b *Bearer) nas.EPSQoS {
+	return nas.EPSQoS{
+		QCI:   b.QCI,
+		MBRUL: b.MBRUL / 1000,
+		MBRDL: b.MBRDL / 1000,
+		GBRUL: b.GBRUL / 1000,
+		GBRDL: b.GBRDL / 1000,
+	}
+}
+
+// protectESM encodes the ESM message security protected.
+func protectESM(ue *UEContext, m nas.Message) ([]byte, error) {
+	pdu, err := nas.Encode(m)
+	if err != nil {
+		return nil, err
+	}
+	return ue.Security.protect(pdu, nas.SECURITY_HEADER_INTEGRITY_CIPHERED)
+}
+
+// emmBearerProcedureAcceptable returns true when the UE runs no other
+// dedicated bearer procedure nor handover.
+func emmBearerProcedureAcceptable(s *Server, ev *Event) bool {
+	return ev.UE.bearerProc == nil && ev.UE.handover == nil
+}
+
+// emmBearerRequestReject rejects the bearer request from the SGW. The UE
+// which is not registered has no context and the request is rejected
+// temporarily while other procedure or handover is in progress.
+func emmBearerRequestReject(s *Server, ev *Event) error {
+	req := ev.Msg.(*s11Request)
+	cause := uint8(gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+	switch ev.UE.EMMState {
+	case EMM_STATE_REGISTERED:
+		cause = gtpv2.CAUSE_REQUEST_REJECTED
+		if ev.UE.handover != nil {
+			cause = gtpv2.CAUSE_TEMPORARILY_REJECTED_HO
+		}
+	case EMM_STATE_COMMON_PROCEDURE_INITIATED:
+		cause = gtpv2.CAUSE_TEMPORARILY_REJECTED_HO
+	}
+	log.Printf("Reject %s of %v with cause %d", gtpv2.MessageName(req.msg), ev.UE, cause)
+	return s.rejectS11(ev.UE, req, cause)
+}
+
+// rejectS11 answers the request from the SGW with the cause.
+func (s *Server) rejectS11(ue *UEContext, req *s11Request, cause uint8) error {
+	r, ok := s11RequestEvent[req.msg.MessageType()]
+	if !ok {
+		return fmt.Errorf("%s can not be rejected", gtpv2.MessageName(req.msg))
+	}
+	return s.respondS11(ue, req, r.reject(gtpv2.Cause{Value: cause}))
+}
+
+// emmCreateBearerRequest activates dedicated bearers of the PDN connection
+// requested by the SGW. Bearer context without QoS or S1-U F-TEID of the
+// SGW is rejected.
+func emmCreateBearerRequest(s *Server, ev *Event) error {
+	ue := ev.UE
+	req := ev.Msg.(*s11Request)
+	cbr := req.msg.(*gtpv2.CreateBearerRequest)
+	linked, ok := ue.Bearers[cbr.LinkedEBI]
+	if !ok || linked.LinkedEBI != 0 {
+		log.Printf("Create Bearer Request of %v: unknown linked EBI %d", ue, cbr.LinkedEBI)
+		return s.rejectS11(ue, req, gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+	}
+	p := &bearerProcedure{typ: BEARER_PROC_ACTIVATE, req: req, pco: cbr.PCO}
+	if cbr.PTI != nil {
+		p.pti = *cbr.PTI
+	}
+	for i := range cbr.BearerContexts {
+		bc := &cbr.BearerContexts[i]
+		op := &bearerOp{bearer: &Bearer{}, cause: gtpv2.CAUSE_REQUEST_ACCEPTED}
+		p.ops = append(p.ops, op)
+		s1u := bc.FTEID(gtpv2.IF_TYPE_S1U_SGW)
+		if bc.BearerQoS == nil || s1u == nil {
+			op.erab, op.esm = true, true
+			op.fail(gtpv2.CAUSE_MANDATORY_IE_MISSING)
+			continue
+		}
+		ebi, err := p.allocEBI(ue)
+		if err != nil {
+			op.erab, op.esm = true, true
+			op.fail(gtpv2.CAUSE_NO_RESOURCES_AVAILABLE)
+			continue
+		}
+		op.bearer = &Bearer{
+			EBI:       ebi,
+			LinkedEBI: linked.EBI,
+			SGWAddr:   s1u.IP(),
+			SGWTEID:   s1u.TEID,
+			TFT:       bc.TFT,
+		}
+		applyBearerQoS(op.bearer, bc.BearerQoS)
+	}
+	return s.runBearerProcedure(ue, p)
+}
+
+// emmUpdateBearerRequest modifies QoS, TFT or APN-AMBR of the bearers
+// requested by the SGW.
+func emmUpdateBearerRequest(s *Server, ev *Event) error {
+	ue := ev.UE
+	req := ev.Msg.(*s11Request)
+	ubr := req.msg.(*gtpv2.UpdateBearerRequest)
+	p := &bearerProcedure{typ: BEARER_PROC_MODIFY, req: req, pco: ubr.PCO}
+	if ubr.PTI != nil {
+		p.pti = *ubr.PTI
+	}
+	if b := ue.defaultBearer(); b != nil &&
+		(uint64(ubr.AMBR.UL)*1000 != b.APNAMBRUL || uint64(ubr.AMBR.DL)*1000 != b.APNAMBRDL) {
+		p.ambr = &ubr.AMBR
+	}
+	for i := range ubr.BearerContexts {
+		bc := &ubr.BearerContexts[i]
+		b, ok := ue.Bearers[bc.EBI]
+		if !ok {
+			b = &Bearer{EBI: bc.EBI}
+		}
+		op := &bearerOp{bearer: b, qos: bc.BearerQoS, tft: bc.TFT, cause: gtpv2.CAUSE_REQUEST_ACCEPTED}
+		p.ops = append(p.ops, op)
+		if !ok {
+			op.erab, op.esm = true, true
+			op.fail(gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+			continue
+		}
+		op.erab = op.qos == nil
+	}
+	return s.runBearerProcedure(ue, p)
+}
+
+// emmPDNDeleted returns true when the SGW deletes the PDN connection.
+// Since the UE has only one PDN connection, the UE is detached.
+func emmPDNDeleted(s *Server, ev *Event) bool {
+	req, ok := ev.Msg.(*s11Request)
+	if !ok {
+		return false
+	}
+	dbr, ok := req.msg.(*gtpv2.DeleteBearerRequest)
+	return ok && dbr.LinkedEBI != nil
+}
+
+func emmPDNDeletedConnected(s *Server, ev *Event) bool {
+	return emmPDNDeleted(s, ev) && ecmConnected(s, ev)
+}
+
+// emmDeletePDN accepts deletion of the last PDN connection and detaches
+// the UE with re-attach required. The session has been deleted by the SGW
+// so that it is not deleted again on detach.
+func emmDeletePDN(s *Server, ev *Event) error {
+	ue := ev.UE
+	req := ev.Msg.(*s11Request)
+	ebi := *req.msg.(*gtpv2.DeleteBearerRequest).LinkedEBI
+	log.Printf("PDN connection of EBI %d of %v is deleted by SGW", ebi, ue)
+	s.abortBearerProcedure(ue, gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+	err := s.respondS11(ue, req, &gtpv2.DeleteBearerResponse{
+		Cause:     gtpv2.Cause{Value: gtpv2.CAUSE_REQUEST_ACCEPTED},
+		LinkedEBI: &ebi,
+	})
+	if err != nil {
+		log.Printf("Delete Bearer Response error: %v: %v", ue, err)
+	}
+	ue.SGWS11TEID = 0
+	ue.Bearers = map[uint8]*Bearer{}
+	if ue.ECMState == ECM_STATE_CONNECTED {
+		return emmNetworkDetach(s, ev)
+	}
+	return emmImplicitDetach(s, ev)
+}
+
+// emmDeleteBearerRequest deactivates the dedicated bearers requested by the
+// SGW. Bearers of the UE in ECM-IDLE are deactivated locally and the UE
+// learns it by EPS bearer context status.
+func emmDeleteBearerRequest(s *Server, ev *Event) error {
+	ue := ev.UE
+	req := ev.Msg.(*s11Request)
+	dbr := req.msg.(*gtpv2.DeleteBearerRequest)
+	p := &bearerProcedure{typ: BEARER_PROC_DEACTIVATE, req: req, pco: dbr.PCO}
+	if dbr.PTI != nil {
+		p.pti = *dbr.PTI
+	}
+	for _, ebi := range dbr.EBIs {
+		b, ok := ue.Bearers[ebi]
+		if !ok || b.LinkedEBI == 0 {
+			b = &Bearer{EBI: ebi}
+		}
+		op := &bearerOp{bearer: b, cause: gtpv2.CAUSE_REQUEST_ACCEPTED}
+		p.ops = append(p.ops, op)
+		if b.LinkedEBI == 0 {
+			op.erab, op.esm = true, true
+			op.fail(gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+			continue
+		}
+		op.erab = b.ENBTEID == 0
+		if ue.ECMState == ECM_STATE_IDLE {
+			op.erab, op.esm = true, true
+		}
+	}
+	return s.runBearerProcedure(ue, p)
+}
+
+// runBearerProcedure starts the procedure of the UE in ECM-CONNECTED. The
+// UE in ECM-IDLE is paged. The SGW is answered at once when no bearer is
+// left to be processed.
+func (s *Server) runBearerProcedure(ue *UEContext, p *bearerProcedure) error {
+	ue.bearerProc = p
+	pending := false
+	for _, op := range p.ops {
+		if !op.done() {
+			pending = true
+		}
+	}
+	if !pending {
+		return s.completeBearerProcedure(ue)
+	}
+	log.Printf("%v of %v", p.typ, ue)
+	if ue.ECMState == ECM_STATE_IDLE {
+		s.startPaging(ue, false)
+		return nil
+	}
+	return s.startBearerProcedure(ue)
+}
+
+// startBearerProcedure sends the ESM messages to the UE in E-RAB Setup,
+// E-RAB Modify or E-RAB Release. ESM message of the bearer which has no
+// E-RAB to be set up, modified or released is sent in Downlink NAS
+// Transport. The ESM timer supervises the answers of the UE.
+func (s *Server) startBearerProcedure(ue *UEContext) error {
+	p := ue.bearerProc
+	p.started = true
+	p.retrans = 0
+	for _, op := range p.ops {
+		if op.done() {
+			continue
+		}
+		m, err := s.bearerESMMessage(ue, p, op)
+		if err != nil {
+			return err
+		}
+		if op.pdu, err = protectESM(ue, m); err != nil {
+			return err
+		}
+	}
+	switch p.typ {
+	case BEARER_PROC_ACTIVATE:
+		req := &s1ap.ERABSetupRequest{
+			MMEUES1APID: ue.MMEUES1APID,
+			ENBUES1APID: ue.ENBUES1APID,
+		}
+		for _, op := range p.ops {
+			if op.done() {
+				continue
+			}
+			b := op.bearer
+			req.ERABToBeSetupListBearerSUReq = append(req.ERABToBeSetupListBearerSUReq,
+				s1ap.ERABToBeSetupItemBearerSUReq{
+					ERABID:                 s1ap.ERABID(b.EBI),
+					ERABLevelQoSParameters: erabQoS(b),
+					TransportLayerAddress:  transportLayerAddress(b.SGWAddr),
+					GTPTEID:                s1ap.GTPTEID(b.SGWTEID),
+					NASPDU:                 op.pdu,
+				})
+		}
+		s.sendUE(ue, req)
+	case BEARER_PROC_MODIFY:
+		req := &s1ap.ERABModifyRequest{
+			MMEUES1APID: ue.MMEUES1APID,
+			ENBUES1APID: ue.ENBUES1APID,
+		}
+		for _, op := range p.ops {
+			if op.done() {
+				continue
+			}
+			if op.erab {
+				s.sendDownlinkNAS(ue, op.pdu)
+				continue
+			}
+			b := *op.bearer
+			applyBearerQoS(&b, op.qos)
+			req.ERABToBeModifiedListBearerModReq = append(req.ERABToBeModifiedListBearerModReq,
+				s1ap.ERABToBeModifiedItemBearerModReq{
+					ERABID:                 s1ap.ERABID(b.EBI),
+					ERABLevelQoSParameters: erabQoS(&b),
+					NASPDU:                 op.pdu,
+				})
+		}
+		if len(req.ERABToBeModifiedListBearerModReq) > 0 {
+			s.sendUE(ue, req)
+		}
+	case BEARER_PROC_DEACTIVATE:
+		req := &s1ap.ERABReleaseCommand{
+			MMEUES1APID: ue.MMEUES1APID,
+			ENBUES1APID: ue.ENBUES1APID,
+		}
+		for _, op := range p.ops {
+			if op.done() {
+				continue
+			}
+			if op.erab || len(req.NASPDU) > 0 {
+				s.sendDownlinkNAS(ue, op.pdu)
+			} else {
+				req.NASPDU = op.pdu
+			}
+			if !op.erab {
+				req.ERABToBeReleasedList = append(req.ERABToBeReleasedList, s1ap.ERABItem{
+					ERABID: s1ap.ERABID(op.bearer.EBI),
+					Cause:  s1ap.Cause{Group: s1ap.CAUSE_NAS, Value: s1ap.CAUSE_NAS_NORMAL_RELEASE},
+				})
+			}
+		}
+		if len(req.ERABToBeReleasedList) > 0 {
+			s.sendUE(ue, req)
+		}
+	}
+	s.timerStart(ue, bearerTimer[p.typ])
+	return nil
+}
+
+// bearerESMMessage returns the ESM message of the bearer to the UE.
+func (s *Server) bearerESMMessage(ue *UEContext, p *bearerProcedure, op *bearerOp) (nas.Message, error) {
+	var pco *nas.PCO
+	if len(p.pco) > 0 {
+		v, err := nas.ParsePCO(p.pco)
+		if err != nil {
+			return nil, fmt.Errorf("PCO from PGW: %v", err)
+		}
+		pco = &v
+	}
+	b := op.bearer
+	header := nas.ESMHeader{EPSBearerIdentity: b.EBI, PTI: p.pti}
+	switch p.typ {
+	case BEARER_PROC_ACTIVATE:
+		return &nas.ActivateDedicatedEPSBearerContextRequest{
+			ESMHeader: header,
+			LinkedEBI: b.LinkedEBI,
+			EPSQoS:    epsQoS(b),
+			TFT:       b.TFT,
+			PCO:       pco,
+		}, nil
+	case BEARER_PROC_MODIFY:
+		req := &nas.ModifyEPSBearerContextRequest{
+			ESMHeader: header,
+			TFT:       op.tft,
+			PCO:       pco,
+		}
+		if op.qos != nil {
+			n := *b
+			applyBearerQoS(&n, op.qos)
+			q := epsQoS(&n)
+			req.NewEPSQoS = &q
+		}
+		if p.ambr != nil && b.LinkedEBI == 0 {
+			req.APNAMBR = &nas.APNAMBR{UL: uint64(p.ambr.UL), DL: uint64(p.ambr.DL)}
+		}
+		return req, nil
+	}
+	return &nas.DeactivateEPSBearerContextRequest{
+		ESMHeader: header,
+		ESMCause:  nas.ESM_CAUSE_REGULAR_DEACTIVATION,
+		PCO:       pco,
+	}, nil
+}
+
+// resumeBearerProcedure starts the procedure which waits for the UE to
+// answer paging.
+func (s *Server) resumeBearerProcedure(ue *UEContext) error {
+	if p := ue.bearerProc; p == nil || p.started {
+		return nil
+	}
+	return s.startBearerProcedure(ue)
+}
+
+// abortBearerProcedure rejects the procedure of the UE with the cause.
+func (s *Server) abortBearerProcedure(ue *UEContext, cause uint8) {
+	p := ue.bearerProc
+	if p == nil {
+		return
+	}
+	s.timerStop(ue, bearerTimer[p.typ])
+	ue.bearerProc = nil
+	log.Printf("Abort %v of %v with cause %d", p.typ, ue, cause)
+	if err := s.rejectS11(ue, p.req, cause); err != nil {
+		log.Printf("%v: %v", p.typ, err)
+	}
+}
+
+// finishBearerProcedure completes the procedure with the bearers which the
+// eNB or the UE has not answered as failed. Procedure which has not been
+// started since the UE did not answer paging is aborted.
+func (s *Server) finishBearerProcedure(ue *UEContext) error {
+	p := ue.bearerProc
+	if p == nil {
+		return nil
+	}
+	if !p.started {
+		s.abortBearerProcedure(ue, gtpv2.CAUSE_UNABLE_TO_PAGE_UE)
+		return nil
+	}
+	for _, op := range p.ops {
+		if !op.erab {
+			op.erab = true
+			op.fail(gtpv2.CAUSE_NO_RESOURCES_AVAILABLE)
+		}
+		if !op.esm {
+			op.esm = true
+			op.fail(gtpv2.CAUSE_UE_NOT_RESPONDING)
+		}
+	}
+	return s.completeBearerProcedure(ue)
+}
+
+// bearerProcedureProgress completes the procedure when the eNB and the UE
+// have answered for all of the bearers.
+func (s *Server) bearerProcedureProgress(ue *UEContext) error {
+	p := ue.bearerProc
+	for _, op := range p.ops {
+		if !op.done() {
+			return nil
+		}
+	}
+	return s.completeBearerProcedure(ue)
+}
+
+// bearerResponseCause returns the cause of the response to the SGW. The
+// cause of the first bearer is used when no bearer is accepted.
+func bearerResponseCause(ops []*bearerOp) gtpv2.Cause {
+	n := 0
+	for _, op := range ops {
+		if op.accepted() {
+			n++
+		}
+	}
+	switch {
+	case n == len(ops):
+		return gtpv2.Cause{Value: gtpv2.CAUSE_REQUEST_ACCEPTED}
+	case n > 0:
+		return gtpv2.Cause{Value: gtpv2.CAUSE_REQUEST_ACCEPTED_PARTIALLY}
+	}
+	return gtpv2.Cause{Value: ops[0].cause}
+}
+
+// completeBearerProcedure updates the bearers of the UE by the result and
+// answers the SGW with the cause of each bearer. Activated bearer which
+// either of the eNB or the UE has refused is released in the other.
+func (s *Server) completeBearerProcedure(ue *UEContext) error {
+	p := ue.bearerProc
+	s.timerStop(ue, bearerTimer[p.typ])
+	ue.bearerProc = nil
+	bcs := []gtpv2.BearerContext{}
+	release := &s1ap.ERABReleaseCommand{
+		MMEUES1APID: ue.MMEUES1APID,
+		ENBUES1APID: ue.ENBUES1APID,
+	}
+	for _, op := range p.ops {
+		b := op.bearer
+		cause := gtpv2.Cause{Value: op.cause}
+		bc := gtpv2.BearerContext{EBI: b.EBI, Cause: &cause}
+		switch {
+		case p.typ == BEARER_PROC_ACTIVATE && op.accepted():
+			ue.Bearers[b.EBI] = b
+			bc.FTEIDs = []gtpv2.FTEID{
+				newFTEID(0, gtpv2.IF_TYPE_S1U_ENB, b.ENBTEID, b.ENBAddr),
+				newFTEID(1, gtpv2.IF_TYPE_S1U_SGW, b.SGWTEID, b.SGWAddr),
+			}
+		case p.typ == BEARER_PROC_ACTIVATE:
+			if b.ENBTEID != 0 {
+				release.ERABToBeReleasedList = append(release.ERABToBeReleasedList, s1ap.ERABItem{
+					ERABID: s1ap.ERABID(b.EBI),
+					Cause:  s1ap.Cause{Group: s1ap.CAUSE_NAS, Value: s1ap.CAUSE_NAS_UNSPECIFIED},
+				})
+			}
+			if op.ueAccepted {
+				err := s.sendNASMessageOnce(ue, &nas.DeactivateEPSBearerContextRequest{
+					ESMHeader: nas.ESMHeader{EPSBearerIdentity: b.EBI},
+					ESMCause:  nas.ESM_CAUSE_NETWORK_FAILURE,
+				})
+				if err != nil {
+					log.Printf("Deactivate EPS Bearer Context Request: %v", err)
+				}
+			}
+		case p.typ == BEARER_PROC_MODIFY && op.accepted():
+			if op.qos != nil {
+				applyBearerQoS(b, op.qos)
+			}
+			if len(op.tft) > 0 {
+				b.TFT = op.tft
+			}
+		case p.typ == BEARER_PROC_DEACTIVATE && op.accepted():
+			delete(ue.Bearers, b.EBI)
+		}
+		log.Printf("%v of EBI %d of %v: cause %d", p.typ, b.EBI, ue, op.cause)
+		bcs = append(bcs, bc)
+	}
+	if len(release.ERABToBeReleasedList) > 0 {
+		s.sendUE(ue, release)
+	}
+	cause := bearerResponseCause(p.ops)
+	var resp gtpv2.Message
+	switch p.typ {
+	case BEARER_PROC_ACTIVATE:
+		resp = &gtpv2.CreateBearerResponse{Cause: cause, BearerContexts: bcs}
+	case BEARER_PROC_MODIFY:
+		if b := ue.defaultBearer(); b != nil && p.ambr != nil && cause.Accepted() {
+			b.APNAMBRUL = uint64(p.ambr.UL) * 1000
+			b.APNAMBRDL = uint64(p.ambr.DL) * 1000
+		}
+		resp = &gtpv2.UpdateBearerResponse{Cause: cause, BearerContexts: bcs}
+	case BEARER_PROC_DEACTIVATE:
+		resp = &gtpv2.DeleteBearerResponse{Cause: cause, BearerContexts: bcs}
+	}
+	return s.respondS11(ue, p.req, resp)
+}
+
+// emmBearerCanRetransmit returns true when the ESM messages of the bearer
+// procedure can be retransmitted on the ESM timer expiry.
+func emmBearerCanRetransmit(s *Server, ev *Event) bool {
+	p := ev.UE.bearerProc
+	return p != nil && p.retrans < nasMaxRetransmission
+}
+
+// emmBearerRetransmit retransmits the ESM messages which the UE has not
+// answered in Downlink NAS Transport.
+func emmBearerRetransmit(s *Server, ev *Event) error {
+	p := ev.UE.bearerProc
+	p.retrans++
+	log.Printf("Retransmit ESM messages (%d) by %v", p.retrans, ev.Type)
+	for _, op := range p.ops {
+		if !op.esm && op.pdu != nil {
+			s.sendDownlinkNAS(ev.UE, op.pdu)
+		}
+	}
+	s.timerStart(ev.UE, ev.Type)
+	return nil
+}
+
+// emmBearerAbort completes the procedure which the UE does not answer.
+func emmBearerAbort(s *Server, ev *Event) error {
+	log.Printf("ESM procedure of %v is not answered by %v", ev.UE, ev.Type)
+	return s.finishBearerProcedure(ev.UE)
+}
+
+// bearerESMAnswer records the answer of the UE for the bearer. ESM cause
+// is zero when the UE accepts.
+func (s *Server) bearerESMAnswer(ue *UEContext, typ bearerProcedureType, ebi, esmCause uint8) error {
+	p := ue.bearerProc
+	if p == nil || p.typ != typ || !p.started {
+		return fmt.Errorf("no %v of EBI %d", typ, ebi)
+	}
+	op := p.op(ebi)
+	if op == nil || op.esm {
+		return fmt.Errorf("%v: unexpected answer for EBI %d", typ, ebi)
+	}
+	op.esm = true
+	if esmCause == 0 {
+		op.ueAccepted = true
+	} else {
+		log.Printf("%v of EBI %d is rejected by ESM cause %d", typ, ebi, esmCause)
+		op.fail(gtpv2.CAUSE_UE_REFUSES)
+	}
+	return s.bearerProcedureProgress(ue)
+}
+
+func emmActivateDedicatedBearerAccept(s *Server, ev *Event) error {
+	m := ev.Msg.(*nas.ActivateDedicatedEPSBearerContextAccept)
+	return s.bearerESMAnswer(ev.UE, BEARER_PROC_ACTIVATE, m.EPSBearerIdentity, 0)
+}
+
+func emmActivateDedicatedBearerReject(s *Server, ev *Event) error {
+	m := ev.Msg.(*nas.ActivateDedicatedEPSBearerContextReject)
+	return s.bearerESMAnswer(ev.UE, BEARER_PROC_ACTIVATE, m.EPSBearerIdentity, m.ESMCause)
+}
+
+func emmModifyBearerAccept(s *Server, ev *Event) error {
+	m := ev.Msg.(*nas.ModifyEPSBearerContextAccept)
+	return s.bearerESMAnswer(ev.UE, BEARER_PROC_MODIFY, m.EPSBearerIdentity, 0)
+}
+
+func emmModifyBearerReject(s *Server, ev *Event) error {
+	m := ev.Msg.(*nas.ModifyEPSBearerContextReject)
+	return s.bearerESMAnswer(ev.UE, BEARER_PROC_MODIFY, m.EPSBearerIdentity, m.ESMCause)
+}
+
+func emmDeactivateBearerAccept(s *Server, ev *Event) error {
+	m := ev.Msg.(*nas.DeactivateEPSBearerContextAccept)
+	return s.bearerESMAnswer(ev.UE, BEARER_PROC_DEACTIVATE, m.EPSBearerIdentity, 0)
+}
+
+// erabAnswered records the answer of the eNB. E-RAB which the eNB has not
+// set up or modified is failed and its ESM message is not delivered to
+// the UE. E-RABs missing in the response are failed likewise.
+func (s *Server) erabAnswered(ue *UEContext, typ bearerProcedureType, ok []s1ap.ERABID, failed s1ap.ERABList) error {
+	p := ue.bearerProc
+	if p == nil || p.typ != typ || !p.started {
+		return fmt.Errorf("no %v", typ)
+	}
+	for _, id := range ok {
+		if op := p.op(uint8(id)); op != nil {
+			op.erab = true
+		}
+	}
+	for _, item := range failed {
+		log.Printf("E-RAB %d of %v failed: %v", item.ERABID, typ, item.Cause)
+	}
+	for _, op := range p.ops {
+		if op.erab {
+			continue
+		}
+		op.erab = true
+		if typ != BEARER_PROC_DEACTIVATE {
+			op.esm = true
+			op.fail(gtpv2.CAUSE_NO_RESOURCES_AVAILABLE)
+		}
+	}
+	return s.bearerProcedureProgress(ue)
+}
+
+// emmERABSetupResponse records S1-U F-TEIDs of the eNB for the dedicated
+// bearers set up.
+func emmERABSetupResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*s1ap.ERABSetupResponse)
+	ids := []s1ap.ERABID{}
+	if p := ev.UE.bearerProc; p != nil && p.typ == BEARER_PROC_ACTIVATE {
+		for _, item := range resp.ERABSetupListBearerSURes {
+			if op := p.op(uint8(item.ERABID)); op != nil {
+				op.bearer.ENBAddr = ipOfTransportLayerAddress(item.TransportLayerAddress)
+				op.bearer.ENBTEID = uint32(item.GTPTEID)
+				ids = append(ids, item.ERABID)
+			}
+		}
+	}
+	return s.erabAnswered(ev.UE, BEARER_PROC_ACTIVATE, ids, resp.ERABFailedToSetupList)
+}
+
+func emmERABModifyResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*s1ap.ERABModifyResponse)
+	return s.erabAnswered(ev.UE, BEARER_PROC_MODIFY, resp.ERABModifyListBearerModRes,
+		resp.ERABFailedToModifyList)
+}
+
+// emmERABReleaseResponse records the release of E-RABs. E-RAB which the
+// eNB failed to release is deactivated anyway.
+func emmERABReleaseResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*s1ap.ERABReleaseResponse)
+	if p := ev.UE.bearerProc; p != nil && p.typ == BEARER_PROC_DEACTIVATE {
+		for _, op := range p.ops {
+			op.bearer.ENBAddr = nil
+			op.bearer.ENBTEID = 0
+		}
+	}
+	return s.erabAnswered(ev.UE, BEARER_PROC_DEACTIVATE, resp.ERABReleaseListBearerRelComp,
+		resp.ERABFailedToReleaseList)
+}
+
+// emmERABReleaseIndication requests the SGW to delete the bearers which
+// the eNB has released. The SGW deletes them by Delete Bearer Request.
+func emmERABReleaseIndication(s *Server, ev *Event) error {
+	ue := ev.UE
+	ind := ev.Msg.(*s1ap.ERABReleaseIndication)
+	released := []*Bearer{}
+	for _, item := range ind.ERABReleasedList {
+		b, ok := ue.Bearers[uint8(item.ERABID)]
+		if !ok {
+			log.Printf("E-RAB Release Indication: unknown E-RAB %d", item.ERABID)
+			continue
+		}
+		log.Printf("E-RAB %d of %v is released by eNB: %v", item.ERABID, ue, item.Cause)
+		b.ENBAddr = nil
+		b.ENBTEID = 0
+		released = append(released, b)
+	}
+	return s.sendDeleteBearerCommand(ue, released)
+}
+
+// sendDeleteBearerCommand requests the SGW to delete the bearers which are
+// released in the access network.
+func (s *Server) sendDeleteBearerCommand(ue *UEContext, bearers []*Bearer) error {
+	if len(bearers) == 0 || ue.SGWS11TEID == 0 {
+		return nil
+	}
+	cmd := &gtpv2.DeleteBearerCommand{ULI: userLocation(ue)}
+	for _, b := range bearers {
+		cmd.BearerContexts = append(cmd.BearerContexts, gtpv2.BearerContext{EBI: b.EBI})
+	}
+	return s.sendS11(ue, cmd)
+}
+
+func emmDeleteBearerFailureIndication(s *Server, ev *Event) error {
+	ind := ev.Msg.(*gtpv2.DeleteBearerFailureIndication)
+	log.Printf("Delete Bearer Command of %v failed with cause %v", ev.UE, ind.Cause)
+	return nil
+}
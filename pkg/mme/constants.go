@@ -3,3 +3,16 @@ package mme
 const (
 	S1AP_PORT_NUMBER = 36412
 )
+
+// NAS COUNT is 24 bits of 16 bits overflow counter and 8 bits sequence
+// number. Re-authentication is started when uplink or downlink NAS COUNT
+// reaches the threshold so that the NAS COUNT never wraps around with the
+// same KASME.
+const (
+	NAS_COUNT_MAX             = 0xffffff
+	NAS_COUNT_REKEY_THRESHOLD = 0xff0000
+)
+
+// T3412_VALUE is periodic TAU timer of 54 minutes in GPRS timer format of
+// TS 24.008 10.5.7.3.
+const T3412_VALUE = 0x49
@@ -0,0 +1,108 @@
+package mme
+
+import (
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// detachCause is S1AP cause of the S1 release after detach.
+var detachCause = s1ap.Cause{Group: s1ap.CAUSE_NAS, Value: s1ap.CAUSE_NAS_DETACH}
+
+// detached purges the UE in HSS and releases the S1 connection of the UE
+// which is detached.
+func (s *Server) detached(ue *UEContext) {
+	if ue.Subscription != nil && s.diam != nil {
+		if err := s.diam.PurgeUE(ue); err != nil {
+			log.Printf("Purge UE %v: %v", ue, err)
+		}
+	}
+	ue.Subscription = nil
+	if ue.ECMState == ECM_STATE_CONNECTED {
+		s.sendUEContextReleaseCommand(ue, detachCause)
+	}
+}
+
+// emmDetachingIMSI returns true when the UE detaches only from non-EPS
+// services.
+func emmDetachingIMSI(s *Server, ev *Event) bool {
+	req, ok := ev.Msg.(*nas.DetachRequest)
+	return ok && req.DetachType&0x7 == nas.DETACH_TYPE_IMSI
+}
+
+// emmIMSIDetach accepts IMSI detach. The UE stays registered for EPS
+// services since the MME has no non-EPS services.
+func emmIMSIDetach(s *Server, ev *Event) error {
+	log.Printf("IMSI detach of %v", ev.UE)
+	if ev.Msg.(*nas.DetachRequest).SwitchOff() {
+		return nil
+	}
+	return s.sendNASMessageOnce(ev.UE, &nas.DetachAccept{})
+}
+
+// emmDetachRequest detaches the UE by the Detach Request. Detach Accept is
+// sent unless the UE is switched off. Session is deleted in the SGW when
+// the UE enters EMM-DEREGISTERED.
+func emmDetachRequest(s *Server, ev *Event) error {
+	ue := ev.UE
+	req := ev.Msg.(*nas.DetachRequest)
+	log.Printf("Detach %v type %d", ue, req.DetachType)
+	if !req.SwitchOff() {
+		if err := s.sendNASMessageOnce(ue, &nas.DetachAccept{}); err != nil {
+			log.Printf("Detach Accept: %v", err)
+		}
+	}
+	s.detached(ue)
+	return nil
+}
+
+// emmNetworkDetach sends Detach Request to the UE. The request is
+// retransmitted on T3422 expiry.
+func emmNetworkDetach(s *Server, ev *Event) error {
+	typ, _ := ev.Msg.(uint8)
+	if typ == 0 {
+		typ = nas.DETACH_TYPE_RE_ATTACH
+	}
+	log.Printf("Network initiated detach of %v type %d", ev.UE, typ)
+	req := &nas.NetworkDetachRequest{DetachType: typ}
+	return s.sendProtectedNASMessage(ev.UE, req, nas.SECURITY_HEADER_INTEGRITY_CIPHERED, EVENT_TIMER_T3422)
+}
+
+// emmImplicitDetach detaches the UE in ECM-IDLE locally without NAS
+// signalling.
+func emmImplicitDetach(s *Server, ev *Event) error {
+	log.Printf("Implicit detach of %v", ev.UE)
+	s.detached(ev.UE)
+	return nil
+}
+
+func emmDetachAccept(s *Server, ev *Event) error {
+	log.Printf("%v is detached", ev.UE)
+	s.detached(ev.UE)
+	return nil
+}
+
+// emmDetachAbort detaches the UE locally when the UE does not respond to
+// the Detach Request.
+func emmDetachAbort(s *Server, ev *Event) error {
+	log.Printf("Abort detach of %v by %v", ev.UE, ev.Type)
+	s.detached(ev.UE)
+	return nil
+}
+
+func emmDeregisteredInitiatedExit(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3422)
+	ev.UE.pendingNAS = nil
+	return nil
+}
+
+func emmPurgeUEAnswer(s *Server, ev *Event) error {
+	pua, ok := ev.Msg.(*PUA)
+	if !ok || !pua.Success() {
+		log.Printf("Purge UE of %v failed", ev.UE)
+		return nil
+	}
+	log.Printf("%v is purged in HSS", ev.UE)
+	return nil
+}
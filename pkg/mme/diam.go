@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
-	"strconv"
 	"sync"
 	"time"
 
 	log "github.com/coreswitch/log"
 
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+
 	"github.com/fiorix/go-diameter/diam"
 	"github.com/fiorix/go-diameter/diam/avp"
 	"github.com/fiorix/go-diameter/diam/datatype"
@@ -19,23 +21,39 @@ import (
 	"github.com/fiorix/go-diameter/diam/sm/smpeer"
 )
 
-// DiamClient is S6A diameter protocol client.
+// DiamClient is S6A diameter protocol client. Answers from HSS are
+// injected to the MME as FSM events of the UE which sent the request.
 type DiamClient struct {
-	cli   *sm.Client
-	opt   *DiamOpt
-	cfg   *sm.Settings
-	param *DiamParam
-	done  chan struct{}
-	conn  diam.Conn
-	wg    sync.WaitGroup
+	cli     *sm.Client
+	opt     *DiamOpt
+	cfg     *sm.Settings
+	inject  func(ev *Event)
+	stop    chan struct{}
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	conn    diam.Conn
+	pending map[string]*diamTransaction
 }
 
 // DiamParam store parameter for HSS session.
 type DiamParam struct {
-	ueIMSI string
-	plmnID string
+	sessionID  string
+	ueIMSI     string
+	plmnID     string
+	resyncInfo []byte
+}
+
+// diamTransaction is a request waiting for the answer. The answer event is
+// injected with nil message when the answer does not arrive in time.
+type diamTransaction struct {
+	ue    *UEContext
+	event EventType
+	timer *time.Timer
 }
 
+// diamAnswerTimeout is time to wait for the answer from HSS.
+const diamAnswerTimeout = 10 * time.Second
+
 // DiamOpt is DiamClient options.
 type DiamOpt struct {
 	// timeout
@@ -83,8 +101,9 @@ func (opt *DiamOpt) HssAddress() string {
 	return opt.hssAddress
 }
 
-// NewDiamClient create new Diameter session for HSS.
-func NewDiamClient(opt *DiamOpt) *DiamClient {
+// NewDiamClient create new Diameter session for HSS. inject is called with
+// the answer events.
+func NewDiamClient(opt *DiamOpt, inject func(ev *Event)) *DiamClient {
 	cfg := &sm.Settings{
 		OriginHost:       datatype.DiameterIdentity(opt.originHost),
 		OriginRealm:      datatype.DiameterIdentity(opt.originRealm),
@@ -119,21 +138,26 @@ func NewDiamClient(opt *DiamOpt) *DiamClient {
 		},
 	}
 
-	done := make(chan struct{}, 1000)
+	d := &DiamClient{
+		cli:     cli,
+		opt:     opt,
+		cfg:     cfg,
+		inject:  inject,
+		stop:    make(chan struct{}),
+		pending: map[string]*diamTransaction{},
+	}
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.AuthenticationInformation, Request: false},
-		handleAuthenticationInformationAnswer(done))
+		d.handleAuthenticationInformationAnswer())
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.UpdateLocation, Request: false},
-		handleUpdateLocationAnswer(done))
+		d.handleUpdateLocationAnswer())
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.PurgeUE, Request: false},
+		d.handlePurgeUEAnswer())
 	mux.HandleIdx(diam.ALL_CMD_INDEX, handleAll())
 
-	return &DiamClient{
-		cli:  cli,
-		opt:  opt,
-		cfg:  cfg,
-		done: done,
-	}
+	return d
 }
 
 type ExperimentalResult struct {
@@ -141,7 +165,7 @@ type ExperimentalResult struct {
 }
 
 type AuthenticationInfo struct {
-	EUtranVector EUtranVector `avp:"E-UTRAN-Vector"`
+	EUtranVectors []EUtranVector `avp:"E-UTRAN-Vector"`
 }
 
 type EUtranVector struct {
@@ -212,33 +236,193 @@ type ULA struct {
 	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
 }
 
-func handleAuthenticationInformationAnswer(done chan struct{}) diam.HandlerFunc {
+type PUA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+}
+
+// PDN-Type of APN configuration.
+const (
+	DIAM_PDN_TYPE_IPV4         = 0
+	DIAM_PDN_TYPE_IPV6         = 1
+	DIAM_PDN_TYPE_IPV4V6       = 2
+	DIAM_PDN_TYPE_IPV4_OR_IPV6 = 3
+)
+
+// Pre-emption-Capability and Pre-emption-Vulnerability.
+const (
+	DIAM_PRE_EMPTION_ENABLED  = 0
+	DIAM_PRE_EMPTION_DISABLED = 1
+)
+
+// decodeMSISDN decodes MSISDN in TBCD.
+func decodeMSISDN(b []byte) string {
+	digits := []byte{}
+	for _, v := range b {
+		for _, d := range []byte{v & 0xf, v >> 4} {
+			if d > 9 {
+				return string(digits)
+			}
+			digits = append(digits, '0'+d)
+		}
+	}
+	return string(digits)
+}
+
+// Subscription returns subscription data of the answer.
+func (a *ULA) Subscription() *Subscription {
+	sd := &a.SubscriptionData
+	apn := &sd.APNConfigurationProfile.APNConfiguration
+	qos := &apn.EPSSubscribedQoSProfile
+	arp := &qos.AllocationRetentionPriority
+	sub := &Subscription{
+		MSISDN:    decodeMSISDN([]byte(sd.MSISDN)),
+		UEAMBRUL:  uint64(sd.AMBR.MaxRequestedBandwidthUL),
+		UEAMBRDL:  uint64(sd.AMBR.MaxRequestedBandwidthDL),
+		APN:       apn.ServiceSelection,
+		PDNType:   uint8(apn.PDNType),
+		QCI:       uint8(qos.QoSClassIdentifier),
+		APNAMBRUL: uint64(apn.AMBR.MaxRequestedBandwidthUL),
+		APNAMBRDL: uint64(apn.AMBR.MaxRequestedBandwidthDL),
+	}
+	sub.ARP.PriorityLevel = uint8(arp.PriorityLevel)
+	if arp.PreemptionCapability == DIAM_PRE_EMPTION_ENABLED {
+		sub.ARP.PreemptionCapability = s1ap.PRE_EMPTION_CAPABILITY_MAY_TRIGGER
+	}
+	if arp.PreemptionVulnerability == DIAM_PRE_EMPTION_ENABLED {
+		sub.ARP.PreemptionVulnerability = s1ap.PRE_EMPTION_VULNERABILITY_PRE_EMPTABLE
+	}
+	return sub
+}
+
+// resultCode returns Result-Code of the answer or Experimental-Result-Code
+// when Result-Code is absent.
+func resultCode(result uint32, exp ExperimentalResult) uint32 {
+	if result != 0 {
+		return result
+	}
+	return uint32(exp.ExperimentalResultCode)
+}
+
+// Success returns true when the answer has E-UTRAN vectors.
+func (a *AIA) Success() bool {
+	if resultCode(uint32(a.ResultCode), a.ExperimentalResult) != diam.Success {
+		return false
+	}
+	return len(a.Vectors()) > 0
+}
+
+// Vectors returns E-UTRAN vectors of the answer.
+func (a *AIA) Vectors() []AuthVector {
+	vectors := []AuthVector{}
+	for _, ai := range a.AIs {
+		for _, v := range ai.EUtranVectors {
+			vectors = append(vectors, AuthVector{
+				RAND:  []byte(v.RAND),
+				XRES:  []byte(v.XRES),
+				AUTN:  []byte(v.AUTN),
+				KASME: []byte(v.KASME),
+			})
+		}
+	}
+	return vectors
+}
+
+// Success returns true when the location update is accepted.
+func (a *ULA) Success() bool {
+	return resultCode(a.ResultCode, a.ExperimentalResult) == diam.Success
+}
+
+// Success returns true when the UE is purged in HSS.
+func (a *PUA) Success() bool {
+	return resultCode(a.ResultCode, a.ExperimentalResult) == diam.Success
+}
+
+// S6a experimental result codes of TS 29.272 7.4.3.
+const (
+	DIAMETER_AUTHENTICATION_DATA_UNAVAILABLE = 4181
+	DIAMETER_ERROR_USER_UNKNOWN              = 5001
+	DIAMETER_ERROR_ROAMING_NOT_ALLOWED       = 5004
+	DIAMETER_ERROR_UNKNOWN_EPS_SUBSCRIPTION  = 5420
+	DIAMETER_ERROR_RAT_NOT_ALLOWED           = 5421
+)
+
+// diamEMMCause maps the result of the answer to EMM cause of TS 29.272
+// Annex A. Missing answer is network failure.
+func diamEMMCause(msg interface{}) uint8 {
+	var code uint32
+	switch a := msg.(type) {
+	case *AIA:
+		code = resultCode(uint32(a.ResultCode), a.ExperimentalResult)
+	case *ULA:
+		code = resultCode(a.ResultCode, a.ExperimentalResult)
+	}
+	switch code {
+	case DIAMETER_ERROR_USER_UNKNOWN:
+		return nas.EMM_CAUSE_EPS_AND_NON_EPS_SERVICES_NOT_ALLOWED
+	case DIAMETER_ERROR_UNKNOWN_EPS_SUBSCRIPTION, DIAMETER_ERROR_RAT_NOT_ALLOWED:
+		return nas.EMM_CAUSE_NO_SUITABLE_CELLS_IN_TRACKING_AREA
+	case DIAMETER_ERROR_ROAMING_NOT_ALLOWED:
+		return nas.EMM_CAUSE_PLMN_NOT_ALLOWED
+	}
+	return nas.EMM_CAUSE_NETWORK_FAILURE
+}
+
+// answer injects the answer to the UE which sent the request.
+func (d *DiamClient) answer(sid string, msg interface{}) {
+	d.mu.Lock()
+	tr, ok := d.pending[sid]
+	if ok {
+		tr.timer.Stop()
+		delete(d.pending, sid)
+	}
+	d.mu.Unlock()
+	if !ok {
+		log.Warnf("Answer of unknown Session-Id %s", sid)
+		return
+	}
+	d.inject(&Event{Type: tr.event, UE: tr.ue, Msg: msg})
+}
+
+func (d *DiamClient) handleAuthenticationInformationAnswer() diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		log.Infof("Received Authentication-Information Answer from %s\n%s\n", c.RemoteAddr(), m)
 		var aia AIA
 		err := m.Unmarshal(&aia)
 		if err != nil {
 			log.Infof("AIA Unmarshal failed: %s", err)
-		} else {
-			log.Infof("Unmarshaled Authentication-Information Answer:\n%#+v\n", aia)
+			return
 		}
-		ok := struct{}{}
-		done <- ok
+		d.answer(string(aia.SessionID), &aia)
 	}
 }
 
-func handleUpdateLocationAnswer(done chan struct{}) diam.HandlerFunc {
+func (d *DiamClient) handleUpdateLocationAnswer() diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		log.Infof("Received Update-Location Answer from %s\n%s\n", c.RemoteAddr(), m)
 		var ula ULA
 		err := m.Unmarshal(&ula)
 		if err != nil {
 			log.Infof("ULA Unmarshal failed: %s", err)
-		} else {
-			log.Infof("Unmarshaled UL Answer:\n%#+v\n", ula)
+			return
+		}
+		d.answer(ula.SessionID, &ula)
+	}
+}
+
+func (d *DiamClient) handlePurgeUEAnswer() diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		log.Infof("Received Purge-UE Answer from %s\n%s\n", c.RemoteAddr(), m)
+		var pua PUA
+		err := m.Unmarshal(&pua)
+		if err != nil {
+			log.Infof("PUA Unmarshal failed: %s", err)
+			return
 		}
-		ok := struct{}{}
-		done <- ok
+		d.answer(pua.SessionID, &pua)
 	}
 }
 
@@ -255,8 +439,7 @@ func sendAIR(c diam.Conn, cfg *sm.Settings, param *DiamParam) (int64, error) {
 		return 0, errors.New("peer metadata unavailable")
 	}
 	m := diam.NewRequest(diam.AuthenticationInformation, diam.TGPP_S6A_APP_ID, dict.Default)
-	sid := "session;" + strconv.Itoa(int(rand.Uint32()))
-	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(param.sessionID))
 	m.NewAVP(avp.OriginHost, avp.Mbit, 0, cfg.OriginHost)
 	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, cfg.OriginRealm)
 	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
@@ -265,13 +448,18 @@ func sendAIR(c diam.Conn, cfg *sm.Settings, param *DiamParam) (int64, error) {
 	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(0))
 	m.NewAVP(avp.VisitedPLMNID, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), datatype.OctetString(param.plmnID))
 
+	info := []*diam.AVP{
+		diam.NewAVP(
+			avp.NumberOfRequestedVectors, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), datatype.Unsigned32(3)),
+		diam.NewAVP(
+			avp.ImmediateResponsePreferred, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), datatype.Unsigned32(0)),
+	}
+	if param.resyncInfo != nil {
+		info = append(info, diam.NewAVP(
+			avp.ResynchronizationInfo, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), datatype.OctetString(param.resyncInfo)))
+	}
 	m.NewAVP(avp.RequestedEUTRANAuthenticationInfo, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), &diam.GroupedAVP{
-		AVP: []*diam.AVP{
-			diam.NewAVP(
-				avp.NumberOfRequestedVectors, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), datatype.Unsigned32(3)),
-			diam.NewAVP(
-				avp.ImmediateResponsePreferred, avp.Vbit|avp.Mbit, uint32(cfg.VendorID), datatype.Unsigned32(0)),
-		},
+		AVP: info,
 	})
 
 	return m.WriteTo(c)
@@ -304,9 +492,8 @@ func sendULR(c diam.Conn, cfg *sm.Settings, param *DiamParam) (int64, error) {
 	if !ok {
 		return 0, errors.New("peer metadata unavailable")
 	}
-	sid := "session;" + strconv.Itoa(int(rand.Uint32()))
 	m := diam.NewRequest(diam.UpdateLocation, diam.TGPP_S6A_APP_ID, dict.Default)
-	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(param.sessionID))
 	m.NewAVP(avp.OriginHost, avp.Mbit, 0, cfg.OriginHost)
 	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, cfg.OriginRealm)
 	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
@@ -320,72 +507,129 @@ func sendULR(c diam.Conn, cfg *sm.Settings, param *DiamParam) (int64, error) {
 	return m.WriteTo(c)
 }
 
-// Start initiate diameter client start.
-func (d *DiamClient) Start() {
-	log.Info("Start")
+// sendPUR send Purge-UE Request.
+func sendPUR(c diam.Conn, cfg *sm.Settings, param *DiamParam) (int64, error) {
+	meta, ok := smpeer.FromContext(c.Context())
+	if !ok {
+		return 0, errors.New("peer metadata unavailable")
+	}
+	m := diam.NewRequest(diam.PurgeUE, diam.TGPP_S6A_APP_ID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(param.sessionID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, cfg.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, cfg.OriginRealm)
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
+	m.NewAVP(avp.DestinationHost, avp.Mbit, 0, meta.OriginHost)
+	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(param.ueIMSI))
+	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(0))
+	log.Infof("\nSending PUR to %s\n%s\n", c.RemoteAddr(), m)
+	return m.WriteTo(c)
+}
+
+// request sends the request of the UE. The answer is injected as the
+// event.
+func (d *DiamClient) request(ue *UEContext, param *DiamParam, event EventType,
+	send func(c diam.Conn, cfg *sm.Settings, param *DiamParam) (int64, error)) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conn == nil {
+		return fmt.Errorf("HSS is not connected")
+	}
+	param.sessionID = fmt.Sprintf("%s;%d;%d", d.opt.originHost, time.Now().Unix(), rand.Uint32())
+	param.ueIMSI = ue.IMSI
+	if _, err := send(d.conn, d.cfg, param); err != nil {
+		return err
+	}
+	sid := param.sessionID
+	d.pending[sid] = &diamTransaction{
+		ue:    ue,
+		event: event,
+		timer: time.AfterFunc(diamAnswerTimeout, func() {
+			log.Errorf("No answer of Session-Id %s", sid)
+			d.answer(sid, nil)
+		}),
+	}
+	return nil
+}
 
-	d.param = &DiamParam{
-		ueIMSI: "001010000000001",
-		plmnID: "\x00\xF1\x10",
+// AuthenticationInformation requests E-UTRAN vectors of the UE from HSS.
+// The answer is injected as EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER.
+func (d *DiamClient) AuthenticationInformation(ue *UEContext, plmnID []byte) error {
+	param := &DiamParam{plmnID: string(plmnID)}
+	return d.request(ue, param, EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER, sendAIR)
+}
+
+// AuthenticationResynchronization requests E-UTRAN vectors with
+// Re-Synchronization-Info of RAND and AUTS from the UE on synch failure so
+// that HSS resynchronizes SQN of the UE.
+func (d *DiamClient) AuthenticationResynchronization(ue *UEContext, plmnID, rnd, auts []byte) error {
+	param := &DiamParam{
+		plmnID:     string(plmnID),
+		resyncInfo: append(append([]byte{}, rnd...), auts...),
 	}
+	return d.request(ue, param, EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER, sendAIR)
+}
+
+// UpdateLocation registers the MME as serving node of the UE to HSS. The
+// answer is injected as EVENT_DIAM_UPDATE_LOCATION_ANSWER.
+func (d *DiamClient) UpdateLocation(ue *UEContext, plmnID []byte) error {
+	return d.request(ue, &DiamParam{plmnID: string(plmnID)}, EVENT_DIAM_UPDATE_LOCATION_ANSWER, sendULR)
+}
+
+// PurgeUE notifies HSS that the subscription data of the detached UE is
+// deleted in the MME. The answer is injected as EVENT_DIAM_PURGE_UE_ANSWER.
+func (d *DiamClient) PurgeUE(ue *UEContext) error {
+	return d.request(ue, &DiamParam{}, EVENT_DIAM_PURGE_UE_ANSWER, sendPUR)
+}
+
+func (d *DiamClient) setConn(conn diam.Conn) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.conn = conn
+}
+
+// Start initiate diameter client start. The connection to HSS is
+// re-established when it is closed.
+func (d *DiamClient) Start() {
+	log.Info("Start")
 
 	d.wg.Add(1)
 	go func() {
 		defer d.wg.Done()
-	retry:
 		for {
 			log.Info("Trying to connect diameter server")
 
 			conn, err := d.cli.DialNetwork(d.opt.connMethod(), d.opt.HssAddress()+":"+d.opt.HssPort())
-			if err == nil {
-				d.conn = conn
-				break
+			if err != nil {
+				log.Warnf("Failed %s", err)
+				select {
+				case <-d.stop:
+					return
+				case <-time.After(time.Second * 3):
+				}
+				continue
 			}
-			log.Warnf("Failed %s", err)
-			time.Sleep(time.Second * 3)
-		}
-		log.Info("Diam connection success!")
+			log.Info("Diam connection success!")
+			d.setConn(conn)
 
-		retryFunc := func() {
-			log.Error("Authentication Information timeout")
-			d.conn.Close()
-			d.conn = nil
-		}
-
-		_, err := sendAIR(d.conn, d.cfg, d.param)
-		if err != nil {
-			retryFunc()
-			goto retry
-		}
-		select {
-		case <-d.done:
-			log.Info("Authentication Information success")
-		case <-time.After(10 * time.Second):
-			log.Error("Authentication Information timeout")
-			retryFunc()
-			goto retry
-		}
-		_, err = sendULR(d.conn, d.cfg, d.param)
-		if err != nil {
-			retryFunc()
-			goto retry
-		}
-		select {
-		case <-d.done:
-			log.Info("Update Location success")
-		case <-time.After(10 * time.Second):
-			log.Error("Update Location timeout")
-			retryFunc()
-			goto retry
+			var closed <-chan struct{}
+			if cn, ok := conn.(diam.CloseNotifier); ok {
+				closed = cn.CloseNotify()
+			}
+			select {
+			case <-closed:
+				log.Warn("Diam connection closed")
+				d.setConn(nil)
+			case <-d.stop:
+				d.setConn(nil)
+				conn.Close()
+				return
+			}
 		}
 	}()
 }
 
 // Stop stops diameter client.
 func (d *DiamClient) Stop() {
-	// When connection is already established, close the connection.
-	if d.conn != nil {
-		d.conn.Close()
-	}
+	close(d.stop)
 	d.wg.Wait()
 }
@@ -0,0 +1,183 @@
+package mme
+
+import (
+	"net"
+	"sync"
+	"unsafe"
+
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+	"github.com/ishidawataru/sctp"
+)
+
+// ENBState is S1 interface state of the eNB.
+type ENBState int
+
+const (
+	ENB_STATE_INIT ENBState = iota
+	ENB_STATE_SETUP
+)
+
+var enbStateStr = map[ENBState]string{
+	ENB_STATE_INIT:  "Init",
+	ENB_STATE_SETUP: "Setup",
+}
+
+func (s ENBState) String() string {
+	if str, ok := enbStateStr[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// ENB keeps per SCTP association state of an eNB. S1 Setup fields are
+// updated by the S1AP handler under the registry lock. Stream allocation is
+// protected by the lock of the ENB.
+type ENB struct {
+	conn         net.Conn
+	header       []byte
+	State        ENBState
+	GlobalENBID  s1ap.GlobalENBID
+	Name         string
+	SupportedTAs s1ap.SupportedTAs
+	PagingDRX    s1ap.PagingDRX
+	outStreams   uint16
+
+	mu         sync.Mutex
+	nextStream uint16
+}
+
+// Conn returns the SCTP association of the eNB.
+func (enb *ENB) Conn() net.Conn {
+	return enb.conn
+}
+
+// AllocStream returns SCTP stream for UE associated signalling. Stream 0 is
+// reserved for non UE associated signalling so streams are allocated round
+// robin from 1.
+func (enb *ENB) AllocStream() uint16 {
+	if enb.outStreams <= 1 {
+		return 0
+	}
+	enb.mu.Lock()
+	defer enb.mu.Unlock()
+	enb.nextStream++
+	if enb.nextStream >= enb.outStreams {
+		enb.nextStream = 1
+	}
+	return enb.nextStream
+}
+
+// Header returns SCTP send info header for the stream.
+func (enb *ENB) Header(stream uint16) []byte {
+	header := make([]byte, len(enb.header))
+	copy(header, enb.header)
+	if len(header) >= SCTPInfoSize() {
+		info := (*sctp.SndRcvInfo)(unsafe.Pointer(&header[0]))
+		info.Stream = stream
+	}
+	return header
+}
+
+// ServeTAI returns true when the eNB supports the TAI.
+func (enb *ENB) ServeTAI(tai s1ap.TAI) bool {
+	for _, ta := range enb.SupportedTAs {
+		if ta.TAC != tai.TAC {
+			continue
+		}
+		for _, plmn := range ta.BroadcastPLMNs {
+			if plmn == tai.PLMNIdentity {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// enbRegistry is eNB table keyed by SCTP association and Global-eNB-ID.
+type enbRegistry struct {
+	mu     sync.RWMutex
+	byConn map[net.Conn]*ENB
+	byID   map[s1ap.GlobalENBID]*ENB
+}
+
+func newENBRegistry() *enbRegistry {
+	return &enbRegistry{
+		byConn: map[net.Conn]*ENB{},
+		byID:   map[s1ap.GlobalENBID]*ENB{},
+	}
+}
+
+// add registers the SCTP association.
+func (r *enbRegistry) add(conn net.Conn, outStreams uint16) *ENB {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	enb := &ENB{
+		conn:       conn,
+		outStreams: outStreams,
+	}
+	r.byConn[conn] = enb
+	return enb
+}
+
+// remove unregisters the SCTP association.
+func (r *enbRegistry) remove(conn net.Conn) *ENB {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	enb, ok := r.byConn[conn]
+	if !ok {
+		return nil
+	}
+	delete(r.byConn, conn)
+	if enb.State == ENB_STATE_SETUP && r.byID[enb.GlobalENBID] == enb {
+		delete(r.byID, enb.GlobalENBID)
+	}
+	return enb
+}
+
+// setup updates the eNB with S1 Setup Request. When other association has
+// the same Global-eNB-ID, the ID is taken over by this association since the
+// eNB has restarted before the old association is detected as down. The old
+// association is unregistered and returned to be closed by the caller.
+func (r *enbRegistry) setup(enb *ENB, header []byte, req *s1ap.S1SetupRequest) (old *ENB) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.byID[req.GlobalENBID]; ok && e != enb {
+		old = e
+		old.State = ENB_STATE_INIT
+		delete(r.byConn, old.conn)
+	}
+	if enb.State == ENB_STATE_SETUP && enb.GlobalENBID != req.GlobalENBID {
+		delete(r.byID, enb.GlobalENBID)
+	}
+	enb.header = header
+	enb.State = ENB_STATE_SETUP
+	enb.GlobalENBID = req.GlobalENBID
+	enb.Name = string(req.ENBname)
+	enb.SupportedTAs = req.SupportedTAs
+	enb.PagingDRX = req.DefaultPagingDRX
+	r.byID[req.GlobalENBID] = enb
+	return old
+}
+
+func (r *enbRegistry) lookupByConn(conn net.Conn) *ENB {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.byConn[conn]
+}
+
+func (r *enbRegistry) lookupByID(id s1ap.GlobalENBID) *ENB {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.byID[id]
+}
+
+// list returns eNBs which completed S1 Setup.
+func (r *enbRegistry) list() []*ENB {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	enbs := make([]*ENB, 0, len(r.byID))
+	for _, enb := range r.byID {
+		enbs = append(enbs, enb)
+	}
+	return enbs
+}
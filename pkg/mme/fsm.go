@@ -1,11 +1,1340 @@
 package mme
 
-import "fmt"
+import (
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
 
-// FSM for MME session.
+	"github.com/coreswitch/coreswitch/pkg/gtpv2"
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// EMMState is EPS mobility management state of the UE.
+type EMMState int
+
+const (
+	EMM_STATE_DEREGISTERED EMMState = iota
+	EMM_STATE_COMMON_PROCEDURE_INITIATED
+	EMM_STATE_REGISTERED
+	EMM_STATE_DEREGISTERED_INITIATED
+)
+
+var emmStateStr = map[EMMState]string{
+	EMM_STATE_DEREGISTERED:               "EMM-DEREGISTERED",
+	EMM_STATE_COMMON_PROCEDURE_INITIATED: "EMM-COMMON-PROCEDURE-INITIATED",
+	EMM_STATE_REGISTERED:                 "EMM-REGISTERED",
+	EMM_STATE_DEREGISTERED_INITIATED:     "EMM-DEREGISTERED-INITIATED",
+}
+
+func (s EMMState) String() string {
+	if str, ok := emmStateStr[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// ECMState is EPS connection management state of the UE.
+type ECMState int
+
+const (
+	ECM_STATE_IDLE ECMState = iota
+	ECM_STATE_CONNECTED
+)
+
+var ecmStateStr = map[ECMState]string{
+	ECM_STATE_IDLE:      "ECM-IDLE",
+	ECM_STATE_CONNECTED: "ECM-CONNECTED",
+}
+
+func (s ECMState) String() string {
+	if str, ok := ecmStateStr[s]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// EMMProcedure is EMM specific procedure which the UE is running. EMM
+// common procedures return to the specific procedure when they complete.
+type EMMProcedure int
+
+const (
+	EMM_PROC_NONE EMMProcedure = iota
+	EMM_PROC_ATTACH
+	EMM_PROC_GUTI_REALLOCATION
+	EMM_PROC_TAU
+)
+
+var emmProcedureStr = map[EMMProcedure]string{
+	EMM_PROC_NONE:              "None",
+	EMM_PROC_ATTACH:            "Attach",
+	EMM_PROC_GUTI_REALLOCATION: "GUTI Reallocation",
+	EMM_PROC_TAU:               "Tracking Area Update",
+}
+
+func (p EMMProcedure) String() string {
+	if str, ok := emmProcedureStr[p]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// EventType is type of FSM event.
+type EventType int
+
+const (
+	// S1AP events.
+	EVENT_S1AP_INITIAL_UE_MESSAGE EventType = iota
+	EVENT_S1AP_INITIAL_CONTEXT_SETUP_RESPONSE
+	EVENT_S1AP_INITIAL_CONTEXT_SETUP_FAILURE
+	EVENT_S1AP_UE_CONTEXT_RELEASE
+	EVENT_S1AP_UE_CONTEXT_RELEASE_REQUEST
+	EVENT_S1AP_UE_CONTEXT_RELEASE_COMPLETE
+	EVENT_S1AP_E_RAB_SETUP_RESPONSE
+	EVENT_S1AP_E_RAB_MODIFY_RESPONSE
+	EVENT_S1AP_E_RAB_RELEASE_RESPONSE
+	EVENT_S1AP_E_RAB_RELEASE_INDICATION
+	EVENT_S1AP_HANDOVER_REQUIRED
+	EVENT_S1AP_HANDOVER_REQUEST_ACKNOWLEDGE
+	EVENT_S1AP_HANDOVER_FAILURE
+	EVENT_S1AP_ENB_STATUS_TRANSFER
+	EVENT_S1AP_HANDOVER_NOTIFY
+	EVENT_S1AP_HANDOVER_CANCEL
+
+	// NAS events.
+	EVENT_NAS_ATTACH_REQUEST
+	EVENT_NAS_AUTHENTICATION_RESPONSE
+	EVENT_NAS_AUTHENTICATION_FAILURE
+	EVENT_NAS_IDENTITY_RESPONSE
+	EVENT_NAS_SECURITY_MODE_COMPLETE
+	EVENT_NAS_SECURITY_MODE_REJECT
+	EVENT_NAS_ATTACH_COMPLETE
+	EVENT_NAS_GUTI_REALLOCATION_COMPLETE
+	EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST
+	EVENT_NAS_TRACKING_AREA_UPDATE_COMPLETE
+	EVENT_NAS_SERVICE_REQUEST
+	EVENT_NAS_DETACH_REQUEST
+	EVENT_NAS_DETACH_ACCEPT
+	EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT
+	EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT
+	EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_ACCEPT
+	EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_REJECT
+	EVENT_NAS_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT
+
+	// NAS security events.
+	EVENT_NAS_COUNT_NEAR_WRAP
+
+	// Management events.
+	EVENT_GUTI_REALLOCATION
+	EVENT_DETACH
+	EVENT_PAGING
+
+	// Diameter events.
+	EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER
+	EVENT_DIAM_UPDATE_LOCATION_ANSWER
+	EVENT_DIAM_PURGE_UE_ANSWER
+
+	// S11 events.
+	EVENT_S11_CREATE_SESSION_RESPONSE
+	EVENT_S11_MODIFY_BEARER_RESPONSE
+	EVENT_S11_DELETE_SESSION_RESPONSE
+	EVENT_S11_RELEASE_ACCESS_BEARERS_RESPONSE
+	EVENT_S11_DOWNLINK_DATA_NOTIFICATION
+	EVENT_S11_CREATE_BEARER_REQUEST
+	EVENT_S11_UPDATE_BEARER_REQUEST
+	EVENT_S11_DELETE_BEARER_REQUEST
+	EVENT_S11_DELETE_BEARER_FAILURE_INDICATION
+	EVENT_S11_TIMEOUT
+
+	// Timer events.
+	EVENT_TIMER_T3413
+	EVENT_TIMER_T3422
+	EVENT_TIMER_T3450
+	EVENT_TIMER_T3460
+	EVENT_TIMER_T3470
+	EVENT_TIMER_T3485
+	EVENT_TIMER_T3486
+	EVENT_TIMER_T3495
+	EVENT_TIMER_TS1RELOC
+)
+
+var eventTypeStr = map[EventType]string{
+	EVENT_S1AP_INITIAL_UE_MESSAGE:                          "S1AP Initial UE Message",
+	EVENT_S1AP_INITIAL_CONTEXT_SETUP_RESPONSE:              "S1AP Initial Context Setup Response",
+	EVENT_S1AP_INITIAL_CONTEXT_SETUP_FAILURE:               "S1AP Initial Context Setup Failure",
+	EVENT_S1AP_UE_CONTEXT_RELEASE:                          "S1AP UE Context Release",
+	EVENT_S1AP_UE_CONTEXT_RELEASE_REQUEST:                  "S1AP UE Context Release Request",
+	EVENT_S1AP_UE_CONTEXT_RELEASE_COMPLETE:                 "S1AP UE Context Release Complete",
+	EVENT_S1AP_E_RAB_SETUP_RESPONSE:                        "S1AP E-RAB Setup Response",
+	EVENT_S1AP_E_RAB_MODIFY_RESPONSE:                       "S1AP E-RAB Modify Response",
+	EVENT_S1AP_E_RAB_RELEASE_RESPONSE:                      "S1AP E-RAB Release Response",
+	EVENT_S1AP_E_RAB_RELEASE_INDICATION:                    "S1AP E-RAB Release Indication",
+	EVENT_S1AP_HANDOVER_REQUIRED:                           "S1AP Handover Required",
+	EVENT_S1AP_HANDOVER_REQUEST_ACKNOWLEDGE:                "S1AP Handover Request Acknowledge",
+	EVENT_S1AP_HANDOVER_FAILURE:                            "S1AP Handover Failure",
+	EVENT_S1AP_ENB_STATUS_TRANSFER:                         "S1AP eNB Status Transfer",
+	EVENT_S1AP_HANDOVER_NOTIFY:                             "S1AP Handover Notify",
+	EVENT_S1AP_HANDOVER_CANCEL:                             "S1AP Handover Cancel",
+	EVENT_NAS_ATTACH_REQUEST:                               "NAS Attach Request",
+	EVENT_NAS_AUTHENTICATION_RESPONSE:                      "NAS Authentication Response",
+	EVENT_NAS_AUTHENTICATION_FAILURE:                       "NAS Authentication Failure",
+	EVENT_NAS_IDENTITY_RESPONSE:                            "NAS Identity Response",
+	EVENT_NAS_SECURITY_MODE_COMPLETE:                       "NAS Security Mode Complete",
+	EVENT_NAS_SECURITY_MODE_REJECT:                         "NAS Security Mode Reject",
+	EVENT_NAS_ATTACH_COMPLETE:                              "NAS Attach Complete",
+	EVENT_NAS_GUTI_REALLOCATION_COMPLETE:                   "NAS GUTI Reallocation Complete",
+	EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST:                 "NAS Tracking Area Update Request",
+	EVENT_NAS_TRACKING_AREA_UPDATE_COMPLETE:                "NAS Tracking Area Update Complete",
+	EVENT_NAS_SERVICE_REQUEST:                              "NAS Service Request",
+	EVENT_NAS_DETACH_REQUEST:                               "NAS Detach Request",
+	EVENT_NAS_DETACH_ACCEPT:                                "NAS Detach Accept",
+	EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT: "NAS Activate Dedicated EPS Bearer Context Accept",
+	EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT: "NAS Activate Dedicated EPS Bearer Context Reject",
+	EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_ACCEPT:             "NAS Modify EPS Bearer Context Accept",
+	EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_REJECT:             "NAS Modify EPS Bearer Context Reject",
+	EVENT_NAS_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT:         "NAS Deactivate EPS Bearer Context Accept",
+	EVENT_NAS_COUNT_NEAR_WRAP:                              "NAS COUNT near wrap-around",
+	EVENT_GUTI_REALLOCATION:                                "GUTI reallocation",
+	EVENT_DETACH:                                           "Detach",
+	EVENT_PAGING:                                           "Paging",
+	EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER:           "Diameter Authentication Information Answer",
+	EVENT_DIAM_UPDATE_LOCATION_ANSWER:                      "Diameter Update Location Answer",
+	EVENT_DIAM_PURGE_UE_ANSWER:                             "Diameter Purge UE Answer",
+	EVENT_S11_CREATE_SESSION_RESPONSE:                      "S11 Create Session Response",
+	EVENT_S11_MODIFY_BEARER_RESPONSE:                       "S11 Modify Bearer Response",
+	EVENT_S11_DELETE_SESSION_RESPONSE:                      "S11 Delete Session Response",
+	EVENT_S11_RELEASE_ACCESS_BEARERS_RESPONSE:              "S11 Release Access Bearers Response",
+	EVENT_S11_DOWNLINK_DATA_NOTIFICATION:                   "S11 Downlink Data Notification",
+	EVENT_S11_CREATE_BEARER_REQUEST:                        "S11 Create Bearer Request",
+	EVENT_S11_UPDATE_BEARER_REQUEST:                        "S11 Update Bearer Request",
+	EVENT_S11_DELETE_BEARER_REQUEST:                        "S11 Delete Bearer Request",
+	EVENT_S11_DELETE_BEARER_FAILURE_INDICATION:             "S11 Delete Bearer Failure Indication",
+	EVENT_S11_TIMEOUT:                                      "S11 request timeout",
+	EVENT_TIMER_T3413:                                      "T3413 expiry",
+	EVENT_TIMER_T3422:                                      "T3422 expiry",
+	EVENT_TIMER_T3450:                                      "T3450 expiry",
+	EVENT_TIMER_T3460:                                      "T3460 expiry",
+	EVENT_TIMER_T3470:                                      "T3470 expiry",
+	EVENT_TIMER_T3485:                                      "T3485 expiry",
+	EVENT_TIMER_T3486:                                      "T3486 expiry",
+	EVENT_TIMER_T3495:                                      "T3495 expiry",
+	EVENT_TIMER_TS1RELOC:                                   "TS1RELOC expiry",
+}
+
+func (e EventType) String() string {
+	if str, ok := eventTypeStr[e]; ok {
+		return str
+	}
+	return fmt.Sprintf("Unknown(%d)", int(e))
+}
+
+// Timer values.
+var timerValue = map[EventType]time.Duration{
+	EVENT_TIMER_T3413:    5 * time.Second,
+	EVENT_TIMER_T3422:    6 * time.Second,
+	EVENT_TIMER_T3450:    6 * time.Second,
+	EVENT_TIMER_T3460:    6 * time.Second,
+	EVENT_TIMER_T3470:    6 * time.Second,
+	EVENT_TIMER_T3485:    8 * time.Second,
+	EVENT_TIMER_T3486:    8 * time.Second,
+	EVENT_TIMER_T3495:    8 * time.Second,
+	EVENT_TIMER_TS1RELOC: 10 * time.Second,
+}
+
+// NAS messages are retransmitted four times on timer expiry then the
+// procedure is aborted on the fifth expiry.
+const nasMaxRetransmission = 4
+
+// Authentication is retried with a new vector on MAC failure or after SQN
+// resynchronization up to authMaxFailure times then the UE is rejected.
+const authMaxFailure = 2
+
+// authAUTSLen is length of AUTS in Authentication Failure on synch failure.
+const authAUTSLen = 14
+
+// Event is FSM event. Msg is S1AP message, NAS message, Diameter answer,
+// GTPv2-C message or expired timer depending on the event type. Diameter
+// answer is nil when HSS does not answer and Msg of EVENT_S11_TIMEOUT is
+// the request which is not answered. GTPv2-C request from the SGW is
+// delivered in s11Request so that it can be answered.
+type Event struct {
+	Type EventType
+	UE   *UEContext
+	Msg  interface{}
+}
+
+type fsmAction func(s *Server, ev *Event) error
+type fsmGuard func(s *Server, ev *Event) bool
+
+const (
+	// FSM_ANY matches any state in transition source.
+	FSM_ANY = -1
+	// FSM_SAME keeps the state in transition destination.
+	FSM_SAME = -2
+)
+
+type fsmTransition struct {
+	from   int
+	event  EventType
+	guard  fsmGuard
+	to     int
+	action fsmAction
+}
+
+// FSM is a state machine of the UE. Transitions are tried in declared
+// order and the first one which source, event and guard match is taken.
+// Exit and entry actions are executed only when the state changes.
 type FSM struct {
+	name        string
+	get         func(ue *UEContext) int
+	set         func(ue *UEContext, state int)
+	str         func(state int) string
+	transitions []fsmTransition
+	entry       map[int]fsmAction
+	exit        map[int]fsmAction
+}
+
+// handles returns true when the FSM has transition for the event.
+func (f *FSM) handles(typ EventType) bool {
+	for _, t := range f.transitions {
+		if t.event == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FSM) lookup(s *Server, state int, ev *Event) *fsmTransition {
+	for i := range f.transitions {
+		t := &f.transitions[i]
+		if t.event != ev.Type || (t.from != FSM_ANY && t.from != state) {
+			continue
+		}
+		if t.guard != nil && !t.guard(s, ev) {
+			continue
+		}
+		return t
+	}
+	return nil
+}
+
+// Handle executes the transition for the event.
+func (f *FSM) Handle(s *Server, ev *Event) error {
+	state := f.get(ev.UE)
+	t := f.lookup(s, state, ev)
+	if t == nil {
+		return fmt.Errorf("%s: no transition for %v in %s", f.name, ev.Type, f.str(state))
+	}
+	if t.action != nil {
+		if err := t.action(s, ev); err != nil {
+			return fmt.Errorf("%s: %v in %s: %v", f.name, ev.Type, f.str(state), err)
+		}
+	}
+	if t.to == FSM_SAME || t.to == state {
+		return nil
+	}
+	log.Printf("%s: %s -> %s by %v", f.name, f.str(state), f.str(t.to), ev.Type)
+	if exit, ok := f.exit[state]; ok {
+		if err := exit(s, ev); err != nil {
+			log.Printf("%s: exit %s: %v", f.name, f.str(state), err)
+		}
+	}
+	f.set(ev.UE, t.to)
+	if entry, ok := f.entry[t.to]; ok {
+		if err := entry(s, ev); err != nil {
+			log.Printf("%s: entry %s: %v", f.name, f.str(t.to), err)
+		}
+	}
+	return nil
+}
+
+func newEMMFSM() *FSM {
+	return &FSM{
+		name: "EMM",
+		get:  func(ue *UEContext) int { return int(ue.EMMState) },
+		set:  func(ue *UEContext, state int) { ue.EMMState = EMMState(state) },
+		str:  func(state int) string { return EMMState(state).String() },
+		transitions: []fsmTransition{
+			{int(EMM_STATE_DEREGISTERED), EVENT_NAS_ATTACH_REQUEST, emmAttachAcceptable,
+				int(EMM_STATE_COMMON_PROCEDURE_INITIATED), emmAttachRequest},
+			{int(EMM_STATE_DEREGISTERED), EVENT_NAS_ATTACH_REQUEST, nil,
+				FSM_SAME, emmAttachRequestReject},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_ATTACH_REQUEST, emmAttachAcceptable,
+				int(EMM_STATE_COMMON_PROCEDURE_INITIATED), emmAttachRestart},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_ATTACH_REQUEST, nil,
+				FSM_SAME, emmAttachRequestReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_ATTACH_REQUEST, emmAttachRetransmitted,
+				FSM_SAME, emmAttachRetransmission},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_ATTACH_REQUEST, emmAttachAcceptable,
+				FSM_SAME, emmAttachRestart},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_ATTACH_REQUEST, nil,
+				FSM_SAME, emmAttachRequestReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_IDENTITY_RESPONSE, emmIdentityIMSI,
+				FSM_SAME, emmIdentityResponse},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER, diamSuccess,
+				FSM_SAME, emmAuthenticationInformationAnswer},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_DIAM_AUTHENTICATION_INFORMATION_ANSWER, nil,
+				int(EMM_STATE_DEREGISTERED), emmHSSReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_AUTHENTICATION_RESPONSE, emmRESMatch,
+				FSM_SAME, emmAuthenticationResponse},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_AUTHENTICATION_RESPONSE, nil,
+				int(EMM_STATE_DEREGISTERED), emmAuthenticationReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_AUTHENTICATION_FAILURE, emmAuthenticationRetryable,
+				FSM_SAME, emmAuthenticationFailure},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_AUTHENTICATION_FAILURE, nil,
+				int(EMM_STATE_DEREGISTERED), emmAuthenticationReject},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_COUNT_NEAR_WRAP, nil,
+				int(EMM_STATE_COMMON_PROCEDURE_INITIATED), emmReauthenticate},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_SECURITY_MODE_COMPLETE, emmAttaching,
+				FSM_SAME, emmSecurityModeComplete},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_SECURITY_MODE_COMPLETE, emmUpdatingTA,
+				int(EMM_STATE_REGISTERED), emmTAUSecurityModeComplete},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_SECURITY_MODE_COMPLETE, nil,
+				int(EMM_STATE_REGISTERED), emmSecurityModeTaken},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_SECURITY_MODE_REJECT, emmReauthenticating,
+				int(EMM_STATE_REGISTERED), emmSecurityModeReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_SECURITY_MODE_REJECT, nil,
+				int(EMM_STATE_DEREGISTERED), emmSecurityModeReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_DIAM_UPDATE_LOCATION_ANSWER, emmLocationUpdated,
+				FSM_SAME, emmUpdateLocationAnswer},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_DIAM_UPDATE_LOCATION_ANSWER, nil,
+				int(EMM_STATE_DEREGISTERED), emmUpdateLocationReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_S11_CREATE_SESSION_RESPONSE, emmSessionCreated,
+				FSM_SAME, emmCreateSessionResponse},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_S11_CREATE_SESSION_RESPONSE, nil,
+				int(EMM_STATE_DEREGISTERED), emmCreateSessionReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_S11_TIMEOUT, emmCreatingSession,
+				int(EMM_STATE_DEREGISTERED), emmCreateSessionReject},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_ATTACH_COMPLETE, nil,
+				int(EMM_STATE_REGISTERED), emmAttachComplete},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3460, emmCanRetransmit,
+				FSM_SAME, emmRetransmit},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3460, emmReauthenticating,
+				int(EMM_STATE_REGISTERED), emmAbort},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3460, nil,
+				int(EMM_STATE_DEREGISTERED), emmAbort},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3470, emmCanRetransmit,
+				FSM_SAME, emmRetransmit},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3470, nil,
+				int(EMM_STATE_DEREGISTERED), emmAbort},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST, emmTAUAcceptable,
+				FSM_SAME, emmTrackingAreaUpdate},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST, emmTAUAuthenticate,
+				int(EMM_STATE_COMMON_PROCEDURE_INITIATED), emmTAUReauthenticate},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST, nil,
+				int(EMM_STATE_DEREGISTERED), emmTAUReject},
+			{int(EMM_STATE_DEREGISTERED), EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST, nil,
+				FSM_SAME, emmTAUReject},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_TRACKING_AREA_UPDATE_COMPLETE, nil,
+				FSM_SAME, emmTAUComplete},
+			{int(EMM_STATE_REGISTERED), EVENT_TIMER_T3450, emmCanRetransmit,
+				FSM_SAME, emmRetransmit},
+			{int(EMM_STATE_REGISTERED), EVENT_TIMER_T3450, nil,
+				FSM_SAME, emmTAUAbort},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_SERVICE_REQUEST, emmServiceAcceptable,
+				FSM_SAME, emmServiceRequest},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_SERVICE_REQUEST, emmServiceVerified,
+				FSM_SAME, emmServiceReject},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_SERVICE_REQUEST, nil,
+				int(EMM_STATE_DEREGISTERED), emmServiceReject},
+			{int(EMM_STATE_DEREGISTERED), EVENT_NAS_SERVICE_REQUEST, nil,
+				FSM_SAME, emmServiceReject},
+			{int(EMM_STATE_REGISTERED), EVENT_GUTI_REALLOCATION, ecmConnected,
+				int(EMM_STATE_COMMON_PROCEDURE_INITIATED), emmGUTIReallocation},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_NAS_GUTI_REALLOCATION_COMPLETE, emmReallocatingGUTI,
+				int(EMM_STATE_REGISTERED), emmGUTIReallocationComplete},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3450, emmCanRetransmit,
+				FSM_SAME, emmRetransmit},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3450, emmReallocatingGUTI,
+				int(EMM_STATE_REGISTERED), emmGUTIReallocationAbort},
+			{int(EMM_STATE_COMMON_PROCEDURE_INITIATED), EVENT_TIMER_T3450, nil,
+				int(EMM_STATE_DEREGISTERED), emmAbort},
+			{FSM_ANY, EVENT_S1AP_INITIAL_CONTEXT_SETUP_RESPONSE, nil,
+				FSM_SAME, emmInitialContextSetupResponse},
+			{FSM_ANY, EVENT_S11_MODIFY_BEARER_RESPONSE, nil,
+				FSM_SAME, emmModifyBearerResponse},
+			{FSM_ANY, EVENT_S11_DELETE_SESSION_RESPONSE, nil,
+				FSM_SAME, emmDeleteSessionResponse},
+			{FSM_ANY, EVENT_S11_RELEASE_ACCESS_BEARERS_RESPONSE, nil,
+				FSM_SAME, emmReleaseAccessBearersResponse},
+			{FSM_ANY, EVENT_S11_TIMEOUT, nil,
+				FSM_SAME, nil},
+			{int(EMM_STATE_REGISTERED), EVENT_DETACH, ecmConnected,
+				int(EMM_STATE_DEREGISTERED_INITIATED), emmNetworkDetach},
+			{int(EMM_STATE_REGISTERED), EVENT_DETACH, nil,
+				int(EMM_STATE_DEREGISTERED), emmImplicitDetach},
+			{int(EMM_STATE_DEREGISTERED_INITIATED), EVENT_NAS_DETACH_ACCEPT, nil,
+				int(EMM_STATE_DEREGISTERED), emmDetachAccept},
+			{int(EMM_STATE_DEREGISTERED_INITIATED), EVENT_TIMER_T3422, emmCanRetransmit,
+				FSM_SAME, emmRetransmit},
+			{int(EMM_STATE_DEREGISTERED_INITIATED), EVENT_TIMER_T3422, nil,
+				int(EMM_STATE_DEREGISTERED), emmDetachAbort},
+			{int(EMM_STATE_REGISTERED), EVENT_NAS_DETACH_REQUEST, emmDetachingIMSI,
+				FSM_SAME, emmIMSIDetach},
+			{FSM_ANY, EVENT_NAS_DETACH_REQUEST, nil,
+				int(EMM_STATE_DEREGISTERED), emmDetachRequest},
+			{FSM_ANY, EVENT_DIAM_PURGE_UE_ANSWER, nil,
+				FSM_SAME, emmPurgeUEAnswer},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_DOWNLINK_DATA_NOTIFICATION, ecmConnected,
+				FSM_SAME, emmDownlinkDataNotificationAccept},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_DOWNLINK_DATA_NOTIFICATION, nil,
+				FSM_SAME, emmDownlinkDataNotification},
+			{FSM_ANY, EVENT_S11_DOWNLINK_DATA_NOTIFICATION, nil,
+				FSM_SAME, emmDownlinkDataNotificationReject},
+			{int(EMM_STATE_REGISTERED), EVENT_PAGING, ecmIdle,
+				FSM_SAME, emmPaging},
+			{int(EMM_STATE_REGISTERED), EVENT_TIMER_T3413, emmCanRepage,
+				FSM_SAME, emmRepage},
+			{int(EMM_STATE_REGISTERED), EVENT_TIMER_T3413, nil,
+				FSM_SAME, emmPagingFailure},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_CREATE_BEARER_REQUEST, emmBearerProcedureAcceptable,
+				FSM_SAME, emmCreateBearerRequest},
+			{FSM_ANY, EVENT_S11_CREATE_BEARER_REQUEST, nil,
+				FSM_SAME, emmBearerRequestReject},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_UPDATE_BEARER_REQUEST, emmBearerProcedureAcceptable,
+				FSM_SAME, emmUpdateBearerRequest},
+			{FSM_ANY, EVENT_S11_UPDATE_BEARER_REQUEST, nil,
+				FSM_SAME, emmBearerRequestReject},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_DELETE_BEARER_REQUEST, emmPDNDeletedConnected,
+				int(EMM_STATE_DEREGISTERED_INITIATED), emmDeletePDN},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_DELETE_BEARER_REQUEST, emmPDNDeleted,
+				int(EMM_STATE_DEREGISTERED), emmDeletePDN},
+			{int(EMM_STATE_REGISTERED), EVENT_S11_DELETE_BEARER_REQUEST, emmBearerProcedureAcceptable,
+				FSM_SAME, emmDeleteBearerRequest},
+			{FSM_ANY, EVENT_S11_DELETE_BEARER_REQUEST, nil,
+				FSM_SAME, emmBearerRequestReject},
+			{FSM_ANY, EVENT_S11_DELETE_BEARER_FAILURE_INDICATION, nil,
+				FSM_SAME, emmDeleteBearerFailureIndication},
+			{FSM_ANY, EVENT_S1AP_E_RAB_SETUP_RESPONSE, nil,
+				FSM_SAME, emmERABSetupResponse},
+			{FSM_ANY, EVENT_S1AP_E_RAB_MODIFY_RESPONSE, nil,
+				FSM_SAME, emmERABModifyResponse},
+			{FSM_ANY, EVENT_S1AP_E_RAB_RELEASE_RESPONSE, nil,
+				FSM_SAME, emmERABReleaseResponse},
+			{int(EMM_STATE_REGISTERED), EVENT_S1AP_E_RAB_RELEASE_INDICATION, nil,
+				FSM_SAME, emmERABReleaseIndication},
+			{FSM_ANY, EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT, nil,
+				FSM_SAME, emmActivateDedicatedBearerAccept},
+			{FSM_ANY, EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT, nil,
+				FSM_SAME, emmActivateDedicatedBearerReject},
+			{FSM_ANY, EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_ACCEPT, nil,
+				FSM_SAME, emmModifyBearerAccept},
+			{FSM_ANY, EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_REJECT, nil,
+				FSM_SAME, emmModifyBearerReject},
+			{FSM_ANY, EVENT_NAS_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT, nil,
+				FSM_SAME, emmDeactivateBearerAccept},
+			{FSM_ANY, EVENT_TIMER_T3485, emmBearerCanRetransmit,
+				FSM_SAME, emmBearerRetransmit},
+			{FSM_ANY, EVENT_TIMER_T3485, nil,
+				FSM_SAME, emmBearerAbort},
+			{FSM_ANY, EVENT_TIMER_T3486, emmBearerCanRetransmit,
+				FSM_SAME, emmBearerRetransmit},
+			{FSM_ANY, EVENT_TIMER_T3486, nil,
+				FSM_SAME, emmBearerAbort},
+			{FSM_ANY, EVENT_TIMER_T3495, emmBearerCanRetransmit,
+				FSM_SAME, emmBearerRetransmit},
+			{FSM_ANY, EVENT_TIMER_T3495, nil,
+				FSM_SAME, emmBearerAbort},
+			{int(EMM_STATE_REGISTERED), EVENT_S1AP_HANDOVER_REQUIRED, emmHandoverAcceptable,
+				FSM_SAME, emmHandoverRequired},
+			{FSM_ANY, EVENT_S1AP_HANDOVER_REQUIRED, nil,
+				FSM_SAME, emmHandoverReject},
+			{FSM_ANY, EVENT_S1AP_HANDOVER_REQUEST_ACKNOWLEDGE, nil,
+				FSM_SAME, emmHandoverRequestAcknowledge},
+			{FSM_ANY, EVENT_S1AP_HANDOVER_FAILURE, nil,
+				FSM_SAME, emmHandoverFailure},
+			{FSM_ANY, EVENT_S1AP_ENB_STATUS_TRANSFER, nil,
+				FSM_SAME, emmENBStatusTransfer},
+			{FSM_ANY, EVENT_S1AP_HANDOVER_NOTIFY, nil,
+				FSM_SAME, emmHandoverNotify},
+			{FSM_ANY, EVENT_S1AP_HANDOVER_CANCEL, nil,
+				FSM_SAME, emmHandoverCancel},
+			{FSM_ANY, EVENT_TIMER_TS1RELOC, nil,
+				FSM_SAME, emmHandoverTimeout},
+		},
+		entry: map[int]fsmAction{
+			int(EMM_STATE_DEREGISTERED): emmDeregisteredEntry,
+			int(EMM_STATE_REGISTERED):   emmRegisteredEntry,
+		},
+		exit: map[int]fsmAction{
+			int(EMM_STATE_COMMON_PROCEDURE_INITIATED): emmCommonProcedureExit,
+			int(EMM_STATE_DEREGISTERED_INITIATED):     emmDeregisteredInitiatedExit,
+		},
+	}
+}
+
+func newECMFSM() *FSM {
+	return &FSM{
+		name: "ECM",
+		get:  func(ue *UEContext) int { return int(ue.ECMState) },
+		set:  func(ue *UEContext, state int) { ue.ECMState = ECMState(state) },
+		str:  func(state int) string { return ECMState(state).String() },
+		transitions: []fsmTransition{
+			{int(ECM_STATE_IDLE), EVENT_S1AP_INITIAL_UE_MESSAGE, nil,
+				int(ECM_STATE_CONNECTED), nil},
+			{int(ECM_STATE_CONNECTED), EVENT_S1AP_INITIAL_UE_MESSAGE, nil,
+				FSM_SAME, nil},
+			{int(ECM_STATE_CONNECTED), EVENT_S1AP_UE_CONTEXT_RELEASE, nil,
+				int(ECM_STATE_IDLE), ecmRelease},
+			{int(ECM_STATE_CONNECTED), EVENT_S1AP_UE_CONTEXT_RELEASE_REQUEST, nil,
+				FSM_SAME, ecmReleaseRequest},
+			{int(ECM_STATE_CONNECTED), EVENT_S1AP_INITIAL_CONTEXT_SETUP_FAILURE, nil,
+				FSM_SAME, ecmInitialContextSetupFailure},
+			{int(ECM_STATE_CONNECTED), EVENT_S1AP_UE_CONTEXT_RELEASE_COMPLETE, nil,
+				int(ECM_STATE_IDLE), ecmRelease},
+		},
+		entry: map[int]fsmAction{
+			int(ECM_STATE_IDLE):      ecmIdleEntry,
+			int(ECM_STATE_CONNECTED): ecmConnectedEntry,
+		},
+	}
+}
+
+// dispatch delivers the event to the FSMs of the UE. Dispatch must be
+// called from the handler goroutine.
+func (s *Server) dispatch(ev *Event) {
+	if s.ues.lookupByMMEID(ev.UE.MMEUES1APID) != ev.UE {
+		log.Printf("Drop %v of removed %v", ev.Type, ev.UE)
+		return
+	}
+	if _, ok := timerValue[ev.Type]; ok {
+		t, _ := ev.Msg.(*time.Timer)
+		if ev.UE.timers[ev.Type] != t {
+			return
+		}
+		delete(ev.UE.timers, ev.Type)
+	}
+	handled := false
+	for _, f := range []*FSM{s.ecm, s.emm} {
+		if !f.handles(ev.Type) {
+			continue
+		}
+		handled = true
+		if err := f.Handle(s, ev); err != nil {
+			log.Println(err)
+		}
+	}
+	if !handled {
+		log.Printf("No FSM handles %v", ev.Type)
+	}
+}
+
+// inject queues the event to the handler goroutine. It is used by timers,
+// Diameter and SCTP association goroutines.
+func (s *Server) inject(ev *Event) {
+	select {
+	case s.events <- ev:
+	case <-s.done:
+	}
+}
+
+// timerStart starts the UE timer. Running timer of the same type is
+// restarted.
+func (s *Server) timerStart(ue *UEContext, typ EventType) {
+	s.timerStop(ue, typ)
+	var t *time.Timer
+	t = time.AfterFunc(timerValue[typ], func() {
+		s.inject(&Event{Type: typ, UE: ue, Msg: t})
+	})
+	ue.timers[typ] = t
+}
+
+// timerStop stops the UE timer.
+func (s *Server) timerStop(ue *UEContext, typ EventType) {
+	if t, ok := ue.timers[typ]; ok {
+		t.Stop()
+		delete(ue.timers, typ)
+	}
+}
+
+// timerStopAll stops all of UE timers.
+func (s *Server) timerStopAll(ue *UEContext) {
+	for typ := range ue.timers {
+		s.timerStop(ue, typ)
+	}
+}
+
+// removeUE stops the UE timers and removes the UE context.
+func (s *Server) removeUE(ue *UEContext) {
+	s.timerStopAll(ue)
+	s.ues.remove(ue)
+	log.Printf("Removed %v", ue)
+}
+
+func ecmConnected(s *Server, ev *Event) bool {
+	return ev.UE.ECMState == ECM_STATE_CONNECTED
+}
+
+func ecmIdle(s *Server, ev *Event) bool {
+	return ev.UE.ECMState == ECM_STATE_IDLE
+}
+
+func emmAttaching(s *Server, ev *Event) bool {
+	return ev.UE.Procedure == EMM_PROC_ATTACH
+}
+
+// attachPDNRequest returns PDN Connectivity Request in the Attach Request.
+func attachPDNRequest(attach *nas.AttachRequest) (*nas.PDNConnectivityRequest, error) {
+	m, err := nas.Decode(attach.ESMMessageContainer)
+	if err != nil {
+		return nil, err
+	}
+	pdn, ok := m.(*nas.PDNConnectivityRequest)
+	if !ok {
+		return nil, fmt.Errorf("%s in Attach Request", nas.MessageName(m))
+	}
+	return pdn, nil
+}
+
+// emmAttachAcceptable returns true when the UE is identified by IMSI or
+// GUTI and the Attach Request has PDN Connectivity Request.
+func emmAttachAcceptable(s *Server, ev *Event) bool {
+	attach, ok := ev.Msg.(*nas.AttachRequest)
+	if !ok {
+		return false
+	}
+	switch attach.EPSMobileIdentity.Type {
+	case nas.IDENTITY_TYPE_IMSI, nas.IDENTITY_TYPE_GUTI:
+	default:
+		if ev.UE.IMSI == "" {
+			return false
+		}
+	}
+	_, err := attachPDNRequest(attach)
+	return err == nil
+}
+
+// setIMSI sets IMSI of the attaching UE. Existing context of the IMSI is
+// kept as the old context until the UE is authenticated since the identity
+// is not integrity protected.
+func (s *Server) setIMSI(ue *UEContext, imsi string) {
+	ue.oldUE = s.ues.setIMSI(ue, imsi)
+	if ue.oldUE != nil {
+		log.Printf("Keep old context %v until %v is authenticated", ue.oldUE, ue)
+	}
+}
+
+// identifyGUTI takes IMSI of the context which has the GUTI. The context
+// is kept as the old context until the UE is authenticated. It returns
+// false when the GUTI is not known by the MME.
+func (s *Server) identifyGUTI(ue *UEContext, guti GUTI) bool {
+	old := s.ues.lookupByGUTI(guti)
+	if old == nil || old.IMSI == "" {
+		return false
+	}
+	if old != ue {
+		s.ues.setIMSI(ue, old.IMSI)
+		ue.oldUE = old
+		log.Printf("Keep old context %v until %v is authenticated", old, ue)
+	}
+	return true
+}
+
+// takeOver removes the state of the previous registration when the
+// attaching UE is authenticated (TS 24.301 5.5.1.2.7). The old context of
+// the IMSI is removed after its S1 connection is released and its session
+// is deleted. Bearers of the UE which attaches again while registered are
+// deleted as well.
+func (s *Server) takeOver(ue *UEContext) {
+	if old := ue.oldUE; old != nil {
+		ue.oldUE = nil
+		log.Printf("Remove old context %v", old)
+		s.abortHandover(old)
+		s.abortBearerProcedure(old, gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+		s.releaseSession(old)
+		if old.ENB() != nil {
+			s.sendUEContextReleaseCommand(old,
+				s1ap.Cause{Group: s1ap.CAUSE_NAS, Value: s1ap.CAUSE_NAS_NORMAL_RELEASE})
+		}
+		s.timerStopAll(old)
+		s.ues.takeOver(ue, old)
+	}
+	if len(ue.Bearers) > 0 {
+		log.Printf("Delete bearers of %v", ue)
+		s.abortBearerProcedure(ue, gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+		s.releaseSession(ue)
+	}
+}
+
+func emmAttachRequest(s *Server, ev *Event) error {
+	ue := ev.UE
+	attach := ev.Msg.(*nas.AttachRequest)
+	pdn, err := attachPDNRequest(attach)
+	if err != nil {
+		return err
+	}
+	ue.Security.UENetworkCapability = attach.UENetworkCapability
+	ue.pdnRequest = pdn
+	ue.attachRequest = attach
+	ue.Procedure = EMM_PROC_ATTACH
+	id := attach.EPSMobileIdentity
+	switch id.Type {
+	case nas.IDENTITY_TYPE_IMSI:
+		s.setIMSI(ue, id.Digits)
+	case nas.IDENTITY_TYPE_GUTI:
+		if !s.identifyGUTI(ue, nasGUTI(id.GUTI)) {
+			log.Printf("Unknown GUTI %v of %v", id.GUTI, ue)
+			return s.sendIdentityRequest(ue, nas.IDENTITY_TYPE_IMSI)
+		}
+	}
+	return s.authenticate(ue)
+}
+
+// emmAttachRetransmitted returns true when the Attach Request has the same
+// IEs as the one of the attach in progress.
+func emmAttachRetransmitted(s *Server, ev *Event) bool {
+	attach, ok := ev.Msg.(*nas.AttachRequest)
+	return ok && ev.UE.Procedure == EMM_PROC_ATTACH && reflect.DeepEqual(attach, ev.UE.attachRequest)
+}
+
+// emmAttachRetransmission continues the attach in progress on the
+// retransmitted Attach Request (TS 24.301 5.5.1.2.7).
+func emmAttachRetransmission(s *Server, ev *Event) error {
+	log.Printf("Ignore retransmitted Attach Request of %v", ev.UE)
+	return nil
+}
+
+// emmAttachRestart aborts the EMM procedure in progress and starts the
+// attach of the new Attach Request. Bearers of the registered UE are kept
+// until the UE is authenticated.
+func emmAttachRestart(s *Server, ev *Event) error {
+	ue := ev.UE
+	if ue.Procedure != EMM_PROC_NONE {
+		log.Printf("Abort %v of %v by Attach Request", ue.Procedure, ue)
+	}
+	s.timerStop(ue, EVENT_TIMER_T3450)
+	s.timerStop(ue, EVENT_TIMER_T3460)
+	s.timerStop(ue, EVENT_TIMER_T3470)
+	ue.pendingNAS = nil
+	ue.newSecurity = nil
+	ue.authVector = nil
+	ue.tauRequest = nil
+	ue.oldUE = nil
+	return emmAttachRequest(s, ev)
+}
+
+// sendIdentityRequest requests the identity of the type from the UE.
+func (s *Server) sendIdentityRequest(ue *UEContext, typ uint8) error {
+	return s.sendNASMessage(ue, &nas.IdentityRequest{IdentityType: typ}, EVENT_TIMER_T3470)
+}
+
+// emmIdentityIMSI returns true when the Identity Response has IMSI.
+// Response of other identity type is ignored and the Identity Request is
+// retransmitted on T3470 expiry.
+func emmIdentityIMSI(s *Server, ev *Event) bool {
+	resp, ok := ev.Msg.(*nas.IdentityResponse)
+	return ok && resp.MobileIdentity.Type == nas.IDENTITY_TYPE_IMSI
+}
+
+// emmIdentityResponse continues the attach with IMSI of the UE. Existing
+// context of the IMSI is not removed until the UE is authenticated.
+func emmIdentityResponse(s *Server, ev *Event) error {
+	ue := ev.UE
+	s.timerStop(ue, EVENT_TIMER_T3470)
+	ue.pendingNAS = nil
+	s.setIMSI(ue, ev.Msg.(*nas.IdentityResponse).MobileIdentity.Digits)
+	log.Println(ue)
+	return s.authenticate(ue)
+}
+
+// emmAttachRequestReject rejects the attach of the UE which can not be
+// identified or does not request PDN connection.
+func emmAttachRequestReject(s *Server, ev *Event) error {
+	attach, ok := ev.Msg.(*nas.AttachRequest)
+	if !ok {
+		return fmt.Errorf("unexpected %T", ev.Msg)
+	}
+	if _, err := attachPDNRequest(attach); err != nil {
+		log.Printf("Invalid ESM message container: %v", err)
+		return s.sendAttachReject(ev.UE, nas.EMM_CAUSE_INVALID_MANDATORY_INFORMATION, nil)
+	}
+	return s.sendAttachReject(ev.UE, nas.EMM_CAUSE_UE_IDENTITY_CANNOT_BE_DERIVED, nil)
+}
+
+// sendAttachReject rejects the attach. ESM message is the reject of the
+// PDN connection when the cause is ESM failure.
+func (s *Server) sendAttachReject(ue *UEContext, cause uint8, esm nas.Message) error {
+	reject := &nas.AttachReject{EMMCause: cause}
+	if esm != nil {
+		pdu, err := nas.Encode(esm)
+		if err != nil {
+			return err
+		}
+		reject.ESMMessageContainer = pdu
+	}
+	log.Printf("Attach Reject %v cause %d", ue, cause)
+	return s.sendNASMessageOnce(ue, reject)
+}
+
+// sendAttachESMReject rejects the attach by ESM failure of the PDN
+// connection.
+func (s *Server) sendAttachESMReject(ue *UEContext, cause uint8) error {
+	reject := &nas.PDNConnectivityReject{ESMCause: cause}
+	if ue.pdnRequest != nil {
+		reject.PTI = ue.pdnRequest.PTI
+	}
+	return s.sendAttachReject(ue, nas.EMM_CAUSE_ESM_FAILURE, reject)
+}
+
+// emmReauthenticate runs authentication and security mode control to take
+// a new KASME into use before the NAS COUNT wraps around.
+func emmReauthenticate(s *Server, ev *Event) error {
+	log.Printf("Re-authenticate %v", ev.UE)
+	return s.authenticate(ev.UE)
+}
+
+// authenticate starts authentication of the UE with an unused
+// authentication vector. Vectors are requested from HSS when none is left.
+func (s *Server) authenticate(ue *UEContext) error {
+	if len(ue.vectors) > 0 {
+		return s.sendAuthenticationRequest(ue)
+	}
+	if s.diam == nil {
+		return fmt.Errorf("HSS is not configured")
+	}
+	return s.diam.AuthenticationInformation(ue, ue.TAI.PLMNIdentity[:])
+}
+
+func diamSuccess(s *Server, ev *Event) bool {
+	switch a := ev.Msg.(type) {
+	case *AIA:
+		return a.Success()
+	case *ULA:
+		return a.Success()
+	}
+	return false
+}
+
+func emmAuthenticationInformationAnswer(s *Server, ev *Event) error {
+	ev.UE.vectors = append(ev.UE.vectors, ev.Msg.(*AIA).Vectors()...)
+	return s.sendAuthenticationRequest(ev.UE)
+}
+
+// emmHSSReject rejects the attach which HSS does not allow.
+func emmHSSReject(s *Server, ev *Event) error {
+	if ev.UE.Procedure != EMM_PROC_ATTACH {
+		log.Printf("Authentication of %v failed by HSS", ev.UE)
+		return nil
+	}
+	return s.sendAttachReject(ev.UE, diamEMMCause(ev.Msg), nil)
+}
+
+// sendAuthenticationRequest starts authentication of the UE with the next
+// authentication vector. New KSI is taken into use by the security mode
+// control which follows.
+func (s *Server) sendAuthenticationRequest(ue *UEContext) error {
+	if len(ue.vectors) == 0 {
+		return fmt.Errorf("no authentication vector")
+	}
+	v := ue.vectors[0]
+	ue.vectors = ue.vectors[1:]
+	ue.authVector = &v
+	ue.authKSI = 0
+	if ue.Security.active() {
+		ue.authKSI = (ue.Security.KSI + 1) % nas.NAS_KSI_NO_KEY
+	}
+	req := &nas.AuthenticationRequest{
+		KSI:  ue.authKSI,
+		RAND: v.RAND,
+		AUTN: v.AUTN,
+	}
+	return s.sendNASMessage(ue, req, EVENT_TIMER_T3460)
+}
+
+// emmRESMatch returns true when RES from the UE equals to XRES.
+func emmRESMatch(s *Server, ev *Event) bool {
+	resp, ok := ev.Msg.(*nas.AuthenticationResponse)
+	if !ok || ev.UE.authVector == nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(resp.RES, ev.UE.authVector.XRES) == 1
+}
+
+// emmAuthenticationResponse starts the security mode control with the new
+// NAS security context of the authentication. The current context stays in
+// use until Security Mode Complete (TS 24.301 5.4.3.3).
+func emmAuthenticationResponse(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3460)
+	sc := ev.UE.Security
+	sc.KASME = ev.UE.authVector.KASME
+	sc.KSI = ev.UE.authKSI
+	ev.UE.authVector = nil
+	ev.UE.authFailures = 0
+	if err := sc.selectAlgorithms(); err != nil {
+		return err
+	}
+	if err := sc.deriveNASKeys(); err != nil {
+		return err
+	}
+	sc.ULCount = 0
+	sc.DLCount = 0
+	smc := &nas.SecurityModeCommand{
+		SelectedAlgorithms:             sc.algorithms(),
+		KSI:                            sc.KSI,
+		ReplayedUESecurityCapabilities: sc.replayedCapabilities(),
+	}
+	if ev.UE.Procedure == EMM_PROC_ATTACH && ev.UE.IMEISV == "" {
+		req := uint8(nas.IMEISV_REQUESTED)
+		smc.IMEISVRequest = &req
+	}
+	pdu, err := nas.Encode(smc)
+	if err != nil {
+		return err
+	}
+	if pdu, err = sc.protect(pdu, nas.SECURITY_HEADER_INTEGRITY_NEW_CONTEXT); err != nil {
+		return err
+	}
+	ev.UE.newSecurity = &sc
+	s.sendNAS(ev.UE, pdu, EVENT_TIMER_T3460)
+	return nil
+}
+
+// emmAuthenticationRetryable returns true when authentication failed by
+// MAC failure or synch failure with AUTS can be retried.
+func emmAuthenticationRetryable(s *Server, ev *Event) bool {
+	fail, ok := ev.Msg.(*nas.AuthenticationFailure)
+	if !ok || ev.UE.authVector == nil || ev.UE.authFailures >= authMaxFailure {
+		return false
+	}
+	switch fail.EMMCause {
+	case nas.EMM_CAUSE_MAC_FAILURE:
+		return true
+	case nas.EMM_CAUSE_SYNCH_FAILURE:
+		return len(fail.AuthenticationFailureParameter) == authAUTSLen
+	}
+	return false
+}
+
+// emmAuthenticationFailure restarts authentication. On synch failure the
+// remaining vectors have stale SQN so they are discarded and new vectors
+// are requested with RAND and AUTS for HSS to resynchronize SQN.
+func emmAuthenticationFailure(s *Server, ev *Event) error {
+	ue := ev.UE
+	fail := ev.Msg.(*nas.AuthenticationFailure)
+	log.Printf("Authentication of %v failed with EMM cause %d (%d)",
+		ue, fail.EMMCause, ue.authFailures+1)
+	s.timerStop(ue, EVENT_TIMER_T3460)
+	ue.pendingNAS = nil
+	ue.authFailures++
+	rnd := ue.authVector.RAND
+	ue.authVector = nil
+	if fail.EMMCause != nas.EMM_CAUSE_SYNCH_FAILURE {
+		return s.authenticate(ue)
+	}
+	ue.vectors = nil
+	if s.diam == nil {
+		return fmt.Errorf("HSS is not configured")
+	}
+	return s.diam.AuthenticationResynchronization(ue, ue.TAI.PLMNIdentity[:],
+		rnd, fail.AuthenticationFailureParameter)
+}
+
+// emmAuthenticationReject rejects the UE of which RES does not match or
+// authentication failed persistently.
+func emmAuthenticationReject(s *Server, ev *Event) error {
+	log.Printf("Authentication of %v failed by %v", ev.UE, ev.Type)
+	s.timerStop(ev.UE, EVENT_TIMER_T3460)
+	ev.UE.authVector = nil
+	pdu, err := nas.Encode(&nas.AuthenticationReject{})
+	if err != nil {
+		return err
+	}
+	s.sendDownlinkNAS(ev.UE, pdu)
+	return nil
+}
+
+// takeNewSecurity puts the new NAS security context in use.
+func (ue *UEContext) takeNewSecurity() {
+	if ue.newSecurity == nil {
+		return
+	}
+	ue.Security = *ue.newSecurity
+	ue.newSecurity = nil
+}
+
+// emmReauthenticating returns true when the registered UE is authenticated
+// again out of any EMM specific procedure.
+func emmReauthenticating(s *Server, ev *Event) bool {
+	return ev.UE.Procedure == EMM_PROC_NONE && ev.UE.Security.active()
+}
+
+// emmSecurityModeTaken takes the new NAS security context of the
+// re-authentication.
+func emmSecurityModeTaken(s *Server, ev *Event) error {
+	ev.UE.takeNewSecurity()
+	return nil
+}
+
+// emmSecurityModeReject discards the new NAS security context which the UE
+// has rejected. The registered UE keeps the current context.
+func emmSecurityModeReject(s *Server, ev *Event) error {
+	log.Printf("Security mode control of %v failed by %v", ev.UE, ev.Type)
+	ev.UE.newSecurity = nil
+	return nil
+}
+
+// emmSecurityModeComplete continues the attach of the authenticated UE
+// with the location update. The state of the previous registration is
+// removed at this point.
+func emmSecurityModeComplete(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3460)
+	ev.UE.pendingNAS = nil
+	ev.UE.takeNewSecurity()
+	s.takeOver(ev.UE)
+	if c := ev.Msg.(*nas.SecurityModeComplete); c.IMEISV != nil {
+		ev.UE.IMEISV = c.IMEISV.Digits
+	}
+	if s.diam == nil {
+		return fmt.Errorf("HSS is not configured")
+	}
+	return s.diam.UpdateLocation(ev.UE, ev.UE.TAI.PLMNIdentity[:])
+}
+
+// emmLocationUpdated returns true when HSS accepts the location update and
+// the requested PDN connection is allowed by the subscription.
+func emmLocationUpdated(s *Server, ev *Event) bool {
+	if !diamSuccess(s, ev) {
+		return false
+	}
+	_, _, cause := selectPDN(ev.Msg.(*ULA).Subscription(), ev.UE.pdnRequest)
+	return cause == 0
+}
+
+func emmUpdateLocationAnswer(s *Server, ev *Event) error {
+	ev.UE.Subscription = ev.Msg.(*ULA).Subscription()
+	return s.sendCreateSessionRequest(ev.UE)
+}
+
+// emmUpdateLocationReject rejects the attach by the result of HSS or by
+// ESM cause when the PDN connection is not allowed.
+func emmUpdateLocationReject(s *Server, ev *Event) error {
+	if !diamSuccess(s, ev) {
+		return s.sendAttachReject(ev.UE, diamEMMCause(ev.Msg), nil)
+	}
+	_, _, cause := selectPDN(ev.Msg.(*ULA).Subscription(), ev.UE.pdnRequest)
+	return s.sendAttachESMReject(ev.UE, cause)
+}
+
+func emmSessionCreated(s *Server, ev *Event) bool {
+	resp, ok := ev.Msg.(*gtpv2.CreateSessionResponse)
+	return ok && resp.Cause.Accepted() && resp.SenderFTEID != nil && createdBearer(ev.UE, resp) != nil
+}
+
+// emmCreatingSession returns true when Create Session Request of the UE is
+// not answered.
+func emmCreatingSession(s *Server, ev *Event) bool {
+	_, ok := ev.Msg.(*gtpv2.CreateSessionRequest)
+	return ok
+}
+
+func emmCreateSessionResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*gtpv2.CreateSessionResponse)
+	ev.UE.SGWS11TEID = resp.SenderFTEID.TEID
+	if err := sessionCreated(ev.UE, resp); err != nil {
+		return err
+	}
+	return s.sendAttachAccept(ev.UE, resp.PCO)
+}
+
+// emmCreateSessionReject rejects the attach when the SGW or the PGW does
+// not create the session. Partially created session is deleted by
+// DEREGISTERED entry.
+func emmCreateSessionReject(s *Server, ev *Event) error {
+	if resp, ok := ev.Msg.(*gtpv2.CreateSessionResponse); ok {
+		log.Printf("Create Session Response cause %v", resp.Cause)
+		if resp.Cause.Accepted() && resp.SenderFTEID != nil {
+			ev.UE.SGWS11TEID = resp.SenderFTEID.TEID
+		}
+	}
+	return s.sendAttachESMReject(ev.UE, sessionCause(ev.Msg))
+}
+
+// sendAttachAccept sends Attach Accept with Activate Default EPS Bearer
+// Context Request in Initial Context Setup Request. It is retransmitted by
+// T3450 in Downlink NAS Transport.
+func (s *Server) sendAttachAccept(ue *UEContext, pco []byte) error {
+	b := ue.defaultBearer()
+	act, err := activateDefaultBearer(ue, b, pco)
+	if err != nil {
+		return err
+	}
+	esm, err := nas.Encode(act)
+	if err != nil {
+		return err
+	}
+	guti, err := s.allocGUTI(ue)
+	if err != nil {
+		return err
+	}
+	accept := &nas.AttachAccept{
+		AttachResult:        nas.EPS_ATTACH_RESULT_EPS_ONLY,
+		T3412Value:          T3412_VALUE,
+		TAIList:             taiList(ue),
+		ESMMessageContainer: esm,
+		GUTI:                guti,
+	}
+	pdu, err := nas.Encode(accept)
+	if err != nil {
+		return err
+	}
+	if pdu, err = ue.Security.protect(pdu, nas.SECURITY_HEADER_INTEGRITY_CIPHERED); err != nil {
+		return err
+	}
+	if err := s.sendInitialContextSetupRequest(ue, pdu); err != nil {
+		return err
+	}
+	ue.pendingNAS = pdu
+	ue.retrans = 0
+	s.timerStart(ue, EVENT_TIMER_T3450)
+	return nil
+}
+
+// emmAttachComplete completes the attach. Bearers are modified when the
+// eNB has already answered Initial Context Setup Request, otherwise on the
+// response in EMM-REGISTERED.
+func emmAttachComplete(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3450)
+	ev.UE.pendingNAS = nil
+	s.ues.commitGUTI(ev.UE)
+	complete := ev.Msg.(*nas.AttachComplete)
+	if m, err := nas.Decode(complete.ESMMessageContainer); err != nil {
+		log.Printf("Attach Complete ESM message: %v", err)
+	} else if _, ok := m.(*nas.ActivateDefaultEPSBearerContextAccept); !ok {
+		log.Printf("Attach Complete with %s", nas.MessageName(m))
+	}
+	if b := ev.UE.defaultBearer(); b != nil && b.ENBTEID != 0 {
+		return s.sendModifyBearerRequest(ev.UE)
+	}
+	return nil
+}
+
+// emmInitialContextSetupResponse records S1-U F-TEIDs of the eNB. Bearers
+// are modified in the SGW when the UE is registered.
+func emmInitialContextSetupResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*s1ap.InitialContextSetupResponse)
+	for _, item := range resp.ERABSetupListCtxtSURes {
+		b, ok := ev.UE.Bearers[uint8(item.ERABID)]
+		if !ok {
+			log.Printf("Initial Context Setup Response: unknown E-RAB %d", item.ERABID)
+			continue
+		}
+		b.ENBAddr = ipOfTransportLayerAddress(item.TransportLayerAddress)
+		b.ENBTEID = uint32(item.GTPTEID)
+	}
+	for _, item := range resp.ERABFailedToSetupList {
+		log.Printf("E-RAB %d failed to setup: %v", item.ERABID, item.Cause)
+	}
+	if ev.UE.EMMState != EMM_STATE_REGISTERED {
+		return nil
+	}
+	if err := s.sendModifyBearerRequest(ev.UE); err != nil {
+		return err
+	}
+	return s.resumeBearerProcedure(ev.UE)
+}
+
+func emmModifyBearerResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*gtpv2.ModifyBearerResponse)
+	if !resp.Cause.Accepted() {
+		return fmt.Errorf("Modify Bearer Response cause %v", resp.Cause)
+	}
+	log.Printf("Bearers of %v are modified", ev.UE)
+	return nil
+}
+
+func emmDeleteSessionResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*gtpv2.DeleteSessionResponse)
+	log.Printf("Delete Session Response of %v cause %v", ev.UE, resp.Cause)
+	return nil
+}
+
+func emmReleaseAccessBearersResponse(s *Server, ev *Event) error {
+	resp := ev.Msg.(*gtpv2.ReleaseAccessBearersResponse)
+	if !resp.Cause.Accepted() {
+		return fmt.Errorf("Release Access Bearers Response cause %v", resp.Cause)
+	}
+	log.Printf("Access bearers of %v are released", ev.UE)
+	return nil
+}
+
+func emmCanRetransmit(s *Server, ev *Event) bool {
+	return ev.UE.retrans < nasMaxRetransmission && ev.UE.pendingNAS != nil
+}
+
+func emmRetransmit(s *Server, ev *Event) error {
+	ev.UE.retrans++
+	log.Printf("Retransmit NAS message (%d) by %v", ev.UE.retrans, ev.Type)
+	s.sendDownlinkNAS(ev.UE, ev.UE.pendingNAS)
+	s.timerStart(ev.UE, ev.Type)
+	return nil
+}
+
+func emmAbort(s *Server, ev *Event) error {
+	log.Printf("Abort EMM procedure of %v by %v", ev.UE, ev.Type)
+	return nil
+}
+
+func emmDeregisteredEntry(s *Server, ev *Event) error {
+	s.abortHandover(ev.UE)
+	s.timerStopAll(ev.UE)
+	s.abortBearerProcedure(ev.UE, gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+	s.releaseSession(ev.UE)
+	ev.UE.Procedure = EMM_PROC_NONE
+	ev.UE.Security = SecurityContext{}
+	ev.UE.newSecurity = nil
+	ev.UE.authVector = nil
+	ev.UE.authFailures = 0
+	ev.UE.pdnRequest = nil
+	ev.UE.tauRequest = nil
+	ev.UE.attachRequest = nil
+	ev.UE.oldUE = nil
+	ev.UE.RadioCapability = nil
+	if ev.UE.ECMState == ECM_STATE_IDLE {
+		s.removeUE(ev.UE)
+	}
+	return nil
+}
+
+func emmRegisteredEntry(s *Server, ev *Event) error {
+	ev.UE.Procedure = EMM_PROC_NONE
+	ev.UE.attachRequest = nil
+	return nil
+}
+
+func emmCommonProcedureExit(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3460)
+	s.timerStop(ev.UE, EVENT_TIMER_T3470)
+	ev.UE.pendingNAS = nil
+	ev.UE.newSecurity = nil
+	return nil
+}
+
+func ecmRelease(s *Server, ev *Event) error {
+	s.ues.release(ev.UE)
+	return nil
+}
+
+// ecmIdleEntry removes the UE context unless the UE is registered. S1-U
+// bearers of the registered UE are released in the SGW after the bearer
+// procedure in progress is completed. Handover in progress is cancelled.
+func ecmIdleEntry(s *Server, ev *Event) error {
+	s.abortHandover(ev.UE)
+	if ev.UE.EMMState != EMM_STATE_REGISTERED {
+		s.abortBearerProcedure(ev.UE, gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+		s.releaseSession(ev.UE)
+		s.removeUE(ev.UE)
+		return nil
+	}
+	if err := s.finishBearerProcedure(ev.UE); err != nil {
+		log.Printf("%v: %v", ev.UE, err)
+	}
+	return s.releaseAccessBearers(ev.UE)
 }
 
-func (f *FSM) hello() {
-	fmt.Println("hello")
+// ecmConnectedEntry stops paging which the UE has answered.
+func ecmConnectedEntry(s *Server, ev *Event) error {
+	s.stopPaging(ev.UE)
+	return nil
 }
@@ -0,0 +1,234 @@
+package mme
+
+import (
+	"net"
+	"testing"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+func TestFSMTransitions(t *testing.T) {
+	for _, f := range []*FSM{newEMMFSM(), newECMFSM()} {
+		for i, tr := range f.transitions {
+			if tr.from == FSM_SAME {
+				t.Errorf("%s: transition %d: FSM_SAME in source", f.name, i)
+			}
+			if tr.to == FSM_ANY {
+				t.Errorf("%s: transition %d: FSM_ANY in destination", f.name, i)
+			}
+			if _, ok := eventTypeStr[tr.event]; !ok {
+				t.Errorf("%s: transition %d: unknown event %d", f.name, i, tr.event)
+			}
+		}
+	}
+}
+
+// testConn keeps S1AP PDUs sent to the eNB.
+type testConn struct {
+	net.Conn
+	pdus [][]byte
+}
+
+func (c *testConn) Write(b []byte) (int, error) {
+	c.pdus = append(c.pdus, append([]byte{}, b...))
+	return len(b), nil
+}
+
+// sent returns S1AP messages sent to the eNB since the last call.
+func (c *testConn) sent(t *testing.T) []s1ap.Message {
+	msgs := []s1ap.Message{}
+	for _, pdu := range c.pdus {
+		m, err := s1ap.Decode(pdu)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, m)
+	}
+	c.pdus = nil
+	return msgs
+}
+
+var testVector = AuthVector{
+	RAND:  make([]byte, 16),
+	XRES:  []byte{1, 2, 3, 4, 5, 6, 7, 8},
+	AUTN:  make([]byte, 16),
+	KASME: make([]byte, 32),
+}
+
+// newTestUE returns the UE connected to the eNB. Authentication vectors
+// are given so that HSS is not asked for them.
+func newTestUE(t *testing.T, s *Server, enb *ENB, id s1ap.ENBUES1APID) *UEContext {
+	ue, err := s.ues.add(enb, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ue.ECMState = ECM_STATE_CONNECTED
+	ue.vectors = []AuthVector{testVector, testVector, testVector}
+	return ue
+}
+
+// newRegisteredUE returns the registered UE of the IMSI which has the
+// default bearer.
+func newRegisteredUE(t *testing.T, s *Server, enb *ENB, id s1ap.ENBUES1APID, imsi string) *UEContext {
+	ue := newTestUE(t, s, enb, id)
+	s.ues.setIMSI(ue, imsi)
+	ue.EMMState = EMM_STATE_REGISTERED
+	ue.Bearers[5] = &Bearer{EBI: 5, APN: "internet"}
+	return ue
+}
+
+func testAttachRequest(imsi string, capa byte) *nas.AttachRequest {
+	esm, _ := nas.Encode(&nas.PDNConnectivityRequest{
+		ESMHeader:   nas.ESMHeader{PTI: 1},
+		PDNType:     nas.PDN_TYPE_IPV4,
+		RequestType: 1,
+	})
+	return &nas.AttachRequest{
+		KSI:                 nas.NAS_KSI_NO_KEY,
+		AttachType:          nas.EPS_ATTACH_TYPE_EPS,
+		EPSMobileIdentity:   nas.MobileIdentity{Type: nas.IDENTITY_TYPE_IMSI, Digits: imsi},
+		UENetworkCapability: []byte{capa, capa},
+		ESMMessageContainer: esm,
+	}
+}
+
+// testAuthenticate answers the authentication and the security mode control.
+func testAuthenticate(s *Server, ue *UEContext) {
+	s.dispatch(&Event{Type: EVENT_NAS_AUTHENTICATION_RESPONSE, UE: ue,
+		Msg: &nas.AuthenticationResponse{RES: testVector.XRES}})
+	s.dispatch(&Event{Type: EVENT_NAS_SECURITY_MODE_COMPLETE, UE: ue,
+		Msg: &nas.SecurityModeComplete{}})
+}
+
+// TestAttachTakeOver checks that the context of the IMSI is removed only
+// when the attaching UE is authenticated.
+func TestAttachTakeOver(t *testing.T) {
+	s := NewServer()
+	conn := &testConn{}
+	enb := s.enbs.add(conn, 1)
+	imsi := "001010000000001"
+	old := newRegisteredUE(t, s, enb, 1, imsi)
+
+	ue := newTestUE(t, s, enb, 2)
+	s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: testAttachRequest(imsi, 0xe0)})
+	s.dispatch(&Event{Type: EVENT_NAS_AUTHENTICATION_RESPONSE, UE: ue,
+		Msg: &nas.AuthenticationResponse{RES: []byte{8, 7, 6, 5, 4, 3, 2, 1}}})
+	if ue.EMMState != EMM_STATE_DEREGISTERED || ue.oldUE != nil {
+		t.Errorf("%v with old context %v after authentication failure", ue, ue.oldUE)
+	}
+	if s.ues.lookupByIMSI(imsi) != old || s.ues.lookupByMMEID(old.MMEUES1APID) != old ||
+		len(old.Bearers) != 1 {
+		t.Fatalf("old context is removed by unauthenticated UE")
+	}
+
+	ue = newTestUE(t, s, enb, 3)
+	s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: testAttachRequest(imsi, 0xe0)})
+	if ue.oldUE != old || s.ues.lookupByIMSI(imsi) != old {
+		t.Fatalf("old context %v of %v", ue.oldUE, ue)
+	}
+	conn.sent(t)
+	testAuthenticate(s, ue)
+	if s.ues.lookupByIMSI(imsi) != ue || s.ues.lookupByMMEID(old.MMEUES1APID) != nil {
+		t.Errorf("old context is not taken over")
+	}
+	released := false
+	for _, m := range conn.sent(t) {
+		if cmd, ok := m.(*s1ap.UEContextReleaseCommand); ok &&
+			cmd.UES1APIDs.MMEUES1APID == old.MMEUES1APID {
+			released = true
+		}
+	}
+	if !released {
+		t.Errorf("S1 connection of old context is not released")
+	}
+	if !s.ues.releaseComplete(enb, old.MMEUES1APID, old.ENBUES1APID) {
+		t.Errorf("S1 connection of old context is not kept in release")
+	}
+}
+
+// TestReattach checks that the registered UE can attach again and its
+// bearers are deleted when the UE is authenticated.
+func TestReattach(t *testing.T) {
+	s := NewServer()
+	enb := s.enbs.add(&testConn{}, 1)
+	imsi := "001010000000001"
+	ue := newRegisteredUE(t, s, enb, 1, imsi)
+
+	s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: testAttachRequest(imsi, 0xe0)})
+	if ue.EMMState != EMM_STATE_COMMON_PROCEDURE_INITIATED || ue.Procedure != EMM_PROC_ATTACH {
+		t.Fatalf("%v in %v", ue, ue.Procedure)
+	}
+	if len(ue.Bearers) != 1 {
+		t.Errorf("bearers are deleted before authentication")
+	}
+	testAuthenticate(s, ue)
+	if len(ue.Bearers) != 0 || s.ues.lookupByIMSI(imsi) != ue {
+		t.Errorf("bearers %v of %v after authentication", ue.Bearers, ue)
+	}
+}
+
+// TestAttachRetransmission checks that the retransmitted Attach Request is
+// ignored and the new one restarts the attach.
+func TestAttachRetransmission(t *testing.T) {
+	s := NewServer()
+	enb := s.enbs.add(&testConn{}, 1)
+	imsi := "001010000000001"
+	ue := newTestUE(t, s, enb, 1)
+
+	s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: testAttachRequest(imsi, 0xe0)})
+	av := ue.authVector
+	s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: testAttachRequest(imsi, 0xe0)})
+	if ue.authVector != av || len(ue.vectors) != 2 {
+		t.Errorf("retransmitted Attach Request restarts the attach")
+	}
+	s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: testAttachRequest(imsi, 0xc0)})
+	if ue.authVector == av || len(ue.vectors) != 1 {
+		t.Errorf("new Attach Request does not restart the attach")
+	}
+	if ue.EMMState != EMM_STATE_COMMON_PROCEDURE_INITIATED || ue.Procedure != EMM_PROC_ATTACH {
+		t.Errorf("%v in %v", ue, ue.Procedure)
+	}
+	testAuthenticate(s, ue)
+	if ue.Security.UENetworkCapability[0] != 0xc0 {
+		t.Errorf("UE network capability %x", ue.Security.UENetworkCapability)
+	}
+}
+
+// TestAttachGUTITakeOver checks that the context of the GUTI or of the
+// IMSI in Identity Response is removed only when the attaching UE is
+// authenticated.
+func TestAttachGUTITakeOver(t *testing.T) {
+	for _, known := range []bool{true, false} {
+		s := NewServer()
+		enb := s.enbs.add(&testConn{}, 1)
+		imsi := "001010000000001"
+		old := newRegisteredUE(t, s, enb, 1, imsi)
+		guti := GUTI{MMEGI: 4, MMEC: 1, MTMSI: 0x12345678}
+		s.ues.setGUTI(old, guti)
+
+		ue := newTestUE(t, s, enb, 2)
+		attach := testAttachRequest(imsi, 0xe0)
+		attach.EPSMobileIdentity = nas.MobileIdentity{Type: nas.IDENTITY_TYPE_GUTI, GUTI: guti.nas()}
+		if !known {
+			attach.EPSMobileIdentity.GUTI.MTMSI++
+		}
+		s.dispatch(&Event{Type: EVENT_NAS_ATTACH_REQUEST, UE: ue, Msg: attach})
+		if !known {
+			s.dispatch(&Event{Type: EVENT_NAS_IDENTITY_RESPONSE, UE: ue, Msg: &nas.IdentityResponse{
+				MobileIdentity: nas.MobileIdentity{Type: nas.IDENTITY_TYPE_IMSI, Digits: imsi},
+			}})
+		}
+		if ue.IMSI != imsi || ue.oldUE != old {
+			t.Fatalf("known GUTI %v: old context %v of %v", known, ue.oldUE, ue)
+		}
+		if s.ues.lookupByGUTI(guti) != old || s.ues.lookupByIMSI(imsi) != old {
+			t.Fatalf("known GUTI %v: old context is removed by unauthenticated UE", known)
+		}
+		testAuthenticate(s, ue)
+		if s.ues.lookupByMMEID(old.MMEUES1APID) != nil || s.ues.lookupByGUTI(guti) != nil ||
+			s.ues.lookupByIMSI(imsi) != ue {
+			t.Errorf("known GUTI %v: old context is not taken over", known)
+		}
+	}
+}
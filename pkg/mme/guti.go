@@ -0,0 +1,97 @@
+package mme
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+)
+
+// gummei returns PLMN, MME group ID and MME code of the MME for the GUTI of
+// the UE. The served PLMN of the UE's TAI is preferred.
+func (s *Server) gummei(ue *UEContext) (GUTI, error) {
+	var base *GUTI
+	for _, gummei := range s.conf.servedGUMMEIs {
+		if len(gummei.ServedGroupIDs) == 0 || len(gummei.ServedMMECs) == 0 {
+			continue
+		}
+		for _, plmn := range gummei.ServedPLMNs {
+			g := GUTI{
+				PLMNIdentity: plmn,
+				MMEGI:        gummei.ServedGroupIDs[0],
+				MMEC:         gummei.ServedMMECs[0],
+			}
+			if plmn == ue.TAI.PLMNIdentity {
+				return g, nil
+			}
+			if base == nil {
+				base = &g
+			}
+		}
+	}
+	if base == nil {
+		return GUTI{}, fmt.Errorf("no served GUMMEI")
+	}
+	return *base, nil
+}
+
+// allocGUTI allocates new GUTI of the UE to be sent in NAS message. It
+// replaces the current GUTI when the UE acknowledges it.
+func (s *Server) allocGUTI(ue *UEContext) (*nas.GUTI, error) {
+	base, err := s.gummei(ue)
+	if err != nil {
+		return nil, err
+	}
+	guti, err := s.ues.allocGUTI(ue, base)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Allocate GUTI %v to %v", guti, ue)
+	g := guti.nas()
+	return &g, nil
+}
+
+// taiList returns TAI list for the UE which is registered in the current
+// TA.
+func taiList(ue *UEContext) nas.TAIList {
+	return nas.TAIList{
+		{PLMNIdentity: nas.PLMNIdentity(ue.TAI.PLMNIdentity), TAC: ue.TAI.TAC},
+	}
+}
+
+func emmReallocatingGUTI(s *Server, ev *Event) bool {
+	return ev.UE.Procedure == EMM_PROC_GUTI_REALLOCATION
+}
+
+// emmGUTIReallocation sends GUTI Reallocation Command with new GUTI. The
+// command is retransmitted on T3450 expiry.
+func emmGUTIReallocation(s *Server, ev *Event) error {
+	ue := ev.UE
+	guti, err := s.allocGUTI(ue)
+	if err != nil {
+		return err
+	}
+	ue.Procedure = EMM_PROC_GUTI_REALLOCATION
+	cmd := &nas.GUTIReallocationCommand{
+		GUTI:    *guti,
+		TAIList: taiList(ue),
+	}
+	return s.sendProtectedNASMessage(ue, cmd, nas.SECURITY_HEADER_INTEGRITY_CIPHERED, EVENT_TIMER_T3450)
+}
+
+// emmGUTIReallocationComplete takes the new GUTI into use.
+func emmGUTIReallocationComplete(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3450)
+	ev.UE.pendingNAS = nil
+	s.ues.commitGUTI(ev.UE)
+	log.Printf("GUTI of %v is reallocated to %v", ev.UE, ev.UE.GUTI)
+	return nil
+}
+
+// emmGUTIReallocationAbort aborts the GUTI reallocation. The UE stays
+// registered and both of the old and new GUTI are kept valid since the UE
+// may use either of them.
+func emmGUTIReallocationAbort(s *Server, ev *Event) error {
+	log.Printf("Abort GUTI reallocation of %v", ev.UE)
+	return nil
+}
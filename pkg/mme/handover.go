@@ -0,0 +1,323 @@
+package mme
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// handoverContext is the S1 handover of the UE. The UE stays connected to
+// the source eNB until the target eNB notifies the arrival of the UE.
+// MMEUES1APID, ENBUES1APID and stream are of the target eNB association.
+// ENBUES1APID is valid after the target eNB has acknowledged the request
+// and commanded is set when Handover Command is sent to the source eNB.
+type handoverContext struct {
+	typ          s1ap.HandoverType
+	directPath   bool
+	enb          *ENB
+	MMEUES1APID  s1ap.MMEUES1APID
+	ENBUES1APID  s1ap.ENBUES1APID
+	stream       uint16
+	acknowledged bool
+	commanded    bool
+	admitted     s1ap.ERABAdmittedList
+}
+
+// radioNetworkCause returns S1AP cause of the radio network layer.
+func radioNetworkCause(value uint8) s1ap.Cause {
+	return s1ap.Cause{Group: s1ap.CAUSE_RADIO_NETWORK, Value: value}
+}
+
+// sendTarget sends UE associated S1AP message to the target eNB of the
+// handover.
+func (s *Server) sendTarget(ho *handoverContext, msg s1ap.Message) {
+	s.sendStream(ho.enb, ho.stream, msg)
+}
+
+// dispatchHandover delivers the message of the target eNB association to
+// the FSMs of the UE in handover.
+func (s *Server) dispatchHandover(m *message, mmeID s1ap.MMEUES1APID, typ EventType, msg s1ap.Message) {
+	ue := s.ues.lookupByHandoverID(mmeID)
+	if ue == nil || ue.handover.enb != m.enb {
+		log.Printf("Unknown handover MME-UE-S1AP-ID %d", mmeID)
+		return
+	}
+	s.dispatch(&Event{Type: typ, UE: ue, Msg: msg})
+}
+
+// emmHandoverAcceptable returns true when the UE is connected and runs no
+// other procedure which modifies the bearers or the security context.
+func emmHandoverAcceptable(s *Server, ev *Event) bool {
+	ue := ev.UE
+	return ue.ECMState == ECM_STATE_CONNECTED && ue.handover == nil && ue.bearerProc == nil
+}
+
+// sendHandoverPreparationFailure rejects the handover required by the
+// source eNB.
+func (s *Server) sendHandoverPreparationFailure(ue *UEContext, cause s1ap.Cause) {
+	log.Printf("Handover preparation of %v failed: %v", ue, cause)
+	s.sendUE(ue, &s1ap.HandoverPreparationFailure{
+		MMEUES1APID: ue.MMEUES1APID,
+		ENBUES1APID: ue.ENBUES1APID,
+		Cause:       cause,
+	})
+}
+
+// emmHandoverReject rejects Handover Required while other procedure is in
+// progress.
+func emmHandoverReject(s *Server, ev *Event) error {
+	s.sendHandoverPreparationFailure(ev.UE,
+		radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_INTERACTION_WITH_OTHER_PROCEDURE))
+	return nil
+}
+
+// emmHandoverRequired requests the target eNB to allocate resources for the
+// UE. Only intra E-UTRAN handover is supported. The target eNB derives
+// KeNB from the next NH and NCC.
+func emmHandoverRequired(s *Server, ev *Event) error {
+	ue := ev.UE
+	req := ev.Msg.(*s1ap.HandoverRequired)
+	if req.HandoverType != s1ap.HANDOVER_TYPE_INTRA_LTE || req.TargetID.Type != s1ap.TARGET_ID_ENB {
+		s.sendHandoverPreparationFailure(ue,
+			radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HO_TARGET_NOT_ALLOWED))
+		return nil
+	}
+	target := s.enbs.lookupByID(req.TargetID.GlobalENBID)
+	if target == nil || target.State != ENB_STATE_SETUP {
+		s.sendHandoverPreparationFailure(ue,
+			radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_UNKNOWN_TARGETID))
+		return nil
+	}
+	if ue.Subscription == nil {
+		s.sendHandoverPreparationFailure(ue,
+			radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HO_FAILURE_IN_TARGET_EPC_ENB_OR_TARGET_SYSTEM))
+		return fmt.Errorf("no subscription data")
+	}
+	nh, ncc, err := ue.Security.nextHop()
+	if err != nil {
+		s.sendHandoverPreparationFailure(ue,
+			radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HO_FAILURE_IN_TARGET_EPC_ENB_OR_TARGET_SYSTEM))
+		return err
+	}
+	ho, err := s.ues.prepareHandover(ue, target)
+	if err != nil {
+		s.sendHandoverPreparationFailure(ue,
+			radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HO_FAILURE_IN_TARGET_EPC_ENB_OR_TARGET_SYSTEM))
+		return err
+	}
+	ho.typ = req.HandoverType
+	ho.directPath = req.DirectForwardingPathAvailability
+
+	hreq := &s1ap.HandoverRequest{
+		MMEUES1APID:  ho.MMEUES1APID,
+		HandoverType: req.HandoverType,
+		Cause:        req.Cause,
+		UEAggregateMaximumBitrate: s1ap.UEAggregateMaximumBitrate{
+			DL: s1ap.BitRate(ue.Subscription.UEAMBRDL),
+			UL: s1ap.BitRate(ue.Subscription.UEAMBRUL),
+		},
+		SourceToTargetTransparentContainer: req.SourceToTargetTransparentContainer,
+		UESecurityCapabilities:             ue.Security.s1apCapabilities(),
+		SecurityContext:                    s1ap.SecurityContext{NextHopChainingCount: ncc},
+	}
+	if ue.GUTI != nil {
+		hreq.HandoverRestrictionList = &s1ap.HandoverRestrictionList{
+			ServingPLMN: ue.GUTI.PLMNIdentity,
+		}
+	}
+	for _, b := range ue.sortedBearers() {
+		hreq.ERABToBeSetupListHOReq = append(hreq.ERABToBeSetupListHOReq, s1ap.ERABToBeSetupItemHOReq{
+			ERABID:                 s1ap.ERABID(b.EBI),
+			TransportLayerAddress:  transportLayerAddress(b.SGWAddr),
+			GTPTEID:                s1ap.GTPTEID(b.SGWTEID),
+			ERABLevelQoSParameters: erabQoS(b),
+		})
+	}
+	copy(hreq.SecurityContext.NextHopParameter[:], nh)
+	log.Printf("Handover of %v to eNB %v MME-UE-S1AP-ID %d NCC %d",
+		ue, target.GlobalENBID, ho.MMEUES1APID, ncc)
+	s.sendTarget(ho, hreq)
+	s.timerStart(ue, EVENT_TIMER_TS1RELOC)
+	return nil
+}
+
+// releaseHandoverTarget releases the UE context in the target eNB and
+// cancels the handover. The target eNB association which is not
+// acknowledged yet is released by MME-UE-S1AP-ID only.
+func (s *Server) releaseHandoverTarget(ue *UEContext, cause s1ap.Cause) {
+	ho := ue.handover
+	if ho == nil {
+		return
+	}
+	ids := s1ap.UES1APIDs{MMEUES1APID: ho.MMEUES1APID}
+	if ho.acknowledged {
+		enbID := ho.ENBUES1APID
+		ids.ENBUES1APID = &enbID
+	}
+	log.Printf("Release handover target of %v by %v", ue, cause)
+	s.sendTarget(ho, &s1ap.UEContextReleaseCommand{UES1APIDs: ids, Cause: cause})
+	s.timerStop(ue, EVENT_TIMER_TS1RELOC)
+	s.ues.cancelHandover(ue)
+}
+
+// abortHandover cancels the handover of the UE which source S1 connection
+// is released.
+func (s *Server) abortHandover(ue *UEContext) {
+	s.releaseHandoverTarget(ue,
+		radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HANDOVER_CANCELLED))
+}
+
+// admittedERAB returns the E-RAB admitted by the target eNB.
+func (ho *handoverContext) admittedERAB(ebi uint8) *s1ap.ERABAdmittedItem {
+	for i := range ho.admitted {
+		if uint8(ho.admitted[i].ERABID) == ebi {
+			return &ho.admitted[i]
+		}
+	}
+	return nil
+}
+
+// emmHandoverRequestAcknowledge sends Handover Command to the source eNB.
+// E-RABs which the target eNB could not set up are released by the
+// source eNB. Data is forwarded directly to the target eNB when the source
+// eNB has the path available. The handover fails when the default bearer
+// is not admitted.
+func emmHandoverRequestAcknowledge(s *Server, ev *Event) error {
+	ue := ev.UE
+	ho := ue.handover
+	ack := ev.Msg.(*s1ap.HandoverRequestAcknowledge)
+	if ho.acknowledged {
+		return fmt.Errorf("duplicate Handover Request Acknowledge")
+	}
+	ho.acknowledged = true
+	ho.ENBUES1APID = ack.ENBUES1APID
+	ho.admitted = ack.ERABAdmittedList
+	if b := ue.defaultBearer(); b == nil || ho.admittedERAB(b.EBI) == nil {
+		cause := radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HO_FAILURE_IN_TARGET_EPC_ENB_OR_TARGET_SYSTEM)
+		s.sendHandoverPreparationFailure(ue, cause)
+		s.releaseHandoverTarget(ue, cause)
+		return nil
+	}
+	cmd := &s1ap.HandoverCommand{
+		MMEUES1APID:                        ue.MMEUES1APID,
+		ENBUES1APID:                        ue.ENBUES1APID,
+		HandoverType:                       ho.typ,
+		ERABToReleaseList:                  s1ap.ERABList(ack.ERABFailedToSetupList),
+		TargetToSourceTransparentContainer: ack.TargetToSourceTransparentContainer,
+	}
+	for _, item := range ack.ERABAdmittedList {
+		if !ho.directPath || (len(item.DLTransportLayerAddress) == 0 && len(item.ULTransportLayerAddress) == 0) {
+			continue
+		}
+		cmd.ERABSubjectToDataForwardingList = append(cmd.ERABSubjectToDataForwardingList,
+			s1ap.ERABDataForwardingItem{ERABID: item.ERABID, ERABForwarding: item.ERABForwarding})
+	}
+	for _, item := range ack.ERABFailedToSetupList {
+		log.Printf("E-RAB %d of %v is not admitted by target eNB: %v", item.ERABID, ue, item.Cause)
+	}
+	ho.commanded = true
+	s.sendUE(ue, cmd)
+	s.timerStart(ue, EVENT_TIMER_TS1RELOC)
+	return nil
+}
+
+// emmHandoverFailure rejects the handover which the target eNB could not
+// accept.
+func emmHandoverFailure(s *Server, ev *Event) error {
+	ue := ev.UE
+	fail := ev.Msg.(*s1ap.HandoverFailure)
+	log.Printf("Handover of %v failed in target eNB: %v", ue, fail.Cause)
+	s.timerStop(ue, EVENT_TIMER_TS1RELOC)
+	s.ues.cancelHandover(ue)
+	s.sendHandoverPreparationFailure(ue,
+		radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HO_FAILURE_IN_TARGET_EPC_ENB_OR_TARGET_SYSTEM))
+	return nil
+}
+
+// emmENBStatusTransfer relays the PDCP status of the source eNB to the
+// target eNB.
+func emmENBStatusTransfer(s *Server, ev *Event) error {
+	ue := ev.UE
+	ho := ue.handover
+	if ho == nil || !ho.commanded {
+		return fmt.Errorf("eNB Status Transfer without handover")
+	}
+	st := ev.Msg.(*s1ap.ENBStatusTransfer)
+	s.sendTarget(ho, &s1ap.MMEStatusTransfer{
+		MMEUES1APID:                           ho.MMEUES1APID,
+		ENBUES1APID:                           ho.ENBUES1APID,
+		ENBStatusTransferTransparentContainer: st.ENBStatusTransferTransparentContainer,
+	})
+	return nil
+}
+
+// emmHandoverNotify moves the UE to the target eNB. The SGW is switched to
+// the S1-U endpoints of the target eNB, the UE context is released in the
+// source eNB and the dedicated bearers which are not admitted are deleted.
+func emmHandoverNotify(s *Server, ev *Event) error {
+	ue := ev.UE
+	ho := ue.handover
+	notify := ev.Msg.(*s1ap.HandoverNotify)
+	if !ho.commanded || ho.ENBUES1APID != notify.ENBUES1APID {
+		return fmt.Errorf("Handover Notify from unknown eNB-UE-S1AP-ID %d", notify.ENBUES1APID)
+	}
+	s.timerStop(ue, EVENT_TIMER_TS1RELOC)
+	s.sendUEContextReleaseCommand(ue,
+		radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_SUCCESSFUL_HANDOVER))
+	s.ues.completeHandover(ue)
+	ue.TAI = notify.TAI
+	ue.ECGI = notify.EUTRANCGI
+	log.Printf("Handover of %v is completed", ue)
+
+	released := []*Bearer{}
+	for _, b := range ue.sortedBearers() {
+		item := ho.admittedERAB(b.EBI)
+		if item == nil {
+			b.ENBAddr = nil
+			b.ENBTEID = 0
+			released = append(released, b)
+			continue
+		}
+		b.ENBAddr = ipOfTransportLayerAddress(item.TransportLayerAddress)
+		b.ENBTEID = uint32(item.GTPTEID)
+	}
+	if err := s.sendModifyBearerRequest(ue); err != nil {
+		return err
+	}
+	return s.sendDeleteBearerCommand(ue, released)
+}
+
+// emmHandoverCancel cancels the handover on request of the source eNB.
+// Cancel is acknowledged also when the handover is already over.
+func emmHandoverCancel(s *Server, ev *Event) error {
+	ue := ev.UE
+	cancel := ev.Msg.(*s1ap.HandoverCancel)
+	log.Printf("Handover of %v is cancelled: %v", ue, cancel.Cause)
+	s.releaseHandoverTarget(ue,
+		radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_HANDOVER_CANCELLED))
+	s.sendUE(ue, &s1ap.HandoverCancelAcknowledge{
+		MMEUES1APID: ue.MMEUES1APID,
+		ENBUES1APID: ue.ENBUES1APID,
+	})
+	return nil
+}
+
+// emmHandoverTimeout gives up the handover which the target eNB has not
+// answered or the UE has not arrived at in time.
+func emmHandoverTimeout(s *Server, ev *Event) error {
+	ue := ev.UE
+	ho := ue.handover
+	if ho == nil {
+		return nil
+	}
+	if !ho.commanded {
+		cause := radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_TS1RELOCPREP_EXPIRY)
+		s.sendHandoverPreparationFailure(ue, cause)
+		s.releaseHandoverTarget(ue, cause)
+		return nil
+	}
+	s.releaseHandoverTarget(ue,
+		radioNetworkCause(s1ap.CAUSE_RADIO_NETWORK_TS1RELOCOVERALL_EXPIRY))
+	return nil
+}
@@ -0,0 +1,56 @@
+package mme
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// FC values of the key derivation function (TS 33.401 Annex A).
+const (
+	KDF_FC_KENB          = 0x11
+	KDF_FC_NH            = 0x12
+	KDF_FC_ALGORITHM_KEY = 0x15
+)
+
+// Algorithm type distinguishers of the algorithm key derivation.
+const (
+	ALG_TYPE_NAS_ENC = 0x01
+	ALG_TYPE_NAS_INT = 0x02
+	ALG_TYPE_RRC_ENC = 0x03
+	ALG_TYPE_RRC_INT = 0x04
+	ALG_TYPE_UP_ENC  = 0x05
+	ALG_TYPE_UP_INT  = 0x06
+)
+
+// kdf is the generic key derivation function of TS 33.220 Annex B.2 with
+// HMAC-SHA-256. Each parameter is followed by its 2 octets length.
+func kdf(key []byte, fc uint8, params ...[]byte) []byte {
+	s := []byte{fc}
+	for _, p := range params {
+		s = append(s, p...)
+		s = append(s, byte(len(p)>>8), byte(len(p)))
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(s)
+	return mac.Sum(nil)
+}
+
+// deriveAlgorithmKey derives 128-bit key for the algorithm from KASME. The
+// key is the least significant 128 bits of the KDF output.
+func deriveAlgorithmKey(kasme []byte, distinguisher, alg uint8) []byte {
+	return kdf(kasme, KDF_FC_ALGORITHM_KEY, []byte{distinguisher}, []byte{alg})[16:]
+}
+
+// deriveKeNB derives KeNB from KASME and the uplink NAS COUNT.
+func deriveKeNB(kasme []byte, count uint32) []byte {
+	c := make([]byte, 4)
+	binary.BigEndian.PutUint32(c, count)
+	return kdf(kasme, KDF_FC_KENB, c)
+}
+
+// deriveNH derives next hop from KASME and the synchronization input which
+// is the initial KeNB or the previous NH.
+func deriveNH(kasme, sync []byte) []byte {
+	return kdf(kasme, KDF_FC_NH, sync)
+}
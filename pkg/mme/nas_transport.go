@@ -0,0 +1,348 @@
+package mme
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// nasEvent maps EMM message type to FSM event.
+var nasEvent = map[uint8]EventType{
+	nas.MSG_ATTACH_REQUEST:                EVENT_NAS_ATTACH_REQUEST,
+	nas.MSG_AUTHENTICATION_RESPONSE:       EVENT_NAS_AUTHENTICATION_RESPONSE,
+	nas.MSG_AUTHENTICATION_FAILURE:        EVENT_NAS_AUTHENTICATION_FAILURE,
+	nas.MSG_IDENTITY_RESPONSE:             EVENT_NAS_IDENTITY_RESPONSE,
+	nas.MSG_SECURITY_MODE_COMPLETE:        EVENT_NAS_SECURITY_MODE_COMPLETE,
+	nas.MSG_SECURITY_MODE_REJECT:          EVENT_NAS_SECURITY_MODE_REJECT,
+	nas.MSG_ATTACH_COMPLETE:               EVENT_NAS_ATTACH_COMPLETE,
+	nas.MSG_GUTI_REALLOCATION_COMPLETE:    EVENT_NAS_GUTI_REALLOCATION_COMPLETE,
+	nas.MSG_TRACKING_AREA_UPDATE_REQUEST:  EVENT_NAS_TRACKING_AREA_UPDATE_REQUEST,
+	nas.MSG_TRACKING_AREA_UPDATE_COMPLETE: EVENT_NAS_TRACKING_AREA_UPDATE_COMPLETE,
+	nas.MSG_SERVICE_REQUEST:               EVENT_NAS_SERVICE_REQUEST,
+	nas.MSG_EXTENDED_SERVICE_REQUEST:      EVENT_NAS_SERVICE_REQUEST,
+	nas.MSG_DETACH_REQUEST:                EVENT_NAS_DETACH_REQUEST,
+	nas.MSG_DETACH_ACCEPT:                 EVENT_NAS_DETACH_ACCEPT,
+}
+
+// esmEvent maps ESM message type to FSM event.
+var esmEvent = map[uint8]EventType{
+	nas.MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT: EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT,
+	nas.MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT: EVENT_NAS_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT,
+	nas.MSG_MODIFY_EPS_BEARER_CONTEXT_ACCEPT:             EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_ACCEPT,
+	nas.MSG_MODIFY_EPS_BEARER_CONTEXT_REJECT:             EVENT_NAS_MODIFY_EPS_BEARER_CONTEXT_REJECT,
+	nas.MSG_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT:         EVENT_NAS_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT,
+}
+
+// decodeNAS decodes the NAS PDU from the UE. Security protected message is
+// verified and deciphered with the NAS security context of the UE, or with
+// the new context when the message is protected with it. Message which
+// fails the integrity check or is not protected while the security context
+// is in use is discarded unless it is allowed to be processed without
+// integrity protection. verified is true when the message passed the
+// integrity check. Service Request is verified by the short MAC.
+func decodeNAS(ue *UEContext, pdu []byte) (m nas.Message, verified bool, err error) {
+	m, err = nas.Decode(pdu)
+	if err != nil {
+		return nil, false, err
+	}
+	if sr, ok := m.(*nas.ServiceRequest); ok {
+		if !ue.Security.active() {
+			return m, false, nil
+		}
+		if err := ue.Security.verifyServiceRequest(pdu, sr); err != nil {
+			log.Printf("Process Service Request which failed integrity check: %v", err)
+			return m, false, nil
+		}
+		return m, true, nil
+	}
+	sm, ok := m.(*nas.SecurityProtectedMessage)
+	if !ok {
+		if ue.Security.active() && !unprotectedAllowed(m) {
+			return nil, false, fmt.Errorf("discard unprotected %s", nas.MessageName(m))
+		}
+		return m, false, nil
+	}
+	sc := &ue.Security
+	if ue.newSecurity != nil && newContext(sm) {
+		sc = ue.newSecurity
+	}
+	if !sc.active() {
+		if sm.Ciphered() {
+			return nil, false, fmt.Errorf("no NAS security context to decipher")
+		}
+		m, err = nas.Decode(sm.Payload)
+		if err != nil {
+			return nil, false, err
+		}
+		if !unprotectedAllowed(m) {
+			return nil, false, fmt.Errorf("discard %s without NAS security context", nas.MessageName(m))
+		}
+		return m, false, nil
+	}
+	payload, err := sc.unprotect(sm)
+	if err == nas.ErrIntegrity && !sm.Ciphered() {
+		if m, derr := nas.Decode(sm.Payload); derr == nil && unprotectedAllowed(m) {
+			log.Printf("Process %s which failed integrity check", nas.MessageName(m))
+			return m, false, nil
+		}
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	m, err = nas.Decode(payload)
+	return m, err == nil, err
+}
+
+// newContext returns true when the message is protected with the new NAS
+// security context taken by the security mode control.
+func newContext(sm *nas.SecurityProtectedMessage) bool {
+	return sm.SecurityHeaderType == nas.SECURITY_HEADER_INTEGRITY_NEW_CONTEXT ||
+		sm.SecurityHeaderType == nas.SECURITY_HEADER_INTEGRITY_CIPHERED_NEW_CONTEXT
+}
+
+// initialGUTI returns old GUTI in the Tracking Area Update Request or the
+// GUTI in the Detach Request which is not ciphered so that the UE context
+// can be found before the message is verified.
+func initialGUTI(pdu []byte) *GUTI {
+	m, err := nas.Decode(pdu)
+	if err != nil {
+		return nil
+	}
+	if sm, ok := m.(*nas.SecurityProtectedMessage); ok {
+		if sm.Ciphered() {
+			return nil
+		}
+		if m, err = nas.Decode(sm.Payload); err != nil {
+			return nil
+		}
+	}
+	var id nas.MobileIdentity
+	switch m := m.(type) {
+	case *nas.TrackingAreaUpdateRequest:
+		id = m.OldGUTI
+	case *nas.DetachRequest:
+		id = m.EPSMobileIdentity
+	}
+	if id.Type != nas.IDENTITY_TYPE_GUTI {
+		return nil
+	}
+	guti := nasGUTI(id.GUTI)
+	return &guti
+}
+
+// dispatchNAS injects the NAS message to the UE FSM.
+func (s *Server) dispatchNAS(ue *UEContext, pdu s1ap.NASPDU) {
+	m, verified, err := decodeNAS(ue, pdu)
+	if err != nil {
+		log.Printf("NAS decode error: %v: %v", ue, err)
+		return
+	}
+	ue.nasVerified = verified
+	log.Printf("NAS %s", nas.MessageName(m))
+	events := nasEvent
+	if m.ProtocolDiscriminator() == nas.PD_ESM {
+		events = esmEvent
+	}
+	ev, ok := events[m.MessageType()]
+	if !ok {
+		log.Printf("Unhandled NAS message: %s", nas.MessageName(m))
+		return
+	}
+	s.dispatch(&Event{Type: ev, UE: ue, Msg: m})
+	if ue.EMMState == EMM_STATE_REGISTERED && ue.Security.needRekey() {
+		s.dispatch(&Event{Type: EVENT_NAS_COUNT_NEAR_WRAP, UE: ue})
+	}
+}
+
+// handleInitialUEMessage creates UE context for the initial NAS message.
+// When the S-TMSI is known, the existing context is connected to the eNB.
+// The old S1 connection of the UE which is still connected is released.
+func (s *Server) handleInitialUEMessage(m *message, msg *s1ap.InitialUEMessage) {
+	log.Printf("eNB-UE-S1AP-ID %d TAI %v ECGI %v RRC cause %d",
+		msg.ENBUES1APID, msg.TAI, msg.EUTRANCGI, msg.RRCEstablishmentCause)
+	if msg.GUMMEI != nil {
+		log.Printf("GUMMEI %v", *msg.GUMMEI)
+	}
+
+	if ue := s.ues.lookupByENB(m.enb, msg.ENBUES1APID); ue != nil {
+		log.Printf("Release stale %v", ue)
+		s.dispatch(&Event{Type: EVENT_S1AP_UE_CONTEXT_RELEASE, UE: ue})
+	}
+
+	var ue *UEContext
+	if msg.STMSI != nil {
+		ue = s.ues.lookupBySTMSI(*msg.STMSI)
+	} else if guti := initialGUTI(msg.NASPDU); guti != nil {
+		ue = s.ues.lookupByGUTI(*guti)
+	}
+	if ue != nil {
+		if ue.ENB() != nil {
+			log.Printf("%v moves to new S1 connection", ue)
+			s.abortHandover(ue)
+			s.sendUEContextReleaseCommand(ue,
+				s1ap.Cause{Group: s1ap.CAUSE_NAS, Value: s1ap.CAUSE_NAS_NORMAL_RELEASE})
+		}
+		if err := s.ues.reconnect(ue, m.enb, msg.ENBUES1APID); err != nil {
+			log.Println(err)
+			return
+		}
+	} else {
+		var err error
+		ue, err = s.ues.add(m.enb, msg.ENBUES1APID)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+	}
+	ue.TAI = msg.TAI
+	ue.ECGI = msg.EUTRANCGI
+	log.Println(ue)
+
+	s.dispatch(&Event{Type: EVENT_S1AP_INITIAL_UE_MESSAGE, UE: ue, Msg: msg})
+	s.dispatchNAS(ue, msg.NASPDU)
+}
+
+// lookupUE returns UE context of UE associated message.
+func (s *Server) lookupUE(m *message, mmeID s1ap.MMEUES1APID, enbID s1ap.ENBUES1APID) (*UEContext, error) {
+	ue := s.ues.lookupByMMEID(mmeID)
+	if ue == nil {
+		return nil, fmt.Errorf("Unknown MME-UE-S1AP-ID %d", mmeID)
+	}
+	if ue.ENB() != m.enb || ue.ENBUES1APID != enbID {
+		return nil, fmt.Errorf("Unknown pair of MME-UE-S1AP-ID %d and eNB-UE-S1AP-ID %d",
+			mmeID, enbID)
+	}
+	return ue, nil
+}
+
+// handleUplinkNASTransport handles NAS message from the UE.
+func (s *Server) handleUplinkNASTransport(m *message, msg *s1ap.UplinkNASTransport) {
+	ue, err := s.lookupUE(m, msg.MMEUES1APID, msg.ENBUES1APID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	ue.TAI = msg.TAI
+	ue.ECGI = msg.EUTRANCGI
+
+	s.dispatchNAS(ue, msg.NASPDU)
+}
+
+// sendDownlinkNAS sends NAS message to the UE.
+func (s *Server) sendDownlinkNAS(ue *UEContext, pdu []byte) {
+	s.sendUE(ue, &s1ap.DownlinkNASTransport{
+		MMEUES1APID: ue.MMEUES1APID,
+		ENBUES1APID: ue.ENBUES1APID,
+		NASPDU:      pdu,
+	})
+}
+
+// sendNAS sends NAS message which is retransmitted on the timer expiry.
+func (s *Server) sendNAS(ue *UEContext, pdu []byte, timer EventType) {
+	ue.pendingNAS = pdu
+	ue.retrans = 0
+	s.sendDownlinkNAS(ue, pdu)
+	s.timerStart(ue, timer)
+}
+
+// sendNASMessage encodes the NAS message and sends it with sendNAS.
+func (s *Server) sendNASMessage(ue *UEContext, m nas.Message, timer EventType) error {
+	pdu, err := nas.Encode(m)
+	if err != nil {
+		return err
+	}
+	s.sendNAS(ue, pdu, timer)
+	return nil
+}
+
+// sendProtectedNASMessage encodes the NAS message and sends it security
+// protected with the security header type.
+func (s *Server) sendProtectedNASMessage(ue *UEContext, m nas.Message, sh uint8, timer EventType) error {
+	pdu, err := nas.Encode(m)
+	if err != nil {
+		return err
+	}
+	if pdu, err = ue.Security.protect(pdu, sh); err != nil {
+		return err
+	}
+	s.sendNAS(ue, pdu, timer)
+	return nil
+}
+
+// sendNASMessageOnce sends the NAS message which is not retransmitted. It
+// is security protected when the NAS security context is in use.
+func (s *Server) sendNASMessageOnce(ue *UEContext, m nas.Message) error {
+	pdu, err := nas.Encode(m)
+	if err != nil {
+		return err
+	}
+	if ue.Security.active() {
+		if pdu, err = ue.Security.protect(pdu, nas.SECURITY_HEADER_INTEGRITY_CIPHERED); err != nil {
+			return err
+		}
+	}
+	s.sendDownlinkNAS(ue, pdu)
+	return nil
+}
+
+// sendInitialContextSetupRequest establishes UE context and E-RABs of all
+// bearers in the eNB. KeNB is derived from the NAS COUNT of the last uplink
+// message. The NAS PDU is carried in the E-RAB of the default bearer. UE
+// radio capability is sent when the eNB has reported it, and handover is
+// restricted to the PLMN of the GUTI.
+func (s *Server) sendInitialContextSetupRequest(ue *UEContext, pdu []byte) error {
+	if ue.Subscription == nil {
+		return fmt.Errorf("no subscription data")
+	}
+	if err := ue.Security.deriveKeNB(); err != nil {
+		return err
+	}
+	req := &s1ap.InitialContextSetupRequest{
+		MMEUES1APID: ue.MMEUES1APID,
+		ENBUES1APID: ue.ENBUES1APID,
+		UEAggregateMaximumBitrate: s1ap.UEAggregateMaximumBitrate{
+			DL: s1ap.BitRate(ue.Subscription.UEAMBRDL),
+			UL: s1ap.BitRate(ue.Subscription.UEAMBRUL),
+		},
+		UESecurityCapabilities: ue.Security.s1apCapabilities(),
+		UERadioCapability:      ue.RadioCapability,
+	}
+	if ue.GUTI != nil {
+		req.HandoverRestrictionList = &s1ap.HandoverRestrictionList{
+			ServingPLMN: ue.GUTI.PLMNIdentity,
+		}
+	}
+	for _, b := range ue.sortedBearers() {
+		item := s1ap.ERABToBeSetupItemCtxtSUReq{
+			ERABID:                 s1ap.ERABID(b.EBI),
+			ERABLevelQoSParameters: erabQoS(b),
+			TransportLayerAddress:  transportLayerAddress(b.SGWAddr),
+			GTPTEID:                s1ap.GTPTEID(b.SGWTEID),
+		}
+		if b.LinkedEBI == 0 && pdu != nil {
+			item.NASPDU = pdu
+			pdu = nil
+		}
+		req.ERABToBeSetupListCtxtSUReq = append(req.ERABToBeSetupListCtxtSUReq, item)
+	}
+	if len(req.ERABToBeSetupListCtxtSUReq) == 0 {
+		return fmt.Errorf("no bearer to setup")
+	}
+	copy(req.SecurityKey[:], ue.Security.KeNB)
+	s.sendUE(ue, req)
+	return nil
+}
+
+// handleUECapabilityInfoIndication keeps the UE radio capability reported
+// by the eNB.
+func (s *Server) handleUECapabilityInfoIndication(m *message, msg *s1ap.UECapabilityInfoIndication) {
+	ue, err := s.lookupUE(m, msg.MMEUES1APID, msg.ENBUES1APID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	log.Printf("UE radio capability of %v: %d bytes", ue, len(msg.UERadioCapability))
+	ue.RadioCapability = msg.UERadioCapability
+}
@@ -0,0 +1,223 @@
+package mme
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/coreswitch/coreswitch/pkg/gtpv2"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// pagingArea is the area where the UE is paged.
+type pagingArea int
+
+const (
+	// PAGING_AREA_LAST_TA is the TA where the UE was seen last.
+	PAGING_AREA_LAST_TA pagingArea = iota
+	// PAGING_AREA_ALL is all of TAs served by the connected eNBs.
+	PAGING_AREA_ALL
+)
+
+var pagingAreaStr = map[pagingArea]string{
+	PAGING_AREA_LAST_TA: "last TA",
+	PAGING_AREA_ALL:     "all TAs",
+}
+
+func (a pagingArea) String() string {
+	if str, ok := pagingAreaStr[a]; ok {
+		return str
+	}
+	return "Unknown"
+}
+
+// pagingStrategies is the escalation of paging. Paging is repeated in the
+// area of the next strategy on T3413 expiry and fails when T3413 of the
+// last one expires. The TAI list allocated to the UE has only the TA where
+// the UE registered, so the last TA covers the TAI list.
+var pagingStrategies = []pagingArea{
+	PAGING_AREA_LAST_TA,
+	PAGING_AREA_ALL,
+}
+
+// pagingContext is the ongoing paging of the UE. attempt is the index of
+// pagingStrategies. ddn is set when the SGW waits for the result of paging
+// triggered by Downlink Data Notification.
+type pagingContext struct {
+	attempt int
+	ddn     bool
+}
+
+// ueIdentityIndex returns UE identity index value which is IMSI mod 1024
+// as in TS 36.304 7.1.
+func ueIdentityIndex(imsi string) s1ap.UEIdentityIndexValue {
+	v, _ := strconv.ParseUint(imsi, 10, 64)
+	return s1ap.UEIdentityIndexValue(v % 1024)
+}
+
+// pagingTAIs returns TAIs of the paging area. It is nil for all TAs.
+func pagingTAIs(ue *UEContext, area pagingArea) []s1ap.TAI {
+	if area == PAGING_AREA_LAST_TA {
+		return []s1ap.TAI{ue.TAI}
+	}
+	return nil
+}
+
+// maxPagingTAIs is maxnoofTAIs of the TAI list in Paging.
+const maxPagingTAIs = 256
+
+// enbPagingTAIs returns TAIs in tais which the eNB serves. All of TAIs of
+// the eNB are returned when tais is nil.
+func enbPagingTAIs(enb *ENB, tais []s1ap.TAI) s1ap.TAIList {
+	list := s1ap.TAIList{}
+	for _, ta := range enb.SupportedTAs {
+		for _, plmn := range ta.BroadcastPLMNs {
+			tai := s1ap.TAI{PLMNIdentity: plmn, TAC: ta.TAC}
+			if tais != nil && !containsTAI(tais, tai) {
+				continue
+			}
+			if len(list) < maxPagingTAIs {
+				list = append(list, tai)
+			}
+		}
+	}
+	return list
+}
+
+func containsTAI(tais []s1ap.TAI, tai s1ap.TAI) bool {
+	for _, t := range tais {
+		if t == tai {
+			return true
+		}
+	}
+	return false
+}
+
+// sendPaging sends Paging to every eNB which serves the paging area of the
+// current attempt. The UE is paged by S-TMSI, or by IMSI when it has no
+// GUTI. It returns the number of eNBs paged.
+func (s *Server) sendPaging(ue *UEContext) int {
+	area := pagingStrategies[ue.paging.attempt]
+	id := s1ap.UEPagingID{IMSI: s1ap.IMSI(ue.IMSI)}
+	if ue.GUTI != nil {
+		stmsi := ue.GUTI.STMSI()
+		id = s1ap.UEPagingID{STMSI: &stmsi}
+	}
+	tais := pagingTAIs(ue, area)
+	n := 0
+	for _, enb := range s.enbs.list() {
+		list := enbPagingTAIs(enb, tais)
+		if len(list) == 0 {
+			continue
+		}
+		s.sendENB(enb, &s1ap.Paging{
+			UEIdentityIndexValue: ueIdentityIndex(ue.IMSI),
+			UEPagingID:           id,
+			CNDomain:             s1ap.CN_DOMAIN_PS,
+			TAIList:              list,
+		})
+		n++
+	}
+	log.Printf("Paging %v in %v by %d eNBs (attempt %d)", ue, area, n, ue.paging.attempt+1)
+	return n
+}
+
+// startPaging pages the UE in ECM-IDLE. Paging in progress is not
+// restarted.
+func (s *Server) startPaging(ue *UEContext, ddn bool) {
+	if ue.paging != nil {
+		ue.paging.ddn = ue.paging.ddn || ddn
+		return
+	}
+	ue.paging = &pagingContext{ddn: ddn}
+	s.page(ue)
+}
+
+// page sends Paging of the current attempt and starts T3413. T3413 is
+// started even when no eNB serves the area so that paging escalates.
+func (s *Server) page(ue *UEContext) {
+	if s.sendPaging(ue) == 0 {
+		log.Printf("No eNB serves %v of %v", pagingStrategies[ue.paging.attempt], ue)
+	}
+	s.timerStart(ue, EVENT_TIMER_T3413)
+}
+
+// stopPaging stops paging of the UE which has entered ECM-CONNECTED.
+func (s *Server) stopPaging(ue *UEContext) {
+	if ue.paging == nil {
+		return
+	}
+	s.timerStop(ue, EVENT_TIMER_T3413)
+	ue.paging = nil
+	log.Printf("Paging of %v is answered", ue)
+}
+
+// ackDownlinkDataNotification answers Downlink Data Notification with the
+// cause.
+func (s *Server) ackDownlinkDataNotification(ue *UEContext, req *s11Request, cause uint8) {
+	err := s.respondS11(ue, req, &gtpv2.DownlinkDataNotificationAck{
+		Cause: gtpv2.Cause{Value: cause},
+	})
+	if err != nil {
+		log.Printf("Downlink Data Notification Acknowledge error: %v: %v", ue, err)
+	}
+}
+
+// emmDownlinkDataNotification accepts the notification and pages the UE.
+func emmDownlinkDataNotification(s *Server, ev *Event) error {
+	s.ackDownlinkDataNotification(ev.UE, ev.Msg.(*s11Request), gtpv2.CAUSE_REQUEST_ACCEPTED)
+	s.startPaging(ev.UE, true)
+	return nil
+}
+
+// emmDownlinkDataNotificationAccept accepts the notification of the UE in
+// ECM-CONNECTED. S1-U bearers are set up by the procedure in progress.
+func emmDownlinkDataNotificationAccept(s *Server, ev *Event) error {
+	s.ackDownlinkDataNotification(ev.UE, ev.Msg.(*s11Request), gtpv2.CAUSE_REQUEST_ACCEPTED)
+	return nil
+}
+
+// emmDownlinkDataNotificationReject rejects the notification of the UE
+// which is not registered.
+func emmDownlinkDataNotificationReject(s *Server, ev *Event) error {
+	s.ackDownlinkDataNotification(ev.UE, ev.Msg.(*s11Request), gtpv2.CAUSE_CONTEXT_NOT_FOUND)
+	return nil
+}
+
+// emmPaging pages the UE on request of the operator.
+func emmPaging(s *Server, ev *Event) error {
+	s.startPaging(ev.UE, false)
+	return nil
+}
+
+func emmCanRepage(s *Server, ev *Event) bool {
+	return ev.UE.paging != nil && ev.UE.paging.attempt+1 < len(pagingStrategies)
+}
+
+// emmRepage escalates paging to the area of the next strategy.
+func emmRepage(s *Server, ev *Event) error {
+	ev.UE.paging.attempt++
+	s.page(ev.UE)
+	return nil
+}
+
+// emmPagingFailure gives up paging. The SGW is informed by Downlink Data
+// Notification Failure Indication so that it discards the buffered data.
+// Bearer procedure waiting for the UE is rejected.
+func emmPagingFailure(s *Server, ev *Event) error {
+	ue := ev.UE
+	p := ue.paging
+	ue.paging = nil
+	log.Printf("Paging of %v failed", ue)
+	if err := s.finishBearerProcedure(ue); err != nil {
+		log.Printf("%v: %v", ue, err)
+	}
+	if p == nil || !p.ddn {
+		return nil
+	}
+	node := uint8(gtpv2.NODE_TYPE_MME)
+	return s.notifyS11(ue, &gtpv2.DownlinkDataNotificationFailureIndication{
+		Cause:           gtpv2.Cause{Value: gtpv2.CAUSE_UE_NOT_RESPONDING},
+		OriginatingNode: &node,
+		IMSI:            ue.IMSI,
+	})
+}
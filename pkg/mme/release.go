@@ -0,0 +1,74 @@
+package mme
+
+import (
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// sendUEContextReleaseCommand releases the S1 connection of the UE. The UE
+// enters ECM-IDLE when the eNB completes the release.
+func (s *Server) sendUEContextReleaseCommand(ue *UEContext, cause s1ap.Cause) {
+	enbID := ue.ENBUES1APID
+	log.Printf("Release S1 connection of %v by %v", ue, cause)
+	s.sendUE(ue, &s1ap.UEContextReleaseCommand{
+		UES1APIDs: s1ap.UES1APIDs{
+			MMEUES1APID: ue.MMEUES1APID,
+			ENBUES1APID: &enbID,
+		},
+		Cause: cause,
+	})
+}
+
+// handleUEContextReleaseRequest releases the S1 connection requested by
+// the eNB. The UE context which is not known is released by
+// MME-UE-S1AP-ID only.
+func (s *Server) handleUEContextReleaseRequest(m *message, msg *s1ap.UEContextReleaseRequest) {
+	log.Printf("UE Context Release Request MME-UE-S1AP-ID %d eNB-UE-S1AP-ID %d cause %v",
+		msg.MMEUES1APID, msg.ENBUES1APID, msg.Cause)
+	ue, err := s.lookupUE(m, msg.MMEUES1APID, msg.ENBUES1APID)
+	if err != nil {
+		log.Println(err)
+		s.sendMessage(m, &s1ap.UEContextReleaseCommand{
+			UES1APIDs: s1ap.UES1APIDs{MMEUES1APID: msg.MMEUES1APID},
+			Cause: s1ap.Cause{
+				Group: s1ap.CAUSE_RADIO_NETWORK,
+				Value: s1ap.CAUSE_RADIO_NETWORK_UNKNOWN_PAIR_UE_S1AP_ID,
+			},
+		})
+		return
+	}
+	s.dispatch(&Event{Type: EVENT_S1AP_UE_CONTEXT_RELEASE_REQUEST, UE: ue, Msg: msg})
+}
+
+func ecmReleaseRequest(s *Server, ev *Event) error {
+	s.sendUEContextReleaseCommand(ev.UE, ev.Msg.(*s1ap.UEContextReleaseRequest).Cause)
+	return nil
+}
+
+// ecmInitialContextSetupFailure releases the S1 connection of the UE of
+// which the eNB failed to establish the context. The session of the UE
+// which is not registered yet is deleted when the UE enters ECM-IDLE.
+func ecmInitialContextSetupFailure(s *Server, ev *Event) error {
+	cause := ev.Msg.(*s1ap.InitialContextSetupFailure).Cause
+	log.Printf("Initial Context Setup of %v failed: %v", ev.UE, cause)
+	s.sendUEContextReleaseCommand(ev.UE, cause)
+	return nil
+}
+
+// handleUEContextReleaseComplete moves the UE to ECM-IDLE. The old S1
+// connection of the UE which has moved to another connection is just
+// forgotten.
+func (s *Server) handleUEContextReleaseComplete(m *message, msg *s1ap.UEContextReleaseComplete) {
+	if s.ues.releaseComplete(m.enb, msg.MMEUES1APID, msg.ENBUES1APID) {
+		log.Printf("Old S1 connection MME-UE-S1AP-ID %d eNB-UE-S1AP-ID %d is released",
+			msg.MMEUES1APID, msg.ENBUES1APID)
+		return
+	}
+	ue, err := s.lookupUE(m, msg.MMEUES1APID, msg.ENBUES1APID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	s.dispatch(&Event{Type: EVENT_S1AP_UE_CONTEXT_RELEASE_COMPLETE, UE: ue, Msg: msg})
+}
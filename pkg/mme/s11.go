@@ -0,0 +1,299 @@
+package mme
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/coreswitch/coreswitch/pkg/gtpv2"
+)
+
+// GTPv2-C requests are retransmitted on T3-RESPONSE expiry up to
+// N3-REQUESTS times.
+const (
+	s11RetransmitInterval = 3 * time.Second
+	s11MaxRetransmission  = 2
+)
+
+// s11Event maps GTPv2-C response type to FSM event.
+var s11Event = map[uint8]EventType{
+	gtpv2.MSG_CREATE_SESSION_RESPONSE:          EVENT_S11_CREATE_SESSION_RESPONSE,
+	gtpv2.MSG_MODIFY_BEARER_RESPONSE:           EVENT_S11_MODIFY_BEARER_RESPONSE,
+	gtpv2.MSG_DELETE_SESSION_RESPONSE:          EVENT_S11_DELETE_SESSION_RESPONSE,
+	gtpv2.MSG_RELEASE_ACCESS_BEARERS_RESPONSE:  EVENT_S11_RELEASE_ACCESS_BEARERS_RESPONSE,
+	gtpv2.MSG_DELETE_BEARER_FAILURE_INDICATION: EVENT_S11_DELETE_BEARER_FAILURE_INDICATION,
+}
+
+// s11RequestEvent maps GTPv2-C request type from the SGW to FSM event and
+// the response which rejects the request of unknown TEID.
+var s11RequestEvent = map[uint8]struct {
+	event  EventType
+	reject func(cause gtpv2.Cause) gtpv2.Message
+}{
+	gtpv2.MSG_DOWNLINK_DATA_NOTIFICATION: {EVENT_S11_DOWNLINK_DATA_NOTIFICATION,
+		func(cause gtpv2.Cause) gtpv2.Message {
+			return &gtpv2.DownlinkDataNotificationAck{Cause: cause}
+		}},
+	gtpv2.MSG_CREATE_BEARER_REQUEST: {EVENT_S11_CREATE_BEARER_REQUEST,
+		func(cause gtpv2.Cause) gtpv2.Message {
+			return &gtpv2.CreateBearerResponse{Cause: cause}
+		}},
+	gtpv2.MSG_UPDATE_BEARER_REQUEST: {EVENT_S11_UPDATE_BEARER_REQUEST,
+		func(cause gtpv2.Cause) gtpv2.Message {
+			return &gtpv2.UpdateBearerResponse{Cause: cause}
+		}},
+	gtpv2.MSG_DELETE_BEARER_REQUEST: {EVENT_S11_DELETE_BEARER_REQUEST,
+		func(cause gtpv2.Cause) gtpv2.Message {
+			return &gtpv2.DeleteBearerResponse{Cause: cause}
+		}},
+}
+
+// s11Request is a request from the SGW. The response is sent to the peer
+// with the sequence number of the request.
+type s11Request struct {
+	peer *net.UDPAddr
+	seq  uint32
+	msg  gtpv2.Message
+}
+
+type s11Transaction struct {
+	ue      *UEContext
+	req     gtpv2.Message
+	buf     []byte
+	retrans int
+	timer   *time.Timer
+}
+
+// s11Client is GTPv2-C client of S11 interface towards SGW. Responses are
+// matched with requests by the sequence number and injected to the handler
+// goroutine as FSM events. Request which is not answered is reported by
+// EVENT_S11_TIMEOUT. Requests from the SGW are delivered to the UE of the
+// TEID in the header.
+type s11Client struct {
+	conn    *net.UDPConn
+	sgw     *net.UDPAddr
+	inject  func(ev *Event)
+	lookup  func(teid uint32) *UEContext
+	mu      sync.Mutex
+	seq     uint32
+	pending map[uint32]*s11Transaction
+}
+
+func newS11Client(local, sgw net.IP, inject func(ev *Event),
+	lookup func(teid uint32) *UEContext) (*s11Client, error) {
+	if sgw == nil {
+		return nil, fmt.Errorf("SGW address is not configured")
+	}
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: local, Port: gtpv2.GTPV2_PORT_NUMBER})
+	if err != nil {
+		return nil, err
+	}
+	return &s11Client{
+		conn:    conn,
+		sgw:     &net.UDPAddr{IP: sgw, Port: gtpv2.GTPV2_PORT_NUMBER},
+		inject:  inject,
+		lookup:  lookup,
+		seq:     1,
+		pending: map[uint32]*s11Transaction{},
+	}, nil
+}
+
+// start starts receiver goroutine. It returns when the client is closed.
+func (c *s11Client) start(wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		buf := make([]byte, 4096)
+		for {
+			n, peer, err := c.conn.ReadFromUDP(buf)
+			if err != nil {
+				log.Printf("S11 receive stopped: %v", err)
+				return
+			}
+			c.receive(peer, append([]byte{}, buf[:n]...))
+		}
+	}()
+}
+
+// close stops the receiver and retransmission of pending requests.
+func (c *s11Client) close() {
+	c.mu.Lock()
+	for seq, tr := range c.pending {
+		tr.timer.Stop()
+		delete(c.pending, seq)
+	}
+	c.mu.Unlock()
+	c.conn.Close()
+}
+
+// send sends the request of the UE to the SGW. TEID is the S11 TEID of the
+// SGW which is zero for Create Session Request.
+func (c *s11Client) send(ue *UEContext, teid uint32, req gtpv2.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seq := c.seq
+	c.seq = (c.seq + 1) & gtpv2.MAX_SEQUENCE
+	buf, err := gtpv2.Encode(teid, seq, req)
+	if err != nil {
+		return err
+	}
+	if _, err := c.conn.WriteToUDP(buf, c.sgw); err != nil {
+		return err
+	}
+	log.Printf("S11 %s TEID 0x%x sequence %d", gtpv2.MessageName(req), teid, seq)
+	tr := &s11Transaction{ue: ue, req: req, buf: buf}
+	tr.timer = time.AfterFunc(s11RetransmitInterval, func() { c.expire(seq) })
+	c.pending[seq] = tr
+	return nil
+}
+
+// notify sends the message which has no response to the SGW.
+func (c *s11Client) notify(teid uint32, m gtpv2.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seq := c.seq
+	c.seq = (c.seq + 1) & gtpv2.MAX_SEQUENCE
+	buf, err := gtpv2.Encode(teid, seq, m)
+	if err != nil {
+		return err
+	}
+	if _, err := c.conn.WriteToUDP(buf, c.sgw); err != nil {
+		return err
+	}
+	log.Printf("S11 %s TEID 0x%x sequence %d", gtpv2.MessageName(m), teid, seq)
+	return nil
+}
+
+// respond sends the response to the request from the SGW.
+func (c *s11Client) respond(teid uint32, req *s11Request, resp gtpv2.Message) error {
+	buf, err := gtpv2.Encode(teid, req.seq, resp)
+	if err != nil {
+		return err
+	}
+	if _, err := c.conn.WriteToUDP(buf, req.peer); err != nil {
+		return err
+	}
+	log.Printf("S11 %s TEID 0x%x sequence %d", gtpv2.MessageName(resp), teid, req.seq)
+	return nil
+}
+
+// expire retransmits the request or reports the timeout.
+func (c *s11Client) expire(seq uint32) {
+	c.mu.Lock()
+	tr, ok := c.pending[seq]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	if tr.retrans < s11MaxRetransmission {
+		tr.retrans++
+		log.Printf("S11 retransmit %s sequence %d (%d)", gtpv2.MessageName(tr.req), seq, tr.retrans)
+		if _, err := c.conn.WriteToUDP(tr.buf, c.sgw); err != nil {
+			log.Printf("S11 write failed: %v", err)
+		}
+		tr.timer = time.AfterFunc(s11RetransmitInterval, func() { c.expire(seq) })
+		c.mu.Unlock()
+		return
+	}
+	delete(c.pending, seq)
+	c.mu.Unlock()
+	log.Printf("S11 %s sequence %d timeout", gtpv2.MessageName(tr.req), seq)
+	c.inject(&Event{Type: EVENT_S11_TIMEOUT, UE: tr.ue, Msg: tr.req})
+}
+
+// triggered completes Delete Bearer Command of the sequence number. Delete
+// Bearer Request triggered by the command has its sequence number.
+func (c *s11Client) triggered(seq uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tr, ok := c.pending[seq]
+	if !ok || tr.req.MessageType() != gtpv2.MSG_DELETE_BEARER_COMMAND {
+		return
+	}
+	tr.timer.Stop()
+	delete(c.pending, seq)
+}
+
+// receive handles GTPv2-C message from the peer.
+func (c *s11Client) receive(peer *net.UDPAddr, buf []byte) {
+	h, m, err := gtpv2.Decode(buf)
+	if err != nil {
+		log.Printf("S11 decode error from %s: %v", peer, err)
+		return
+	}
+	if req, ok := m.(*gtpv2.EchoRequest); ok {
+		log.Printf("S11 Echo Request from %s recovery %d", peer, req.Recovery)
+		resp, err := gtpv2.Encode(0, h.Sequence, &gtpv2.EchoResponse{})
+		if err == nil {
+			_, err = c.conn.WriteToUDP(resp, peer)
+		}
+		if err != nil {
+			log.Printf("S11 Echo Response error: %v", err)
+		}
+		return
+	}
+	if r, ok := s11RequestEvent[m.MessageType()]; ok {
+		if m.MessageType() == gtpv2.MSG_DELETE_BEARER_REQUEST {
+			c.triggered(h.Sequence)
+		}
+		req := &s11Request{peer: peer, seq: h.Sequence, msg: m}
+		ue := c.lookup(h.TEID)
+		if ue == nil {
+			log.Printf("S11 %s TEID 0x%x has no context", gtpv2.MessageName(m), h.TEID)
+			err := c.respond(0, req, r.reject(gtpv2.Cause{Value: gtpv2.CAUSE_CONTEXT_NOT_FOUND}))
+			if err != nil {
+				log.Printf("S11 response error: %v", err)
+			}
+			return
+		}
+		c.inject(&Event{Type: r.event, UE: ue, Msg: req})
+		return
+	}
+	typ, ok := s11Event[m.MessageType()]
+	if !ok {
+		log.Printf("Unhandled S11 message %s from %s", gtpv2.MessageName(m), peer)
+		return
+	}
+	c.mu.Lock()
+	tr, ok := c.pending[h.Sequence]
+	if ok {
+		tr.timer.Stop()
+		delete(c.pending, h.Sequence)
+	}
+	c.mu.Unlock()
+	if !ok {
+		log.Printf("S11 %s sequence %d has no request", gtpv2.MessageName(m), h.Sequence)
+		return
+	}
+	if tr.ue.S11TEID != h.TEID {
+		log.Printf("S11 %s TEID 0x%x mismatch", gtpv2.MessageName(m), h.TEID)
+	}
+	c.inject(&Event{Type: typ, UE: tr.ue, Msg: m})
+}
+
+// sendS11 sends the request to the SGW on the S11 tunnel of the UE.
+func (s *Server) sendS11(ue *UEContext, req gtpv2.Message) error {
+	if s.s11 == nil {
+		return fmt.Errorf("S11 is not started")
+	}
+	return s.s11.send(ue, ue.SGWS11TEID, req)
+}
+
+// notifyS11 sends the message which has no response to the SGW on the S11
+// tunnel of the UE.
+func (s *Server) notifyS11(ue *UEContext, m gtpv2.Message) error {
+	if s.s11 == nil {
+		return fmt.Errorf("S11 is not started")
+	}
+	return s.s11.notify(ue.SGWS11TEID, m)
+}
+
+// respondS11 answers the request from the SGW on the S11 tunnel of the UE.
+func (s *Server) respondS11(ue *UEContext, req *s11Request, resp gtpv2.Message) error {
+	if s.s11 == nil {
+		return fmt.Errorf("S11 is not started")
+	}
+	return s.s11.respond(ue.SGWS11TEID, req, resp)
+}
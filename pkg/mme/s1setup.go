@@ -0,0 +1,117 @@
+package mme
+
+import (
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// servePLMN returns true when the PLMN is served by the MME.
+func (s *Server) servePLMN(plmn s1ap.PLMNIdentity) bool {
+	for _, gummei := range s.conf.servedGUMMEIs {
+		for _, p := range gummei.ServedPLMNs {
+			if p == plmn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// serveTAC returns true when the TAC is served by the MME. All of TACs are
+// served when no TAC is configured.
+func (s *Server) serveTAC(tac uint16) bool {
+	if len(s.conf.tacs) == 0 {
+		return true
+	}
+	for _, t := range s.conf.tacs {
+		if t == tac {
+			return true
+		}
+	}
+	return false
+}
+
+// servedTAs returns the TAs in the S1 Setup Request which are served by the
+// MME.
+func (s *Server) servedTAs(req *s1ap.S1SetupRequest) []s1ap.TAI {
+	tais := []s1ap.TAI{}
+	for _, ta := range req.SupportedTAs {
+		if !s.serveTAC(ta.TAC) {
+			continue
+		}
+		for _, plmn := range ta.BroadcastPLMNs {
+			if s.servePLMN(plmn) {
+				tais = append(tais, s1ap.TAI{PLMNIdentity: plmn, TAC: ta.TAC})
+			}
+		}
+	}
+	return tais
+}
+
+// servesPLMN returns true when any of PLMNs broadcast by the eNB is served
+// by the MME.
+func (s *Server) servesPLMN(req *s1ap.S1SetupRequest) bool {
+	for _, ta := range req.SupportedTAs {
+		for _, plmn := range ta.BroadcastPLMNs {
+			if s.servePLMN(plmn) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setupFailureCause returns the cause of S1 Setup Failure for the request
+// which has no served TA. Unknown PLMN is used only when no broadcast PLMN
+// is served.
+func (s *Server) setupFailureCause(req *s1ap.S1SetupRequest) s1ap.Cause {
+	if !s.servesPLMN(req) {
+		return s1ap.Cause{Group: s1ap.CAUSE_MISC, Value: s1ap.CAUSE_MISC_UNKNOWN_PLMN}
+	}
+	return s1ap.Cause{Group: s1ap.CAUSE_MISC, Value: s1ap.CAUSE_MISC_UNSPECIFIED}
+}
+
+// handleS1SetupRequest validates S1 Setup Request against configured PLMNs
+// and TACs then replies S1 Setup Response or S1 Setup Failure.
+func (s *Server) handleS1SetupRequest(m *message, req *s1ap.S1SetupRequest) {
+	log.Printf("S1 Setup Request from eNB %v name %q DRX %d", req.GlobalENBID,
+		req.ENBname, req.DefaultPagingDRX)
+
+	if len(s.servedTAs(req)) == 0 {
+		log.Printf("S1 Setup Failure: no served TA for eNB %v", req.GlobalENBID)
+		wait := s1ap.TIME_TO_WAIT_V10S
+		s.sendMessage(m, &s1ap.S1SetupFailure{
+			Cause:      s.setupFailureCause(req),
+			TimeToWait: &wait,
+		})
+		return
+	}
+
+	if old := s.enbs.setup(m.enb, m.header, req); old != nil {
+		log.Printf("eNB %v moved from %s to %s", req.GlobalENBID,
+			old.Conn().RemoteAddr(), m.conn.RemoteAddr())
+		s.removeENB(old)
+	}
+
+	resp := &s1ap.S1SetupResponse{
+		MMEname:                  s1ap.MMEname(s.conf.mmeName),
+		ServedGUMMEIs:            s.conf.servedGUMMEIs,
+		RelativeMMECapacity:      s1ap.RelativeMMECapacity(s.conf.relativeCapacity),
+		MMERelaySupportIndicator: s.conf.relaySupport,
+	}
+	if len(req.UnknownIEs) > 0 {
+		resp.CriticalityDiagnostics = s1ap.NewCriticalityDiagnostics(req, req.UnknownIEs)
+	}
+	s.sendMessage(m, resp)
+}
+
+// removeENB releases the UEs of the old association of the eNB which has
+// set up S1 again from a new association, and closes the old association.
+func (s *Server) removeENB(enb *ENB) {
+	s.ues.removeENB(enb)
+	for _, ue := range s.ues.enbUEs(enb) {
+		s.dispatch(&Event{Type: EVENT_S1AP_UE_CONTEXT_RELEASE, UE: ue})
+	}
+	enb.Conn().Close()
+}
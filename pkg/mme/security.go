@@ -0,0 +1,232 @@
+package mme
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// errNASReplay is returned when NAS COUNT of the uplink message has been
+// already used.
+var errNASReplay = errors.New("replayed NAS message")
+
+// NAS security algorithms in the order of MME preference. EEA0 is the last
+// resort for UEs which support no ciphering algorithm.
+var (
+	integrityPreference = []uint8{nas.EIA2, nas.EIA1, nas.EIA3}
+	cipheringPreference = []uint8{nas.EEA2, nas.EEA1, nas.EEA3, nas.EEA0}
+)
+
+// supported returns true when the UE network capability octet has the bit
+// of the algorithm. Bit 8 of the octet is algorithm 0.
+func supported(octet uint8, alg uint8) bool {
+	return octet&(0x80>>alg) != 0
+}
+
+// selectAlgorithms selects NAS security algorithms which are supported by
+// the UE.
+func (sc *SecurityContext) selectAlgorithms() error {
+	capa := sc.UENetworkCapability
+	if len(capa) < 2 {
+		return fmt.Errorf("no UE network capability")
+	}
+	found := false
+	for _, alg := range cipheringPreference {
+		if supported(capa[0], alg) {
+			sc.CipheringAlg = alg
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("no ciphering algorithm in UE network capability %x", capa)
+	}
+	for _, alg := range integrityPreference {
+		if supported(capa[1], alg) {
+			sc.IntegrityAlg = alg
+			return nil
+		}
+	}
+	return fmt.Errorf("no integrity algorithm in UE network capability %x", capa)
+}
+
+// replayedCapabilities returns the UE security capabilities replayed in the
+// Security Mode Command. UMTS algorithms are replayed when the UE has sent
+// them.
+func (sc *SecurityContext) replayedCapabilities() []byte {
+	capa := sc.UENetworkCapability
+	if len(capa) >= 4 {
+		// Bit 8 of UIA octet is UCS2 support which is spare in the
+		// replayed capabilities.
+		return []byte{capa[0], capa[1], capa[2], capa[3] & 0x7f}
+	}
+	return append([]byte{}, capa[:2]...)
+}
+
+func (sc *SecurityContext) algorithms() nas.SecurityAlgorithms {
+	return nas.SecurityAlgorithms{Ciphering: sc.CipheringAlg, Integrity: sc.IntegrityAlg}
+}
+
+// s1apCapabilities returns the UE security capabilities for S1AP. EEA0 and
+// EIA0 are not in the S1AP bitmaps which start from 128-EEA1 and 128-EIA1.
+func (sc *SecurityContext) s1apCapabilities() s1ap.UESecurityCapabilities {
+	capa := sc.UENetworkCapability
+	if len(capa) < 2 {
+		return s1ap.UESecurityCapabilities{}
+	}
+	return s1ap.UESecurityCapabilities{
+		EncryptionAlgorithms:          uint16(capa[0]<<1) << 8,
+		IntegrityProtectionAlgorithms: uint16(capa[1]<<1) << 8,
+	}
+}
+
+// deriveNASKeys derives KNASint and KNASenc of the selected algorithms from
+// KASME.
+func (sc *SecurityContext) deriveNASKeys() error {
+	if len(sc.KASME) != 32 {
+		return fmt.Errorf("invalid KASME length %d", len(sc.KASME))
+	}
+	sc.KNASint = deriveAlgorithmKey(sc.KASME, ALG_TYPE_NAS_INT, sc.IntegrityAlg)
+	sc.KNASenc = deriveAlgorithmKey(sc.KASME, ALG_TYPE_NAS_ENC, sc.CipheringAlg)
+	return nil
+}
+
+// lastULCount returns the NAS COUNT of the last uplink message.
+func (sc *SecurityContext) lastULCount() uint32 {
+	if sc.ULCount == 0 {
+		return 0
+	}
+	return sc.ULCount - 1
+}
+
+// deriveKeNB derives KeNB from the NAS COUNT of the last uplink message. The
+// KeNB is the virtual NH of NCC 0 for the NH chaining.
+func (sc *SecurityContext) deriveKeNB() error {
+	if len(sc.KASME) != 32 {
+		return fmt.Errorf("invalid KASME length %d", len(sc.KASME))
+	}
+	sc.KeNB = deriveKeNB(sc.KASME, sc.lastULCount())
+	sc.NH = sc.KeNB
+	sc.NCC = 0
+	return nil
+}
+
+// nextHop derives next NH from the current NH and steps NCC.
+func (sc *SecurityContext) nextHop() ([]byte, uint8, error) {
+	if sc.NH == nil {
+		return nil, 0, fmt.Errorf("no KeNB to derive NH")
+	}
+	sc.NH = deriveNH(sc.KASME, sc.NH)
+	sc.NCC = (sc.NCC + 1) & 0x7
+	return sc.NH, sc.NCC, nil
+}
+
+// active returns true when NAS keys are in use.
+func (sc *SecurityContext) active() bool {
+	return sc.KNASint != nil
+}
+
+// needRekey returns true when the NAS COUNT is near to wrap around and a
+// new KASME has to be taken into use by re-authentication.
+func (sc *SecurityContext) needRekey() bool {
+	return sc.active() &&
+		(sc.ULCount >= NAS_COUNT_REKEY_THRESHOLD || sc.DLCount >= NAS_COUNT_REKEY_THRESHOLD)
+}
+
+// protect returns security protected NAS PDU of the plain NAS PDU and steps
+// the downlink NAS COUNT.
+func (sc *SecurityContext) protect(pdu []byte, sh uint8) ([]byte, error) {
+	if !sc.active() {
+		return nil, fmt.Errorf("no NAS security context")
+	}
+	if sc.DLCount > NAS_COUNT_MAX {
+		return nil, fmt.Errorf("downlink NAS COUNT wraps around")
+	}
+	m, err := nas.Protect(pdu, sh, sc.algorithms(), sc.KNASint, sc.KNASenc,
+		sc.DLCount, nas.DIRECTION_DOWNLINK)
+	if err != nil {
+		return nil, err
+	}
+	sc.DLCount++
+	return nas.Encode(m)
+}
+
+// estimateULCount estimates NAS COUNT of the uplink message from the
+// sequence number. Sequence number smaller than the expected one means the
+// overflow counter has been incremented by the UE.
+func (sc *SecurityContext) estimateULCount(sqn uint8) uint32 {
+	count := sc.ULCount&^0xff | uint32(sqn)
+	if count < sc.ULCount {
+		count += 0x100
+	}
+	return count
+}
+
+// unprotect verifies and deciphers the security protected message from the
+// UE. Message with NAS COUNT which has been already received is rejected as
+// replay.
+func (sc *SecurityContext) unprotect(m *nas.SecurityProtectedMessage) ([]byte, error) {
+	count := sc.estimateULCount(m.SequenceNumber)
+	if count > NAS_COUNT_MAX {
+		return nil, fmt.Errorf("uplink NAS COUNT wraps around")
+	}
+	pdu, err := m.Unprotect(sc.algorithms(), sc.KNASint, sc.KNASenc, count, nas.DIRECTION_UPLINK)
+	if err == nas.ErrIntegrity && count >= 0x100 &&
+		m.Verify(sc.IntegrityAlg, sc.KNASint, count-0x100, nas.DIRECTION_UPLINK) == nil {
+		return nil, errNASReplay
+	}
+	if err != nil {
+		return nil, err
+	}
+	sc.ULCount = count + 1
+	return pdu, nil
+}
+
+// verifyServiceRequest verifies the short MAC of the Service Request PDU.
+// The NAS COUNT is estimated from the 5 bit sequence number and the short
+// MAC is the 16 least significant bits of the MAC over the first 2 octets.
+func (sc *SecurityContext) verifyServiceRequest(pdu []byte, m *nas.ServiceRequest) error {
+	if len(pdu) < 4 {
+		return fmt.Errorf("short Service Request")
+	}
+	if m.KSI != sc.KSI {
+		return fmt.Errorf("KSI mismatch %d", m.KSI)
+	}
+	count := sc.ULCount&^0x1f | uint32(m.SequenceNumber&0x1f)
+	if count < sc.ULCount {
+		count += 0x20
+	}
+	if count > NAS_COUNT_MAX {
+		return fmt.Errorf("uplink NAS COUNT wraps around")
+	}
+	mac, err := nas.ComputeMAC(sc.IntegrityAlg, sc.KNASint, count, nas.DIRECTION_UPLINK, pdu[:2])
+	if err != nil {
+		return err
+	}
+	if uint16(mac) != m.ShortMAC {
+		return nas.ErrIntegrity
+	}
+	sc.ULCount = count + 1
+	return nil
+}
+
+// nasUnprotectedAllowed lists EMM messages which are processed without
+// integrity protection (TS 24.301 4.4.4.3).
+var nasUnprotectedAllowed = map[uint8]bool{
+	nas.MSG_ATTACH_REQUEST:               true,
+	nas.MSG_IDENTITY_RESPONSE:            true,
+	nas.MSG_AUTHENTICATION_RESPONSE:      true,
+	nas.MSG_AUTHENTICATION_FAILURE:       true,
+	nas.MSG_SECURITY_MODE_REJECT:         true,
+	nas.MSG_DETACH_REQUEST:               true,
+	nas.MSG_DETACH_ACCEPT:                true,
+	nas.MSG_TRACKING_AREA_UPDATE_REQUEST: true,
+	nas.MSG_SERVICE_REQUEST:              true,
+	nas.MSG_EXTENDED_SERVICE_REQUEST:     true,
+}
+
+func unprotectedAllowed(m nas.Message) bool {
+	return m.ProtocolDiscriminator() == nas.PD_EMM && nasUnprotectedAllowed[m.MessageType()]
+}
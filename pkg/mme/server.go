@@ -14,32 +14,62 @@ import (
 
 // ServerConfig keep MME server configuration.
 type ServerConfig struct {
-	retryTime time.Duration
+	retryTime        time.Duration
+	mmeName          string
+	servedGUMMEIs    s1ap.ServedGUMMEIs
+	relativeCapacity uint8
+	relaySupport     bool
+	tacs             []uint16
+	streams          uint16
+	s11Addr          net.IP
+	sgwAddr          net.IP
+	pgwAddr          net.IP
 }
 
 // Server message.
 type message struct {
 	conn   net.Conn
 	header []byte
-	p      unsafe.Pointer
-	typ    int
+	enb    *ENB
+	msg    s1ap.Message
 }
 
 // Server is MME top level structure.
 type Server struct {
-	conf           ServerConfig
-	ln             *sctp.SCTPListener
-	wg             sync.WaitGroup
-	ch             chan *message
-	done           chan interface{}
-	enb_ie_s1ap_id int32
+	conf   ServerConfig
+	ln     *sctp.SCTPListener
+	wg     sync.WaitGroup
+	ch     chan *message
+	events chan *Event
+	done   chan interface{}
+	enbs   *enbRegistry
+	ues    *ueTable
+	emm    *FSM
+	ecm    *FSM
+	diam   *DiamClient
+	s11    *s11Client
 }
 
 func NewServer() *Server {
 	return &Server{
 		conf: ServerConfig{
 			retryTime: 30,
+			servedGUMMEIs: s1ap.ServedGUMMEIs{
+				{
+					ServedPLMNs:    []s1ap.PLMNIdentity{{0x02, 0xf8, 0x39}},
+					ServedGroupIDs: []uint16{0x0004},
+					ServedMMECs:    []uint8{0x01},
+				},
+			},
+			relativeCapacity: 10,
+			streams:          2,
+			s11Addr:          net.ParseIP("172.16.0.53"),
+			sgwAddr:          net.ParseIP("172.16.0.54"),
 		},
+		enbs: newENBRegistry(),
+		ues:  newUETable(),
+		emm:  newEMMFSM(),
+		ecm:  newECMFSM(),
 	}
 }
 
@@ -66,6 +96,17 @@ func SCTPBuffer() []byte {
 }
 
 func (s *Server) serveClient(conn net.Conn, infoSize int) error {
+	enb := s.enbs.add(conn, s.conf.streams)
+	defer func() {
+		s.enbs.remove(conn)
+		s.ues.removeENB(enb)
+		for _, ue := range s.ues.enbUEs(enb) {
+			s.inject(&Event{Type: EVENT_S1AP_UE_CONTEXT_RELEASE, UE: ue})
+		}
+		conn.Close()
+		log.Printf("eNB %v association from %s is removed", enb.GlobalENBID, conn.RemoteAddr())
+	}()
+
 	for {
 		buf := SCTPBuffer()
 
@@ -82,11 +123,12 @@ func (s *Server) serveClient(conn net.Conn, infoSize int) error {
 		payload := buf[infoSize:n]
 		SCTPDumpBuf(payload)
 
-		p, typ, err := s1ap.Decode(payload)
+		msg, err := s1ap.Decode(payload)
 		if err != nil {
-			return fmt.Errorf("S1AP decode error")
+			log.Printf("S1AP decode error from %s: %v", conn.RemoteAddr(), err)
+			continue
 		}
-		s.ch <- &message{conn, header, p, typ}
+		s.ch <- &message{conn, header, enb, msg}
 	}
 }
 
@@ -120,76 +162,10 @@ func (s *Server) startHandler() {
 		defer s.wg.Done()
 		for {
 			select {
-			case msg := <-s.ch:
-				s1ap.XerPrint(msg.p)
-				switch msg.typ {
-				case s1ap.S1_SETUP_REQUEST:
-					log.Println("S1 SETUP REQUEST")
-					payload, err := s1ap.S1SetupResponse()
-					if err != nil {
-						log.Println("S1SetupResponse error")
-						continue
-					}
-					SCTPDumpBuf(payload)
-					buf := append(msg.header, payload...)
-					s.send(msg.conn, buf)
-				case s1ap.INITIAL_UE_MESSAGE:
-					log.Println("INITIAL UE MESSAGE")
-					enb_ie_s1ap_id, err := s1ap.InitialUEMessageHandle(msg.p)
-					if err != nil {
-						log.Println("Initial UE Message error")
-						continue
-					}
-					s.enb_ie_s1ap_id = enb_ie_s1ap_id
-					mmebuf := []byte{
-						0x07, 0x52, 0x00, 0x37, 0x74, 0x76, 0x61, 0x5c,
-						0xb6, 0xd3, 0x7a, 0x91, 0x7d, 0x05, 0x72, 0x74,
-						0x61, 0xb2, 0x41, 0x10, 0x7e, 0x0f, 0x9d, 0x7d,
-						0x5a, 0xcb, 0x80, 0x00, 0x9f, 0xb3, 0xb3, 0x19,
-						0x2a, 0x4c, 0x72, 0x12,
-					}
-					payload, err := s1ap.DownlinkNASTransport(enb_ie_s1ap_id, mmebuf)
-					if err != nil {
-						log.Println("DownlinkNASTransport error")
-						continue
-					}
-					SCTPDumpBuf(payload)
-					buf := append(msg.header, payload...)
-					s.send(msg.conn, buf)
-				case s1ap.UPLINK_NAS_TRANSPORT:
-					_, eps_mmm_type, err := s1ap.UplinkNASTransportHandle(msg.p)
-					if err != nil {
-						continue
-					}
-					switch eps_mmm_type {
-					case s1ap.NAS_EPS_AUTH_RESPONSE:
-						mmebuf := []byte{
-							0x37, 0x9f, 0x76, 0xaf, 0xd9, 0x00, 0x07, 0x5d,
-							0x02, 0x00, 0x02, 0x80, 0x20,
-						}
-						payload, err := s1ap.DownlinkNASTransport(s.enb_ie_s1ap_id, mmebuf)
-						if err != nil {
-							log.Println("DownlinkNASTransport error")
-							continue
-						}
-						SCTPDumpBuf(payload)
-						buf := append(msg.header, payload...)
-						s.send(msg.conn, buf)
-					case s1ap.NAS_EPS_SECURITY_MODE_COMPLETE:
-						payload, err := s1ap.InitialContextSetupRequest(s.enb_ie_s1ap_id)
-						if err != nil {
-							log.Println("InitialContextSetupRequest error")
-							continue
-						}
-						SCTPDumpBuf(payload)
-						buf := append(msg.header, payload...)
-						s.send(msg.conn, buf)
-					default:
-						fmt.Println("Skip unknown MMM type")
-					}
-				default:
-				}
-				s1ap.Free(msg.p)
+			case m := <-s.ch:
+				s.handleMessage(m)
+			case ev := <-s.events:
+				s.dispatch(ev)
 			case <-s.done:
 				return
 			}
@@ -197,6 +173,110 @@ func (s *Server) startHandler() {
 	}()
 }
 
+// sendMessage encodes S1AP message and sends it to the eNB.
+func (s *Server) sendMessage(m *message, msg s1ap.Message) {
+	payload, err := s1ap.Encode(msg)
+	if err != nil {
+		log.Printf("%s error: %v", s1ap.MessageName(msg), err)
+		return
+	}
+	SCTPDumpBuf(payload)
+	buf := append(m.header, payload...)
+	s.send(m.conn, buf)
+}
+
+// sendUE encodes UE associated S1AP message and sends it on the stream of
+// the UE.
+func (s *Server) sendUE(ue *UEContext, msg s1ap.Message) {
+	enb := ue.ENB()
+	if enb == nil {
+		log.Printf("%s: UE %d is not connected", s1ap.MessageName(msg), ue.MMEUES1APID)
+		return
+	}
+	s.sendStream(enb, ue.Stream, msg)
+}
+
+// sendENB encodes non UE associated S1AP message and sends it on stream 0
+// of the eNB.
+func (s *Server) sendENB(enb *ENB, msg s1ap.Message) {
+	s.sendStream(enb, 0, msg)
+}
+
+// sendStream encodes S1AP message and sends it on the stream of the eNB.
+func (s *Server) sendStream(enb *ENB, stream uint16, msg s1ap.Message) {
+	payload, err := s1ap.Encode(msg)
+	if err != nil {
+		log.Printf("%s error: %v", s1ap.MessageName(msg), err)
+		return
+	}
+	SCTPDumpBuf(payload)
+	buf := append(enb.Header(stream), payload...)
+	s.send(enb.Conn(), buf)
+}
+
+// dispatchUE delivers UE associated message to the FSMs of the UE.
+func (s *Server) dispatchUE(m *message, mmeID s1ap.MMEUES1APID, enbID s1ap.ENBUES1APID,
+	typ EventType, msg s1ap.Message) {
+	ue, err := s.lookupUE(m, mmeID, enbID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	s.dispatch(&Event{Type: typ, UE: ue, Msg: msg})
+}
+
+// handleMessage dispatches S1AP message by its type.
+func (s *Server) handleMessage(m *message) {
+	log.Println(s1ap.MessageName(m.msg))
+	if _, ok := m.msg.(*s1ap.S1SetupRequest); !ok && m.enb.State != ENB_STATE_SETUP {
+		log.Printf("Drop %s from %s before S1 Setup", s1ap.MessageName(m.msg),
+			m.conn.RemoteAddr())
+		return
+	}
+	switch msg := m.msg.(type) {
+	case *s1ap.S1SetupRequest:
+		s.handleS1SetupRequest(m, msg)
+	case *s1ap.InitialUEMessage:
+		s.handleInitialUEMessage(m, msg)
+	case *s1ap.UplinkNASTransport:
+		s.handleUplinkNASTransport(m, msg)
+	case *s1ap.InitialContextSetupResponse:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_INITIAL_CONTEXT_SETUP_RESPONSE, msg)
+	case *s1ap.InitialContextSetupFailure:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_INITIAL_CONTEXT_SETUP_FAILURE, msg)
+	case *s1ap.UECapabilityInfoIndication:
+		s.handleUECapabilityInfoIndication(m, msg)
+	case *s1ap.ERABSetupResponse:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_E_RAB_SETUP_RESPONSE, msg)
+	case *s1ap.ERABModifyResponse:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_E_RAB_MODIFY_RESPONSE, msg)
+	case *s1ap.ERABReleaseResponse:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_E_RAB_RELEASE_RESPONSE, msg)
+	case *s1ap.ERABReleaseIndication:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_E_RAB_RELEASE_INDICATION, msg)
+	case *s1ap.HandoverRequired:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_HANDOVER_REQUIRED, msg)
+	case *s1ap.HandoverRequestAcknowledge:
+		s.dispatchHandover(m, msg.MMEUES1APID, EVENT_S1AP_HANDOVER_REQUEST_ACKNOWLEDGE, msg)
+	case *s1ap.HandoverFailure:
+		s.dispatchHandover(m, msg.MMEUES1APID, EVENT_S1AP_HANDOVER_FAILURE, msg)
+	case *s1ap.ENBStatusTransfer:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_ENB_STATUS_TRANSFER, msg)
+	case *s1ap.HandoverNotify:
+		s.dispatchHandover(m, msg.MMEUES1APID, EVENT_S1AP_HANDOVER_NOTIFY, msg)
+	case *s1ap.HandoverCancel:
+		s.dispatchUE(m, msg.MMEUES1APID, msg.ENBUES1APID, EVENT_S1AP_HANDOVER_CANCEL, msg)
+	case *s1ap.UEContextReleaseRequest:
+		s.handleUEContextReleaseRequest(m, msg)
+	case *s1ap.UEContextReleaseComplete:
+		s.handleUEContextReleaseComplete(m, msg)
+	case *s1ap.UnknownMessage:
+		log.Printf("Unsupported S1AP message: %s", s1ap.MessageName(msg))
+	default:
+		log.Printf("Unhandled S1AP message: %s", s1ap.MessageName(msg))
+	}
+}
+
 // startServer start SCTP server.
 func (s *Server) startServer() {
 	s.wg.Add(1)
@@ -243,6 +323,18 @@ func (s *Server) startServer() {
 
 // Start function initiate MME services.
 func (s *Server) Start() error {
+	if s.done != nil {
+		return fmt.Errorf("Server already started")
+	}
+	s11, err := newS11Client(s.conf.s11Addr, s.conf.sgwAddr, s.inject, s.ues.lookupByTEID)
+	if err != nil {
+		return fmt.Errorf("S11: %v", err)
+	}
+	s.s11 = s11
+	s.ch = make(chan *message, 1024)
+	s.events = make(chan *Event, 1024)
+	s.done = make(chan interface{})
+
 	diamOpt := &DiamOpt{
 		originHost:       "mme.coreswitch.io",
 		originRealm:      "coreswitch.io",
@@ -253,26 +345,12 @@ func (s *Server) Start() error {
 		hssConnMethod:    "tcp4",
 		hssAddress:       "172.16.0.52",
 	}
-	diam := NewDiamClient(diamOpt)
-	diam.Start()
-	// diam.Stop()
-
-	// err = sendAIR(conn, cfg)
-	// if err != nil {
-	// 	log.Fatal(err)
-	// }
-
-	///////////////////
-	// SCTP S1AP Server.
-	// if s.done != nil {
-	// 	return fmt.Errorf("Server already started")
-	// }
-	// s.ch = make(chan *message, 1024)
-	// s.done = make(chan interface{})
-
-	// s.startHandler()
-	// s.startServer()
-	///////////////////
+	s.diam = NewDiamClient(diamOpt, s.inject)
+	s.diam.Start()
+
+	s.s11.start(&s.wg)
+	s.startHandler()
+	s.startServer()
 
 	return nil
 }
@@ -283,6 +361,11 @@ func (s *Server) Stop() error {
 		return fmt.Errorf("Server already stopped")
 	}
 	close(s.done)
+	if s.ln != nil {
+		s.ln.Close()
+	}
+	s.diam.Stop()
+	s.s11.close()
 	s.wg.Wait()
 	s.done = nil
 
@@ -0,0 +1,67 @@
+package mme
+
+import (
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+)
+
+// emmServiceVerified returns true when the Service Request or Extended
+// Service Request is verified with the current NAS security context.
+func emmServiceVerified(s *Server, ev *Event) bool {
+	if !ev.UE.nasVerified || !ev.UE.Security.active() {
+		return false
+	}
+	if esr, ok := ev.Msg.(*nas.ExtendedServiceRequest); ok {
+		return esr.KSI == ev.UE.Security.KSI
+	}
+	return true
+}
+
+// emmServiceAcceptable returns true when the verified service request asks
+// for packet services of the UE which has bearers.
+func emmServiceAcceptable(s *Server, ev *Event) bool {
+	if !emmServiceVerified(s, ev) || len(ev.UE.Bearers) == 0 {
+		return false
+	}
+	if esr, ok := ev.Msg.(*nas.ExtendedServiceRequest); ok {
+		return esr.ServiceType == nas.SERVICE_TYPE_PACKET_SERVICES
+	}
+	return true
+}
+
+// emmServiceRequest establishes all bearers of the UE by Initial Context
+// Setup. KeNB is derived from the NAS COUNT of the service request. The
+// user plane is switched to the eNB by Modify Bearer Request when the eNB
+// responds.
+func emmServiceRequest(s *Server, ev *Event) error {
+	log.Printf("Service Request of %v", ev.UE)
+	return s.sendInitialContextSetupRequest(ev.UE, nil)
+}
+
+// emmServiceReject rejects the service request. The UE of which service
+// request can not be verified has to attach again.
+func emmServiceReject(s *Server, ev *Event) error {
+	ue := ev.UE
+	verified := emmServiceVerified(s, ev)
+	cause := uint8(nas.EMM_CAUSE_UE_IDENTITY_CANNOT_BE_DERIVED)
+	switch {
+	case verified && len(ue.Bearers) == 0:
+		cause = nas.EMM_CAUSE_NO_EPS_BEARER_CONTEXT_ACTIVATED
+	case verified:
+		cause = nas.EMM_CAUSE_CS_DOMAIN_NOT_AVAILABLE
+	case ue.EMMState == EMM_STATE_DEREGISTERED && ue.IMSI != "":
+		cause = nas.EMM_CAUSE_IMPLICITLY_DETACHED
+	}
+	log.Printf("Reject Service Request of %v with EMM cause %d", ue, cause)
+	reject := &nas.ServiceReject{EMMCause: cause}
+	if verified {
+		return s.sendNASMessageOnce(ue, reject)
+	}
+	pdu, err := nas.Encode(reject)
+	if err != nil {
+		return err
+	}
+	s.sendDownlinkNAS(ue, pdu)
+	return nil
+}
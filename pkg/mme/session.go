@@ -0,0 +1,393 @@
+package mme
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/coreswitch/coreswitch/pkg/gtpv2"
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// selectPDN selects APN and PDN type of the PDN connection requested by the
+// UE within the subscription. ESM cause is returned when the request is
+// not allowed, otherwise zero.
+func selectPDN(sub *Subscription, req *nas.PDNConnectivityRequest) (string, uint8, uint8) {
+	apn := sub.APN
+	if req.APN != nil && *req.APN != "" && string(*req.APN) != sub.APN {
+		if sub.APN != "*" {
+			return "", 0, nas.ESM_CAUSE_MISSING_OR_UNKNOWN_APN
+		}
+		apn = string(*req.APN)
+	}
+	if apn == "" || apn == "*" {
+		return "", 0, nas.ESM_CAUSE_MISSING_OR_UNKNOWN_APN
+	}
+
+	requested := req.PDNType
+	switch requested {
+	case nas.PDN_TYPE_IPV4, nas.PDN_TYPE_IPV6, nas.PDN_TYPE_IPV4V6:
+	default:
+		return "", 0, nas.ESM_CAUSE_UNKNOWN_PDN_TYPE
+	}
+	switch sub.PDNType {
+	case DIAM_PDN_TYPE_IPV4:
+		if requested == nas.PDN_TYPE_IPV6 {
+			return "", 0, nas.ESM_CAUSE_PDN_TYPE_IPV4_ONLY_ALLOWED
+		}
+		return apn, nas.PDN_TYPE_IPV4, 0
+	case DIAM_PDN_TYPE_IPV6:
+		if requested == nas.PDN_TYPE_IPV4 {
+			return "", 0, nas.ESM_CAUSE_PDN_TYPE_IPV6_ONLY_ALLOWED
+		}
+		return apn, nas.PDN_TYPE_IPV6, 0
+	case DIAM_PDN_TYPE_IPV4_OR_IPV6:
+		if requested == nas.PDN_TYPE_IPV4V6 {
+			return apn, nas.PDN_TYPE_IPV4, 0
+		}
+	}
+	return apn, requested, 0
+}
+
+// newFTEID returns F-TEID of the address which is IPv4 or IPv6.
+func newFTEID(inst, iface uint8, teid uint32, ip net.IP) gtpv2.FTEID {
+	f := gtpv2.FTEID{Instance: inst, Interface: iface, TEID: teid}
+	if v4 := ip.To4(); v4 != nil {
+		f.IPv4 = v4
+	} else {
+		f.IPv6 = ip
+	}
+	return f
+}
+
+// userLocation returns ULI of the current TAI and ECGI of the UE.
+func userLocation(ue *UEContext) *gtpv2.ULI {
+	return &gtpv2.ULI{
+		TAI: &gtpv2.TAI{
+			PLMNIdentity: gtpv2.PLMNIdentity(ue.TAI.PLMNIdentity),
+			TAC:          ue.TAI.TAC,
+		},
+		ECGI: &gtpv2.ECGI{
+			PLMNIdentity: gtpv2.PLMNIdentity(ue.ECGI.PLMNIdentity),
+			ECI:          ue.ECGI.CellID,
+		},
+	}
+}
+
+// bearerQoS returns GTP bearer level QoS of the bearer. Bit rates of GTP
+// are in kbps.
+func bearerQoS(b *Bearer) *gtpv2.BearerQoS {
+	q := &gtpv2.BearerQoS{
+		PL:    b.ARP.PriorityLevel,
+		QCI:   b.QCI,
+		MBRUL: b.MBRUL / 1000,
+		MBRDL: b.MBRDL / 1000,
+		GBRUL: b.GBRUL / 1000,
+		GBRDL: b.GBRDL / 1000,
+	}
+	if b.ARP.PreemptionCapability == s1ap.PRE_EMPTION_CAPABILITY_SHALL_NOT_TRIGGER {
+		q.PCI = 1
+	}
+	if b.ARP.PreemptionVulnerability == s1ap.PRE_EMPTION_VULNERABILITY_NOT_PRE_EMPTABLE {
+		q.PVI = 1
+	}
+	return q
+}
+
+// applyBearerQoS sets GTP bearer level QoS to the bearer.
+func applyBearerQoS(b *Bearer, q *gtpv2.BearerQoS) {
+	b.QCI = q.QCI
+	b.ARP = s1ap.AllocationAndRetentionPriority{
+		PriorityLevel:           q.PL,
+		PreemptionCapability:    s1ap.PRE_EMPTION_CAPABILITY_MAY_TRIGGER,
+		PreemptionVulnerability: s1ap.PRE_EMPTION_VULNERABILITY_PRE_EMPTABLE,
+	}
+	if q.PCI == 1 {
+		b.ARP.PreemptionCapability = s1ap.PRE_EMPTION_CAPABILITY_SHALL_NOT_TRIGGER
+	}
+	if q.PVI == 1 {
+		b.ARP.PreemptionVulnerability = s1ap.PRE_EMPTION_VULNERABILITY_NOT_PRE_EMPTABLE
+	}
+	b.MBRUL = q.MBRUL * 1000
+	b.MBRDL = q.MBRDL * 1000
+	b.GBRUL = q.GBRUL * 1000
+	b.GBRDL = q.GBRDL * 1000
+}
+
+// erabQoS returns E-RAB level QoS of the bearer. GBR QoS information is
+// present when the bearer has bit rates.
+func erabQoS(b *Bearer) s1ap.ERABLevelQoSParameters {
+	q := s1ap.ERABLevelQoSParameters{QCI: b.QCI, ARP: b.ARP}
+	if b.MBRUL != 0 || b.MBRDL != 0 || b.GBRUL != 0 || b.GBRDL != 0 {
+		q.GBR = &s1ap.GBRQosInformation{
+			MaximumBitrateDL:    s1ap.BitRate(b.MBRDL),
+			MaximumBitrateUL:    s1ap.BitRate(b.MBRUL),
+			GuaranteedBitrateDL: s1ap.BitRate(b.GBRDL),
+			GuaranteedBitrateUL: s1ap.BitRate(b.GBRUL),
+		}
+	}
+	return q
+}
+
+// pgwAddr returns S5/S8 address of the PGW. SGW is used as PGW when it is
+// not configured.
+func (s *Server) pgwAddr() net.IP {
+	if s.conf.pgwAddr != nil {
+		return s.conf.pgwAddr
+	}
+	return s.conf.sgwAddr
+}
+
+// sendCreateSessionRequest creates the PDN connection requested in the
+// attach with the default bearer.
+func (s *Server) sendCreateSessionRequest(ue *UEContext) error {
+	sub := ue.Subscription
+	apn, pdnType, cause := selectPDN(sub, ue.pdnRequest)
+	if cause != 0 {
+		return fmt.Errorf("PDN connection is not allowed by ESM cause %d", cause)
+	}
+	teid, err := s.ues.allocTEID(ue)
+	if err != nil {
+		return err
+	}
+	ebi, err := ue.allocEBI()
+	if err != nil {
+		return err
+	}
+	b := &Bearer{
+		EBI:       ebi,
+		QCI:       sub.QCI,
+		ARP:       sub.ARP,
+		APN:       apn,
+		PDNType:   pdnType,
+		APNAMBRUL: sub.APNAMBRUL,
+		APNAMBRDL: sub.APNAMBRDL,
+	}
+	ue.Bearers[ebi] = b
+
+	plmn := gtpv2.PLMNIdentity(ue.TAI.PLMNIdentity)
+	mode := uint8(gtpv2.SELECTION_MODE_VERIFIED_BY_NETWORK)
+	pgw := newFTEID(1, gtpv2.IF_TYPE_S5S8_PGW_GTPC, 0, s.pgwAddr())
+	req := &gtpv2.CreateSessionRequest{
+		IMSI:           ue.IMSI,
+		MSISDN:         sub.MSISDN,
+		ULI:            userLocation(ue),
+		ServingNetwork: &plmn,
+		RATType:        gtpv2.RAT_TYPE_EUTRAN,
+		SenderFTEID:    newFTEID(0, gtpv2.IF_TYPE_S11_MME, teid, s.conf.s11Addr),
+		PGWFTEID:       &pgw,
+		APN:            apn,
+		SelectionMode:  &mode,
+		PDNType:        &pdnType,
+		PAA:            &gtpv2.PAA{Type: pdnType},
+		AMBR: &gtpv2.AMBR{
+			UL: uint32(sub.APNAMBRUL / 1000),
+			DL: uint32(sub.APNAMBRDL / 1000),
+		},
+		BearerContexts: []gtpv2.BearerContext{
+			{EBI: ebi, BearerQoS: bearerQoS(b)},
+		},
+	}
+	if ue.pdnRequest.PCO != nil {
+		req.PCO = ue.pdnRequest.PCO.Bytes()
+	}
+	return s.sendS11(ue, req)
+}
+
+// createdBearer returns the bearer context of the default bearer in Create
+// Session Response when it is accepted with S1-U F-TEID of the SGW.
+func createdBearer(ue *UEContext, resp *gtpv2.CreateSessionResponse) *gtpv2.BearerContext {
+	b := ue.defaultBearer()
+	if b == nil {
+		return nil
+	}
+	for i := range resp.BearerContexts {
+		bc := &resp.BearerContexts[i]
+		if bc.EBI != b.EBI {
+			continue
+		}
+		if bc.Cause != nil && !bc.Cause.Accepted() {
+			return nil
+		}
+		if bc.FTEID(gtpv2.IF_TYPE_S1U_SGW) == nil {
+			return nil
+		}
+		return bc
+	}
+	return nil
+}
+
+// sessionCreated updates the default bearer by the accepted Create Session
+// Response.
+func sessionCreated(ue *UEContext, resp *gtpv2.CreateSessionResponse) error {
+	b := ue.defaultBearer()
+	bc := createdBearer(ue, resp)
+	if b == nil || bc == nil {
+		return fmt.Errorf("no default bearer in Create Session Response")
+	}
+	s1u := bc.FTEID(gtpv2.IF_TYPE_S1U_SGW)
+	b.SGWAddr = s1u.IP()
+	b.SGWTEID = s1u.TEID
+	if resp.PGWFTEID != nil {
+		b.PGWAddr = resp.PGWFTEID.IP()
+		b.PGWTEID = resp.PGWFTEID.TEID
+	}
+	if bc.BearerQoS != nil {
+		b.QCI = bc.BearerQoS.QCI
+	}
+	if resp.PAA != nil {
+		b.PDNType = resp.PAA.Type
+		b.PDNAddress = resp.PAA.IPv4
+		b.IPv6Prefix = resp.PAA.IPv6
+	}
+	if resp.AMBR != nil {
+		b.APNAMBRUL = uint64(resp.AMBR.UL) * 1000
+		b.APNAMBRDL = uint64(resp.AMBR.DL) * 1000
+	}
+	return nil
+}
+
+// sessionCause maps the cause of Create Session Response to ESM cause.
+// Missing response is network failure.
+func sessionCause(msg interface{}) uint8 {
+	resp, ok := msg.(*gtpv2.CreateSessionResponse)
+	if !ok {
+		return nas.ESM_CAUSE_NETWORK_FAILURE
+	}
+	switch resp.Cause.Value {
+	case gtpv2.CAUSE_MISSING_OR_UNKNOWN_APN:
+		return nas.ESM_CAUSE_MISSING_OR_UNKNOWN_APN
+	case gtpv2.CAUSE_UNKNOWN_PDN_TYPE:
+		return nas.ESM_CAUSE_UNKNOWN_PDN_TYPE
+	case gtpv2.CAUSE_NO_RESOURCES_AVAILABLE, gtpv2.CAUSE_ALL_DYNAMIC_ADDRESSES_OCCUPIED,
+		gtpv2.CAUSE_NO_MEMORY_AVAILABLE:
+		return nas.ESM_CAUSE_INSUFFICIENT_RESOURCES
+	case gtpv2.CAUSE_SERVICE_DENIED:
+		return nas.ESM_CAUSE_SERVICE_OPTION_NOT_SUBSCRIBED
+	}
+	return nas.ESM_CAUSE_REQUEST_REJECTED_BY_SGW_OR_PGW
+}
+
+// pdnAddress returns PDN address of the default bearer for the UE. IPv6
+// interface identifier is the lower half of the address given by the PGW.
+func pdnAddress(b *Bearer) nas.PDNAddress {
+	a := nas.PDNAddress{Type: b.PDNType, IPv4: b.PDNAddress}
+	if v6 := b.IPv6Prefix.To16(); v6 != nil {
+		a.IPv6InterfaceID = v6[8:]
+	} else if b.PDNType != nas.PDN_TYPE_IPV4 {
+		a.IPv6InterfaceID = make([]byte, 8)
+	}
+	return a
+}
+
+// activateDefaultBearer returns Activate Default EPS Bearer Context Request
+// of the default bearer.
+func activateDefaultBearer(ue *UEContext, b *Bearer, pco []byte) (*nas.ActivateDefaultEPSBearerContextRequest, error) {
+	req := &nas.ActivateDefaultEPSBearerContextRequest{
+		ESMHeader: nas.ESMHeader{
+			EPSBearerIdentity: b.EBI,
+			PTI:               ue.pdnRequest.PTI,
+		},
+		EPSQoS:     nas.EPSQoS{QCI: b.QCI},
+		APN:        nas.APN(b.APN),
+		PDNAddress: pdnAddress(b),
+		APNAMBR: &nas.APNAMBR{
+			DL: b.APNAMBRDL / 1000,
+			UL: b.APNAMBRUL / 1000,
+		},
+	}
+	if len(pco) > 0 {
+		p, err := nas.ParsePCO(pco)
+		if err != nil {
+			return nil, fmt.Errorf("PCO from PGW: %v", err)
+		}
+		req.PCO = &p
+	}
+	return req, nil
+}
+
+// transportLayerAddress returns S1AP transport layer address of the IP.
+func transportLayerAddress(ip net.IP) s1ap.TransportLayerAddress {
+	if v4 := ip.To4(); v4 != nil {
+		return s1ap.TransportLayerAddress(v4)
+	}
+	return s1ap.TransportLayerAddress(ip.To16())
+}
+
+// ipOfTransportLayerAddress returns the IP of S1AP transport layer address.
+// IPv4 address is preferred when both are present.
+func ipOfTransportLayerAddress(a s1ap.TransportLayerAddress) net.IP {
+	switch len(a) {
+	case net.IPv4len, net.IPv6len:
+		return net.IP(a)
+	case net.IPv4len + net.IPv6len:
+		return net.IP(a[:net.IPv4len])
+	}
+	return nil
+}
+
+// sendModifyBearerRequest sends S1-U F-TEIDs of the eNB to the SGW for the
+// bearers established in the eNB.
+func (s *Server) sendModifyBearerRequest(ue *UEContext) error {
+	req := &gtpv2.ModifyBearerRequest{}
+	for _, b := range ue.sortedBearers() {
+		if b.ENBTEID == 0 {
+			continue
+		}
+		req.BearerContexts = append(req.BearerContexts, gtpv2.BearerContext{
+			EBI: b.EBI,
+			FTEIDs: []gtpv2.FTEID{
+				newFTEID(0, gtpv2.IF_TYPE_S1U_ENB, b.ENBTEID, b.ENBAddr),
+			},
+		})
+	}
+	if len(req.BearerContexts) == 0 {
+		return fmt.Errorf("no bearer is established in eNB")
+	}
+	return s.sendS11(ue, req)
+}
+
+// sendDeleteSessionRequest deletes the PDN connection of the UE in the SGW
+// and the PGW.
+func (s *Server) sendDeleteSessionRequest(ue *UEContext) error {
+	b := ue.defaultBearer()
+	if b == nil {
+		return fmt.Errorf("no PDN connection")
+	}
+	ebi := b.EBI
+	return s.sendS11(ue, &gtpv2.DeleteSessionRequest{
+		LinkedEBI: &ebi,
+		ULI:       userLocation(ue),
+	})
+}
+
+// releaseAccessBearers releases S1-U bearers of the UE in the SGW when the
+// UE enters ECM-IDLE. Downlink data is buffered in the SGW until the
+// bearers are established again.
+func (s *Server) releaseAccessBearers(ue *UEContext) error {
+	established := false
+	for _, b := range ue.Bearers {
+		if b.ENBTEID != 0 {
+			established = true
+		}
+		b.ENBAddr = nil
+		b.ENBTEID = 0
+	}
+	if !established || ue.SGWS11TEID == 0 {
+		return nil
+	}
+	return s.sendS11(ue, &gtpv2.ReleaseAccessBearersRequest{})
+}
+
+// releaseSession deletes the session in the SGW when it has been created
+// and removes the bearers of the UE.
+func (s *Server) releaseSession(ue *UEContext) {
+	if ue.SGWS11TEID != 0 {
+		if err := s.sendDeleteSessionRequest(ue); err != nil {
+			log.Printf("Delete Session Request: %v", err)
+		}
+	}
+	ue.SGWS11TEID = 0
+	ue.Bearers = map[uint8]*Bearer{}
+}
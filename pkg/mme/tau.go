@@ -0,0 +1,149 @@
+package mme
+
+import (
+	"log"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+)
+
+func emmUpdatingTA(s *Server, ev *Event) bool {
+	return ev.UE.Procedure == EMM_PROC_TAU
+}
+
+// emmTAUAcceptable returns true when the Tracking Area Update Request is
+// verified with the current NAS security context and the TA is served.
+func emmTAUAcceptable(s *Server, ev *Event) bool {
+	tau, ok := ev.Msg.(*nas.TrackingAreaUpdateRequest)
+	if !ok || !ev.UE.nasVerified || !ev.UE.Security.active() {
+		return false
+	}
+	return tau.KSI == ev.UE.Security.KSI && s.serveTA(ev.UE)
+}
+
+// emmTAUAuthenticate returns true when the UE context is known but the
+// Tracking Area Update Request can not be verified so that the UE has to be
+// authenticated.
+func emmTAUAuthenticate(s *Server, ev *Event) bool {
+	return ev.UE.IMSI != "" && s.serveTA(ev.UE)
+}
+
+// serveTA returns true when the current TA of the UE is served by the MME.
+func (s *Server) serveTA(ue *UEContext) bool {
+	return s.servePLMN(ue.TAI.PLMNIdentity) && s.serveTAC(ue.TAI.TAC)
+}
+
+// emmTrackingAreaUpdate accepts the Tracking Area Update Request.
+func emmTrackingAreaUpdate(s *Server, ev *Event) error {
+	ev.UE.tauRequest = ev.Msg.(*nas.TrackingAreaUpdateRequest)
+	return s.sendTrackingAreaUpdateAccept(ev.UE)
+}
+
+// emmTAUReauthenticate authenticates the UE of which Tracking Area Update
+// Request failed the integrity check. The TAU is accepted after the
+// security mode control.
+func emmTAUReauthenticate(s *Server, ev *Event) error {
+	ue := ev.UE
+	log.Printf("Authenticate %v for Tracking Area Update", ue)
+	ue.tauRequest = ev.Msg.(*nas.TrackingAreaUpdateRequest)
+	ue.Procedure = EMM_PROC_TAU
+	if capa := ue.tauRequest.UENetworkCapability; capa != nil {
+		ue.Security.UENetworkCapability = capa
+	}
+	return s.authenticate(ue)
+}
+
+func emmTAUSecurityModeComplete(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3460)
+	ev.UE.pendingNAS = nil
+	return s.sendTrackingAreaUpdateAccept(ev.UE)
+}
+
+// bearerContextStatus returns EPS bearer context status IE of the bearers
+// of the UE.
+func bearerContextStatus(ue *UEContext) []byte {
+	status := make([]byte, 2)
+	for ebi := range ue.Bearers {
+		if ebi < 8 {
+			status[0] |= 1 << ebi
+		} else {
+			status[1] |= 1 << (ebi - 8)
+		}
+	}
+	return status
+}
+
+// sendTrackingAreaUpdateAccept sends Tracking Area Update Accept with the
+// TAI list of the current TA. New GUTI is allocated on normal TAU which is
+// supervised by T3450 until TAU Complete. When the UE has set the active
+// flag, bearers are established by Initial Context Setup.
+func (s *Server) sendTrackingAreaUpdateAccept(ue *UEContext) error {
+	tau := ue.tauRequest
+	ue.tauRequest = nil
+	if tau == nil {
+		return nil
+	}
+	t3412 := uint8(T3412_VALUE)
+	accept := &nas.TrackingAreaUpdateAccept{
+		UpdateResult:           nas.EPS_UPDATE_RESULT_TA_UPDATED,
+		T3412Value:             &t3412,
+		TAIList:                taiList(ue),
+		EPSBearerContextStatus: bearerContextStatus(ue),
+	}
+	if tau.UpdateType&0x7 != nas.EPS_UPDATE_TYPE_PERIODIC_UPDATING || ue.GUTI == nil {
+		guti, err := s.allocGUTI(ue)
+		if err != nil {
+			return err
+		}
+		accept.GUTI = guti
+	}
+	log.Printf("Tracking Area Update of %v to %v", ue, ue.TAI)
+	var err error
+	if accept.GUTI != nil {
+		err = s.sendProtectedNASMessage(ue, accept, nas.SECURITY_HEADER_INTEGRITY_CIPHERED, EVENT_TIMER_T3450)
+	} else {
+		err = s.sendNASMessageOnce(ue, accept)
+	}
+	if err != nil {
+		return err
+	}
+	if tau.UpdateType&nas.EPS_UPDATE_TYPE_ACTIVE != 0 {
+		return s.sendInitialContextSetupRequest(ue, nil)
+	}
+	return nil
+}
+
+// emmTAUReject rejects the Tracking Area Update Request of the UE which is
+// not known or not allowed in the TA. The UE has to attach again.
+func emmTAUReject(s *Server, ev *Event) error {
+	cause := uint8(nas.EMM_CAUSE_UE_IDENTITY_CANNOT_BE_DERIVED)
+	switch {
+	case !s.serveTA(ev.UE):
+		cause = nas.EMM_CAUSE_TRACKING_AREA_NOT_ALLOWED
+	case ev.UE.IMSI != "":
+		cause = nas.EMM_CAUSE_IMPLICITLY_DETACHED
+	}
+	log.Printf("Reject Tracking Area Update of %v with EMM cause %d", ev.UE, cause)
+	ev.UE.tauRequest = nil
+	pdu, err := nas.Encode(&nas.TrackingAreaUpdateReject{EMMCause: cause})
+	if err != nil {
+		return err
+	}
+	s.sendDownlinkNAS(ev.UE, pdu)
+	return nil
+}
+
+// emmTAUComplete takes the GUTI sent in TAU Accept into use.
+func emmTAUComplete(s *Server, ev *Event) error {
+	s.timerStop(ev.UE, EVENT_TIMER_T3450)
+	ev.UE.pendingNAS = nil
+	s.ues.commitGUTI(ev.UE)
+	return nil
+}
+
+// emmTAUAbort gives up TAU Accept retransmission. Both of the old and new
+// GUTI are kept valid.
+func emmTAUAbort(s *Server, ev *Event) error {
+	log.Printf("Abort Tracking Area Update of %v", ev.UE)
+	ev.UE.pendingNAS = nil
+	return nil
+}
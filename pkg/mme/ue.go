@@ -0,0 +1,661 @@
+package mme
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/coreswitch/coreswitch/pkg/nas"
+	"github.com/coreswitch/coreswitch/pkg/s1ap"
+)
+
+// GUTI is globally unique temporary UE identity.
+type GUTI struct {
+	PLMNIdentity s1ap.PLMNIdentity
+	MMEGI        uint16
+	MMEC         uint8
+	MTMSI        uint32
+}
+
+// STMSI returns S-TMSI part of the GUTI.
+func (g GUTI) STMSI() s1ap.STMSI {
+	return s1ap.STMSI{MMEC: g.MMEC, MTMSI: g.MTMSI}
+}
+
+// nasGUTI converts GUTI in NAS EPS mobile identity.
+func nasGUTI(g nas.GUTI) GUTI {
+	return GUTI{
+		PLMNIdentity: s1ap.PLMNIdentity(g.PLMNIdentity),
+		MMEGI:        g.MMEGI,
+		MMEC:         g.MMEC,
+		MTMSI:        g.MTMSI,
+	}
+}
+
+// nas returns the GUTI for NAS EPS mobile identity.
+func (g GUTI) nas() nas.GUTI {
+	return nas.GUTI{
+		PLMNIdentity: nas.PLMNIdentity(g.PLMNIdentity),
+		MMEGI:        g.MMEGI,
+		MMEC:         g.MMEC,
+		MTMSI:        g.MTMSI,
+	}
+}
+
+func (g GUTI) String() string {
+	return fmt.Sprintf("%x-%04x-%02x-%08x", g.PLMNIdentity[:], g.MMEGI, g.MMEC, g.MTMSI)
+}
+
+// SecurityContext is EPS NAS security context. ULCount is the NAS COUNT
+// expected in the next uplink message and DLCount is the NAS COUNT of the
+// next downlink message. NH and NCC are the next hop parameters for
+// handover.
+type SecurityContext struct {
+	KSI                 uint8
+	KASME               []byte
+	KNASint             []byte
+	KNASenc             []byte
+	IntegrityAlg        uint8
+	CipheringAlg        uint8
+	ULCount             uint32
+	DLCount             uint32
+	UENetworkCapability []byte
+	KeNB                []byte
+	NH                  []byte
+	NCC                 uint8
+}
+
+// AuthVector is E-UTRAN authentication vector from HSS.
+type AuthVector struct {
+	RAND  []byte
+	XRES  []byte
+	AUTN  []byte
+	KASME []byte
+}
+
+// Subscription is subscription data of the UE downloaded from HSS. Bit
+// rates are in bps.
+type Subscription struct {
+	MSISDN    string
+	UEAMBRUL  uint64
+	UEAMBRDL  uint64
+	APN       string
+	PDNType   uint8
+	QCI       uint8
+	ARP       s1ap.AllocationAndRetentionPriority
+	APNAMBRUL uint64
+	APNAMBRDL uint64
+}
+
+// Bearer is EPS bearer of the UE. PDN connection parameters are kept in the
+// default bearer of which LinkedEBI is zero. Bit rates are in bps.
+type Bearer struct {
+	EBI        uint8
+	QCI        uint8
+	ARP        s1ap.AllocationAndRetentionPriority
+	MBRUL      uint64
+	MBRDL      uint64
+	GBRUL      uint64
+	GBRDL      uint64
+	TFT        []byte
+	ENBAddr    net.IP
+	ENBTEID    uint32
+	SGWAddr    net.IP
+	SGWTEID    uint32
+	LinkedEBI  uint8
+	APN        string
+	PDNType    uint8
+	PDNAddress net.IP
+	IPv6Prefix net.IP
+	APNAMBRUL  uint64
+	APNAMBRDL  uint64
+	PGWAddr    net.IP
+	PGWTEID    uint32
+}
+
+// EPS bearer identities which can be allocated by the MME.
+const (
+	EBI_MIN = 5
+	EBI_MAX = 15
+)
+
+// M-TMSI of all ones is not allocated since it is used as invalid TMSI.
+// M-TMSI allocation gives up after gutiAllocRetry collisions.
+const (
+	MTMSI_INVALID  = 0xffffffff
+	gutiAllocRetry = 32
+)
+
+// UEContext is MME UE context. S1 association fields are valid while the UE
+// is in ECM-CONNECTED.
+type UEContext struct {
+	MMEUES1APID s1ap.MMEUES1APID
+	ENBUES1APID s1ap.ENBUES1APID
+	enb         *ENB
+	Stream      uint16
+
+	IMSI   string
+	IMEISV string
+	GUTI   *GUTI
+	TAI    s1ap.TAI
+	ECGI   s1ap.EUTRANCGI
+
+	// newGUTI is GUTI sent to the UE which is not acknowledged yet. Both
+	// of GUTI and newGUTI identify the UE until it is acknowledged.
+	newGUTI *GUTI
+
+	EMMState     EMMState
+	ECMState     ECMState
+	Procedure    EMMProcedure
+	Security     SecurityContext
+	Subscription *Subscription
+	Bearers      map[uint8]*Bearer
+
+	// newSecurity is the NAS security context taken by the authentication
+	// which is not in use yet. It replaces Security on Security Mode
+	// Complete.
+	newSecurity *SecurityContext
+
+	// attachRequest is the Attach Request of the attach in progress. It
+	// tells a retransmission from a new Attach Request.
+	attachRequest *nas.AttachRequest
+
+	// oldUE is the existing context of the IMSI of the attaching UE. It
+	// is removed when the UE is authenticated.
+	oldUE *UEContext
+
+	// RadioCapability is UE radio capability reported by the eNB. It is
+	// kept in ECM-IDLE and sent in Initial Context Setup.
+	RadioCapability s1ap.UERadioCapability
+
+	// S11 tunnel of the UE. S11TEID is allocated by the MME and SGWS11TEID
+	// is given by the SGW in Create Session Response.
+	S11TEID    uint32
+	SGWS11TEID uint32
+
+	// paging is the ongoing paging of the UE in ECM-IDLE.
+	paging *pagingContext
+
+	// bearerProc is the dedicated bearer procedure requested by the SGW.
+	bearerProc *bearerProcedure
+
+	// handover is the ongoing S1 handover of the UE.
+	handover *handoverContext
+
+	timers       map[EventType]*time.Timer
+	pendingNAS   []byte
+	retrans      int
+	vectors      []AuthVector
+	authVector   *AuthVector
+	authKSI      uint8
+	authFailures int
+	pdnRequest   *nas.PDNConnectivityRequest
+	tauRequest   *nas.TrackingAreaUpdateRequest
+	nasVerified  bool
+}
+
+// ENB returns the eNB which the UE is connected to.
+func (ue *UEContext) ENB() *ENB {
+	return ue.enb
+}
+
+// allocEBI allocates unused EPS bearer identity.
+func (ue *UEContext) allocEBI() (uint8, error) {
+	for ebi := uint8(EBI_MIN); ebi <= EBI_MAX; ebi++ {
+		if _, ok := ue.Bearers[ebi]; !ok {
+			return ebi, nil
+		}
+	}
+	return 0, fmt.Errorf("EPS bearer identity exhausted")
+}
+
+// defaultBearer returns the default bearer of the first PDN connection.
+func (ue *UEContext) defaultBearer() *Bearer {
+	for ebi := uint8(EBI_MIN); ebi <= EBI_MAX; ebi++ {
+		if b, ok := ue.Bearers[ebi]; ok && b.LinkedEBI == 0 {
+			return b
+		}
+	}
+	return nil
+}
+
+// sortedBearers returns bearers in the order of EPS bearer identity.
+func (ue *UEContext) sortedBearers() []*Bearer {
+	bearers := []*Bearer{}
+	for ebi := uint8(EBI_MIN); ebi <= EBI_MAX; ebi++ {
+		if b, ok := ue.Bearers[ebi]; ok {
+			bearers = append(bearers, b)
+		}
+	}
+	return bearers
+}
+
+func (ue *UEContext) String() string {
+	return fmt.Sprintf("UE MME-UE-S1AP-ID %d eNB-UE-S1AP-ID %d IMSI %s %v %v",
+		ue.MMEUES1APID, ue.ENBUES1APID, ue.IMSI, ue.EMMState, ue.ECMState)
+}
+
+type enbUEKey struct {
+	enb *ENB
+	id  s1ap.ENBUES1APID
+}
+
+// ueTable is UE context table. All of methods are safe for concurrent use.
+type ueTable struct {
+	mu      sync.RWMutex
+	nextID  s1ap.MMEUES1APID
+	byMMEID map[s1ap.MMEUES1APID]*UEContext
+	byENB   map[enbUEKey]*UEContext
+	byIMSI  map[string]*UEContext
+	byGUTI  map[GUTI]*UEContext
+	bySTMSI map[s1ap.STMSI]*UEContext
+
+	// byHandover is MME-UE-S1AP-ID of the target eNB association of the
+	// UE in handover.
+	byHandover map[s1ap.MMEUES1APID]*UEContext
+
+	// byRelease is the old S1 association of the UE which has moved to
+	// another association. It is kept until the old eNB completes the
+	// release.
+	byRelease map[s1ap.MMEUES1APID]enbUEKey
+
+	nextTEID uint32
+	byTEID   map[uint32]*UEContext
+}
+
+func newUETable() *ueTable {
+	return &ueTable{
+		nextID:  1,
+		byMMEID: map[s1ap.MMEUES1APID]*UEContext{},
+		byENB:   map[enbUEKey]*UEContext{},
+		byIMSI:  map[string]*UEContext{},
+		byGUTI:  map[GUTI]*UEContext{},
+		bySTMSI: map[s1ap.STMSI]*UEContext{},
+
+		byHandover: map[s1ap.MMEUES1APID]*UEContext{},
+		byRelease:  map[s1ap.MMEUES1APID]enbUEKey{},
+
+		nextTEID: 1,
+		byTEID:   map[uint32]*UEContext{},
+	}
+}
+
+// allocID allocates unused MME-UE-S1AP-ID. Caller must hold the lock.
+func (t *ueTable) allocID() (s1ap.MMEUES1APID, error) {
+	for i := 0; i < len(t.byMMEID)+len(t.byHandover)+len(t.byRelease)+1; i++ {
+		id := t.nextID
+		t.nextID++
+		if _, ok := t.byMMEID[id]; ok {
+			continue
+		}
+		if _, ok := t.byHandover[id]; ok {
+			continue
+		}
+		if _, ok := t.byRelease[id]; !ok {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("MME-UE-S1AP-ID exhausted")
+}
+
+// add creates UE context connected to the eNB.
+func (t *ueTable) add(enb *ENB, enbID s1ap.ENBUES1APID) (*UEContext, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id, err := t.allocID()
+	if err != nil {
+		return nil, err
+	}
+	ue := &UEContext{
+		MMEUES1APID: id,
+		Bearers:     map[uint8]*Bearer{},
+		timers:      map[EventType]*time.Timer{},
+	}
+	t.byMMEID[id] = ue
+	t.connect(ue, enb, enbID)
+	return ue, nil
+}
+
+// connect binds S1 association to the UE. Caller must hold the lock.
+func (t *ueTable) connect(ue *UEContext, enb *ENB, enbID s1ap.ENBUES1APID) {
+	if ue.enb != nil {
+		delete(t.byENB, enbUEKey{ue.enb, ue.ENBUES1APID})
+	}
+	ue.enb = enb
+	ue.ENBUES1APID = enbID
+	ue.Stream = enb.AllocStream()
+	t.byENB[enbUEKey{enb, enbID}] = ue
+}
+
+// reconnect binds new S1 association to existing UE context. New
+// MME-UE-S1AP-ID is allocated since the old one may be still used by the
+// old eNB. The old association is kept in release until the old eNB
+// completes the release.
+func (t *ueTable) reconnect(ue *UEContext, enb *ENB, enbID s1ap.ENBUES1APID) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id, err := t.allocID()
+	if err != nil {
+		return err
+	}
+	t.moveLocked(ue)
+	delete(t.byMMEID, ue.MMEUES1APID)
+	ue.MMEUES1APID = id
+	t.byMMEID[id] = ue
+	t.connect(ue, enb, enbID)
+	return nil
+}
+
+// release unbinds S1 association of the UE. The UE context is kept.
+func (t *ueTable) release(ue *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.releaseLocked(ue)
+}
+
+func (t *ueTable) releaseLocked(ue *UEContext) {
+	if ue.enb != nil {
+		delete(t.byENB, enbUEKey{ue.enb, ue.ENBUES1APID})
+		ue.enb = nil
+	}
+}
+
+// moveLocked keeps the current S1 association of the UE in release.
+func (t *ueTable) moveLocked(ue *UEContext) {
+	if ue.enb != nil {
+		t.byRelease[ue.MMEUES1APID] = enbUEKey{ue.enb, ue.ENBUES1APID}
+	}
+}
+
+// releaseComplete forgets the old S1 association in release. It returns
+// false when the association is not in release.
+func (t *ueTable) releaseComplete(enb *ENB, mmeID s1ap.MMEUES1APID, enbID s1ap.ENBUES1APID) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if key, ok := t.byRelease[mmeID]; !ok || key != (enbUEKey{enb, enbID}) {
+		return false
+	}
+	delete(t.byRelease, mmeID)
+	return true
+}
+
+// prepareHandover allocates MME-UE-S1AP-ID of the target eNB association
+// of the UE. The UE stays connected to the source eNB until
+// completeHandover is called.
+func (t *ueTable) prepareHandover(ue *UEContext, enb *ENB) (*handoverContext, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id, err := t.allocID()
+	if err != nil {
+		return nil, err
+	}
+	ho := &handoverContext{
+		enb:         enb,
+		MMEUES1APID: id,
+		stream:      enb.AllocStream(),
+	}
+	ue.handover = ho
+	t.byHandover[id] = ue
+	return ho, nil
+}
+
+// completeHandover moves the UE to the target eNB association. The source
+// association is kept in release until the source eNB completes the
+// release.
+func (t *ueTable) completeHandover(ue *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ho := ue.handover
+	if ho == nil {
+		return
+	}
+	t.cancelHandoverLocked(ue)
+	t.moveLocked(ue)
+	t.releaseLocked(ue)
+	delete(t.byMMEID, ue.MMEUES1APID)
+	ue.MMEUES1APID = ho.MMEUES1APID
+	ue.enb = ho.enb
+	ue.ENBUES1APID = ho.ENBUES1APID
+	ue.Stream = ho.stream
+	t.byMMEID[ue.MMEUES1APID] = ue
+	t.byENB[enbUEKey{ue.enb, ue.ENBUES1APID}] = ue
+}
+
+// cancelHandover drops the target eNB association of the UE.
+func (t *ueTable) cancelHandover(ue *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cancelHandoverLocked(ue)
+}
+
+func (t *ueTable) cancelHandoverLocked(ue *UEContext) {
+	if ue.handover == nil {
+		return
+	}
+	if t.byHandover[ue.handover.MMEUES1APID] == ue {
+		delete(t.byHandover, ue.handover.MMEUES1APID)
+	}
+	ue.handover = nil
+}
+
+// enbUEs returns UEs connected to the eNB.
+func (t *ueTable) enbUEs(enb *ENB) []*UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ues := []*UEContext{}
+	for key, ue := range t.byENB {
+		if key.enb == enb {
+			ues = append(ues, ue)
+		}
+	}
+	return ues
+}
+
+// removeENB forgets the old S1 associations in release of the eNB.
+func (t *ueTable) removeENB(enb *ENB) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, key := range t.byRelease {
+		if key.enb == enb {
+			delete(t.byRelease, id)
+		}
+	}
+}
+
+// remove deletes the UE context.
+func (t *ueTable) remove(ue *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.removeLocked(ue)
+}
+
+func (t *ueTable) removeLocked(ue *UEContext) {
+	t.releaseLocked(ue)
+	t.cancelHandoverLocked(ue)
+	if t.byMMEID[ue.MMEUES1APID] == ue {
+		delete(t.byMMEID, ue.MMEUES1APID)
+	}
+	if ue.IMSI != "" && t.byIMSI[ue.IMSI] == ue {
+		delete(t.byIMSI, ue.IMSI)
+	}
+	t.unindexGUTI(ue, ue.GUTI)
+	t.unindexGUTI(ue, ue.newGUTI)
+	if ue.S11TEID != 0 && t.byTEID[ue.S11TEID] == ue {
+		delete(t.byTEID, ue.S11TEID)
+	}
+}
+
+// allocTEID allocates S11 TEID of the MME for the UE. TEID 0 is not used
+// since it means the peer TEID is unknown.
+func (t *ueTable) allocTEID(ue *UEContext) (uint32, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ue.S11TEID != 0 {
+		return ue.S11TEID, nil
+	}
+	for i := 0; i < len(t.byTEID)+1; i++ {
+		teid := t.nextTEID
+		t.nextTEID++
+		if t.nextTEID == 0 {
+			t.nextTEID = 1
+		}
+		if _, ok := t.byTEID[teid]; !ok {
+			ue.S11TEID = teid
+			t.byTEID[teid] = ue
+			return teid, nil
+		}
+	}
+	return 0, fmt.Errorf("S11 TEID exhausted")
+}
+
+// setIMSI sets IMSI of the UE. The UE is indexed by the IMSI unless other
+// context has the same IMSI. The other context is returned so that the
+// caller can take it over by takeOver.
+func (t *ueTable) setIMSI(ue *UEContext, imsi string) (old *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ue.IMSI != "" && t.byIMSI[ue.IMSI] == ue {
+		delete(t.byIMSI, ue.IMSI)
+	}
+	ue.IMSI = imsi
+	if o, ok := t.byIMSI[imsi]; ok && o != ue {
+		return o
+	}
+	t.byIMSI[imsi] = ue
+	return nil
+}
+
+// takeOver removes the old context and indexes the UE by its IMSI. The S1
+// association of the old context is kept in release until the eNB
+// completes the release.
+func (t *ueTable) takeOver(ue, old *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.moveLocked(old)
+	t.removeLocked(old)
+	if ue.IMSI != "" {
+		t.byIMSI[ue.IMSI] = ue
+	}
+}
+
+// setGUTI sets GUTI of the UE. S-TMSI index is updated as well.
+func (t *ueTable) setGUTI(ue *UEContext, guti GUTI) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.unindexGUTI(ue, ue.GUTI)
+	ue.GUTI = &guti
+	t.indexGUTI(ue, guti)
+}
+
+// indexGUTI indexes the GUTI and the S-TMSI. Caller must hold the lock.
+func (t *ueTable) indexGUTI(ue *UEContext, guti GUTI) {
+	t.byGUTI[guti] = ue
+	t.bySTMSI[guti.STMSI()] = ue
+}
+
+// unindexGUTI removes the GUTI of the UE from index. The M-TMSI can be
+// allocated again after that. Caller must hold the lock.
+func (t *ueTable) unindexGUTI(ue *UEContext, guti *GUTI) {
+	if guti == nil {
+		return
+	}
+	if t.byGUTI[*guti] == ue {
+		delete(t.byGUTI, *guti)
+	}
+	if t.bySTMSI[guti.STMSI()] == ue {
+		delete(t.bySTMSI, guti.STMSI())
+	}
+}
+
+// allocGUTI allocates new GUTI of the UE in the MME group and the MME code
+// of base. M-TMSI is chosen randomly from M-TMSIs which are not used by
+// other UEs so that it does not tell the allocation order. The GUTI is kept
+// in newGUTI until commitGUTI is called on the acknowledgement.
+func (t *ueTable) allocGUTI(ue *UEContext, base GUTI) (GUTI, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i := 0; i < gutiAllocRetry; i++ {
+		guti := base
+		guti.MTMSI = rand.Uint32()
+		if guti.MTMSI == MTMSI_INVALID {
+			continue
+		}
+		if _, ok := t.bySTMSI[guti.STMSI()]; ok {
+			continue
+		}
+		if ue.newGUTI != nil && (ue.GUTI == nil || *ue.newGUTI != *ue.GUTI) {
+			t.unindexGUTI(ue, ue.newGUTI)
+		}
+		ue.newGUTI = &guti
+		t.indexGUTI(ue, guti)
+		return guti, nil
+	}
+	return GUTI{}, fmt.Errorf("M-TMSI exhausted")
+}
+
+// commitGUTI takes the new GUTI of the UE into use and releases the old
+// one.
+func (t *ueTable) commitGUTI(ue *UEContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ue.newGUTI == nil {
+		return
+	}
+	t.unindexGUTI(ue, ue.GUTI)
+	ue.GUTI = ue.newGUTI
+	ue.newGUTI = nil
+	t.indexGUTI(ue, *ue.GUTI)
+}
+
+func (t *ueTable) lookupByMMEID(id s1ap.MMEUES1APID) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.byMMEID[id]
+}
+
+// lookupByHandoverID returns the UE of which target eNB association has
+// the MME-UE-S1AP-ID.
+func (t *ueTable) lookupByHandoverID(id s1ap.MMEUES1APID) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.byHandover[id]
+}
+
+func (t *ueTable) lookupByENB(enb *ENB, id s1ap.ENBUES1APID) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.byENB[enbUEKey{enb, id}]
+}
+
+func (t *ueTable) lookupByIMSI(imsi string) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.byIMSI[imsi]
+}
+
+func (t *ueTable) lookupByGUTI(guti GUTI) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.byGUTI[guti]
+}
+
+func (t *ueTable) lookupByTEID(teid uint32) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.byTEID[teid]
+}
+
+func (t *ueTable) lookupBySTMSI(stmsi s1ap.STMSI) *UEContext {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.bySTMSI[stmsi]
+}
+
+// count returns number of UE contexts.
+func (t *ueTable) count() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.byMMEID)
+}
@@ -0,0 +1,252 @@
+package nas
+
+import (
+	"fmt"
+)
+
+// IE format of TS 24.007 11.2.1.1.
+type ieFormat uint8
+
+const (
+	ieTV1  ieFormat = iota // Type 1: half octet IEI and half octet value.
+	ieTV                   // Type 3: IEI and fixed length value.
+	ieTLV                  // Type 4: IEI, one octet length and value.
+	ieTLVE                 // Type 6: IEI, two octets length and value.
+)
+
+// ieSpec declares optional IE of a message. Length is the value length of
+// ieTV.
+type ieSpec struct {
+	iei    uint8
+	format ieFormat
+	length int
+}
+
+type writer struct {
+	buf []byte
+	err error
+}
+
+func (w *writer) setError(format string, a ...interface{}) {
+	if w.err == nil {
+		w.err = fmt.Errorf(format, a...)
+	}
+}
+
+func (w *writer) putUint8(v uint8) {
+	w.buf = append(w.buf, v)
+}
+
+func (w *writer) putUint16(v uint16) {
+	w.buf = append(w.buf, byte(v>>8), byte(v))
+}
+
+func (w *writer) putUint32(v uint32) {
+	w.buf = append(w.buf, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// putHalf puts two half octet values. high is put in bits 5 to 8.
+func (w *writer) putHalf(high, low uint8) {
+	w.buf = append(w.buf, high<<4|low&0x0f)
+}
+
+// putV puts value part of the IE.
+func (w *writer) putV(b []byte) {
+	w.buf = append(w.buf, b...)
+}
+
+func (w *writer) putLV(b []byte) {
+	if len(b) > 255 {
+		w.setError("LV length %d is too long", len(b))
+		return
+	}
+	w.buf = append(w.buf, byte(len(b)))
+	w.buf = append(w.buf, b...)
+}
+
+func (w *writer) putLVE(b []byte) {
+	if len(b) > 65535 {
+		w.setError("LV-E length %d is too long", len(b))
+		return
+	}
+	w.putUint16(uint16(len(b)))
+	w.buf = append(w.buf, b...)
+}
+
+// putTV1 puts type 1 IE. iei is in the high half octet.
+func (w *writer) putTV1(iei, v uint8) {
+	w.buf = append(w.buf, iei&0xf0|v&0x0f)
+}
+
+func (w *writer) putTV(iei uint8, b []byte) {
+	w.buf = append(w.buf, iei)
+	w.buf = append(w.buf, b...)
+}
+
+func (w *writer) putTLV(iei uint8, b []byte) {
+	w.buf = append(w.buf, iei)
+	w.putLV(b)
+}
+
+func (w *writer) putTLVE(iei uint8, b []byte) {
+	w.buf = append(w.buf, iei)
+	w.putLVE(b)
+}
+
+type reader struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (r *reader) setError(format string, a ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, a...)
+	}
+}
+
+func (r *reader) remaining() int {
+	return len(r.buf) - r.pos
+}
+
+// getV returns n octets of value.
+func (r *reader) getV(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if r.remaining() < n {
+		r.setError("short buffer: need %d octets at %d, have %d", n, r.pos, r.remaining())
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, r.buf[r.pos:r.pos+n])
+	r.pos += n
+	return b
+}
+
+func (r *reader) getUint8() uint8 {
+	b := r.getV(1)
+	if len(b) != 1 {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *reader) getUint16() uint16 {
+	b := r.getV(2)
+	if len(b) != 2 {
+		return 0
+	}
+	return uint16(b[0])<<8 | uint16(b[1])
+}
+
+func (r *reader) getUint32() uint32 {
+	b := r.getV(4)
+	if len(b) != 4 {
+		return 0
+	}
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+// getHalf returns high and low half octets.
+func (r *reader) getHalf() (uint8, uint8) {
+	v := r.getUint8()
+	return v >> 4, v & 0x0f
+}
+
+func (r *reader) getLV() []byte {
+	n := int(r.getUint8())
+	return r.getV(n)
+}
+
+func (r *reader) getLVE() []byte {
+	n := int(r.getUint16())
+	return r.getV(n)
+}
+
+// getOptionalIEs decodes rest of the message as optional IEs. The result is
+// keyed by IEI. Key of type 1 IE is the IEI in the high half octet and the
+// value is the low half octet. Unknown IEs are skipped with the rules of TS
+// 24.007 11.2.4.
+func (r *reader) getOptionalIEs(specs []ieSpec) map[uint8][]byte {
+	ies := map[uint8][]byte{}
+	for r.err == nil && r.remaining() > 0 {
+		iei := r.buf[r.pos]
+		spec, ok := lookupIESpec(specs, iei)
+		if !ok {
+			r.pos++
+			if iei&0x80 == 0 {
+				r.getLV()
+			}
+			continue
+		}
+		switch spec.format {
+		case ieTV1:
+			r.pos++
+			ies[spec.iei] = []byte{iei & 0x0f}
+		case ieTV:
+			r.pos++
+			ies[spec.iei] = r.getV(spec.length)
+		case ieTLV:
+			r.pos++
+			ies[spec.iei] = r.getLV()
+		case ieTLVE:
+			r.pos++
+			ies[spec.iei] = r.getLVE()
+		}
+	}
+	return ies
+}
+
+func lookupIESpec(specs []ieSpec, iei uint8) (ieSpec, bool) {
+	for _, spec := range specs {
+		if spec.format == ieTV1 {
+			if spec.iei == iei&0xf0 {
+				return spec, true
+			}
+		} else if spec.iei == iei {
+			return spec, true
+		}
+	}
+	return ieSpec{}, false
+}
+
+// Helpers for optional IE values.
+
+func optUint8(ies map[uint8][]byte, iei uint8) *uint8 {
+	if b, ok := ies[iei]; ok && len(b) > 0 {
+		v := b[0]
+		return &v
+	}
+	return nil
+}
+
+func putOptTV1(w *writer, iei uint8, v *uint8) {
+	if v != nil {
+		w.putTV1(iei, *v)
+	}
+}
+
+func putOptTV(w *writer, iei uint8, b []byte) {
+	if b != nil {
+		w.putTV(iei, b)
+	}
+}
+
+func putOptTVUint8(w *writer, iei uint8, v *uint8) {
+	if v != nil {
+		w.putTV(iei, []byte{*v})
+	}
+}
+
+func putOptTLV(w *writer, iei uint8, b []byte) {
+	if b != nil {
+		w.putTLV(iei, b)
+	}
+}
+
+func putOptTLVE(w *writer, iei uint8, b []byte) {
+	if b != nil {
+		w.putTLVE(iei, b)
+	}
+}
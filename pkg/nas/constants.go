@@ -0,0 +1,364 @@
+package nas
+
+// Protocol discriminator.
+const (
+	PD_ESM = 0x2
+	PD_EMM = 0x7
+)
+
+// Security header type.
+const (
+	SECURITY_HEADER_PLAIN                          = 0x0
+	SECURITY_HEADER_INTEGRITY                      = 0x1
+	SECURITY_HEADER_INTEGRITY_CIPHERED             = 0x2
+	SECURITY_HEADER_INTEGRITY_NEW_CONTEXT          = 0x3
+	SECURITY_HEADER_INTEGRITY_CIPHERED_NEW_CONTEXT = 0x4
+	SECURITY_HEADER_SERVICE_REQUEST                = 0xc
+)
+
+// EPS encryption algorithm.
+const (
+	EEA0 = 0x0
+	EEA1 = 0x1
+	EEA2 = 0x2
+	EEA3 = 0x3
+)
+
+// EPS integrity algorithm.
+const (
+	EIA0 = 0x0
+	EIA1 = 0x1
+	EIA2 = 0x2
+	EIA3 = 0x3
+)
+
+// Direction of NAS message for security algorithms.
+const (
+	DIRECTION_UPLINK   = 0
+	DIRECTION_DOWNLINK = 1
+)
+
+// EPS mobility management message type.
+const (
+	MSG_ATTACH_REQUEST                = 0x41
+	MSG_ATTACH_ACCEPT                 = 0x42
+	MSG_ATTACH_COMPLETE               = 0x43
+	MSG_ATTACH_REJECT                 = 0x44
+	MSG_DETACH_REQUEST                = 0x45
+	MSG_DETACH_ACCEPT                 = 0x46
+	MSG_TRACKING_AREA_UPDATE_REQUEST  = 0x48
+	MSG_TRACKING_AREA_UPDATE_ACCEPT   = 0x49
+	MSG_TRACKING_AREA_UPDATE_COMPLETE = 0x4a
+	MSG_TRACKING_AREA_UPDATE_REJECT   = 0x4b
+	MSG_EXTENDED_SERVICE_REQUEST      = 0x4c
+	MSG_SERVICE_REJECT                = 0x4e
+	MSG_GUTI_REALLOCATION_COMMAND     = 0x50
+	MSG_GUTI_REALLOCATION_COMPLETE    = 0x51
+	MSG_AUTHENTICATION_REQUEST        = 0x52
+	MSG_AUTHENTICATION_RESPONSE       = 0x53
+	MSG_AUTHENTICATION_REJECT         = 0x54
+	MSG_IDENTITY_REQUEST              = 0x55
+	MSG_IDENTITY_RESPONSE             = 0x56
+	MSG_AUTHENTICATION_FAILURE        = 0x5c
+	MSG_SECURITY_MODE_COMMAND         = 0x5d
+	MSG_SECURITY_MODE_COMPLETE        = 0x5e
+	MSG_SECURITY_MODE_REJECT          = 0x5f
+	MSG_EMM_STATUS                    = 0x60
+	MSG_EMM_INFORMATION               = 0x61
+	MSG_DOWNLINK_NAS_TRANSPORT        = 0x62
+	MSG_UPLINK_NAS_TRANSPORT          = 0x63
+)
+
+// MSG_SERVICE_REQUEST is not a real message type. Service Request is
+// identified by the security header type and has no message type octet.
+const MSG_SERVICE_REQUEST = 0x4d
+
+// EMM cause.
+const (
+	EMM_CAUSE_IMSI_UNKNOWN_IN_HSS                       = 2
+	EMM_CAUSE_ILLEGAL_UE                                = 3
+	EMM_CAUSE_IMEI_NOT_ACCEPTED                         = 5
+	EMM_CAUSE_ILLEGAL_ME                                = 6
+	EMM_CAUSE_EPS_SERVICES_NOT_ALLOWED                  = 7
+	EMM_CAUSE_EPS_AND_NON_EPS_SERVICES_NOT_ALLOWED      = 8
+	EMM_CAUSE_UE_IDENTITY_CANNOT_BE_DERIVED             = 9
+	EMM_CAUSE_IMPLICITLY_DETACHED                       = 10
+	EMM_CAUSE_PLMN_NOT_ALLOWED                          = 11
+	EMM_CAUSE_TRACKING_AREA_NOT_ALLOWED                 = 12
+	EMM_CAUSE_ROAMING_NOT_ALLOWED_IN_THIS_TRACKING_AREA = 13
+	EMM_CAUSE_EPS_SERVICES_NOT_ALLOWED_IN_THIS_PLMN     = 14
+	EMM_CAUSE_NO_SUITABLE_CELLS_IN_TRACKING_AREA        = 15
+	EMM_CAUSE_MSC_TEMPORARILY_NOT_REACHABLE             = 16
+	EMM_CAUSE_NETWORK_FAILURE                           = 17
+	EMM_CAUSE_CS_DOMAIN_NOT_AVAILABLE                   = 18
+	EMM_CAUSE_ESM_FAILURE                               = 19
+	EMM_CAUSE_MAC_FAILURE                               = 20
+	EMM_CAUSE_SYNCH_FAILURE                             = 21
+	EMM_CAUSE_CONGESTION                                = 22
+	EMM_CAUSE_UE_SECURITY_CAPABILITIES_MISMATCH         = 23
+	EMM_CAUSE_SECURITY_MODE_REJECTED_UNSPECIFIED        = 24
+	EMM_CAUSE_NOT_AUTHORIZED_FOR_THIS_CSG               = 25
+	EMM_CAUSE_NON_EPS_AUTHENTICATION_UNACCEPTABLE       = 26
+	EMM_CAUSE_REQUESTED_SERVICE_OPTION_NOT_AUTHORIZED   = 35
+	EMM_CAUSE_CS_SERVICE_TEMPORARILY_NOT_AVAILABLE      = 39
+	EMM_CAUSE_NO_EPS_BEARER_CONTEXT_ACTIVATED           = 40
+	EMM_CAUSE_SEVERE_NETWORK_FAILURE                    = 42
+	EMM_CAUSE_SEMANTICALLY_INCORRECT_MESSAGE            = 95
+	EMM_CAUSE_INVALID_MANDATORY_INFORMATION             = 96
+	EMM_CAUSE_MESSAGE_TYPE_NON_EXISTENT                 = 97
+	EMM_CAUSE_MESSAGE_TYPE_NOT_COMPATIBLE               = 98
+	EMM_CAUSE_IE_NON_EXISTENT                           = 99
+	EMM_CAUSE_CONDITIONAL_IE_ERROR                      = 100
+	EMM_CAUSE_MESSAGE_NOT_COMPATIBLE                    = 101
+	EMM_CAUSE_PROTOCOL_ERROR_UNSPECIFIED                = 111
+)
+
+// EPS attach type.
+const (
+	EPS_ATTACH_TYPE_EPS       = 1
+	EPS_ATTACH_TYPE_COMBINED  = 2
+	EPS_ATTACH_TYPE_EMERGENCY = 6
+)
+
+// EPS attach result.
+const (
+	EPS_ATTACH_RESULT_EPS_ONLY = 1
+	EPS_ATTACH_RESULT_COMBINED = 2
+)
+
+// EPS update type. Active flag is bit 4 of the update type.
+const (
+	EPS_UPDATE_TYPE_TA_UPDATING                  = 0
+	EPS_UPDATE_TYPE_COMBINED_TA_LA_UPDATING      = 1
+	EPS_UPDATE_TYPE_COMBINED_TA_LA_UPDATING_IMSI = 2
+	EPS_UPDATE_TYPE_PERIODIC_UPDATING            = 3
+	EPS_UPDATE_TYPE_ACTIVE                       = 0x8
+)
+
+// EPS update result.
+const (
+	EPS_UPDATE_RESULT_TA_UPDATED                 = 0
+	EPS_UPDATE_RESULT_COMBINED_TA_LA_UPDATED     = 1
+	EPS_UPDATE_RESULT_TA_UPDATED_ISR_ACTIVATED   = 4
+	EPS_UPDATE_RESULT_COMBINED_TA_LA_UPDATED_ISR = 5
+)
+
+// Detach type. Switch off flag is bit 4 of UE originating detach type.
+const (
+	DETACH_TYPE_EPS          = 1
+	DETACH_TYPE_IMSI         = 2
+	DETACH_TYPE_COMBINED     = 3
+	DETACH_TYPE_RE_ATTACH    = 1
+	DETACH_TYPE_NO_RE_ATTACH = 2
+	DETACH_TYPE_SWITCH_OFF   = 0x8
+)
+
+// Service type of Extended Service Request.
+const (
+	SERVICE_TYPE_MO_CSFB           = 0
+	SERVICE_TYPE_MT_CSFB           = 1
+	SERVICE_TYPE_MO_CSFB_EMERGENCY = 2
+	SERVICE_TYPE_PACKET_SERVICES   = 8
+)
+
+// Identity type.
+const (
+	IDENTITY_TYPE_IMSI   = 1
+	IDENTITY_TYPE_IMEI   = 2
+	IDENTITY_TYPE_IMEISV = 3
+	IDENTITY_TYPE_TMSI   = 4
+	IDENTITY_TYPE_GUTI   = 6
+)
+
+// IMEISV request value of Security Mode Command.
+const IMEISV_REQUESTED = 1
+
+// NAS key set identifier value which means no key is available.
+const NAS_KSI_NO_KEY = 7
+
+// Information element identifiers.
+const (
+	IEI_OLD_P_TMSI_SIGNATURE               = 0x19
+	IEI_ADDITIONAL_GUTI                    = 0x50
+	IEI_GUTI                               = 0x50
+	IEI_LAST_VISITED_REGISTERED_TAI        = 0x52
+	IEI_DRX_PARAMETER                      = 0x5c
+	IEI_MS_NETWORK_CAPABILITY              = 0x31
+	IEI_OLD_LAI                            = 0x13
+	IEI_LAI                                = 0x13
+	IEI_TMSI_STATUS                        = 0x90
+	IEI_MS_CLASSMARK_2                     = 0x11
+	IEI_MS_CLASSMARK_3                     = 0x20
+	IEI_SUPPORTED_CODECS                   = 0x40
+	IEI_ADDITIONAL_UPDATE_TYPE             = 0xf0
+	IEI_VOICE_DOMAIN_PREFERENCE            = 0x5d
+	IEI_DEVICE_PROPERTIES                  = 0xd0
+	IEI_OLD_GUTI_TYPE                      = 0xe0
+	IEI_MS_NETWORK_FEATURE_SUPPORT         = 0xc0
+	IEI_TMSI_BASED_NRI_CONTAINER           = 0x10
+	IEI_T3324_VALUE                        = 0x6a
+	IEI_T3412_EXTENDED_VALUE               = 0x5e
+	IEI_EXTENDED_DRX_PARAMETERS            = 0x6e
+	IEI_MS_IDENTITY                        = 0x23
+	IEI_IMEISV                             = 0x23
+	IEI_EMM_CAUSE                          = 0x53
+	IEI_T3402_VALUE                        = 0x17
+	IEI_T3423_VALUE                        = 0x59
+	IEI_T3412_VALUE                        = 0x5a
+	IEI_EQUIVALENT_PLMNS                   = 0x4a
+	IEI_EMERGENCY_NUMBER_LIST              = 0x34
+	IEI_EPS_NETWORK_FEATURE_SUPPORT        = 0x64
+	IEI_ADDITIONAL_UPDATE_RESULT           = 0xf0
+	IEI_ESM_MESSAGE_CONTAINER              = 0x78
+	IEI_T3346_VALUE                        = 0x5f
+	IEI_T3402_VALUE_TLV                    = 0x16
+	IEI_EXTENDED_EMM_CAUSE                 = 0xa0
+	IEI_AUTHENTICATION_FAILURE_PARAMETER   = 0x30
+	IEI_FULL_NAME_FOR_NETWORK              = 0x43
+	IEI_SHORT_NAME_FOR_NETWORK             = 0x45
+	IEI_LOCAL_TIME_ZONE                    = 0x46
+	IEI_UNIVERSAL_TIME_AND_LOCAL_TIME_ZONE = 0x47
+	IEI_NETWORK_DAYLIGHT_SAVING_TIME       = 0x49
+	IEI_CSFB_RESPONSE                      = 0xb0
+	IEI_EPS_BEARER_CONTEXT_STATUS          = 0x57
+	IEI_TAI_LIST                           = 0x54
+	IEI_IMEISV_REQUEST                     = 0xc0
+	IEI_REPLAYED_NONCE_UE                  = 0x55
+	IEI_NONCE_MME                          = 0x56
+	IEI_NONCE_UE                           = 0x55
+	IEI_REPLAYED_NAS_MESSAGE_CONTAINER     = 0x79
+	IEI_T3442_VALUE                        = 0x5b
+	IEI_NON_CURRENT_NATIVE_NAS_KSI         = 0xb0
+	IEI_GPRS_CIPHERING_KEY_SEQUENCE        = 0x80
+	IEI_UE_NETWORK_CAPABILITY              = 0x58
+	IEI_UE_RADIO_CAPABILITY_UPDATE_NEEDED  = 0xa0
+)
+
+// EPS session management message type.
+const (
+	MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_REQUEST   = 0xc1
+	MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_ACCEPT    = 0xc2
+	MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_REJECT    = 0xc3
+	MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REQUEST = 0xc5
+	MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT  = 0xc6
+	MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT  = 0xc7
+	MSG_MODIFY_EPS_BEARER_CONTEXT_REQUEST             = 0xc9
+	MSG_MODIFY_EPS_BEARER_CONTEXT_ACCEPT              = 0xca
+	MSG_MODIFY_EPS_BEARER_CONTEXT_REJECT              = 0xcb
+	MSG_DEACTIVATE_EPS_BEARER_CONTEXT_REQUEST         = 0xcd
+	MSG_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT          = 0xce
+	MSG_PDN_CONNECTIVITY_REQUEST                      = 0xd0
+	MSG_PDN_CONNECTIVITY_REJECT                       = 0xd1
+	MSG_PDN_DISCONNECT_REQUEST                        = 0xd2
+	MSG_PDN_DISCONNECT_REJECT                         = 0xd3
+	MSG_BEARER_RESOURCE_ALLOCATION_REQUEST            = 0xd4
+	MSG_BEARER_RESOURCE_ALLOCATION_REJECT             = 0xd5
+	MSG_BEARER_RESOURCE_MODIFICATION_REQUEST          = 0xd6
+	MSG_BEARER_RESOURCE_MODIFICATION_REJECT           = 0xd7
+	MSG_ESM_INFORMATION_REQUEST                       = 0xd9
+	MSG_ESM_INFORMATION_RESPONSE                      = 0xda
+	MSG_ESM_STATUS                                    = 0xe8
+)
+
+// ESM cause.
+const (
+	ESM_CAUSE_OPERATOR_DETERMINED_BARRING                = 8
+	ESM_CAUSE_INSUFFICIENT_RESOURCES                     = 26
+	ESM_CAUSE_MISSING_OR_UNKNOWN_APN                     = 27
+	ESM_CAUSE_UNKNOWN_PDN_TYPE                           = 28
+	ESM_CAUSE_USER_AUTHENTICATION_FAILED                 = 29
+	ESM_CAUSE_REQUEST_REJECTED_BY_SGW_OR_PGW             = 30
+	ESM_CAUSE_REQUEST_REJECTED_UNSPECIFIED               = 31
+	ESM_CAUSE_SERVICE_OPTION_NOT_SUPPORTED               = 32
+	ESM_CAUSE_SERVICE_OPTION_NOT_SUBSCRIBED              = 33
+	ESM_CAUSE_SERVICE_OPTION_TEMPORARILY_OUT_OF_ORDER    = 34
+	ESM_CAUSE_PTI_ALREADY_IN_USE                         = 35
+	ESM_CAUSE_REGULAR_DEACTIVATION                       = 36
+	ESM_CAUSE_EPS_QOS_NOT_ACCEPTED                       = 37
+	ESM_CAUSE_NETWORK_FAILURE                            = 38
+	ESM_CAUSE_REACTIVATION_REQUESTED                     = 39
+	ESM_CAUSE_SEMANTIC_ERROR_IN_THE_TFT_OPERATION        = 41
+	ESM_CAUSE_SYNTACTICAL_ERROR_IN_THE_TFT_OPERATION     = 42
+	ESM_CAUSE_INVALID_EPS_BEARER_IDENTITY                = 43
+	ESM_CAUSE_SEMANTIC_ERRORS_IN_PACKET_FILTERS          = 44
+	ESM_CAUSE_SYNTACTICAL_ERRORS_IN_PACKET_FILTERS       = 45
+	ESM_CAUSE_PTI_MISMATCH                               = 47
+	ESM_CAUSE_LAST_PDN_DISCONNECTION_NOT_ALLOWED         = 49
+	ESM_CAUSE_PDN_TYPE_IPV4_ONLY_ALLOWED                 = 50
+	ESM_CAUSE_PDN_TYPE_IPV6_ONLY_ALLOWED                 = 51
+	ESM_CAUSE_SINGLE_ADDRESS_BEARERS_ONLY_ALLOWED        = 52
+	ESM_CAUSE_ESM_INFORMATION_NOT_RECEIVED               = 53
+	ESM_CAUSE_PDN_CONNECTION_DOES_NOT_EXIST              = 54
+	ESM_CAUSE_MULTIPLE_PDN_CONNECTIONS_NOT_ALLOWED       = 55
+	ESM_CAUSE_COLLISION_WITH_NETWORK_INITIATED_REQUEST   = 56
+	ESM_CAUSE_UNSUPPORTED_QCI_VALUE                      = 59
+	ESM_CAUSE_BEARER_HANDLING_NOT_SUPPORTED              = 60
+	ESM_CAUSE_INVALID_PTI_VALUE                          = 81
+	ESM_CAUSE_SEMANTICALLY_INCORRECT_MESSAGE             = 95
+	ESM_CAUSE_INVALID_MANDATORY_INFORMATION              = 96
+	ESM_CAUSE_MESSAGE_TYPE_NON_EXISTENT                  = 97
+	ESM_CAUSE_MESSAGE_TYPE_NOT_COMPATIBLE                = 98
+	ESM_CAUSE_IE_NON_EXISTENT                            = 99
+	ESM_CAUSE_CONDITIONAL_IE_ERROR                       = 100
+	ESM_CAUSE_MESSAGE_NOT_COMPATIBLE                     = 101
+	ESM_CAUSE_PROTOCOL_ERROR_UNSPECIFIED                 = 111
+	ESM_CAUSE_APN_RESTRICTION_VALUE_INCOMPATIBLE         = 112
+	ESM_CAUSE_MULTIPLE_ACCESSES_TO_PDN_CONNECTION_DENIED = 113
+)
+
+// PDN type.
+const (
+	PDN_TYPE_IPV4   = 1
+	PDN_TYPE_IPV6   = 2
+	PDN_TYPE_IPV4V6 = 3
+	PDN_TYPE_NON_IP = 5
+)
+
+// Request type of PDN Connectivity Request.
+const (
+	REQUEST_TYPE_INITIAL            = 1
+	REQUEST_TYPE_HANDOVER           = 2
+	REQUEST_TYPE_EMERGENCY          = 4
+	REQUEST_TYPE_HANDOVER_EMERGENCY = 6
+)
+
+// Procedure transaction identity which is not assigned.
+const PTI_UNASSIGNED = 0
+
+// Configuration protocol option identifiers of PCO.
+const (
+	PCO_ID_IPCP                          = 0x8021
+	PCO_ID_P_CSCF_IPV6_ADDRESS           = 0x0001
+	PCO_ID_DNS_SERVER_IPV6_ADDRESS       = 0x0003
+	PCO_ID_IP_ADDRESS_ALLOCATION_VIA_NAS = 0x000a
+	PCO_ID_P_CSCF_IPV4_ADDRESS           = 0x000c
+	PCO_ID_DNS_SERVER_IPV4_ADDRESS       = 0x000d
+	PCO_ID_IPV4_LINK_MTU                 = 0x0010
+)
+
+// ESM information element identifiers.
+const (
+	IEI_TRANSACTION_IDENTIFIER           = 0x5d
+	IEI_NEGOTIATED_QOS                   = 0x30
+	IEI_NEGOTIATED_LLC_SAPI              = 0x32
+	IEI_RADIO_PRIORITY                   = 0x80
+	IEI_PACKET_FLOW_IDENTIFIER           = 0x34
+	IEI_APN_AMBR                         = 0x5e
+	IEI_ESM_CAUSE                        = 0x58
+	IEI_PROTOCOL_CONFIGURATION_OPTIONS   = 0x27
+	IEI_CONNECTIVITY_TYPE                = 0xb0
+	IEI_WLAN_OFFLOAD_INDICATION          = 0xc0
+	IEI_NBIFOM_CONTAINER                 = 0x33
+	IEI_HEADER_COMPRESSION_CONFIGURATION = 0x66
+	IEI_CONTROL_PLANE_ONLY_INDICATION    = 0x90
+	IEI_EXTENDED_PCO                     = 0x7b
+	IEI_SERVING_PLMN_RATE_CONTROL        = 0x6e
+	IEI_NEW_EPS_QOS                      = 0x5b
+	IEI_TFT                              = 0x36
+	IEI_NEW_QOS                          = 0x30
+	IEI_T3396_VALUE                      = 0x37
+	IEI_ESM_INFORMATION_TRANSFER_FLAG    = 0xd0
+	IEI_ACCESS_POINT_NAME                = 0x28
+	IEI_ESM_DEVICE_PROPERTIES            = 0xc0
+	IEI_REQUIRED_TRAFFIC_FLOW_QOS        = 0x5b
+)
@@ -0,0 +1,994 @@
+package nas
+
+func init() {
+	registerMessage(func() Message { return &AttachRequest{} })
+	registerMessage(func() Message { return &AttachAccept{} })
+	registerMessage(func() Message { return &AttachComplete{} })
+	registerMessage(func() Message { return &AttachReject{} })
+	registerMessage(func() Message { return &DetachRequest{} })
+	registerMessage(func() Message { return &DetachAccept{} })
+	registerMessage(func() Message { return &TrackingAreaUpdateRequest{} })
+	registerMessage(func() Message { return &TrackingAreaUpdateAccept{} })
+	registerMessage(func() Message { return &TrackingAreaUpdateComplete{} })
+	registerMessage(func() Message { return &TrackingAreaUpdateReject{} })
+	registerMessage(func() Message { return &ExtendedServiceRequest{} })
+	registerMessage(func() Message { return &ServiceReject{} })
+	registerMessage(func() Message { return &GUTIReallocationCommand{} })
+	registerMessage(func() Message { return &GUTIReallocationComplete{} })
+	registerMessage(func() Message { return &AuthenticationRequest{} })
+	registerMessage(func() Message { return &AuthenticationResponse{} })
+	registerMessage(func() Message { return &AuthenticationReject{} })
+	registerMessage(func() Message { return &AuthenticationFailure{} })
+	registerMessage(func() Message { return &IdentityRequest{} })
+	registerMessage(func() Message { return &IdentityResponse{} })
+	registerMessage(func() Message { return &SecurityModeCommand{} })
+	registerMessage(func() Message { return &SecurityModeComplete{} })
+	registerMessage(func() Message { return &SecurityModeReject{} })
+	registerMessage(func() Message { return &EMMStatus{} })
+	registerMessage(func() Message { return &EMMInformation{} })
+	registerMessage(func() Message { return &DownlinkNASTransport{} })
+	registerMessage(func() Message { return &UplinkNASTransport{} })
+}
+
+// AttachRequest is TS 24.301 8.2.4.
+type AttachRequest struct {
+	KSI                      uint8
+	AttachType               uint8
+	EPSMobileIdentity        MobileIdentity
+	UENetworkCapability      []byte
+	ESMMessageContainer      []byte
+	OldPTMSISignature        []byte
+	AdditionalGUTI           *GUTI
+	LastVisitedRegisteredTAI *TAI
+	DRXParameter             []byte
+	MSNetworkCapability      []byte
+	OldLAI                   []byte
+	TMSIStatus               *uint8
+	MSClassmark2             []byte
+	MSClassmark3             []byte
+	SupportedCodecs          []byte
+	AdditionalUpdateType     *uint8
+	VoiceDomainPreference    []byte
+	DeviceProperties         *uint8
+	OldGUTIType              *uint8
+	MSNetworkFeatureSupport  *uint8
+	TMSIBasedNRIContainer    []byte
+	T3324Value               []byte
+	T3412ExtendedValue       []byte
+	ExtendedDRXParameters    []byte
+}
+
+var attachRequestIEs = []ieSpec{
+	{IEI_OLD_P_TMSI_SIGNATURE, ieTV, 3},
+	{IEI_ADDITIONAL_GUTI, ieTLV, 0},
+	{IEI_LAST_VISITED_REGISTERED_TAI, ieTV, 5},
+	{IEI_DRX_PARAMETER, ieTV, 2},
+	{IEI_MS_NETWORK_CAPABILITY, ieTLV, 0},
+	{IEI_OLD_LAI, ieTV, 5},
+	{IEI_TMSI_STATUS, ieTV1, 0},
+	{IEI_MS_CLASSMARK_2, ieTLV, 0},
+	{IEI_MS_CLASSMARK_3, ieTLV, 0},
+	{IEI_SUPPORTED_CODECS, ieTLV, 0},
+	{IEI_ADDITIONAL_UPDATE_TYPE, ieTV1, 0},
+	{IEI_VOICE_DOMAIN_PREFERENCE, ieTLV, 0},
+	{IEI_DEVICE_PROPERTIES, ieTV1, 0},
+	{IEI_OLD_GUTI_TYPE, ieTV1, 0},
+	{IEI_MS_NETWORK_FEATURE_SUPPORT, ieTV1, 0},
+	{IEI_TMSI_BASED_NRI_CONTAINER, ieTLV, 0},
+	{IEI_T3324_VALUE, ieTLV, 0},
+	{IEI_T3412_EXTENDED_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_DRX_PARAMETERS, ieTLV, 0},
+}
+
+func (m *AttachRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AttachRequest) MessageType() uint8           { return MSG_ATTACH_REQUEST }
+
+func (m *AttachRequest) encode(w *writer) {
+	w.putHalf(m.KSI, m.AttachType)
+	w.putLV(m.EPSMobileIdentity.bytes())
+	w.putLV(m.UENetworkCapability)
+	w.putLVE(m.ESMMessageContainer)
+	putOptTV(w, IEI_OLD_P_TMSI_SIGNATURE, m.OldPTMSISignature)
+	putOptGUTI(w, IEI_ADDITIONAL_GUTI, m.AdditionalGUTI)
+	putOptTAI(w, IEI_LAST_VISITED_REGISTERED_TAI, m.LastVisitedRegisteredTAI)
+	putOptTV(w, IEI_DRX_PARAMETER, m.DRXParameter)
+	putOptTLV(w, IEI_MS_NETWORK_CAPABILITY, m.MSNetworkCapability)
+	putOptTV(w, IEI_OLD_LAI, m.OldLAI)
+	putOptTV1(w, IEI_TMSI_STATUS, m.TMSIStatus)
+	putOptTLV(w, IEI_MS_CLASSMARK_2, m.MSClassmark2)
+	putOptTLV(w, IEI_MS_CLASSMARK_3, m.MSClassmark3)
+	putOptTLV(w, IEI_SUPPORTED_CODECS, m.SupportedCodecs)
+	putOptTV1(w, IEI_ADDITIONAL_UPDATE_TYPE, m.AdditionalUpdateType)
+	putOptTLV(w, IEI_VOICE_DOMAIN_PREFERENCE, m.VoiceDomainPreference)
+	putOptTV1(w, IEI_DEVICE_PROPERTIES, m.DeviceProperties)
+	putOptTV1(w, IEI_OLD_GUTI_TYPE, m.OldGUTIType)
+	putOptTV1(w, IEI_MS_NETWORK_FEATURE_SUPPORT, m.MSNetworkFeatureSupport)
+	putOptTLV(w, IEI_TMSI_BASED_NRI_CONTAINER, m.TMSIBasedNRIContainer)
+	putOptTLV(w, IEI_T3324_VALUE, m.T3324Value)
+	putOptTLV(w, IEI_T3412_EXTENDED_VALUE, m.T3412ExtendedValue)
+	putOptTLV(w, IEI_EXTENDED_DRX_PARAMETERS, m.ExtendedDRXParameters)
+}
+
+func (m *AttachRequest) decode(r *reader) {
+	m.KSI, m.AttachType = r.getHalf()
+	m.EPSMobileIdentity = r.getMobileIdentity()
+	m.UENetworkCapability = r.getLV()
+	m.ESMMessageContainer = r.getLVE()
+	ies := r.getOptionalIEs(attachRequestIEs)
+	m.OldPTMSISignature = ies[IEI_OLD_P_TMSI_SIGNATURE]
+	m.AdditionalGUTI = r.optGUTI(ies, IEI_ADDITIONAL_GUTI)
+	m.LastVisitedRegisteredTAI = r.optTAI(ies, IEI_LAST_VISITED_REGISTERED_TAI)
+	m.DRXParameter = ies[IEI_DRX_PARAMETER]
+	m.MSNetworkCapability = ies[IEI_MS_NETWORK_CAPABILITY]
+	m.OldLAI = ies[IEI_OLD_LAI]
+	m.TMSIStatus = optUint8(ies, IEI_TMSI_STATUS)
+	m.MSClassmark2 = ies[IEI_MS_CLASSMARK_2]
+	m.MSClassmark3 = ies[IEI_MS_CLASSMARK_3]
+	m.SupportedCodecs = ies[IEI_SUPPORTED_CODECS]
+	m.AdditionalUpdateType = optUint8(ies, IEI_ADDITIONAL_UPDATE_TYPE)
+	m.VoiceDomainPreference = ies[IEI_VOICE_DOMAIN_PREFERENCE]
+	m.DeviceProperties = optUint8(ies, IEI_DEVICE_PROPERTIES)
+	m.OldGUTIType = optUint8(ies, IEI_OLD_GUTI_TYPE)
+	m.MSNetworkFeatureSupport = optUint8(ies, IEI_MS_NETWORK_FEATURE_SUPPORT)
+	m.TMSIBasedNRIContainer = ies[IEI_TMSI_BASED_NRI_CONTAINER]
+	m.T3324Value = ies[IEI_T3324_VALUE]
+	m.T3412ExtendedValue = ies[IEI_T3412_EXTENDED_VALUE]
+	m.ExtendedDRXParameters = ies[IEI_EXTENDED_DRX_PARAMETERS]
+}
+
+// AttachAccept is TS 24.301 8.2.1.
+type AttachAccept struct {
+	AttachResult             uint8
+	T3412Value               uint8
+	TAIList                  TAIList
+	ESMMessageContainer      []byte
+	GUTI                     *GUTI
+	LAI                      []byte
+	MSIdentity               *MobileIdentity
+	EMMCause                 *uint8
+	T3402Value               *uint8
+	T3423Value               *uint8
+	EquivalentPLMNs          []byte
+	EmergencyNumberList      []byte
+	EPSNetworkFeatureSupport []byte
+	AdditionalUpdateResult   *uint8
+	T3412ExtendedValue       []byte
+	T3324Value               []byte
+	ExtendedDRXParameters    []byte
+}
+
+var attachAcceptIEs = []ieSpec{
+	{IEI_GUTI, ieTLV, 0},
+	{IEI_LAI, ieTV, 5},
+	{IEI_MS_IDENTITY, ieTLV, 0},
+	{IEI_EMM_CAUSE, ieTV, 1},
+	{IEI_T3402_VALUE, ieTV, 1},
+	{IEI_T3423_VALUE, ieTV, 1},
+	{IEI_EQUIVALENT_PLMNS, ieTLV, 0},
+	{IEI_EMERGENCY_NUMBER_LIST, ieTLV, 0},
+	{IEI_EPS_NETWORK_FEATURE_SUPPORT, ieTLV, 0},
+	{IEI_ADDITIONAL_UPDATE_RESULT, ieTV1, 0},
+	{IEI_T3412_EXTENDED_VALUE, ieTLV, 0},
+	{IEI_T3324_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_DRX_PARAMETERS, ieTLV, 0},
+}
+
+func (m *AttachAccept) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AttachAccept) MessageType() uint8           { return MSG_ATTACH_ACCEPT }
+
+func (m *AttachAccept) encode(w *writer) {
+	w.putHalf(0, m.AttachResult)
+	w.putUint8(m.T3412Value)
+	w.putLV(m.TAIList.bytes())
+	w.putLVE(m.ESMMessageContainer)
+	putOptGUTI(w, IEI_GUTI, m.GUTI)
+	putOptTV(w, IEI_LAI, m.LAI)
+	if m.MSIdentity != nil {
+		w.putTLV(IEI_MS_IDENTITY, m.MSIdentity.bytes())
+	}
+	putOptTVUint8(w, IEI_EMM_CAUSE, m.EMMCause)
+	putOptTVUint8(w, IEI_T3402_VALUE, m.T3402Value)
+	putOptTVUint8(w, IEI_T3423_VALUE, m.T3423Value)
+	putOptTLV(w, IEI_EQUIVALENT_PLMNS, m.EquivalentPLMNs)
+	putOptTLV(w, IEI_EMERGENCY_NUMBER_LIST, m.EmergencyNumberList)
+	putOptTLV(w, IEI_EPS_NETWORK_FEATURE_SUPPORT, m.EPSNetworkFeatureSupport)
+	putOptTV1(w, IEI_ADDITIONAL_UPDATE_RESULT, m.AdditionalUpdateResult)
+	putOptTLV(w, IEI_T3412_EXTENDED_VALUE, m.T3412ExtendedValue)
+	putOptTLV(w, IEI_T3324_VALUE, m.T3324Value)
+	putOptTLV(w, IEI_EXTENDED_DRX_PARAMETERS, m.ExtendedDRXParameters)
+}
+
+func (m *AttachAccept) decode(r *reader) {
+	_, m.AttachResult = r.getHalf()
+	m.T3412Value = r.getUint8()
+	l, err := decodeTAIList(r.getLV())
+	if err != nil {
+		r.setError("%v", err)
+	}
+	m.TAIList = l
+	m.ESMMessageContainer = r.getLVE()
+	ies := r.getOptionalIEs(attachAcceptIEs)
+	m.GUTI = r.optGUTI(ies, IEI_GUTI)
+	m.LAI = ies[IEI_LAI]
+	m.MSIdentity = r.optMobileIdentity(ies, IEI_MS_IDENTITY)
+	m.EMMCause = optUint8(ies, IEI_EMM_CAUSE)
+	m.T3402Value = optUint8(ies, IEI_T3402_VALUE)
+	m.T3423Value = optUint8(ies, IEI_T3423_VALUE)
+	m.EquivalentPLMNs = ies[IEI_EQUIVALENT_PLMNS]
+	m.EmergencyNumberList = ies[IEI_EMERGENCY_NUMBER_LIST]
+	m.EPSNetworkFeatureSupport = ies[IEI_EPS_NETWORK_FEATURE_SUPPORT]
+	m.AdditionalUpdateResult = optUint8(ies, IEI_ADDITIONAL_UPDATE_RESULT)
+	m.T3412ExtendedValue = ies[IEI_T3412_EXTENDED_VALUE]
+	m.T3324Value = ies[IEI_T3324_VALUE]
+	m.ExtendedDRXParameters = ies[IEI_EXTENDED_DRX_PARAMETERS]
+}
+
+// AttachComplete is TS 24.301 8.2.2.
+type AttachComplete struct {
+	ESMMessageContainer []byte
+}
+
+func (m *AttachComplete) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AttachComplete) MessageType() uint8           { return MSG_ATTACH_COMPLETE }
+
+func (m *AttachComplete) encode(w *writer) {
+	w.putLVE(m.ESMMessageContainer)
+}
+
+func (m *AttachComplete) decode(r *reader) {
+	m.ESMMessageContainer = r.getLVE()
+}
+
+// AttachReject is TS 24.301 8.2.3.
+type AttachReject struct {
+	EMMCause            uint8
+	ESMMessageContainer []byte
+	T3346Value          []byte
+	T3402Value          []byte
+	ExtendedEMMCause    *uint8
+}
+
+var attachRejectIEs = []ieSpec{
+	{IEI_ESM_MESSAGE_CONTAINER, ieTLVE, 0},
+	{IEI_T3346_VALUE, ieTLV, 0},
+	{IEI_T3402_VALUE_TLV, ieTLV, 0},
+	{IEI_EXTENDED_EMM_CAUSE, ieTV1, 0},
+}
+
+func (m *AttachReject) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AttachReject) MessageType() uint8           { return MSG_ATTACH_REJECT }
+
+func (m *AttachReject) encode(w *writer) {
+	w.putUint8(m.EMMCause)
+	putOptTLVE(w, IEI_ESM_MESSAGE_CONTAINER, m.ESMMessageContainer)
+	putOptTLV(w, IEI_T3346_VALUE, m.T3346Value)
+	putOptTLV(w, IEI_T3402_VALUE_TLV, m.T3402Value)
+	putOptTV1(w, IEI_EXTENDED_EMM_CAUSE, m.ExtendedEMMCause)
+}
+
+func (m *AttachReject) decode(r *reader) {
+	m.EMMCause = r.getUint8()
+	ies := r.getOptionalIEs(attachRejectIEs)
+	m.ESMMessageContainer = ies[IEI_ESM_MESSAGE_CONTAINER]
+	m.T3346Value = ies[IEI_T3346_VALUE]
+	m.T3402Value = ies[IEI_T3402_VALUE_TLV]
+	m.ExtendedEMMCause = optUint8(ies, IEI_EXTENDED_EMM_CAUSE)
+}
+
+// DetachRequest is UE originating detach request of TS 24.301 8.2.11.1.
+// DetachType includes the switch off flag.
+type DetachRequest struct {
+	KSI               uint8
+	DetachType        uint8
+	EPSMobileIdentity MobileIdentity
+}
+
+func (m *DetachRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *DetachRequest) MessageType() uint8           { return MSG_DETACH_REQUEST }
+
+// SwitchOff returns true when the UE is switched off.
+func (m *DetachRequest) SwitchOff() bool {
+	return m.DetachType&DETACH_TYPE_SWITCH_OFF != 0
+}
+
+func (m *DetachRequest) encode(w *writer) {
+	w.putHalf(m.KSI, m.DetachType)
+	w.putLV(m.EPSMobileIdentity.bytes())
+}
+
+func (m *DetachRequest) decode(r *reader) {
+	m.KSI, m.DetachType = r.getHalf()
+	m.EPSMobileIdentity = r.getMobileIdentity()
+}
+
+// NetworkDetachRequest is UE terminated detach request of TS 24.301
+// 8.2.11.2. It has the same message type as DetachRequest.
+type NetworkDetachRequest struct {
+	DetachType uint8
+	EMMCause   *uint8
+}
+
+var networkDetachRequestIEs = []ieSpec{
+	{IEI_EMM_CAUSE, ieTV, 1},
+}
+
+func (m *NetworkDetachRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *NetworkDetachRequest) MessageType() uint8           { return MSG_DETACH_REQUEST }
+
+func (m *NetworkDetachRequest) encode(w *writer) {
+	w.putHalf(0, m.DetachType)
+	putOptTVUint8(w, IEI_EMM_CAUSE, m.EMMCause)
+}
+
+func (m *NetworkDetachRequest) decode(r *reader) {
+	_, m.DetachType = r.getHalf()
+	ies := r.getOptionalIEs(networkDetachRequestIEs)
+	m.EMMCause = optUint8(ies, IEI_EMM_CAUSE)
+}
+
+// isNetworkDetachRequest returns true when the detach request body has no
+// EPS mobile identity.
+func isNetworkDetachRequest(body []byte) bool {
+	return len(body) == 1 || (len(body) == 3 && body[1] == IEI_EMM_CAUSE)
+}
+
+// DetachAccept is TS 24.301 8.2.9 and 8.2.10.
+type DetachAccept struct{}
+
+func (m *DetachAccept) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *DetachAccept) MessageType() uint8           { return MSG_DETACH_ACCEPT }
+func (m *DetachAccept) encode(w *writer)             {}
+func (m *DetachAccept) decode(r *reader)             {}
+
+// TrackingAreaUpdateRequest is TS 24.301 8.2.29.
+type TrackingAreaUpdateRequest struct {
+	KSI                         uint8
+	UpdateType                  uint8
+	OldGUTI                     MobileIdentity
+	NonCurrentNativeKSI         *uint8
+	GPRSCipheringKeySequence    *uint8
+	OldPTMSISignature           []byte
+	AdditionalGUTI              *GUTI
+	NonceUE                     []byte
+	UENetworkCapability         []byte
+	LastVisitedRegisteredTAI    *TAI
+	DRXParameter                []byte
+	UERadioCapabilityInfoUpdate *uint8
+	EPSBearerContextStatus      []byte
+	MSNetworkCapability         []byte
+	OldLAI                      []byte
+	TMSIStatus                  *uint8
+	MSClassmark2                []byte
+	MSClassmark3                []byte
+	SupportedCodecs             []byte
+	AdditionalUpdateType        *uint8
+	VoiceDomainPreference       []byte
+	OldGUTIType                 *uint8
+	DeviceProperties            *uint8
+	MSNetworkFeatureSupport     *uint8
+	TMSIBasedNRIContainer       []byte
+	T3324Value                  []byte
+	T3412ExtendedValue          []byte
+	ExtendedDRXParameters       []byte
+}
+
+var trackingAreaUpdateRequestIEs = []ieSpec{
+	{IEI_NON_CURRENT_NATIVE_NAS_KSI, ieTV1, 0},
+	{IEI_GPRS_CIPHERING_KEY_SEQUENCE, ieTV1, 0},
+	{IEI_OLD_P_TMSI_SIGNATURE, ieTV, 3},
+	{IEI_ADDITIONAL_GUTI, ieTLV, 0},
+	{IEI_NONCE_UE, ieTV, 4},
+	{IEI_UE_NETWORK_CAPABILITY, ieTLV, 0},
+	{IEI_LAST_VISITED_REGISTERED_TAI, ieTV, 5},
+	{IEI_DRX_PARAMETER, ieTV, 2},
+	{IEI_UE_RADIO_CAPABILITY_UPDATE_NEEDED, ieTV1, 0},
+	{IEI_EPS_BEARER_CONTEXT_STATUS, ieTLV, 0},
+	{IEI_MS_NETWORK_CAPABILITY, ieTLV, 0},
+	{IEI_OLD_LAI, ieTV, 5},
+	{IEI_TMSI_STATUS, ieTV1, 0},
+	{IEI_MS_CLASSMARK_2, ieTLV, 0},
+	{IEI_MS_CLASSMARK_3, ieTLV, 0},
+	{IEI_SUPPORTED_CODECS, ieTLV, 0},
+	{IEI_ADDITIONAL_UPDATE_TYPE, ieTV1, 0},
+	{IEI_VOICE_DOMAIN_PREFERENCE, ieTLV, 0},
+	{IEI_OLD_GUTI_TYPE, ieTV1, 0},
+	{IEI_DEVICE_PROPERTIES, ieTV1, 0},
+	{IEI_MS_NETWORK_FEATURE_SUPPORT, ieTV1, 0},
+	{IEI_TMSI_BASED_NRI_CONTAINER, ieTLV, 0},
+	{IEI_T3324_VALUE, ieTLV, 0},
+	{IEI_T3412_EXTENDED_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_DRX_PARAMETERS, ieTLV, 0},
+}
+
+func (m *TrackingAreaUpdateRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *TrackingAreaUpdateRequest) MessageType() uint8 {
+	return MSG_TRACKING_AREA_UPDATE_REQUEST
+}
+
+func (m *TrackingAreaUpdateRequest) encode(w *writer) {
+	w.putHalf(m.KSI, m.UpdateType)
+	w.putLV(m.OldGUTI.bytes())
+	putOptTV1(w, IEI_NON_CURRENT_NATIVE_NAS_KSI, m.NonCurrentNativeKSI)
+	putOptTV1(w, IEI_GPRS_CIPHERING_KEY_SEQUENCE, m.GPRSCipheringKeySequence)
+	putOptTV(w, IEI_OLD_P_TMSI_SIGNATURE, m.OldPTMSISignature)
+	putOptGUTI(w, IEI_ADDITIONAL_GUTI, m.AdditionalGUTI)
+	putOptTV(w, IEI_NONCE_UE, m.NonceUE)
+	putOptTLV(w, IEI_UE_NETWORK_CAPABILITY, m.UENetworkCapability)
+	putOptTAI(w, IEI_LAST_VISITED_REGISTERED_TAI, m.LastVisitedRegisteredTAI)
+	putOptTV(w, IEI_DRX_PARAMETER, m.DRXParameter)
+	putOptTV1(w, IEI_UE_RADIO_CAPABILITY_UPDATE_NEEDED, m.UERadioCapabilityInfoUpdate)
+	putOptTLV(w, IEI_EPS_BEARER_CONTEXT_STATUS, m.EPSBearerContextStatus)
+	putOptTLV(w, IEI_MS_NETWORK_CAPABILITY, m.MSNetworkCapability)
+	putOptTV(w, IEI_OLD_LAI, m.OldLAI)
+	putOptTV1(w, IEI_TMSI_STATUS, m.TMSIStatus)
+	putOptTLV(w, IEI_MS_CLASSMARK_2, m.MSClassmark2)
+	putOptTLV(w, IEI_MS_CLASSMARK_3, m.MSClassmark3)
+	putOptTLV(w, IEI_SUPPORTED_CODECS, m.SupportedCodecs)
+	putOptTV1(w, IEI_ADDITIONAL_UPDATE_TYPE, m.AdditionalUpdateType)
+	putOptTLV(w, IEI_VOICE_DOMAIN_PREFERENCE, m.VoiceDomainPreference)
+	putOptTV1(w, IEI_OLD_GUTI_TYPE, m.OldGUTIType)
+	putOptTV1(w, IEI_DEVICE_PROPERTIES, m.DeviceProperties)
+	putOptTV1(w, IEI_MS_NETWORK_FEATURE_SUPPORT, m.MSNetworkFeatureSupport)
+	putOptTLV(w, IEI_TMSI_BASED_NRI_CONTAINER, m.TMSIBasedNRIContainer)
+	putOptTLV(w, IEI_T3324_VALUE, m.T3324Value)
+	putOptTLV(w, IEI_T3412_EXTENDED_VALUE, m.T3412ExtendedValue)
+	putOptTLV(w, IEI_EXTENDED_DRX_PARAMETERS, m.ExtendedDRXParameters)
+}
+
+func (m *TrackingAreaUpdateRequest) decode(r *reader) {
+	m.KSI, m.UpdateType = r.getHalf()
+	m.OldGUTI = r.getMobileIdentity()
+	ies := r.getOptionalIEs(trackingAreaUpdateRequestIEs)
+	m.NonCurrentNativeKSI = optUint8(ies, IEI_NON_CURRENT_NATIVE_NAS_KSI)
+	m.GPRSCipheringKeySequence = optUint8(ies, IEI_GPRS_CIPHERING_KEY_SEQUENCE)
+	m.OldPTMSISignature = ies[IEI_OLD_P_TMSI_SIGNATURE]
+	m.AdditionalGUTI = r.optGUTI(ies, IEI_ADDITIONAL_GUTI)
+	m.NonceUE = ies[IEI_NONCE_UE]
+	m.UENetworkCapability = ies[IEI_UE_NETWORK_CAPABILITY]
+	m.LastVisitedRegisteredTAI = r.optTAI(ies, IEI_LAST_VISITED_REGISTERED_TAI)
+	m.DRXParameter = ies[IEI_DRX_PARAMETER]
+	m.UERadioCapabilityInfoUpdate = optUint8(ies, IEI_UE_RADIO_CAPABILITY_UPDATE_NEEDED)
+	m.EPSBearerContextStatus = ies[IEI_EPS_BEARER_CONTEXT_STATUS]
+	m.MSNetworkCapability = ies[IEI_MS_NETWORK_CAPABILITY]
+	m.OldLAI = ies[IEI_OLD_LAI]
+	m.TMSIStatus = optUint8(ies, IEI_TMSI_STATUS)
+	m.MSClassmark2 = ies[IEI_MS_CLASSMARK_2]
+	m.MSClassmark3 = ies[IEI_MS_CLASSMARK_3]
+	m.SupportedCodecs = ies[IEI_SUPPORTED_CODECS]
+	m.AdditionalUpdateType = optUint8(ies, IEI_ADDITIONAL_UPDATE_TYPE)
+	m.VoiceDomainPreference = ies[IEI_VOICE_DOMAIN_PREFERENCE]
+	m.OldGUTIType = optUint8(ies, IEI_OLD_GUTI_TYPE)
+	m.DeviceProperties = optUint8(ies, IEI_DEVICE_PROPERTIES)
+	m.MSNetworkFeatureSupport = optUint8(ies, IEI_MS_NETWORK_FEATURE_SUPPORT)
+	m.TMSIBasedNRIContainer = ies[IEI_TMSI_BASED_NRI_CONTAINER]
+	m.T3324Value = ies[IEI_T3324_VALUE]
+	m.T3412ExtendedValue = ies[IEI_T3412_EXTENDED_VALUE]
+	m.ExtendedDRXParameters = ies[IEI_EXTENDED_DRX_PARAMETERS]
+}
+
+// TrackingAreaUpdateAccept is TS 24.301 8.2.26.
+type TrackingAreaUpdateAccept struct {
+	UpdateResult             uint8
+	T3412Value               *uint8
+	GUTI                     *GUTI
+	TAIList                  TAIList
+	EPSBearerContextStatus   []byte
+	LAI                      []byte
+	MSIdentity               *MobileIdentity
+	EMMCause                 *uint8
+	T3402Value               *uint8
+	T3423Value               *uint8
+	EquivalentPLMNs          []byte
+	EmergencyNumberList      []byte
+	EPSNetworkFeatureSupport []byte
+	AdditionalUpdateResult   *uint8
+	T3412ExtendedValue       []byte
+	T3324Value               []byte
+	ExtendedDRXParameters    []byte
+}
+
+var trackingAreaUpdateAcceptIEs = []ieSpec{
+	{IEI_T3412_VALUE, ieTV, 1},
+	{IEI_GUTI, ieTLV, 0},
+	{IEI_TAI_LIST, ieTLV, 0},
+	{IEI_EPS_BEARER_CONTEXT_STATUS, ieTLV, 0},
+	{IEI_LAI, ieTV, 5},
+	{IEI_MS_IDENTITY, ieTLV, 0},
+	{IEI_EMM_CAUSE, ieTV, 1},
+	{IEI_T3402_VALUE, ieTV, 1},
+	{IEI_T3423_VALUE, ieTV, 1},
+	{IEI_EQUIVALENT_PLMNS, ieTLV, 0},
+	{IEI_EMERGENCY_NUMBER_LIST, ieTLV, 0},
+	{IEI_EPS_NETWORK_FEATURE_SUPPORT, ieTLV, 0},
+	{IEI_ADDITIONAL_UPDATE_RESULT, ieTV1, 0},
+	{IEI_T3412_EXTENDED_VALUE, ieTLV, 0},
+	{IEI_T3324_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_DRX_PARAMETERS, ieTLV, 0},
+}
+
+func (m *TrackingAreaUpdateAccept) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *TrackingAreaUpdateAccept) MessageType() uint8 {
+	return MSG_TRACKING_AREA_UPDATE_ACCEPT
+}
+
+func (m *TrackingAreaUpdateAccept) encode(w *writer) {
+	w.putHalf(0, m.UpdateResult)
+	putOptTVUint8(w, IEI_T3412_VALUE, m.T3412Value)
+	putOptGUTI(w, IEI_GUTI, m.GUTI)
+	putOptTLV(w, IEI_TAI_LIST, m.TAIList.bytes())
+	putOptTLV(w, IEI_EPS_BEARER_CONTEXT_STATUS, m.EPSBearerContextStatus)
+	putOptTV(w, IEI_LAI, m.LAI)
+	if m.MSIdentity != nil {
+		w.putTLV(IEI_MS_IDENTITY, m.MSIdentity.bytes())
+	}
+	putOptTVUint8(w, IEI_EMM_CAUSE, m.EMMCause)
+	putOptTVUint8(w, IEI_T3402_VALUE, m.T3402Value)
+	putOptTVUint8(w, IEI_T3423_VALUE, m.T3423Value)
+	putOptTLV(w, IEI_EQUIVALENT_PLMNS, m.EquivalentPLMNs)
+	putOptTLV(w, IEI_EMERGENCY_NUMBER_LIST, m.EmergencyNumberList)
+	putOptTLV(w, IEI_EPS_NETWORK_FEATURE_SUPPORT, m.EPSNetworkFeatureSupport)
+	putOptTV1(w, IEI_ADDITIONAL_UPDATE_RESULT, m.AdditionalUpdateResult)
+	putOptTLV(w, IEI_T3412_EXTENDED_VALUE, m.T3412ExtendedValue)
+	putOptTLV(w, IEI_T3324_VALUE, m.T3324Value)
+	putOptTLV(w, IEI_EXTENDED_DRX_PARAMETERS, m.ExtendedDRXParameters)
+}
+
+func (m *TrackingAreaUpdateAccept) decode(r *reader) {
+	_, m.UpdateResult = r.getHalf()
+	ies := r.getOptionalIEs(trackingAreaUpdateAcceptIEs)
+	m.T3412Value = optUint8(ies, IEI_T3412_VALUE)
+	m.GUTI = r.optGUTI(ies, IEI_GUTI)
+	m.TAIList = r.optTAIList(ies, IEI_TAI_LIST)
+	m.EPSBearerContextStatus = ies[IEI_EPS_BEARER_CONTEXT_STATUS]
+	m.LAI = ies[IEI_LAI]
+	m.MSIdentity = r.optMobileIdentity(ies, IEI_MS_IDENTITY)
+	m.EMMCause = optUint8(ies, IEI_EMM_CAUSE)
+	m.T3402Value = optUint8(ies, IEI_T3402_VALUE)
+	m.T3423Value = optUint8(ies, IEI_T3423_VALUE)
+	m.EquivalentPLMNs = ies[IEI_EQUIVALENT_PLMNS]
+	m.EmergencyNumberList = ies[IEI_EMERGENCY_NUMBER_LIST]
+	m.EPSNetworkFeatureSupport = ies[IEI_EPS_NETWORK_FEATURE_SUPPORT]
+	m.AdditionalUpdateResult = optUint8(ies, IEI_ADDITIONAL_UPDATE_RESULT)
+	m.T3412ExtendedValue = ies[IEI_T3412_EXTENDED_VALUE]
+	m.T3324Value = ies[IEI_T3324_VALUE]
+	m.ExtendedDRXParameters = ies[IEI_EXTENDED_DRX_PARAMETERS]
+}
+
+// TrackingAreaUpdateComplete is TS 24.301 8.2.27.
+type TrackingAreaUpdateComplete struct{}
+
+func (m *TrackingAreaUpdateComplete) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *TrackingAreaUpdateComplete) MessageType() uint8 {
+	return MSG_TRACKING_AREA_UPDATE_COMPLETE
+}
+func (m *TrackingAreaUpdateComplete) encode(w *writer) {}
+func (m *TrackingAreaUpdateComplete) decode(r *reader) {}
+
+// TrackingAreaUpdateReject is TS 24.301 8.2.28.
+type TrackingAreaUpdateReject struct {
+	EMMCause         uint8
+	T3346Value       []byte
+	ExtendedEMMCause *uint8
+}
+
+var trackingAreaUpdateRejectIEs = []ieSpec{
+	{IEI_T3346_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_EMM_CAUSE, ieTV1, 0},
+}
+
+func (m *TrackingAreaUpdateReject) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *TrackingAreaUpdateReject) MessageType() uint8 {
+	return MSG_TRACKING_AREA_UPDATE_REJECT
+}
+
+func (m *TrackingAreaUpdateReject) encode(w *writer) {
+	w.putUint8(m.EMMCause)
+	putOptTLV(w, IEI_T3346_VALUE, m.T3346Value)
+	putOptTV1(w, IEI_EXTENDED_EMM_CAUSE, m.ExtendedEMMCause)
+}
+
+func (m *TrackingAreaUpdateReject) decode(r *reader) {
+	m.EMMCause = r.getUint8()
+	ies := r.getOptionalIEs(trackingAreaUpdateRejectIEs)
+	m.T3346Value = ies[IEI_T3346_VALUE]
+	m.ExtendedEMMCause = optUint8(ies, IEI_EXTENDED_EMM_CAUSE)
+}
+
+// ServiceRequest is TS 24.301 8.2.25. It has security header type 12 and
+// no message type. KSI is 3 bits and SequenceNumber is 5 least significant
+// bits of the NAS COUNT.
+type ServiceRequest struct {
+	KSI            uint8
+	SequenceNumber uint8
+	ShortMAC       uint16
+}
+
+func (m *ServiceRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *ServiceRequest) MessageType() uint8           { return MSG_SERVICE_REQUEST }
+
+func (m *ServiceRequest) encode(w *writer) {
+	w.putUint8((m.KSI&0x7)<<5 | m.SequenceNumber&0x1f)
+	w.putUint16(m.ShortMAC)
+}
+
+func (m *ServiceRequest) decode(r *reader) {
+	v := r.getUint8()
+	m.KSI = v >> 5
+	m.SequenceNumber = v & 0x1f
+	m.ShortMAC = r.getUint16()
+}
+
+// ExtendedServiceRequest is TS 24.301 8.2.15.
+type ExtendedServiceRequest struct {
+	KSI                    uint8
+	ServiceType            uint8
+	MTMSI                  MobileIdentity
+	CSFBResponse           *uint8
+	EPSBearerContextStatus []byte
+	DeviceProperties       *uint8
+}
+
+var extendedServiceRequestIEs = []ieSpec{
+	{IEI_CSFB_RESPONSE, ieTV1, 0},
+	{IEI_EPS_BEARER_CONTEXT_STATUS, ieTLV, 0},
+	{IEI_DEVICE_PROPERTIES, ieTV1, 0},
+}
+
+func (m *ExtendedServiceRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *ExtendedServiceRequest) MessageType() uint8           { return MSG_EXTENDED_SERVICE_REQUEST }
+
+func (m *ExtendedServiceRequest) encode(w *writer) {
+	w.putHalf(m.KSI, m.ServiceType)
+	w.putLV(m.MTMSI.bytes())
+	putOptTV1(w, IEI_CSFB_RESPONSE, m.CSFBResponse)
+	putOptTLV(w, IEI_EPS_BEARER_CONTEXT_STATUS, m.EPSBearerContextStatus)
+	putOptTV1(w, IEI_DEVICE_PROPERTIES, m.DeviceProperties)
+}
+
+func (m *ExtendedServiceRequest) decode(r *reader) {
+	m.KSI, m.ServiceType = r.getHalf()
+	m.MTMSI = r.getMobileIdentity()
+	ies := r.getOptionalIEs(extendedServiceRequestIEs)
+	m.CSFBResponse = optUint8(ies, IEI_CSFB_RESPONSE)
+	m.EPSBearerContextStatus = ies[IEI_EPS_BEARER_CONTEXT_STATUS]
+	m.DeviceProperties = optUint8(ies, IEI_DEVICE_PROPERTIES)
+}
+
+// ServiceReject is TS 24.301 8.2.24.
+type ServiceReject struct {
+	EMMCause   uint8
+	T3442Value *uint8
+	T3346Value []byte
+}
+
+var serviceRejectIEs = []ieSpec{
+	{IEI_T3442_VALUE, ieTV, 1},
+	{IEI_T3346_VALUE, ieTLV, 0},
+}
+
+func (m *ServiceReject) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *ServiceReject) MessageType() uint8           { return MSG_SERVICE_REJECT }
+
+func (m *ServiceReject) encode(w *writer) {
+	w.putUint8(m.EMMCause)
+	putOptTVUint8(w, IEI_T3442_VALUE, m.T3442Value)
+	putOptTLV(w, IEI_T3346_VALUE, m.T3346Value)
+}
+
+func (m *ServiceReject) decode(r *reader) {
+	m.EMMCause = r.getUint8()
+	ies := r.getOptionalIEs(serviceRejectIEs)
+	m.T3442Value = optUint8(ies, IEI_T3442_VALUE)
+	m.T3346Value = ies[IEI_T3346_VALUE]
+}
+
+// GUTIReallocationCommand is TS 24.301 8.2.16.
+type GUTIReallocationCommand struct {
+	GUTI    GUTI
+	TAIList TAIList
+}
+
+var gutiReallocationCommandIEs = []ieSpec{
+	{IEI_TAI_LIST, ieTLV, 0},
+}
+
+func (m *GUTIReallocationCommand) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *GUTIReallocationCommand) MessageType() uint8           { return MSG_GUTI_REALLOCATION_COMMAND }
+
+func (m *GUTIReallocationCommand) encode(w *writer) {
+	w.putLV(MobileIdentity{Type: IDENTITY_TYPE_GUTI, GUTI: m.GUTI}.bytes())
+	putOptTLV(w, IEI_TAI_LIST, m.TAIList.bytes())
+}
+
+func (m *GUTIReallocationCommand) decode(r *reader) {
+	id := r.getMobileIdentity()
+	if r.err == nil && id.Type != IDENTITY_TYPE_GUTI {
+		r.setError("identity type %d is not GUTI", id.Type)
+	}
+	m.GUTI = id.GUTI
+	ies := r.getOptionalIEs(gutiReallocationCommandIEs)
+	m.TAIList = r.optTAIList(ies, IEI_TAI_LIST)
+}
+
+// GUTIReallocationComplete is TS 24.301 8.2.17.
+type GUTIReallocationComplete struct{}
+
+func (m *GUTIReallocationComplete) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *GUTIReallocationComplete) MessageType() uint8 {
+	return MSG_GUTI_REALLOCATION_COMPLETE
+}
+func (m *GUTIReallocationComplete) encode(w *writer) {}
+func (m *GUTIReallocationComplete) decode(r *reader) {}
+
+// AuthenticationRequest is TS 24.301 8.2.7.
+type AuthenticationRequest struct {
+	KSI  uint8
+	RAND []byte
+	AUTN []byte
+}
+
+func (m *AuthenticationRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AuthenticationRequest) MessageType() uint8           { return MSG_AUTHENTICATION_REQUEST }
+
+func (m *AuthenticationRequest) encode(w *writer) {
+	w.putHalf(0, m.KSI)
+	if len(m.RAND) != 16 {
+		w.setError("RAND length %d", len(m.RAND))
+		return
+	}
+	w.putV(m.RAND)
+	w.putLV(m.AUTN)
+}
+
+func (m *AuthenticationRequest) decode(r *reader) {
+	_, m.KSI = r.getHalf()
+	m.RAND = r.getV(16)
+	m.AUTN = r.getLV()
+}
+
+// AuthenticationResponse is TS 24.301 8.2.8.
+type AuthenticationResponse struct {
+	RES []byte
+}
+
+func (m *AuthenticationResponse) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AuthenticationResponse) MessageType() uint8           { return MSG_AUTHENTICATION_RESPONSE }
+
+func (m *AuthenticationResponse) encode(w *writer) {
+	w.putLV(m.RES)
+}
+
+func (m *AuthenticationResponse) decode(r *reader) {
+	m.RES = r.getLV()
+}
+
+// AuthenticationReject is TS 24.301 8.2.6.
+type AuthenticationReject struct{}
+
+func (m *AuthenticationReject) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AuthenticationReject) MessageType() uint8           { return MSG_AUTHENTICATION_REJECT }
+func (m *AuthenticationReject) encode(w *writer)             {}
+func (m *AuthenticationReject) decode(r *reader)             {}
+
+// AuthenticationFailure is TS 24.301 8.2.5. AuthenticationFailureParameter
+// is AUTS on synch failure.
+type AuthenticationFailure struct {
+	EMMCause                       uint8
+	AuthenticationFailureParameter []byte
+}
+
+var authenticationFailureIEs = []ieSpec{
+	{IEI_AUTHENTICATION_FAILURE_PARAMETER, ieTLV, 0},
+}
+
+func (m *AuthenticationFailure) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *AuthenticationFailure) MessageType() uint8           { return MSG_AUTHENTICATION_FAILURE }
+
+func (m *AuthenticationFailure) encode(w *writer) {
+	w.putUint8(m.EMMCause)
+	putOptTLV(w, IEI_AUTHENTICATION_FAILURE_PARAMETER, m.AuthenticationFailureParameter)
+}
+
+func (m *AuthenticationFailure) decode(r *reader) {
+	m.EMMCause = r.getUint8()
+	ies := r.getOptionalIEs(authenticationFailureIEs)
+	m.AuthenticationFailureParameter = ies[IEI_AUTHENTICATION_FAILURE_PARAMETER]
+}
+
+// IdentityRequest is TS 24.301 8.2.18.
+type IdentityRequest struct {
+	IdentityType uint8
+}
+
+func (m *IdentityRequest) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *IdentityRequest) MessageType() uint8           { return MSG_IDENTITY_REQUEST }
+
+func (m *IdentityRequest) encode(w *writer) {
+	w.putHalf(0, m.IdentityType)
+}
+
+func (m *IdentityRequest) decode(r *reader) {
+	_, m.IdentityType = r.getHalf()
+}
+
+// IdentityResponse is TS 24.301 8.2.19.
+type IdentityResponse struct {
+	MobileIdentity MobileIdentity
+}
+
+func (m *IdentityResponse) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *IdentityResponse) MessageType() uint8           { return MSG_IDENTITY_RESPONSE }
+
+func (m *IdentityResponse) encode(w *writer) {
+	w.putLV(m.MobileIdentity.bytes())
+}
+
+func (m *IdentityResponse) decode(r *reader) {
+	m.MobileIdentity = r.getMobileIdentity()
+}
+
+// SecurityModeCommand is TS 24.301 8.2.20.
+type SecurityModeCommand struct {
+	SelectedAlgorithms             SecurityAlgorithms
+	KSI                            uint8
+	ReplayedUESecurityCapabilities []byte
+	IMEISVRequest                  *uint8
+	ReplayedNonceUE                []byte
+	NonceMME                       []byte
+}
+
+var securityModeCommandIEs = []ieSpec{
+	{IEI_IMEISV_REQUEST, ieTV1, 0},
+	{IEI_REPLAYED_NONCE_UE, ieTV, 4},
+	{IEI_NONCE_MME, ieTV, 4},
+}
+
+func (m *SecurityModeCommand) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *SecurityModeCommand) MessageType() uint8           { return MSG_SECURITY_MODE_COMMAND }
+
+func (m *SecurityModeCommand) encode(w *writer) {
+	w.putUint8(m.SelectedAlgorithms.byte())
+	w.putHalf(0, m.KSI)
+	w.putLV(m.ReplayedUESecurityCapabilities)
+	putOptTV1(w, IEI_IMEISV_REQUEST, m.IMEISVRequest)
+	putOptTV(w, IEI_REPLAYED_NONCE_UE, m.ReplayedNonceUE)
+	putOptTV(w, IEI_NONCE_MME, m.NonceMME)
+}
+
+func (m *SecurityModeCommand) decode(r *reader) {
+	m.SelectedAlgorithms = decodeSecurityAlgorithms(r.getUint8())
+	_, m.KSI = r.getHalf()
+	m.ReplayedUESecurityCapabilities = r.getLV()
+	ies := r.getOptionalIEs(securityModeCommandIEs)
+	m.IMEISVRequest = optUint8(ies, IEI_IMEISV_REQUEST)
+	m.ReplayedNonceUE = ies[IEI_REPLAYED_NONCE_UE]
+	m.NonceMME = ies[IEI_NONCE_MME]
+}
+
+// SecurityModeComplete is TS 24.301 8.2.21.
+type SecurityModeComplete struct {
+	IMEISV                      *MobileIdentity
+	ReplayedNASMessageContainer []byte
+}
+
+var securityModeCompleteIEs = []ieSpec{
+	{IEI_IMEISV, ieTLV, 0},
+	{IEI_REPLAYED_NAS_MESSAGE_CONTAINER, ieTLV, 0},
+}
+
+func (m *SecurityModeComplete) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *SecurityModeComplete) MessageType() uint8           { return MSG_SECURITY_MODE_COMPLETE }
+
+func (m *SecurityModeComplete) encode(w *writer) {
+	if m.IMEISV != nil {
+		w.putTLV(IEI_IMEISV, m.IMEISV.bytes())
+	}
+	putOptTLV(w, IEI_REPLAYED_NAS_MESSAGE_CONTAINER, m.ReplayedNASMessageContainer)
+}
+
+func (m *SecurityModeComplete) decode(r *reader) {
+	ies := r.getOptionalIEs(securityModeCompleteIEs)
+	m.IMEISV = r.optMobileIdentity(ies, IEI_IMEISV)
+	m.ReplayedNASMessageContainer = ies[IEI_REPLAYED_NAS_MESSAGE_CONTAINER]
+}
+
+// SecurityModeReject is TS 24.301 8.2.22.
+type SecurityModeReject struct {
+	EMMCause uint8
+}
+
+func (m *SecurityModeReject) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *SecurityModeReject) MessageType() uint8           { return MSG_SECURITY_MODE_REJECT }
+
+func (m *SecurityModeReject) encode(w *writer) {
+	w.putUint8(m.EMMCause)
+}
+
+func (m *SecurityModeReject) decode(r *reader) {
+	m.EMMCause = r.getUint8()
+}
+
+// EMMStatus is TS 24.301 8.2.14.
+type EMMStatus struct {
+	EMMCause uint8
+}
+
+func (m *EMMStatus) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *EMMStatus) MessageType() uint8           { return MSG_EMM_STATUS }
+
+func (m *EMMStatus) encode(w *writer) {
+	w.putUint8(m.EMMCause)
+}
+
+func (m *EMMStatus) decode(r *reader) {
+	m.EMMCause = r.getUint8()
+}
+
+// EMMInformation is TS 24.301 8.2.13.
+type EMMInformation struct {
+	FullNameForNetwork            []byte
+	ShortNameForNetwork           []byte
+	LocalTimeZone                 *uint8
+	UniversalTimeAndLocalTimeZone []byte
+	NetworkDaylightSavingTime     []byte
+}
+
+var emmInformationIEs = []ieSpec{
+	{IEI_FULL_NAME_FOR_NETWORK, ieTLV, 0},
+	{IEI_SHORT_NAME_FOR_NETWORK, ieTLV, 0},
+	{IEI_LOCAL_TIME_ZONE, ieTV, 1},
+	{IEI_UNIVERSAL_TIME_AND_LOCAL_TIME_ZONE, ieTV, 7},
+	{IEI_NETWORK_DAYLIGHT_SAVING_TIME, ieTLV, 0},
+}
+
+func (m *EMMInformation) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *EMMInformation) MessageType() uint8           { return MSG_EMM_INFORMATION }
+
+func (m *EMMInformation) encode(w *writer) {
+	putOptTLV(w, IEI_FULL_NAME_FOR_NETWORK, m.FullNameForNetwork)
+	putOptTLV(w, IEI_SHORT_NAME_FOR_NETWORK, m.ShortNameForNetwork)
+	putOptTVUint8(w, IEI_LOCAL_TIME_ZONE, m.LocalTimeZone)
+	putOptTV(w, IEI_UNIVERSAL_TIME_AND_LOCAL_TIME_ZONE, m.UniversalTimeAndLocalTimeZone)
+	putOptTLV(w, IEI_NETWORK_DAYLIGHT_SAVING_TIME, m.NetworkDaylightSavingTime)
+}
+
+func (m *EMMInformation) decode(r *reader) {
+	ies := r.getOptionalIEs(emmInformationIEs)
+	m.FullNameForNetwork = ies[IEI_FULL_NAME_FOR_NETWORK]
+	m.ShortNameForNetwork = ies[IEI_SHORT_NAME_FOR_NETWORK]
+	m.LocalTimeZone = optUint8(ies, IEI_LOCAL_TIME_ZONE)
+	m.UniversalTimeAndLocalTimeZone = ies[IEI_UNIVERSAL_TIME_AND_LOCAL_TIME_ZONE]
+	m.NetworkDaylightSavingTime = ies[IEI_NETWORK_DAYLIGHT_SAVING_TIME]
+}
+
+// DownlinkNASTransport is TS 24.301 8.2.12.
+type DownlinkNASTransport struct {
+	NASMessageContainer []byte
+}
+
+func (m *DownlinkNASTransport) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *DownlinkNASTransport) MessageType() uint8           { return MSG_DOWNLINK_NAS_TRANSPORT }
+
+func (m *DownlinkNASTransport) encode(w *writer) {
+	w.putLV(m.NASMessageContainer)
+}
+
+func (m *DownlinkNASTransport) decode(r *reader) {
+	m.NASMessageContainer = r.getLV()
+}
+
+// UplinkNASTransport is TS 24.301 8.2.30.
+type UplinkNASTransport struct {
+	NASMessageContainer []byte
+}
+
+func (m *UplinkNASTransport) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *UplinkNASTransport) MessageType() uint8           { return MSG_UPLINK_NAS_TRANSPORT }
+
+func (m *UplinkNASTransport) encode(w *writer) {
+	w.putLV(m.NASMessageContainer)
+}
+
+func (m *UplinkNASTransport) decode(r *reader) {
+	m.NASMessageContainer = r.getLV()
+}
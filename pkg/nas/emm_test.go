@@ -0,0 +1,321 @@
+package nas
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func u8(v uint8) *uint8 {
+	return &v
+}
+
+var (
+	testPLMN = PLMNIdentity{0x00, 0xf1, 0x10}
+	testGUTI = GUTI{PLMNIdentity: testPLMN, MMEGI: 0x8001, MMEC: 0x01, MTMSI: 0x23456789}
+	testTAI  = TAI{PLMNIdentity: testPLMN, TAC: 7}
+	testIMSI = MobileIdentity{Type: IDENTITY_TYPE_IMSI, Digits: "001010000000001"}
+)
+
+// emmMessages have all IEs of the messages present so that decode of the
+// encoded message must return the same message.
+var emmMessages = []Message{
+	&AttachRequest{
+		KSI:                      7,
+		AttachType:               EPS_ATTACH_TYPE_COMBINED,
+		EPSMobileIdentity:        testIMSI,
+		UENetworkCapability:      []byte{0xe0, 0xe0},
+		ESMMessageContainer:      []byte{0x02, 0x01, 0xd0, 0x11},
+		OldPTMSISignature:        []byte{1, 2, 3},
+		AdditionalGUTI:           &testGUTI,
+		LastVisitedRegisteredTAI: &testTAI,
+		DRXParameter:             []byte{0x0a, 0x00},
+		MSNetworkCapability:      []byte{0xe5, 0xe0},
+		OldLAI:                   []byte{0x00, 0xf1, 0x10, 0xff, 0xfe},
+		TMSIStatus:               u8(1),
+		MSClassmark2:             []byte{0x57, 0x58, 0xa6},
+		MSClassmark3:             []byte{0x20, 0x63},
+		SupportedCodecs:          []byte{0x04, 0x02, 0x60, 0x04},
+		AdditionalUpdateType:     u8(1),
+		VoiceDomainPreference:    []byte{0x01},
+		DeviceProperties:         u8(1),
+		OldGUTIType:              u8(1),
+		MSNetworkFeatureSupport:  u8(1),
+		TMSIBasedNRIContainer:    []byte{0x12, 0x30},
+		T3324Value:               []byte{0x21},
+		T3412ExtendedValue:       []byte{0x2a},
+		ExtendedDRXParameters:    []byte{0x15},
+	},
+	&AttachAccept{
+		AttachResult:             EPS_ATTACH_RESULT_COMBINED,
+		T3412Value:               0x21,
+		TAIList:                  TAIList{testTAI, {PLMNIdentity: PLMNIdentity{0x02, 0xf8, 0x39}, TAC: 0x1234}},
+		ESMMessageContainer:      []byte{0x52, 0x01, 0xc1},
+		GUTI:                     &testGUTI,
+		LAI:                      []byte{0x00, 0xf1, 0x10, 0x00, 0x01},
+		MSIdentity:               &MobileIdentity{Type: IDENTITY_TYPE_TMSI, TMSI: 0xc0de0001},
+		EMMCause:                 u8(EMM_CAUSE_CS_DOMAIN_NOT_AVAILABLE),
+		T3402Value:               u8(0x2c),
+		T3423Value:               u8(0x23),
+		EquivalentPLMNs:          []byte{0x02, 0xf8, 0x39},
+		EmergencyNumberList:      []byte{0x03, 0x01, 0x11, 0xf2},
+		EPSNetworkFeatureSupport: []byte{0x01},
+		AdditionalUpdateResult:   u8(2),
+		T3412ExtendedValue:       []byte{0x2a},
+		T3324Value:               []byte{0x21},
+		ExtendedDRXParameters:    []byte{0x15},
+	},
+	&AttachComplete{ESMMessageContainer: []byte{0x52, 0x01, 0xc2}},
+	&AttachReject{
+		EMMCause:            EMM_CAUSE_EPS_SERVICES_NOT_ALLOWED,
+		ESMMessageContainer: []byte{0x02, 0x01, 0xd1, 0x1b},
+		T3346Value:          []byte{0x21},
+		T3402Value:          []byte{0x2c},
+		ExtendedEMMCause:    u8(1),
+	},
+	&DetachRequest{
+		KSI:               1,
+		DetachType:        DETACH_TYPE_SWITCH_OFF | DETACH_TYPE_EPS,
+		EPSMobileIdentity: MobileIdentity{Type: IDENTITY_TYPE_GUTI, GUTI: testGUTI},
+	},
+	&NetworkDetachRequest{DetachType: DETACH_TYPE_RE_ATTACH},
+	&NetworkDetachRequest{DetachType: DETACH_TYPE_NO_RE_ATTACH, EMMCause: u8(EMM_CAUSE_IMSI_UNKNOWN_IN_HSS)},
+	&DetachAccept{},
+	&TrackingAreaUpdateRequest{
+		KSI:                         2,
+		UpdateType:                  EPS_UPDATE_TYPE_PERIODIC_UPDATING,
+		OldGUTI:                     MobileIdentity{Type: IDENTITY_TYPE_GUTI, GUTI: testGUTI},
+		NonCurrentNativeKSI:         u8(3),
+		GPRSCipheringKeySequence:    u8(7),
+		OldPTMSISignature:           []byte{1, 2, 3},
+		AdditionalGUTI:              &testGUTI,
+		NonceUE:                     []byte{0xde, 0xad, 0xbe, 0xef},
+		UENetworkCapability:         []byte{0xe0, 0xe0},
+		LastVisitedRegisteredTAI:    &testTAI,
+		DRXParameter:                []byte{0x0a, 0x00},
+		UERadioCapabilityInfoUpdate: u8(1),
+		EPSBearerContextStatus:      []byte{0x20, 0x00},
+		MSNetworkCapability:         []byte{0xe5, 0xe0},
+		OldLAI:                      []byte{0x00, 0xf1, 0x10, 0xff, 0xfe},
+		TMSIStatus:                  u8(0),
+		MSClassmark2:                []byte{0x57, 0x58, 0xa6},
+		MSClassmark3:                []byte{0x20, 0x63},
+		SupportedCodecs:             []byte{0x04, 0x02, 0x60, 0x04},
+		AdditionalUpdateType:        u8(1),
+		VoiceDomainPreference:       []byte{0x01},
+		OldGUTIType:                 u8(0),
+		DeviceProperties:            u8(1),
+		MSNetworkFeatureSupport:     u8(1),
+		TMSIBasedNRIContainer:       []byte{0x12, 0x30},
+		T3324Value:                  []byte{0x21},
+		T3412ExtendedValue:          []byte{0x2a},
+		ExtendedDRXParameters:       []byte{0x15},
+	},
+	&TrackingAreaUpdateAccept{
+		UpdateResult:             EPS_UPDATE_RESULT_TA_UPDATED,
+		T3412Value:               u8(0x21),
+		GUTI:                     &testGUTI,
+		TAIList:                  TAIList{testTAI},
+		EPSBearerContextStatus:   []byte{0x20, 0x00},
+		LAI:                      []byte{0x00, 0xf1, 0x10, 0x00, 0x01},
+		MSIdentity:               &MobileIdentity{Type: IDENTITY_TYPE_TMSI, TMSI: 0xc0de0001},
+		EMMCause:                 u8(EMM_CAUSE_CS_DOMAIN_NOT_AVAILABLE),
+		T3402Value:               u8(0x2c),
+		T3423Value:               u8(0x23),
+		EquivalentPLMNs:          []byte{0x02, 0xf8, 0x39},
+		EmergencyNumberList:      []byte{0x03, 0x01, 0x11, 0xf2},
+		EPSNetworkFeatureSupport: []byte{0x01},
+		AdditionalUpdateResult:   u8(2),
+		T3412ExtendedValue:       []byte{0x2a},
+		T3324Value:               []byte{0x21},
+		ExtendedDRXParameters:    []byte{0x15},
+	},
+	&TrackingAreaUpdateComplete{},
+	&TrackingAreaUpdateReject{
+		EMMCause:         EMM_CAUSE_TRACKING_AREA_NOT_ALLOWED,
+		T3346Value:       []byte{0x21},
+		ExtendedEMMCause: u8(1),
+	},
+	&ServiceRequest{KSI: 1, SequenceNumber: 5, ShortMAC: 0x1234},
+	&ExtendedServiceRequest{
+		KSI:                    1,
+		ServiceType:            SERVICE_TYPE_MT_CSFB,
+		MTMSI:                  MobileIdentity{Type: IDENTITY_TYPE_TMSI, TMSI: 0xc0de0001},
+		CSFBResponse:           u8(1),
+		EPSBearerContextStatus: []byte{0x20, 0x00},
+		DeviceProperties:       u8(1),
+	},
+	&ServiceReject{
+		EMMCause:   EMM_CAUSE_CONGESTION,
+		T3442Value: u8(0x21),
+		T3346Value: []byte{0x21},
+	},
+	&GUTIReallocationCommand{GUTI: testGUTI, TAIList: TAIList{testTAI}},
+	&GUTIReallocationComplete{},
+	&AuthenticationRequest{
+		KSI:  1,
+		RAND: bytes.Repeat([]byte{0x5a}, 16),
+		AUTN: bytes.Repeat([]byte{0xa5}, 16),
+	},
+	&AuthenticationResponse{RES: []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+	&AuthenticationReject{},
+	&AuthenticationFailure{
+		EMMCause:                       EMM_CAUSE_SYNCH_FAILURE,
+		AuthenticationFailureParameter: bytes.Repeat([]byte{0x33}, 14),
+	},
+	&IdentityRequest{IdentityType: IDENTITY_TYPE_IMSI},
+	&IdentityResponse{MobileIdentity: testIMSI},
+	&SecurityModeCommand{
+		SelectedAlgorithms:             SecurityAlgorithms{Ciphering: EEA2, Integrity: EIA2},
+		KSI:                            1,
+		ReplayedUESecurityCapabilities: []byte{0xe0, 0xe0},
+		IMEISVRequest:                  u8(1),
+		ReplayedNonceUE:                []byte{0xde, 0xad, 0xbe, 0xef},
+		NonceMME:                       []byte{0xca, 0xfe, 0xba, 0xbe},
+	},
+	&SecurityModeComplete{
+		IMEISV:                      &MobileIdentity{Type: IDENTITY_TYPE_IMEISV, Digits: "3534900698733201"},
+		ReplayedNASMessageContainer: []byte{0x07, 0x41},
+	},
+	&SecurityModeReject{EMMCause: EMM_CAUSE_UE_SECURITY_CAPABILITIES_MISMATCH},
+	&EMMStatus{EMMCause: EMM_CAUSE_MESSAGE_TYPE_NON_EXISTENT},
+	&EMMInformation{
+		FullNameForNetwork:            []byte{0x80, 0x4f, 0x70},
+		ShortNameForNetwork:           []byte{0x80, 0x4f, 0x70},
+		LocalTimeZone:                 u8(0x23),
+		UniversalTimeAndLocalTimeZone: []byte{0x02, 0x01, 0x81, 0x21, 0x43, 0x65, 0x23},
+		NetworkDaylightSavingTime:     []byte{0x00},
+	},
+	&DownlinkNASTransport{NASMessageContainer: []byte{0x09, 0x01, 0x02}},
+	&UplinkNASTransport{NASMessageContainer: []byte{0x39, 0x01, 0x02}},
+}
+
+func TestEMMRoundTrip(t *testing.T) {
+	for _, m := range emmMessages {
+		b, err := Encode(m)
+		if err != nil {
+			t.Errorf("%s: encode: %v", MessageName(m), err)
+			continue
+		}
+		d, err := Decode(b)
+		if err != nil {
+			t.Errorf("%s: decode % x: %v", MessageName(m), b, err)
+			continue
+		}
+		if !reflect.DeepEqual(d, m) {
+			t.Errorf("%s: decoded %+v, want %+v", MessageName(m), d, m)
+		}
+	}
+}
+
+// emmPDUs are EMM messages assembled by hand from TS 24.301 and TS 24.008.
+var emmPDUs = []struct {
+	name string
+	pdu  []byte
+	m    Message
+}{
+	{
+		name: "Attach Request with IMSI",
+		pdu: []byte{
+			0x07, 0x41, 0x71,
+			0x08, 0x09, 0x10, 0x10, 0x00, 0x00, 0x00, 0x00, 0x10,
+			0x02, 0xe0, 0xe0,
+			0x00, 0x04, 0x02, 0x01, 0xd0, 0x11,
+			0x5c, 0x0a, 0x00,
+			0x90,
+		},
+		m: &AttachRequest{
+			KSI:                 NAS_KSI_NO_KEY,
+			AttachType:          EPS_ATTACH_TYPE_EPS,
+			EPSMobileIdentity:   testIMSI,
+			UENetworkCapability: []byte{0xe0, 0xe0},
+			ESMMessageContainer: []byte{0x02, 0x01, 0xd0, 0x11},
+			DRXParameter:        []byte{0x0a, 0x00},
+			TMSIStatus:          u8(0),
+		},
+	},
+	{
+		name: "Detach Request with GUTI",
+		pdu: []byte{
+			0x07, 0x45, 0x09,
+			0x0b, 0xf6, 0x00, 0xf1, 0x10, 0x80, 0x01, 0x01, 0x23, 0x45, 0x67, 0x89,
+		},
+		m: &DetachRequest{
+			DetachType:        DETACH_TYPE_SWITCH_OFF | DETACH_TYPE_EPS,
+			EPSMobileIdentity: MobileIdentity{Type: IDENTITY_TYPE_GUTI, GUTI: testGUTI},
+		},
+	},
+	{
+		name: "Network Detach Request",
+		pdu:  []byte{0x07, 0x45, 0x01},
+		m:    &NetworkDetachRequest{DetachType: DETACH_TYPE_RE_ATTACH},
+	},
+	{
+		name: "Service Request",
+		pdu:  []byte{0xc7, 0x25, 0x12, 0x34},
+		m:    &ServiceRequest{KSI: 1, SequenceNumber: 5, ShortMAC: 0x1234},
+	},
+	{
+		name: "Identity Response with IMSI",
+		pdu:  []byte{0x07, 0x56, 0x08, 0x09, 0x10, 0x10, 0x00, 0x00, 0x00, 0x00, 0x10},
+		m:    &IdentityResponse{MobileIdentity: testIMSI},
+	},
+	{
+		name: "Security Mode Command with IMEISV request",
+		pdu:  []byte{0x07, 0x5d, 0x22, 0x01, 0x02, 0xe0, 0xe0, 0xc1},
+		m: &SecurityModeCommand{
+			SelectedAlgorithms:             SecurityAlgorithms{Ciphering: EEA2, Integrity: EIA2},
+			KSI:                            1,
+			ReplayedUESecurityCapabilities: []byte{0xe0, 0xe0},
+			IMEISVRequest:                  u8(1),
+		},
+	},
+	{
+		name: "Security protected Attach Complete",
+		pdu:  []byte{0x27, 0x01, 0x02, 0x03, 0x04, 0x05, 0x07, 0x43, 0x00, 0x03, 0x52, 0x00, 0xc2},
+		m: &SecurityProtectedMessage{
+			SecurityHeaderType: SECURITY_HEADER_INTEGRITY_CIPHERED,
+			MAC:                0x01020304,
+			SequenceNumber:     5,
+			Payload:            []byte{0x07, 0x43, 0x00, 0x03, 0x52, 0x00, 0xc2},
+		},
+	},
+}
+
+func TestEMMDecode(t *testing.T) {
+	for _, tt := range emmPDUs {
+		m, err := Decode(tt.pdu)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(m, tt.m) {
+			t.Errorf("%s: decoded %+v, want %+v", tt.name, m, tt.m)
+			continue
+		}
+		b, err := Encode(m)
+		if err != nil {
+			t.Errorf("%s: encode: %v", tt.name, err)
+			continue
+		}
+		if !bytes.Equal(b, tt.pdu) {
+			t.Errorf("%s: encoded % x, want % x", tt.name, b, tt.pdu)
+		}
+	}
+}
+
+func TestEMMDecodeError(t *testing.T) {
+	for _, b := range [][]byte{
+		{},
+		{0x07},
+		{0x07, 0xff},
+		{0x07, 0x41, 0x71, 0x08, 0x09},
+		{0x07, 0x52, 0x01, 0x5a},
+		{0x07, 0x5d, 0x22, 0x01, 0x02, 0xe0, 0xe0, 0x56, 0x01},
+		{0x57, 0x41},
+	} {
+		if m, err := Decode(b); err == nil {
+			t.Errorf("% x is decoded as %+v", b, m)
+		}
+	}
+}
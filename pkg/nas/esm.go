@@ -0,0 +1,816 @@
+package nas
+
+func init() {
+	registerMessage(func() Message { return &ActivateDefaultEPSBearerContextRequest{} })
+	registerMessage(func() Message { return &ActivateDefaultEPSBearerContextAccept{} })
+	registerMessage(func() Message { return &ActivateDefaultEPSBearerContextReject{} })
+	registerMessage(func() Message { return &ActivateDedicatedEPSBearerContextRequest{} })
+	registerMessage(func() Message { return &ActivateDedicatedEPSBearerContextAccept{} })
+	registerMessage(func() Message { return &ActivateDedicatedEPSBearerContextReject{} })
+	registerMessage(func() Message { return &ModifyEPSBearerContextRequest{} })
+	registerMessage(func() Message { return &ModifyEPSBearerContextAccept{} })
+	registerMessage(func() Message { return &ModifyEPSBearerContextReject{} })
+	registerMessage(func() Message { return &DeactivateEPSBearerContextRequest{} })
+	registerMessage(func() Message { return &DeactivateEPSBearerContextAccept{} })
+	registerMessage(func() Message { return &PDNConnectivityRequest{} })
+	registerMessage(func() Message { return &PDNConnectivityReject{} })
+	registerMessage(func() Message { return &PDNDisconnectRequest{} })
+	registerMessage(func() Message { return &PDNDisconnectReject{} })
+	registerMessage(func() Message { return &BearerResourceAllocationRequest{} })
+	registerMessage(func() Message { return &BearerResourceAllocationReject{} })
+	registerMessage(func() Message { return &BearerResourceModificationRequest{} })
+	registerMessage(func() Message { return &BearerResourceModificationReject{} })
+	registerMessage(func() Message { return &ESMInformationRequest{} })
+	registerMessage(func() Message { return &ESMInformationResponse{} })
+	registerMessage(func() Message { return &ESMStatus{} })
+}
+
+// Most of ESM messages carry only PCO and ePCO as optional IEs.
+var pcoIEs = []ieSpec{
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+// ActivateDefaultEPSBearerContextRequest is TS 24.301 8.3.6.
+type ActivateDefaultEPSBearerContextRequest struct {
+	ESMHeader
+	EPSQoS                 EPSQoS
+	APN                    APN
+	PDNAddress             PDNAddress
+	TransactionIdentifier  []byte
+	NegotiatedQoS          []byte
+	NegotiatedLLCSAPI      *uint8
+	RadioPriority          *uint8
+	PacketFlowIdentifier   []byte
+	APNAMBR                *APNAMBR
+	ESMCause               *uint8
+	PCO                    *PCO
+	ConnectivityType       *uint8
+	WLANOffloadIndication  *uint8
+	NBIFOMContainer        []byte
+	HeaderCompression      []byte
+	ControlPlaneOnly       *uint8
+	ExtendedPCO            *PCO
+	ServingPLMNRateControl []byte
+}
+
+var activateDefaultEPSBearerContextRequestIEs = []ieSpec{
+	{IEI_TRANSACTION_IDENTIFIER, ieTLV, 0},
+	{IEI_NEGOTIATED_QOS, ieTLV, 0},
+	{IEI_NEGOTIATED_LLC_SAPI, ieTV, 1},
+	{IEI_RADIO_PRIORITY, ieTV1, 0},
+	{IEI_PACKET_FLOW_IDENTIFIER, ieTLV, 0},
+	{IEI_APN_AMBR, ieTLV, 0},
+	{IEI_ESM_CAUSE, ieTV, 1},
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_CONNECTIVITY_TYPE, ieTV1, 0},
+	{IEI_WLAN_OFFLOAD_INDICATION, ieTV1, 0},
+	{IEI_NBIFOM_CONTAINER, ieTLV, 0},
+	{IEI_HEADER_COMPRESSION_CONFIGURATION, ieTLV, 0},
+	{IEI_CONTROL_PLANE_ONLY_INDICATION, ieTV1, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+	{IEI_SERVING_PLMN_RATE_CONTROL, ieTLV, 0},
+}
+
+func (m *ActivateDefaultEPSBearerContextRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ActivateDefaultEPSBearerContextRequest) MessageType() uint8 {
+	return MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_REQUEST
+}
+
+func (m *ActivateDefaultEPSBearerContextRequest) encode(w *writer) {
+	w.putLV(m.EPSQoS.bytes())
+	w.putLV(m.APN.bytes())
+	w.putLV(m.PDNAddress.bytes())
+	putOptTLV(w, IEI_TRANSACTION_IDENTIFIER, m.TransactionIdentifier)
+	putOptTLV(w, IEI_NEGOTIATED_QOS, m.NegotiatedQoS)
+	putOptTVUint8(w, IEI_NEGOTIATED_LLC_SAPI, m.NegotiatedLLCSAPI)
+	putOptTV1(w, IEI_RADIO_PRIORITY, m.RadioPriority)
+	putOptTLV(w, IEI_PACKET_FLOW_IDENTIFIER, m.PacketFlowIdentifier)
+	putOptAPNAMBR(w, IEI_APN_AMBR, m.APNAMBR)
+	putOptTVUint8(w, IEI_ESM_CAUSE, m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTV1(w, IEI_CONNECTIVITY_TYPE, m.ConnectivityType)
+	putOptTV1(w, IEI_WLAN_OFFLOAD_INDICATION, m.WLANOffloadIndication)
+	putOptTLV(w, IEI_NBIFOM_CONTAINER, m.NBIFOMContainer)
+	putOptTLV(w, IEI_HEADER_COMPRESSION_CONFIGURATION, m.HeaderCompression)
+	putOptTV1(w, IEI_CONTROL_PLANE_ONLY_INDICATION, m.ControlPlaneOnly)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+	putOptTLV(w, IEI_SERVING_PLMN_RATE_CONTROL, m.ServingPLMNRateControl)
+}
+
+func (m *ActivateDefaultEPSBearerContextRequest) decode(r *reader) {
+	m.EPSQoS = r.getEPSQoS()
+	apn, err := decodeAPN(r.getLV())
+	if err != nil {
+		r.setError("%v", err)
+	}
+	m.APN = apn
+	b := r.getLV()
+	if r.err == nil {
+		if m.PDNAddress, err = decodePDNAddress(b); err != nil {
+			r.setError("%v", err)
+		}
+	}
+	ies := r.getOptionalIEs(activateDefaultEPSBearerContextRequestIEs)
+	m.TransactionIdentifier = ies[IEI_TRANSACTION_IDENTIFIER]
+	m.NegotiatedQoS = ies[IEI_NEGOTIATED_QOS]
+	m.NegotiatedLLCSAPI = optUint8(ies, IEI_NEGOTIATED_LLC_SAPI)
+	m.RadioPriority = optUint8(ies, IEI_RADIO_PRIORITY)
+	m.PacketFlowIdentifier = ies[IEI_PACKET_FLOW_IDENTIFIER]
+	m.APNAMBR = r.optAPNAMBR(ies, IEI_APN_AMBR)
+	m.ESMCause = optUint8(ies, IEI_ESM_CAUSE)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ConnectivityType = optUint8(ies, IEI_CONNECTIVITY_TYPE)
+	m.WLANOffloadIndication = optUint8(ies, IEI_WLAN_OFFLOAD_INDICATION)
+	m.NBIFOMContainer = ies[IEI_NBIFOM_CONTAINER]
+	m.HeaderCompression = ies[IEI_HEADER_COMPRESSION_CONFIGURATION]
+	m.ControlPlaneOnly = optUint8(ies, IEI_CONTROL_PLANE_ONLY_INDICATION)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+	m.ServingPLMNRateControl = ies[IEI_SERVING_PLMN_RATE_CONTROL]
+}
+
+// ActivateDefaultEPSBearerContextAccept is TS 24.301 8.3.4.
+type ActivateDefaultEPSBearerContextAccept struct {
+	ESMHeader
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *ActivateDefaultEPSBearerContextAccept) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ActivateDefaultEPSBearerContextAccept) MessageType() uint8 {
+	return MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_ACCEPT
+}
+
+func (m *ActivateDefaultEPSBearerContextAccept) encode(w *writer) {
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ActivateDefaultEPSBearerContextAccept) decode(r *reader) {
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ActivateDefaultEPSBearerContextReject is TS 24.301 8.3.5.
+type ActivateDefaultEPSBearerContextReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *ActivateDefaultEPSBearerContextReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ActivateDefaultEPSBearerContextReject) MessageType() uint8 {
+	return MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_REJECT
+}
+
+func (m *ActivateDefaultEPSBearerContextReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ActivateDefaultEPSBearerContextReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ActivateDedicatedEPSBearerContextRequest is TS 24.301 8.3.3.
+type ActivateDedicatedEPSBearerContextRequest struct {
+	ESMHeader
+	LinkedEBI             uint8
+	EPSQoS                EPSQoS
+	TFT                   []byte
+	TransactionIdentifier []byte
+	NegotiatedQoS         []byte
+	NegotiatedLLCSAPI     *uint8
+	RadioPriority         *uint8
+	PacketFlowIdentifier  []byte
+	PCO                   *PCO
+	WLANOffloadIndication *uint8
+	NBIFOMContainer       []byte
+	ExtendedPCO           *PCO
+}
+
+var activateDedicatedEPSBearerContextRequestIEs = []ieSpec{
+	{IEI_TRANSACTION_IDENTIFIER, ieTLV, 0},
+	{IEI_NEGOTIATED_QOS, ieTLV, 0},
+	{IEI_NEGOTIATED_LLC_SAPI, ieTV, 1},
+	{IEI_RADIO_PRIORITY, ieTV1, 0},
+	{IEI_PACKET_FLOW_IDENTIFIER, ieTLV, 0},
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_WLAN_OFFLOAD_INDICATION, ieTV1, 0},
+	{IEI_NBIFOM_CONTAINER, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *ActivateDedicatedEPSBearerContextRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ActivateDedicatedEPSBearerContextRequest) MessageType() uint8 {
+	return MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REQUEST
+}
+
+func (m *ActivateDedicatedEPSBearerContextRequest) encode(w *writer) {
+	w.putHalf(0, m.LinkedEBI)
+	w.putLV(m.EPSQoS.bytes())
+	w.putLV(m.TFT)
+	putOptTLV(w, IEI_TRANSACTION_IDENTIFIER, m.TransactionIdentifier)
+	putOptTLV(w, IEI_NEGOTIATED_QOS, m.NegotiatedQoS)
+	putOptTVUint8(w, IEI_NEGOTIATED_LLC_SAPI, m.NegotiatedLLCSAPI)
+	putOptTV1(w, IEI_RADIO_PRIORITY, m.RadioPriority)
+	putOptTLV(w, IEI_PACKET_FLOW_IDENTIFIER, m.PacketFlowIdentifier)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTV1(w, IEI_WLAN_OFFLOAD_INDICATION, m.WLANOffloadIndication)
+	putOptTLV(w, IEI_NBIFOM_CONTAINER, m.NBIFOMContainer)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ActivateDedicatedEPSBearerContextRequest) decode(r *reader) {
+	_, m.LinkedEBI = r.getHalf()
+	m.EPSQoS = r.getEPSQoS()
+	m.TFT = r.getLV()
+	ies := r.getOptionalIEs(activateDedicatedEPSBearerContextRequestIEs)
+	m.TransactionIdentifier = ies[IEI_TRANSACTION_IDENTIFIER]
+	m.NegotiatedQoS = ies[IEI_NEGOTIATED_QOS]
+	m.NegotiatedLLCSAPI = optUint8(ies, IEI_NEGOTIATED_LLC_SAPI)
+	m.RadioPriority = optUint8(ies, IEI_RADIO_PRIORITY)
+	m.PacketFlowIdentifier = ies[IEI_PACKET_FLOW_IDENTIFIER]
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.WLANOffloadIndication = optUint8(ies, IEI_WLAN_OFFLOAD_INDICATION)
+	m.NBIFOMContainer = ies[IEI_NBIFOM_CONTAINER]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ActivateDedicatedEPSBearerContextAccept is TS 24.301 8.3.1.
+type ActivateDedicatedEPSBearerContextAccept struct {
+	ESMHeader
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *ActivateDedicatedEPSBearerContextAccept) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ActivateDedicatedEPSBearerContextAccept) MessageType() uint8 {
+	return MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT
+}
+
+func (m *ActivateDedicatedEPSBearerContextAccept) encode(w *writer) {
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ActivateDedicatedEPSBearerContextAccept) decode(r *reader) {
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ActivateDedicatedEPSBearerContextReject is TS 24.301 8.3.2.
+type ActivateDedicatedEPSBearerContextReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *ActivateDedicatedEPSBearerContextReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ActivateDedicatedEPSBearerContextReject) MessageType() uint8 {
+	return MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT
+}
+
+func (m *ActivateDedicatedEPSBearerContextReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ActivateDedicatedEPSBearerContextReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ModifyEPSBearerContextRequest is TS 24.301 8.3.18.
+type ModifyEPSBearerContextRequest struct {
+	ESMHeader
+	NewEPSQoS             *EPSQoS
+	TFT                   []byte
+	NewQoS                []byte
+	NegotiatedLLCSAPI     *uint8
+	RadioPriority         *uint8
+	PacketFlowIdentifier  []byte
+	APNAMBR               *APNAMBR
+	PCO                   *PCO
+	WLANOffloadIndication *uint8
+	HeaderCompression     []byte
+	ExtendedPCO           *PCO
+}
+
+var modifyEPSBearerContextRequestIEs = []ieSpec{
+	{IEI_NEW_EPS_QOS, ieTLV, 0},
+	{IEI_TFT, ieTLV, 0},
+	{IEI_NEW_QOS, ieTLV, 0},
+	{IEI_NEGOTIATED_LLC_SAPI, ieTV, 1},
+	{IEI_RADIO_PRIORITY, ieTV1, 0},
+	{IEI_PACKET_FLOW_IDENTIFIER, ieTLV, 0},
+	{IEI_APN_AMBR, ieTLV, 0},
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_WLAN_OFFLOAD_INDICATION, ieTV1, 0},
+	{IEI_HEADER_COMPRESSION_CONFIGURATION, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *ModifyEPSBearerContextRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ModifyEPSBearerContextRequest) MessageType() uint8 {
+	return MSG_MODIFY_EPS_BEARER_CONTEXT_REQUEST
+}
+
+func (m *ModifyEPSBearerContextRequest) encode(w *writer) {
+	putOptEPSQoS(w, IEI_NEW_EPS_QOS, m.NewEPSQoS)
+	putOptTLV(w, IEI_TFT, m.TFT)
+	putOptTLV(w, IEI_NEW_QOS, m.NewQoS)
+	putOptTVUint8(w, IEI_NEGOTIATED_LLC_SAPI, m.NegotiatedLLCSAPI)
+	putOptTV1(w, IEI_RADIO_PRIORITY, m.RadioPriority)
+	putOptTLV(w, IEI_PACKET_FLOW_IDENTIFIER, m.PacketFlowIdentifier)
+	putOptAPNAMBR(w, IEI_APN_AMBR, m.APNAMBR)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTV1(w, IEI_WLAN_OFFLOAD_INDICATION, m.WLANOffloadIndication)
+	putOptTLV(w, IEI_HEADER_COMPRESSION_CONFIGURATION, m.HeaderCompression)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ModifyEPSBearerContextRequest) decode(r *reader) {
+	ies := r.getOptionalIEs(modifyEPSBearerContextRequestIEs)
+	m.NewEPSQoS = r.optEPSQoS(ies, IEI_NEW_EPS_QOS)
+	m.TFT = ies[IEI_TFT]
+	m.NewQoS = ies[IEI_NEW_QOS]
+	m.NegotiatedLLCSAPI = optUint8(ies, IEI_NEGOTIATED_LLC_SAPI)
+	m.RadioPriority = optUint8(ies, IEI_RADIO_PRIORITY)
+	m.PacketFlowIdentifier = ies[IEI_PACKET_FLOW_IDENTIFIER]
+	m.APNAMBR = r.optAPNAMBR(ies, IEI_APN_AMBR)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.WLANOffloadIndication = optUint8(ies, IEI_WLAN_OFFLOAD_INDICATION)
+	m.HeaderCompression = ies[IEI_HEADER_COMPRESSION_CONFIGURATION]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ModifyEPSBearerContextAccept is TS 24.301 8.3.16.
+type ModifyEPSBearerContextAccept struct {
+	ESMHeader
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *ModifyEPSBearerContextAccept) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ModifyEPSBearerContextAccept) MessageType() uint8 {
+	return MSG_MODIFY_EPS_BEARER_CONTEXT_ACCEPT
+}
+
+func (m *ModifyEPSBearerContextAccept) encode(w *writer) {
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ModifyEPSBearerContextAccept) decode(r *reader) {
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ModifyEPSBearerContextReject is TS 24.301 8.3.17.
+type ModifyEPSBearerContextReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *ModifyEPSBearerContextReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ModifyEPSBearerContextReject) MessageType() uint8 {
+	return MSG_MODIFY_EPS_BEARER_CONTEXT_REJECT
+}
+
+func (m *ModifyEPSBearerContextReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ModifyEPSBearerContextReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// DeactivateEPSBearerContextRequest is TS 24.301 8.3.12.
+type DeactivateEPSBearerContextRequest struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	T3396Value  []byte
+	ExtendedPCO *PCO
+}
+
+var deactivateEPSBearerContextRequestIEs = []ieSpec{
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_T3396_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *DeactivateEPSBearerContextRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *DeactivateEPSBearerContextRequest) MessageType() uint8 {
+	return MSG_DEACTIVATE_EPS_BEARER_CONTEXT_REQUEST
+}
+
+func (m *DeactivateEPSBearerContextRequest) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTLV(w, IEI_T3396_VALUE, m.T3396Value)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *DeactivateEPSBearerContextRequest) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(deactivateEPSBearerContextRequestIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.T3396Value = ies[IEI_T3396_VALUE]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// DeactivateEPSBearerContextAccept is TS 24.301 8.3.11.
+type DeactivateEPSBearerContextAccept struct {
+	ESMHeader
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *DeactivateEPSBearerContextAccept) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *DeactivateEPSBearerContextAccept) MessageType() uint8 {
+	return MSG_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT
+}
+
+func (m *DeactivateEPSBearerContextAccept) encode(w *writer) {
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *DeactivateEPSBearerContextAccept) decode(r *reader) {
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// PDNConnectivityRequest is TS 24.301 8.3.20.
+type PDNConnectivityRequest struct {
+	ESMHeader
+	PDNType                    uint8
+	RequestType                uint8
+	ESMInformationTransferFlag *uint8
+	APN                        *APN
+	PCO                        *PCO
+	DeviceProperties           *uint8
+	NBIFOMContainer            []byte
+	HeaderCompression          []byte
+	ExtendedPCO                *PCO
+}
+
+var pdnConnectivityRequestIEs = []ieSpec{
+	{IEI_ESM_INFORMATION_TRANSFER_FLAG, ieTV1, 0},
+	{IEI_ACCESS_POINT_NAME, ieTLV, 0},
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_ESM_DEVICE_PROPERTIES, ieTV1, 0},
+	{IEI_NBIFOM_CONTAINER, ieTLV, 0},
+	{IEI_HEADER_COMPRESSION_CONFIGURATION, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *PDNConnectivityRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *PDNConnectivityRequest) MessageType() uint8           { return MSG_PDN_CONNECTIVITY_REQUEST }
+
+func (m *PDNConnectivityRequest) encode(w *writer) {
+	w.putHalf(m.PDNType, m.RequestType)
+	putOptTV1(w, IEI_ESM_INFORMATION_TRANSFER_FLAG, m.ESMInformationTransferFlag)
+	putOptAPN(w, IEI_ACCESS_POINT_NAME, m.APN)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTV1(w, IEI_ESM_DEVICE_PROPERTIES, m.DeviceProperties)
+	putOptTLV(w, IEI_NBIFOM_CONTAINER, m.NBIFOMContainer)
+	putOptTLV(w, IEI_HEADER_COMPRESSION_CONFIGURATION, m.HeaderCompression)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *PDNConnectivityRequest) decode(r *reader) {
+	m.PDNType, m.RequestType = r.getHalf()
+	ies := r.getOptionalIEs(pdnConnectivityRequestIEs)
+	m.ESMInformationTransferFlag = optUint8(ies, IEI_ESM_INFORMATION_TRANSFER_FLAG)
+	m.APN = r.optAPN(ies, IEI_ACCESS_POINT_NAME)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.DeviceProperties = optUint8(ies, IEI_ESM_DEVICE_PROPERTIES)
+	m.NBIFOMContainer = ies[IEI_NBIFOM_CONTAINER]
+	m.HeaderCompression = ies[IEI_HEADER_COMPRESSION_CONFIGURATION]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// PDNConnectivityReject is TS 24.301 8.3.19.
+type PDNConnectivityReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	T3396Value  []byte
+	ExtendedPCO *PCO
+}
+
+var pdnConnectivityRejectIEs = []ieSpec{
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_T3396_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *PDNConnectivityReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *PDNConnectivityReject) MessageType() uint8           { return MSG_PDN_CONNECTIVITY_REJECT }
+
+func (m *PDNConnectivityReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTLV(w, IEI_T3396_VALUE, m.T3396Value)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *PDNConnectivityReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(pdnConnectivityRejectIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.T3396Value = ies[IEI_T3396_VALUE]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// PDNDisconnectRequest is TS 24.301 8.3.22.
+type PDNDisconnectRequest struct {
+	ESMHeader
+	LinkedEBI   uint8
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *PDNDisconnectRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *PDNDisconnectRequest) MessageType() uint8           { return MSG_PDN_DISCONNECT_REQUEST }
+
+func (m *PDNDisconnectRequest) encode(w *writer) {
+	w.putHalf(0, m.LinkedEBI)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *PDNDisconnectRequest) decode(r *reader) {
+	_, m.LinkedEBI = r.getHalf()
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// PDNDisconnectReject is TS 24.301 8.3.21.
+type PDNDisconnectReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+func (m *PDNDisconnectReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *PDNDisconnectReject) MessageType() uint8           { return MSG_PDN_DISCONNECT_REJECT }
+
+func (m *PDNDisconnectReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *PDNDisconnectReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(pcoIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// BearerResourceAllocationRequest is TS 24.301 8.3.8.
+type BearerResourceAllocationRequest struct {
+	ESMHeader
+	LinkedEBI              uint8
+	TrafficFlowAggregate   []byte
+	RequiredTrafficFlowQoS EPSQoS
+	PCO                    *PCO
+	DeviceProperties       *uint8
+	NBIFOMContainer        []byte
+	ExtendedPCO            *PCO
+}
+
+var bearerResourceAllocationRequestIEs = []ieSpec{
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_ESM_DEVICE_PROPERTIES, ieTV1, 0},
+	{IEI_NBIFOM_CONTAINER, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *BearerResourceAllocationRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *BearerResourceAllocationRequest) MessageType() uint8 {
+	return MSG_BEARER_RESOURCE_ALLOCATION_REQUEST
+}
+
+func (m *BearerResourceAllocationRequest) encode(w *writer) {
+	w.putHalf(0, m.LinkedEBI)
+	w.putLV(m.TrafficFlowAggregate)
+	w.putLV(m.RequiredTrafficFlowQoS.bytes())
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTV1(w, IEI_ESM_DEVICE_PROPERTIES, m.DeviceProperties)
+	putOptTLV(w, IEI_NBIFOM_CONTAINER, m.NBIFOMContainer)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *BearerResourceAllocationRequest) decode(r *reader) {
+	_, m.LinkedEBI = r.getHalf()
+	m.TrafficFlowAggregate = r.getLV()
+	m.RequiredTrafficFlowQoS = r.getEPSQoS()
+	ies := r.getOptionalIEs(bearerResourceAllocationRequestIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.DeviceProperties = optUint8(ies, IEI_ESM_DEVICE_PROPERTIES)
+	m.NBIFOMContainer = ies[IEI_NBIFOM_CONTAINER]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// BearerResourceAllocationReject is TS 24.301 8.3.7.
+type BearerResourceAllocationReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	T3396Value  []byte
+	ExtendedPCO *PCO
+}
+
+var bearerResourceRejectIEs = []ieSpec{
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_T3396_VALUE, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *BearerResourceAllocationReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *BearerResourceAllocationReject) MessageType() uint8 {
+	return MSG_BEARER_RESOURCE_ALLOCATION_REJECT
+}
+
+func (m *BearerResourceAllocationReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTLV(w, IEI_T3396_VALUE, m.T3396Value)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *BearerResourceAllocationReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(bearerResourceRejectIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.T3396Value = ies[IEI_T3396_VALUE]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// BearerResourceModificationRequest is TS 24.301 8.3.10. EBI is the EPS
+// bearer identity for packet filter.
+type BearerResourceModificationRequest struct {
+	ESMHeader
+	EBI                    uint8
+	TrafficFlowAggregate   []byte
+	RequiredTrafficFlowQoS *EPSQoS
+	ESMCause               *uint8
+	PCO                    *PCO
+	DeviceProperties       *uint8
+	NBIFOMContainer        []byte
+	HeaderCompression      []byte
+	ExtendedPCO            *PCO
+}
+
+var bearerResourceModificationRequestIEs = []ieSpec{
+	{IEI_REQUIRED_TRAFFIC_FLOW_QOS, ieTLV, 0},
+	{IEI_ESM_CAUSE, ieTV, 1},
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_ESM_DEVICE_PROPERTIES, ieTV1, 0},
+	{IEI_NBIFOM_CONTAINER, ieTLV, 0},
+	{IEI_HEADER_COMPRESSION_CONFIGURATION, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *BearerResourceModificationRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *BearerResourceModificationRequest) MessageType() uint8 {
+	return MSG_BEARER_RESOURCE_MODIFICATION_REQUEST
+}
+
+func (m *BearerResourceModificationRequest) encode(w *writer) {
+	w.putHalf(0, m.EBI)
+	w.putLV(m.TrafficFlowAggregate)
+	putOptEPSQoS(w, IEI_REQUIRED_TRAFFIC_FLOW_QOS, m.RequiredTrafficFlowQoS)
+	putOptTVUint8(w, IEI_ESM_CAUSE, m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTV1(w, IEI_ESM_DEVICE_PROPERTIES, m.DeviceProperties)
+	putOptTLV(w, IEI_NBIFOM_CONTAINER, m.NBIFOMContainer)
+	putOptTLV(w, IEI_HEADER_COMPRESSION_CONFIGURATION, m.HeaderCompression)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *BearerResourceModificationRequest) decode(r *reader) {
+	_, m.EBI = r.getHalf()
+	m.TrafficFlowAggregate = r.getLV()
+	ies := r.getOptionalIEs(bearerResourceModificationRequestIEs)
+	m.RequiredTrafficFlowQoS = r.optEPSQoS(ies, IEI_REQUIRED_TRAFFIC_FLOW_QOS)
+	m.ESMCause = optUint8(ies, IEI_ESM_CAUSE)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.DeviceProperties = optUint8(ies, IEI_ESM_DEVICE_PROPERTIES)
+	m.NBIFOMContainer = ies[IEI_NBIFOM_CONTAINER]
+	m.HeaderCompression = ies[IEI_HEADER_COMPRESSION_CONFIGURATION]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// BearerResourceModificationReject is TS 24.301 8.3.9.
+type BearerResourceModificationReject struct {
+	ESMHeader
+	ESMCause    uint8
+	PCO         *PCO
+	T3396Value  []byte
+	ExtendedPCO *PCO
+}
+
+func (m *BearerResourceModificationReject) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *BearerResourceModificationReject) MessageType() uint8 {
+	return MSG_BEARER_RESOURCE_MODIFICATION_REJECT
+}
+
+func (m *BearerResourceModificationReject) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptTLV(w, IEI_T3396_VALUE, m.T3396Value)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *BearerResourceModificationReject) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+	ies := r.getOptionalIEs(bearerResourceRejectIEs)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.T3396Value = ies[IEI_T3396_VALUE]
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ESMInformationRequest is TS 24.301 8.3.13.
+type ESMInformationRequest struct {
+	ESMHeader
+}
+
+func (m *ESMInformationRequest) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ESMInformationRequest) MessageType() uint8           { return MSG_ESM_INFORMATION_REQUEST }
+func (m *ESMInformationRequest) encode(w *writer)             {}
+func (m *ESMInformationRequest) decode(r *reader)             {}
+
+// ESMInformationResponse is TS 24.301 8.3.14.
+type ESMInformationResponse struct {
+	ESMHeader
+	APN         *APN
+	PCO         *PCO
+	ExtendedPCO *PCO
+}
+
+var esmInformationResponseIEs = []ieSpec{
+	{IEI_ACCESS_POINT_NAME, ieTLV, 0},
+	{IEI_PROTOCOL_CONFIGURATION_OPTIONS, ieTLV, 0},
+	{IEI_EXTENDED_PCO, ieTLVE, 0},
+}
+
+func (m *ESMInformationResponse) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ESMInformationResponse) MessageType() uint8           { return MSG_ESM_INFORMATION_RESPONSE }
+
+func (m *ESMInformationResponse) encode(w *writer) {
+	putOptAPN(w, IEI_ACCESS_POINT_NAME, m.APN)
+	putOptPCO(w, IEI_PROTOCOL_CONFIGURATION_OPTIONS, m.PCO)
+	putOptEPCO(w, IEI_EXTENDED_PCO, m.ExtendedPCO)
+}
+
+func (m *ESMInformationResponse) decode(r *reader) {
+	ies := r.getOptionalIEs(esmInformationResponseIEs)
+	m.APN = r.optAPN(ies, IEI_ACCESS_POINT_NAME)
+	m.PCO = r.optPCO(ies, IEI_PROTOCOL_CONFIGURATION_OPTIONS)
+	m.ExtendedPCO = r.optPCO(ies, IEI_EXTENDED_PCO)
+}
+
+// ESMStatus is TS 24.301 8.3.15.
+type ESMStatus struct {
+	ESMHeader
+	ESMCause uint8
+}
+
+func (m *ESMStatus) ProtocolDiscriminator() uint8 { return PD_ESM }
+func (m *ESMStatus) MessageType() uint8           { return MSG_ESM_STATUS }
+
+func (m *ESMStatus) encode(w *writer) {
+	w.putUint8(m.ESMCause)
+}
+
+func (m *ESMStatus) decode(r *reader) {
+	m.ESMCause = r.getUint8()
+}
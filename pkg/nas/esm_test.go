@@ -0,0 +1,311 @@
+package nas
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+)
+
+var (
+	testAPN = APN("internet")
+	testPCO = &PCO{Options: []PCOOption{
+		{ID: PCO_ID_DNS_SERVER_IPV4_ADDRESS, Contents: []byte{8, 8, 8, 8}},
+		{ID: PCO_ID_IPV4_LINK_MTU, Contents: []byte{0x05, 0xdc}},
+	}}
+	testEPCO = &PCO{Options: []PCOOption{
+		{ID: PCO_ID_IP_ADDRESS_ALLOCATION_VIA_NAS, Contents: []byte{}},
+	}}
+	// testQoS has bit rates which are encoded without loss in octet,
+	// extended and extended-2 octets.
+	testQoS = EPSQoS{QCI: 1, MBRUL: 1024, MBRDL: 300000, GBRUL: 32, GBRDL: 50000}
+)
+
+// esmMessages have all IEs of the messages present so that decode of the
+// encoded message must return the same message.
+var esmMessages = []Message{
+	&ActivateDefaultEPSBearerContextRequest{
+		ESMHeader:              ESMHeader{EPSBearerIdentity: 5, PTI: 1},
+		EPSQoS:                 EPSQoS{QCI: 9},
+		APN:                    testAPN,
+		PDNAddress:             PDNAddress{Type: PDN_TYPE_IPV4V6, IPv4: net.IPv4(10, 45, 0, 2).To4(), IPv6InterfaceID: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		TransactionIdentifier:  []byte{0x01},
+		NegotiatedQoS:          []byte{0x13, 0x93, 0x1f},
+		NegotiatedLLCSAPI:      u8(0),
+		RadioPriority:          u8(1),
+		PacketFlowIdentifier:   []byte{0x00},
+		APNAMBR:                &APNAMBR{DL: 300000, UL: 50000},
+		ESMCause:               u8(ESM_CAUSE_PDN_TYPE_IPV4_ONLY_ALLOWED),
+		PCO:                    testPCO,
+		ConnectivityType:       u8(1),
+		WLANOffloadIndication:  u8(1),
+		NBIFOMContainer:        []byte{0x01},
+		HeaderCompression:      []byte{0x00, 0x0f},
+		ControlPlaneOnly:       u8(1),
+		ExtendedPCO:            testEPCO,
+		ServingPLMNRateControl: []byte{0x00, 0x0a},
+	},
+	&ActivateDefaultEPSBearerContextAccept{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 5},
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&ActivateDefaultEPSBearerContextReject{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 5},
+		ESMCause:    ESM_CAUSE_INSUFFICIENT_RESOURCES,
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&ActivateDedicatedEPSBearerContextRequest{
+		ESMHeader:             ESMHeader{EPSBearerIdentity: 6, PTI: 2},
+		LinkedEBI:             5,
+		EPSQoS:                testQoS,
+		TFT:                   []byte{0x21, 0x20, 0x11, 0x01, 0x06},
+		TransactionIdentifier: []byte{0x02},
+		NegotiatedQoS:         []byte{0x13, 0x93, 0x1f},
+		NegotiatedLLCSAPI:     u8(3),
+		RadioPriority:         u8(2),
+		PacketFlowIdentifier:  []byte{0x00},
+		PCO:                   testPCO,
+		WLANOffloadIndication: u8(0),
+		NBIFOMContainer:       []byte{0x01},
+		ExtendedPCO:           testEPCO,
+	},
+	&ActivateDedicatedEPSBearerContextAccept{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 6},
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&ActivateDedicatedEPSBearerContextReject{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 6},
+		ESMCause:    ESM_CAUSE_INSUFFICIENT_RESOURCES,
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&ModifyEPSBearerContextRequest{
+		ESMHeader:             ESMHeader{EPSBearerIdentity: 6, PTI: 3},
+		NewEPSQoS:             &testQoS,
+		TFT:                   []byte{0x21, 0x20, 0x11, 0x01, 0x06},
+		NewQoS:                []byte{0x13, 0x93, 0x1f},
+		NegotiatedLLCSAPI:     u8(3),
+		RadioPriority:         u8(2),
+		PacketFlowIdentifier:  []byte{0x00},
+		APNAMBR:               &APNAMBR{DL: 10000, UL: 0},
+		PCO:                   testPCO,
+		WLANOffloadIndication: u8(1),
+		HeaderCompression:     []byte{0x00, 0x0f},
+		ExtendedPCO:           testEPCO,
+	},
+	&ModifyEPSBearerContextAccept{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 6},
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&ModifyEPSBearerContextReject{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 6},
+		ESMCause:    ESM_CAUSE_REQUEST_REJECTED_UNSPECIFIED,
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&DeactivateEPSBearerContextRequest{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 6, PTI: 4},
+		ESMCause:    ESM_CAUSE_REGULAR_DEACTIVATION,
+		PCO:         testPCO,
+		T3396Value:  []byte{0x21},
+		ExtendedPCO: testEPCO,
+	},
+	&DeactivateEPSBearerContextAccept{
+		ESMHeader:   ESMHeader{EPSBearerIdentity: 6},
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&PDNConnectivityRequest{
+		ESMHeader:                  ESMHeader{PTI: 1},
+		PDNType:                    PDN_TYPE_IPV4V6,
+		RequestType:                REQUEST_TYPE_INITIAL,
+		ESMInformationTransferFlag: u8(1),
+		APN:                        &testAPN,
+		PCO:                        testPCO,
+		DeviceProperties:           u8(1),
+		NBIFOMContainer:            []byte{0x01},
+		HeaderCompression:          []byte{0x00, 0x0f},
+		ExtendedPCO:                testEPCO,
+	},
+	&PDNConnectivityReject{
+		ESMHeader:   ESMHeader{PTI: 1},
+		ESMCause:    ESM_CAUSE_MISSING_OR_UNKNOWN_APN,
+		PCO:         testPCO,
+		T3396Value:  []byte{0x21},
+		ExtendedPCO: testEPCO,
+	},
+	&PDNDisconnectRequest{
+		ESMHeader:   ESMHeader{PTI: 2},
+		LinkedEBI:   5,
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&PDNDisconnectReject{
+		ESMHeader:   ESMHeader{PTI: 2},
+		ESMCause:    ESM_CAUSE_INVALID_EPS_BEARER_IDENTITY,
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&BearerResourceAllocationRequest{
+		ESMHeader:              ESMHeader{PTI: 3},
+		LinkedEBI:              5,
+		TrafficFlowAggregate:   []byte{0x21, 0x20, 0x11, 0x01, 0x06},
+		RequiredTrafficFlowQoS: testQoS,
+		PCO:                    testPCO,
+		DeviceProperties:       u8(1),
+		NBIFOMContainer:        []byte{0x01},
+		ExtendedPCO:            testEPCO,
+	},
+	&BearerResourceAllocationReject{
+		ESMHeader:   ESMHeader{PTI: 3},
+		ESMCause:    ESM_CAUSE_SERVICE_OPTION_NOT_SUPPORTED,
+		PCO:         testPCO,
+		T3396Value:  []byte{0x21},
+		ExtendedPCO: testEPCO,
+	},
+	&BearerResourceModificationRequest{
+		ESMHeader:              ESMHeader{PTI: 4},
+		EBI:                    6,
+		TrafficFlowAggregate:   []byte{0x21, 0x20, 0x11, 0x01, 0x06},
+		RequiredTrafficFlowQoS: &testQoS,
+		ESMCause:               u8(ESM_CAUSE_REGULAR_DEACTIVATION),
+		PCO:                    testPCO,
+		DeviceProperties:       u8(1),
+		NBIFOMContainer:        []byte{0x01},
+		HeaderCompression:      []byte{0x00, 0x0f},
+		ExtendedPCO:            testEPCO,
+	},
+	&BearerResourceModificationReject{
+		ESMHeader:   ESMHeader{PTI: 4},
+		ESMCause:    ESM_CAUSE_SERVICE_OPTION_NOT_SUBSCRIBED,
+		PCO:         testPCO,
+		T3396Value:  []byte{0x21},
+		ExtendedPCO: testEPCO,
+	},
+	&ESMInformationRequest{ESMHeader: ESMHeader{PTI: 1}},
+	&ESMInformationResponse{
+		ESMHeader:   ESMHeader{PTI: 1},
+		APN:         &testAPN,
+		PCO:         testPCO,
+		ExtendedPCO: testEPCO,
+	},
+	&ESMStatus{ESMHeader: ESMHeader{EPSBearerIdentity: 5, PTI: 1}, ESMCause: ESM_CAUSE_INVALID_PTI_VALUE},
+}
+
+func TestESMRoundTrip(t *testing.T) {
+	for _, m := range esmMessages {
+		b, err := Encode(m)
+		if err != nil {
+			t.Errorf("%s: encode: %v", MessageName(m), err)
+			continue
+		}
+		d, err := Decode(b)
+		if err != nil {
+			t.Errorf("%s: decode % x: %v", MessageName(m), b, err)
+			continue
+		}
+		if !reflect.DeepEqual(d, m) {
+			t.Errorf("%s: decoded %+v, want %+v", MessageName(m), d, m)
+		}
+	}
+}
+
+// esmPDUs are ESM messages assembled by hand from TS 24.301 and TS 24.008.
+var esmPDUs = []struct {
+	name string
+	pdu  []byte
+	m    Message
+}{
+	{
+		name: "PDN Connectivity Request in Attach Request",
+		pdu:  []byte{0x02, 0x01, 0xd0, 0x11, 0xd1},
+		m: &PDNConnectivityRequest{
+			ESMHeader:                  ESMHeader{PTI: 1},
+			PDNType:                    PDN_TYPE_IPV4,
+			RequestType:                REQUEST_TYPE_INITIAL,
+			ESMInformationTransferFlag: u8(1),
+		},
+	},
+	{
+		name: "Activate Default EPS Bearer Context Request",
+		pdu: []byte{
+			0x52, 0x01, 0xc1,
+			0x01, 0x09,
+			0x09, 0x08, 'i', 'n', 't', 'e', 'r', 'n', 'e', 't',
+			0x05, 0x01, 0x0a, 0x2d, 0x00, 0x02,
+			0x5e, 0x02, 0x87, 0x87,
+			0x27, 0x08, 0x80, 0x00, 0x0d, 0x04, 0x08, 0x08, 0x08, 0x08,
+		},
+		m: &ActivateDefaultEPSBearerContextRequest{
+			ESMHeader:  ESMHeader{EPSBearerIdentity: 5, PTI: 1},
+			EPSQoS:     EPSQoS{QCI: 9},
+			APN:        testAPN,
+			PDNAddress: PDNAddress{Type: PDN_TYPE_IPV4, IPv4: net.IP{10, 45, 0, 2}},
+			APNAMBR:    &APNAMBR{DL: 1024, UL: 1024},
+			PCO: &PCO{Options: []PCOOption{
+				{ID: PCO_ID_DNS_SERVER_IPV4_ADDRESS, Contents: []byte{8, 8, 8, 8}},
+			}},
+		},
+	},
+	{
+		name: "Activate Dedicated EPS Bearer Context Request with GBR",
+		pdu: []byte{
+			0x62, 0x00, 0xc5, 0x05,
+			0x05, 0x01, 0x40, 0x40, 0x40, 0x40,
+			0x00,
+		},
+		m: &ActivateDedicatedEPSBearerContextRequest{
+			ESMHeader: ESMHeader{EPSBearerIdentity: 6},
+			LinkedEBI: 5,
+			EPSQoS:    EPSQoS{QCI: 1, MBRUL: 64, MBRDL: 64, GBRUL: 64, GBRDL: 64},
+			TFT:       []byte{},
+		},
+	},
+	{
+		name: "ESM Information Response",
+		pdu:  []byte{0x02, 0x01, 0xda, 0x28, 0x09, 0x08, 'i', 'n', 't', 'e', 'r', 'n', 'e', 't'},
+		m:    &ESMInformationResponse{ESMHeader: ESMHeader{PTI: 1}, APN: &testAPN},
+	},
+}
+
+func TestESMDecode(t *testing.T) {
+	for _, tt := range esmPDUs {
+		m, err := Decode(tt.pdu)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(m, tt.m) {
+			t.Errorf("%s: decoded %+v, want %+v", tt.name, m, tt.m)
+			continue
+		}
+		b, err := Encode(m)
+		if err != nil {
+			t.Errorf("%s: encode: %v", tt.name, err)
+			continue
+		}
+		if !bytes.Equal(b, tt.pdu) {
+			t.Errorf("%s: encoded % x, want % x", tt.name, b, tt.pdu)
+		}
+	}
+}
+
+func TestESMDecodeError(t *testing.T) {
+	for _, b := range [][]byte{
+		{0x02},
+		{0x02, 0x01},
+		{0x02, 0x01, 0xff},
+		{0x52, 0x01, 0xc1, 0x02, 0x09},
+		{0x52, 0x01, 0xc1, 0x01, 0x09, 0x00, 0x02, 0x01, 0x0a},
+		{0x52, 0x01, 0xc1, 0x01, 0x09, 0x00, 0x05, 0x01, 0x0a, 0x2d, 0x00, 0x02, 0x5e, 0x01, 0x87},
+		{0x02, 0x01, 0xda, 0x28, 0x02, 0x08, 'i'},
+	} {
+		if m, err := Decode(b); err == nil {
+			t.Errorf("% x is decoded as %+v", b, m)
+		}
+	}
+}
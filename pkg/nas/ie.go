@@ -0,0 +1,675 @@
+package nas
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// PLMNIdentity is MCC and MNC in BCD as in TS 24.008 10.5.1.3.
+type PLMNIdentity [3]byte
+
+// TAI is tracking area identity.
+type TAI struct {
+	PLMNIdentity PLMNIdentity
+	TAC          uint16
+}
+
+func (t TAI) bytes() []byte {
+	return []byte{t.PLMNIdentity[0], t.PLMNIdentity[1], t.PLMNIdentity[2],
+		byte(t.TAC >> 8), byte(t.TAC)}
+}
+
+func decodeTAI(b []byte) (TAI, error) {
+	if len(b) != 5 {
+		return TAI{}, fmt.Errorf("TAI length %d", len(b))
+	}
+	t := TAI{TAC: uint16(b[3])<<8 | uint16(b[4])}
+	copy(t.PLMNIdentity[:], b[:3])
+	return t, nil
+}
+
+// TAIList is tracking area identity list.
+type TAIList []TAI
+
+// TAI list types.
+const (
+	TAI_LIST_TYPE_NON_CONSECUTIVE = 0
+	TAI_LIST_TYPE_CONSECUTIVE     = 1
+	TAI_LIST_TYPE_MULTIPLE_PLMNS  = 2
+)
+
+// bytes encodes the list as a list of TAIs belonging to different PLMNs.
+func (l TAIList) bytes() []byte {
+	if len(l) == 0 {
+		return nil
+	}
+	b := []byte{TAI_LIST_TYPE_MULTIPLE_PLMNS<<5 | byte(len(l)-1)}
+	for _, t := range l {
+		b = append(b, t.bytes()...)
+	}
+	return b
+}
+
+func decodeTAIList(b []byte) (TAIList, error) {
+	l := TAIList{}
+	for len(b) > 0 {
+		typ := (b[0] >> 5) & 0x3
+		n := int(b[0]&0x1f) + 1
+		b = b[1:]
+		switch typ {
+		case TAI_LIST_TYPE_NON_CONSECUTIVE:
+			if len(b) < 3+2*n {
+				return nil, fmt.Errorf("short TAI list")
+			}
+			for i := 0; i < n; i++ {
+				t := TAI{TAC: uint16(b[3+2*i])<<8 | uint16(b[4+2*i])}
+				copy(t.PLMNIdentity[:], b[:3])
+				l = append(l, t)
+			}
+			b = b[3+2*n:]
+		case TAI_LIST_TYPE_CONSECUTIVE:
+			if len(b) < 5 {
+				return nil, fmt.Errorf("short TAI list")
+			}
+			t, _ := decodeTAI(b[:5])
+			for i := 0; i < n; i++ {
+				l = append(l, t)
+				t.TAC++
+			}
+			b = b[5:]
+		case TAI_LIST_TYPE_MULTIPLE_PLMNS:
+			if len(b) < 5*n {
+				return nil, fmt.Errorf("short TAI list")
+			}
+			for i := 0; i < n; i++ {
+				t, _ := decodeTAI(b[5*i : 5*i+5])
+				l = append(l, t)
+			}
+			b = b[5*n:]
+		default:
+			return nil, fmt.Errorf("unknown TAI list type %d", typ)
+		}
+	}
+	return l, nil
+}
+
+// GUTI is globally unique temporary UE identity.
+type GUTI struct {
+	PLMNIdentity PLMNIdentity
+	MMEGI        uint16
+	MMEC         uint8
+	MTMSI        uint32
+}
+
+func (g GUTI) String() string {
+	return fmt.Sprintf("%x-%04x-%02x-%08x", g.PLMNIdentity[:], g.MMEGI, g.MMEC, g.MTMSI)
+}
+
+// MobileIdentity is EPS mobile identity of TS 24.301 9.9.3.12 and mobile
+// identity of TS 24.008 10.5.1.4. Digits is used by IMSI, IMEI and IMEISV,
+// TMSI by TMSI and GUTI by GUTI.
+type MobileIdentity struct {
+	Type   uint8
+	Digits string
+	TMSI   uint32
+	GUTI   GUTI
+}
+
+func (id MobileIdentity) String() string {
+	switch id.Type {
+	case IDENTITY_TYPE_IMSI:
+		return "IMSI " + id.Digits
+	case IDENTITY_TYPE_IMEI:
+		return "IMEI " + id.Digits
+	case IDENTITY_TYPE_IMEISV:
+		return "IMEISV " + id.Digits
+	case IDENTITY_TYPE_TMSI:
+		return fmt.Sprintf("TMSI %08x", id.TMSI)
+	case IDENTITY_TYPE_GUTI:
+		return "GUTI " + id.GUTI.String()
+	}
+	return fmt.Sprintf("Unknown identity type %d", id.Type)
+}
+
+func (id MobileIdentity) bytes() []byte {
+	switch id.Type {
+	case IDENTITY_TYPE_IMSI, IDENTITY_TYPE_IMEI, IDENTITY_TYPE_IMEISV:
+		return encodeDigits(id.Type, id.Digits)
+	case IDENTITY_TYPE_TMSI:
+		t := id.TMSI
+		return []byte{0xf0 | IDENTITY_TYPE_TMSI, byte(t >> 24), byte(t >> 16), byte(t >> 8), byte(t)}
+	case IDENTITY_TYPE_GUTI:
+		g := id.GUTI
+		return []byte{0xf0 | IDENTITY_TYPE_GUTI,
+			g.PLMNIdentity[0], g.PLMNIdentity[1], g.PLMNIdentity[2],
+			byte(g.MMEGI >> 8), byte(g.MMEGI), g.MMEC,
+			byte(g.MTMSI >> 24), byte(g.MTMSI >> 16), byte(g.MTMSI >> 8), byte(g.MTMSI)}
+	}
+	return []byte{id.Type & 0x7}
+}
+
+// encodeDigits encodes the digits in BCD. The first digit is put in the
+// high half of the first octet with odd/even indicator and identity type.
+func encodeDigits(typ uint8, digits string) []byte {
+	odd := uint8(0)
+	if len(digits)%2 == 1 {
+		odd = 0x8
+	}
+	b := []byte{typ&0x7 | odd}
+	if len(digits) > 0 {
+		b[0] |= (digits[0] - '0') << 4
+	}
+	for i := 1; i < len(digits); i += 2 {
+		v := (digits[i] - '0') & 0x0f
+		if i+1 < len(digits) {
+			v |= (digits[i+1] - '0') << 4
+		} else {
+			v |= 0xf0
+		}
+		b = append(b, v)
+	}
+	return b
+}
+
+func decodeDigits(b []byte) string {
+	var sb strings.Builder
+	sb.WriteByte('0' + b[0]>>4)
+	for _, v := range b[1:] {
+		sb.WriteByte('0' + v&0x0f)
+		if v>>4 != 0xf {
+			sb.WriteByte('0' + v>>4)
+		}
+	}
+	return sb.String()
+}
+
+func decodeMobileIdentity(b []byte) (MobileIdentity, error) {
+	if len(b) < 1 {
+		return MobileIdentity{}, fmt.Errorf("empty mobile identity")
+	}
+	id := MobileIdentity{Type: b[0] & 0x7}
+	switch id.Type {
+	case IDENTITY_TYPE_IMSI, IDENTITY_TYPE_IMEI, IDENTITY_TYPE_IMEISV:
+		id.Digits = decodeDigits(b)
+	case IDENTITY_TYPE_TMSI:
+		if len(b) != 5 {
+			return id, fmt.Errorf("TMSI length %d", len(b))
+		}
+		id.TMSI = uint32(b[1])<<24 | uint32(b[2])<<16 | uint32(b[3])<<8 | uint32(b[4])
+	case IDENTITY_TYPE_GUTI:
+		if len(b) != 11 {
+			return id, fmt.Errorf("GUTI length %d", len(b))
+		}
+		copy(id.GUTI.PLMNIdentity[:], b[1:4])
+		id.GUTI.MMEGI = uint16(b[4])<<8 | uint16(b[5])
+		id.GUTI.MMEC = b[6]
+		id.GUTI.MTMSI = uint32(b[7])<<24 | uint32(b[8])<<16 | uint32(b[9])<<8 | uint32(b[10])
+	}
+	return id, nil
+}
+
+// getMobileIdentity decodes LV mobile identity.
+func (r *reader) getMobileIdentity() MobileIdentity {
+	b := r.getLV()
+	if r.err != nil {
+		return MobileIdentity{}
+	}
+	id, err := decodeMobileIdentity(b)
+	if err != nil {
+		r.setError("%v", err)
+	}
+	return id
+}
+
+// optMobileIdentity decodes optional mobile identity.
+func (r *reader) optMobileIdentity(ies map[uint8][]byte, iei uint8) *MobileIdentity {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	id, err := decodeMobileIdentity(b)
+	if err != nil {
+		r.setError("%v", err)
+		return nil
+	}
+	return &id
+}
+
+// optGUTI decodes optional EPS mobile identity which must be GUTI.
+func (r *reader) optGUTI(ies map[uint8][]byte, iei uint8) *GUTI {
+	id := r.optMobileIdentity(ies, iei)
+	if id == nil {
+		return nil
+	}
+	if id.Type != IDENTITY_TYPE_GUTI {
+		r.setError("identity type %d is not GUTI", id.Type)
+		return nil
+	}
+	return &id.GUTI
+}
+
+func putOptGUTI(w *writer, iei uint8, g *GUTI) {
+	if g != nil {
+		w.putTLV(iei, MobileIdentity{Type: IDENTITY_TYPE_GUTI, GUTI: *g}.bytes())
+	}
+}
+
+func (r *reader) optTAI(ies map[uint8][]byte, iei uint8) *TAI {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	t, err := decodeTAI(b)
+	if err != nil {
+		r.setError("%v", err)
+		return nil
+	}
+	return &t
+}
+
+func putOptTAI(w *writer, iei uint8, t *TAI) {
+	if t != nil {
+		w.putTV(iei, t.bytes())
+	}
+}
+
+func (r *reader) optTAIList(ies map[uint8][]byte, iei uint8) TAIList {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	l, err := decodeTAIList(b)
+	if err != nil {
+		r.setError("%v", err)
+	}
+	return l
+}
+
+// SecurityAlgorithms is NAS security algorithms. Values are 0 to 7 of
+// EEA and EIA.
+type SecurityAlgorithms struct {
+	Ciphering uint8
+	Integrity uint8
+}
+
+func (a SecurityAlgorithms) byte() uint8 {
+	return (a.Ciphering&0x7)<<4 | a.Integrity&0x7
+}
+
+func decodeSecurityAlgorithms(v uint8) SecurityAlgorithms {
+	return SecurityAlgorithms{Ciphering: (v >> 4) & 0x7, Integrity: v & 0x7}
+}
+
+// APN is access point name. Labels are separated by dot.
+type APN string
+
+func (a APN) bytes() []byte {
+	b := []byte{}
+	if a == "" {
+		return b
+	}
+	for _, label := range strings.Split(string(a), ".") {
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return b
+}
+
+func decodeAPN(b []byte) (APN, error) {
+	labels := []string{}
+	for len(b) > 0 {
+		n := int(b[0])
+		if len(b) < 1+n {
+			return "", fmt.Errorf("APN label length %d", n)
+		}
+		labels = append(labels, string(b[1:1+n]))
+		b = b[1+n:]
+	}
+	return APN(strings.Join(labels, ".")), nil
+}
+
+func (r *reader) optAPN(ies map[uint8][]byte, iei uint8) *APN {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	a, err := decodeAPN(b)
+	if err != nil {
+		r.setError("%v", err)
+		return nil
+	}
+	return &a
+}
+
+func putOptAPN(w *writer, iei uint8, a *APN) {
+	if a != nil {
+		w.putTLV(iei, a.bytes())
+	}
+}
+
+// PDNAddress is PDN address of TS 24.301 9.9.4.9. IPv6InterfaceID is used
+// by IPv6 and IPv4v6.
+type PDNAddress struct {
+	Type            uint8
+	IPv4            net.IP
+	IPv6InterfaceID []byte
+}
+
+func (a PDNAddress) bytes() []byte {
+	b := []byte{a.Type & 0x7}
+	switch a.Type {
+	case PDN_TYPE_IPV4:
+		b = append(b, a.IPv4.To4()...)
+	case PDN_TYPE_IPV6:
+		b = append(b, a.IPv6InterfaceID...)
+	case PDN_TYPE_IPV4V6:
+		b = append(b, a.IPv6InterfaceID...)
+		b = append(b, a.IPv4.To4()...)
+	}
+	return b
+}
+
+func decodePDNAddress(b []byte) (PDNAddress, error) {
+	if len(b) < 1 {
+		return PDNAddress{}, fmt.Errorf("empty PDN address")
+	}
+	a := PDNAddress{Type: b[0] & 0x7}
+	b = b[1:]
+	switch a.Type {
+	case PDN_TYPE_IPV4:
+		if len(b) != 4 {
+			return a, fmt.Errorf("IPv4 PDN address length %d", len(b))
+		}
+		a.IPv4 = net.IP(b)
+	case PDN_TYPE_IPV6:
+		if len(b) != 8 {
+			return a, fmt.Errorf("IPv6 PDN address length %d", len(b))
+		}
+		a.IPv6InterfaceID = b
+	case PDN_TYPE_IPV4V6:
+		if len(b) != 12 {
+			return a, fmt.Errorf("IPv4v6 PDN address length %d", len(b))
+		}
+		a.IPv6InterfaceID = b[:8]
+		a.IPv4 = net.IP(b[8:])
+	}
+	return a, nil
+}
+
+// Bit rates of EPS QoS and APN-AMBR are in kbps. The value is encoded in an
+// octet and its extension octets of TS 24.301 9.9.4.2 and 9.9.4.3.
+const maxBitRate = 255 * 256000
+
+func encodeBitRate(kbps uint64) (v, ext, ext2 uint8) {
+	if kbps > maxBitRate {
+		kbps = maxBitRate
+	}
+	if kbps > 256000 {
+		ext2 = uint8(kbps / 256000)
+		kbps %= 256000
+	}
+	switch {
+	case kbps == 0:
+		v = 0xff
+	case kbps <= 63:
+		v = uint8(kbps)
+	case kbps <= 568:
+		v = uint8(0x40 + (kbps-64)/8)
+	case kbps <= 8640:
+		v = uint8(0x80 + (kbps-576)/64)
+	case kbps <= 16000:
+		v, ext = 0xfe, uint8((kbps-8600)/100)
+	case kbps <= 128000:
+		v, ext = 0xfe, uint8(0x4a+(kbps-16000)/1000)
+	default:
+		v, ext = 0xfe, uint8(0xba+(kbps-128000)/2000)
+	}
+	return v, ext, ext2
+}
+
+func decodeBitRate(v, ext, ext2 uint8) uint64 {
+	var kbps uint64
+	switch {
+	case ext > 0xba:
+		kbps = 128000 + uint64(ext-0xba)*2000
+	case ext > 0x4a:
+		kbps = 16000 + uint64(ext-0x4a)*1000
+	case ext > 0:
+		kbps = 8600 + uint64(ext)*100
+	case v == 0xff:
+		kbps = 0
+	case v >= 0x80:
+		kbps = 576 + uint64(v-0x80)*64
+	case v >= 0x40:
+		kbps = 64 + uint64(v-0x40)*8
+	default:
+		kbps = uint64(v)
+	}
+	return kbps + uint64(ext2)*256000
+}
+
+// EPSQoS is EPS quality of service. Bit rates are omitted when all of them
+// are zero.
+type EPSQoS struct {
+	QCI   uint8
+	MBRUL uint64
+	MBRDL uint64
+	GBRUL uint64
+	GBRDL uint64
+}
+
+func (q EPSQoS) bytes() []byte {
+	b := []byte{q.QCI}
+	rates := []uint64{q.MBRUL, q.MBRDL, q.GBRUL, q.GBRDL}
+	if q.MBRUL == 0 && q.MBRDL == 0 && q.GBRUL == 0 && q.GBRDL == 0 {
+		return b
+	}
+	var v, ext, ext2 [4]uint8
+	useExt, useExt2 := false, false
+	for i, r := range rates {
+		v[i], ext[i], ext2[i] = encodeBitRate(r)
+		useExt = useExt || ext[i] != 0 || ext2[i] != 0
+		useExt2 = useExt2 || ext2[i] != 0
+	}
+	b = append(b, v[:]...)
+	if useExt {
+		b = append(b, ext[:]...)
+	}
+	if useExt2 {
+		b = append(b, ext2[:]...)
+	}
+	return b
+}
+
+func decodeEPSQoS(b []byte) (EPSQoS, error) {
+	if len(b) != 1 && len(b) != 5 && len(b) != 9 && len(b) != 13 {
+		return EPSQoS{}, fmt.Errorf("EPS QoS length %d", len(b))
+	}
+	q := EPSQoS{QCI: b[0]}
+	if len(b) == 1 {
+		return q, nil
+	}
+	var v, ext, ext2 [4]uint8
+	copy(v[:], b[1:5])
+	if len(b) >= 9 {
+		copy(ext[:], b[5:9])
+	}
+	if len(b) == 13 {
+		copy(ext2[:], b[9:13])
+	}
+	q.MBRUL = decodeBitRate(v[0], ext[0], ext2[0])
+	q.MBRDL = decodeBitRate(v[1], ext[1], ext2[1])
+	q.GBRUL = decodeBitRate(v[2], ext[2], ext2[2])
+	q.GBRDL = decodeBitRate(v[3], ext[3], ext2[3])
+	return q, nil
+}
+
+func (r *reader) getEPSQoS() EPSQoS {
+	b := r.getLV()
+	if r.err != nil {
+		return EPSQoS{}
+	}
+	q, err := decodeEPSQoS(b)
+	if err != nil {
+		r.setError("%v", err)
+	}
+	return q
+}
+
+func (r *reader) optEPSQoS(ies map[uint8][]byte, iei uint8) *EPSQoS {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	q, err := decodeEPSQoS(b)
+	if err != nil {
+		r.setError("%v", err)
+		return nil
+	}
+	return &q
+}
+
+func putOptEPSQoS(w *writer, iei uint8, q *EPSQoS) {
+	if q != nil {
+		w.putTLV(iei, q.bytes())
+	}
+}
+
+// APNAMBR is APN aggregate maximum bit rate in kbps.
+type APNAMBR struct {
+	DL uint64
+	UL uint64
+}
+
+func (a APNAMBR) bytes() []byte {
+	dl, dlExt, dlExt2 := encodeBitRate(a.DL)
+	ul, ulExt, ulExt2 := encodeBitRate(a.UL)
+	b := []byte{dl, ul}
+	if dlExt != 0 || ulExt != 0 || dlExt2 != 0 || ulExt2 != 0 {
+		b = append(b, dlExt, ulExt)
+	}
+	if dlExt2 != 0 || ulExt2 != 0 {
+		b = append(b, dlExt2, ulExt2)
+	}
+	return b
+}
+
+func decodeAPNAMBR(b []byte) (APNAMBR, error) {
+	if len(b) != 2 && len(b) != 4 && len(b) != 6 {
+		return APNAMBR{}, fmt.Errorf("APN-AMBR length %d", len(b))
+	}
+	var v [6]uint8
+	copy(v[:], b)
+	return APNAMBR{
+		DL: decodeBitRate(v[0], v[2], v[4]),
+		UL: decodeBitRate(v[1], v[3], v[5]),
+	}, nil
+}
+
+func (r *reader) optAPNAMBR(ies map[uint8][]byte, iei uint8) *APNAMBR {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	a, err := decodeAPNAMBR(b)
+	if err != nil {
+		r.setError("%v", err)
+		return nil
+	}
+	return &a
+}
+
+func putOptAPNAMBR(w *writer, iei uint8, a *APNAMBR) {
+	if a != nil {
+		w.putTLV(iei, a.bytes())
+	}
+}
+
+// PCOOption is a configuration protocol option of PCO.
+type PCOOption struct {
+	ID       uint16
+	Contents []byte
+}
+
+// PCO is protocol configuration options of TS 24.008 10.5.6.3. It is used
+// as extended protocol configuration options as well.
+type PCO struct {
+	ConfigurationProtocol uint8
+	Options               []PCOOption
+}
+
+func (p PCO) bytes() []byte {
+	b := []byte{0x80 | p.ConfigurationProtocol&0x7}
+	for _, o := range p.Options {
+		b = append(b, byte(o.ID>>8), byte(o.ID), byte(len(o.Contents)))
+		b = append(b, o.Contents...)
+	}
+	return b
+}
+
+func decodePCO(b []byte) (PCO, error) {
+	if len(b) < 1 {
+		return PCO{}, fmt.Errorf("empty PCO")
+	}
+	p := PCO{ConfigurationProtocol: b[0] & 0x7}
+	b = b[1:]
+	for len(b) > 0 {
+		if len(b) < 3 || len(b) < 3+int(b[2]) {
+			return p, fmt.Errorf("short PCO option")
+		}
+		n := int(b[2])
+		p.Options = append(p.Options, PCOOption{
+			ID:       uint16(b[0])<<8 | uint16(b[1]),
+			Contents: b[3 : 3+n],
+		})
+		b = b[3+n:]
+	}
+	return p, nil
+}
+
+// Lookup returns contents of the option.
+func (p PCO) Lookup(id uint16) ([]byte, bool) {
+	for _, o := range p.Options {
+		if o.ID == id {
+			return o.Contents, true
+		}
+	}
+	return nil, false
+}
+
+// Bytes returns the PCO value without IEI and length. It is the format of
+// PCO relayed over GTP.
+func (p PCO) Bytes() []byte {
+	return p.bytes()
+}
+
+// ParsePCO decodes PCO value relayed over GTP.
+func ParsePCO(b []byte) (PCO, error) {
+	return decodePCO(b)
+}
+
+func (r *reader) optPCO(ies map[uint8][]byte, iei uint8) *PCO {
+	b, ok := ies[iei]
+	if !ok {
+		return nil
+	}
+	p, err := decodePCO(b)
+	if err != nil {
+		r.setError("%v", err)
+		return nil
+	}
+	return &p
+}
+
+func putOptPCO(w *writer, iei uint8, p *PCO) {
+	if p != nil {
+		w.putTLV(iei, p.bytes())
+	}
+}
+
+func putOptEPCO(w *writer, iei uint8, p *PCO) {
+	if p != nil {
+		w.putTLVE(iei, p.bytes())
+	}
+}
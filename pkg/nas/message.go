@@ -0,0 +1,230 @@
+package nas
+
+import (
+	"fmt"
+)
+
+// Message is NAS message. encode and decode handle the message body after
+// the message type octet.
+type Message interface {
+	ProtocolDiscriminator() uint8
+	MessageType() uint8
+	encode(w *writer)
+	decode(r *reader)
+}
+
+// ESMHeader is EPS bearer identity and procedure transaction identity in
+// the header of ESM message.
+type ESMHeader struct {
+	EPSBearerIdentity uint8
+	PTI               uint8
+}
+
+func (h *ESMHeader) esmHeader() *ESMHeader {
+	return h
+}
+
+// esmMessage is implemented by ESM messages which embed ESMHeader.
+type esmMessage interface {
+	esmHeader() *ESMHeader
+}
+
+var messageStr = map[uint8]string{
+	MSG_ATTACH_REQUEST:                "Attach Request",
+	MSG_ATTACH_ACCEPT:                 "Attach Accept",
+	MSG_ATTACH_COMPLETE:               "Attach Complete",
+	MSG_ATTACH_REJECT:                 "Attach Reject",
+	MSG_DETACH_REQUEST:                "Detach Request",
+	MSG_DETACH_ACCEPT:                 "Detach Accept",
+	MSG_TRACKING_AREA_UPDATE_REQUEST:  "Tracking Area Update Request",
+	MSG_TRACKING_AREA_UPDATE_ACCEPT:   "Tracking Area Update Accept",
+	MSG_TRACKING_AREA_UPDATE_COMPLETE: "Tracking Area Update Complete",
+	MSG_TRACKING_AREA_UPDATE_REJECT:   "Tracking Area Update Reject",
+	MSG_EXTENDED_SERVICE_REQUEST:      "Extended Service Request",
+	MSG_SERVICE_REQUEST:               "Service Request",
+	MSG_SERVICE_REJECT:                "Service Reject",
+	MSG_GUTI_REALLOCATION_COMMAND:     "GUTI Reallocation Command",
+	MSG_GUTI_REALLOCATION_COMPLETE:    "GUTI Reallocation Complete",
+	MSG_AUTHENTICATION_REQUEST:        "Authentication Request",
+	MSG_AUTHENTICATION_RESPONSE:       "Authentication Response",
+	MSG_AUTHENTICATION_REJECT:         "Authentication Reject",
+	MSG_IDENTITY_REQUEST:              "Identity Request",
+	MSG_IDENTITY_RESPONSE:             "Identity Response",
+	MSG_AUTHENTICATION_FAILURE:        "Authentication Failure",
+	MSG_SECURITY_MODE_COMMAND:         "Security Mode Command",
+	MSG_SECURITY_MODE_COMPLETE:        "Security Mode Complete",
+	MSG_SECURITY_MODE_REJECT:          "Security Mode Reject",
+	MSG_EMM_STATUS:                    "EMM Status",
+	MSG_EMM_INFORMATION:               "EMM Information",
+	MSG_DOWNLINK_NAS_TRANSPORT:        "Downlink NAS Transport",
+	MSG_UPLINK_NAS_TRANSPORT:          "Uplink NAS Transport",
+
+	MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_REQUEST:   "Activate Default EPS Bearer Context Request",
+	MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_ACCEPT:    "Activate Default EPS Bearer Context Accept",
+	MSG_ACTIVATE_DEFAULT_EPS_BEARER_CONTEXT_REJECT:    "Activate Default EPS Bearer Context Reject",
+	MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REQUEST: "Activate Dedicated EPS Bearer Context Request",
+	MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_ACCEPT:  "Activate Dedicated EPS Bearer Context Accept",
+	MSG_ACTIVATE_DEDICATED_EPS_BEARER_CONTEXT_REJECT:  "Activate Dedicated EPS Bearer Context Reject",
+	MSG_MODIFY_EPS_BEARER_CONTEXT_REQUEST:             "Modify EPS Bearer Context Request",
+	MSG_MODIFY_EPS_BEARER_CONTEXT_ACCEPT:              "Modify EPS Bearer Context Accept",
+	MSG_MODIFY_EPS_BEARER_CONTEXT_REJECT:              "Modify EPS Bearer Context Reject",
+	MSG_DEACTIVATE_EPS_BEARER_CONTEXT_REQUEST:         "Deactivate EPS Bearer Context Request",
+	MSG_DEACTIVATE_EPS_BEARER_CONTEXT_ACCEPT:          "Deactivate EPS Bearer Context Accept",
+	MSG_PDN_CONNECTIVITY_REQUEST:                      "PDN Connectivity Request",
+	MSG_PDN_CONNECTIVITY_REJECT:                       "PDN Connectivity Reject",
+	MSG_PDN_DISCONNECT_REQUEST:                        "PDN Disconnect Request",
+	MSG_PDN_DISCONNECT_REJECT:                         "PDN Disconnect Reject",
+	MSG_BEARER_RESOURCE_ALLOCATION_REQUEST:            "Bearer Resource Allocation Request",
+	MSG_BEARER_RESOURCE_ALLOCATION_REJECT:             "Bearer Resource Allocation Reject",
+	MSG_BEARER_RESOURCE_MODIFICATION_REQUEST:          "Bearer Resource Modification Request",
+	MSG_BEARER_RESOURCE_MODIFICATION_REJECT:           "Bearer Resource Modification Reject",
+	MSG_ESM_INFORMATION_REQUEST:                       "ESM Information Request",
+	MSG_ESM_INFORMATION_RESPONSE:                      "ESM Information Response",
+	MSG_ESM_STATUS:                                    "ESM Status",
+}
+
+// MessageName returns name of the message.
+func MessageName(m Message) string {
+	if _, ok := m.(*SecurityProtectedMessage); ok {
+		return "Security Protected NAS Message"
+	}
+	if str, ok := messageStr[m.MessageType()]; ok {
+		return str
+	}
+	return fmt.Sprintf("Unknown(PD %d type 0x%02x)", m.ProtocolDiscriminator(), m.MessageType())
+}
+
+type messageKey struct {
+	pd  uint8
+	typ uint8
+}
+
+var messageRegistry = map[messageKey]func() Message{}
+
+func registerMessage(f func() Message) {
+	m := f()
+	messageRegistry[messageKey{m.ProtocolDiscriminator(), m.MessageType()}] = f
+}
+
+// NewMessage returns empty message of the protocol discriminator and the
+// message type.
+func NewMessage(pd, typ uint8) (Message, error) {
+	f, ok := messageRegistry[messageKey{pd, typ}]
+	if !ok {
+		return nil, fmt.Errorf("unknown NAS message type 0x%02x of PD %d", typ, pd)
+	}
+	return f(), nil
+}
+
+// SecurityProtectedMessage is security protected NAS message. Payload is the
+// plain NAS message when the security header type is not ciphered.
+type SecurityProtectedMessage struct {
+	SecurityHeaderType uint8
+	MAC                uint32
+	SequenceNumber     uint8
+	Payload            []byte
+}
+
+func (m *SecurityProtectedMessage) ProtocolDiscriminator() uint8 { return PD_EMM }
+func (m *SecurityProtectedMessage) MessageType() uint8           { return 0 }
+
+func (m *SecurityProtectedMessage) encode(w *writer) {
+	w.putUint32(m.MAC)
+	w.putUint8(m.SequenceNumber)
+	w.putV(m.Payload)
+}
+
+func (m *SecurityProtectedMessage) decode(r *reader) {
+	m.MAC = r.getUint32()
+	m.SequenceNumber = r.getUint8()
+	m.Payload = r.getV(r.remaining())
+}
+
+// Ciphered returns true when the payload is ciphered.
+func (m *SecurityProtectedMessage) Ciphered() bool {
+	return m.SecurityHeaderType == SECURITY_HEADER_INTEGRITY_CIPHERED ||
+		m.SecurityHeaderType == SECURITY_HEADER_INTEGRITY_CIPHERED_NEW_CONTEXT
+}
+
+// Decode decodes NAS message. Security protected message is returned as
+// SecurityProtectedMessage without decoding the payload.
+func Decode(buf []byte) (Message, error) {
+	if len(buf) < 1 {
+		return nil, fmt.Errorf("empty NAS message")
+	}
+	pd := buf[0] & 0x0f
+	sh := buf[0] >> 4
+	r := &reader{buf: buf, pos: 1}
+
+	var m Message
+	switch pd {
+	case PD_EMM:
+		switch sh {
+		case SECURITY_HEADER_PLAIN:
+			typ := r.getUint8()
+			if r.err != nil {
+				return nil, r.err
+			}
+			if typ == MSG_DETACH_REQUEST && isNetworkDetachRequest(buf[2:]) {
+				m = &NetworkDetachRequest{}
+			} else {
+				var err error
+				if m, err = NewMessage(pd, typ); err != nil {
+					return nil, err
+				}
+			}
+		case SECURITY_HEADER_INTEGRITY, SECURITY_HEADER_INTEGRITY_CIPHERED,
+			SECURITY_HEADER_INTEGRITY_NEW_CONTEXT, SECURITY_HEADER_INTEGRITY_CIPHERED_NEW_CONTEXT:
+			m = &SecurityProtectedMessage{SecurityHeaderType: sh}
+		case SECURITY_HEADER_SERVICE_REQUEST:
+			m = &ServiceRequest{}
+		default:
+			return nil, fmt.Errorf("unknown security header type %d", sh)
+		}
+	case PD_ESM:
+		pti := r.getUint8()
+		typ := r.getUint8()
+		if r.err != nil {
+			return nil, r.err
+		}
+		var err error
+		if m, err = NewMessage(pd, typ); err != nil {
+			return nil, err
+		}
+		h := m.(esmMessage).esmHeader()
+		h.EPSBearerIdentity = sh
+		h.PTI = pti
+	default:
+		return nil, fmt.Errorf("unsupported protocol discriminator %d", pd)
+	}
+
+	m.decode(r)
+	if r.err != nil {
+		return nil, fmt.Errorf("%s: %v", MessageName(m), r.err)
+	}
+	return m, nil
+}
+
+// Encode encodes NAS message.
+func Encode(m Message) ([]byte, error) {
+	w := &writer{}
+	switch msg := m.(type) {
+	case *SecurityProtectedMessage:
+		w.putHalf(msg.SecurityHeaderType, PD_EMM)
+	case *ServiceRequest:
+		w.putHalf(SECURITY_HEADER_SERVICE_REQUEST, PD_EMM)
+	case esmMessage:
+		h := msg.esmHeader()
+		w.putHalf(h.EPSBearerIdentity, PD_ESM)
+		w.putUint8(h.PTI)
+		w.putUint8(m.MessageType())
+	default:
+		w.putHalf(SECURITY_HEADER_PLAIN, m.ProtocolDiscriminator())
+		w.putUint8(m.MessageType())
+	}
+	m.encode(w)
+	if w.err != nil {
+		return nil, fmt.Errorf("%s: %v", MessageName(m), w.err)
+	}
+	return w.buf, nil
+}
@@ -0,0 +1,199 @@
+package nas
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// NAS uses bearer identity 0 as input of the security algorithms.
+const nasBearer = 0
+
+// ErrIntegrity is returned when MAC of the security protected message does
+// not match.
+var ErrIntegrity = errors.New("NAS message integrity check failed")
+
+// eea2 is 128-EEA2 which is AES in counter mode. length is in bits.
+func eea2(key []byte, count uint32, bearer, dir uint8, msg []byte, length int) []byte {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil
+	}
+	var iv [aes.BlockSize]byte
+	binary.BigEndian.PutUint32(iv[0:], count)
+	iv[4] = (bearer&0x1f)<<3 | (dir&1)<<2
+	n := (length + 7) / 8
+	out := make([]byte, n)
+	cipher.NewCTR(block, iv[:]).XORKeyStream(out, msg[:n])
+	if length%8 != 0 {
+		out[n-1] &= 0xff << uint(8-length%8)
+	}
+	return out
+}
+
+func xorBytes(dst, a, b []byte) {
+	for i := range dst {
+		dst[i] = a[i] ^ b[i]
+	}
+}
+
+// cmacShift returns b << 1 xored with Rb when the MSB is set.
+func cmacShift(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := 0; i < len(b); i++ {
+		out[i] = b[i] << 1
+		if i+1 < len(b) {
+			out[i] |= b[i+1] >> 7
+		}
+	}
+	if b[0]&0x80 != 0 {
+		out[len(out)-1] ^= 0x87
+	}
+	return out
+}
+
+// cmac is AES-CMAC of RFC 4493 extended to bit length message as NIST
+// SP 800-38B.
+func cmac(block cipher.Block, msg []byte, length int) []byte {
+	bs := aes.BlockSize
+	l := make([]byte, bs)
+	block.Encrypt(l, l)
+	k1 := cmacShift(l)
+	k2 := cmacShift(k1)
+
+	n := (length + bs*8 - 1) / (bs * 8)
+	complete := n > 0 && length%(bs*8) == 0
+	if n == 0 {
+		n = 1
+	}
+	x := make([]byte, bs)
+	for i := 0; i < n-1; i++ {
+		xorBytes(x, x, msg[i*bs:(i+1)*bs])
+		block.Encrypt(x, x)
+	}
+
+	last := make([]byte, bs)
+	rest := length - (n-1)*bs*8
+	copy(last, msg[(n-1)*bs:(n-1)*bs+(rest+7)/8])
+	if complete {
+		xorBytes(last, last, k1)
+	} else {
+		if rest%8 != 0 {
+			last[rest/8] &= 0xff << uint(8-rest%8)
+		}
+		last[rest/8] |= 0x80 >> uint(rest%8)
+		xorBytes(last, last, k2)
+	}
+	xorBytes(x, x, last)
+	block.Encrypt(x, x)
+	return x
+}
+
+// eia2 is 128-EIA2 which is AES-CMAC. length is in bits.
+func eia2(key []byte, count uint32, bearer, dir uint8, msg []byte, length int) uint32 {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return 0
+	}
+	m := make([]byte, 8, 8+len(msg))
+	binary.BigEndian.PutUint32(m[0:], count)
+	m[4] = (bearer&0x1f)<<3 | (dir&1)<<2
+	m = append(m, msg...)
+	return binary.BigEndian.Uint32(cmac(block, m, 64+length))
+}
+
+// ComputeMAC computes NAS MAC of the message with the integrity algorithm.
+func ComputeMAC(alg uint8, key []byte, count uint32, dir uint8, msg []byte) (uint32, error) {
+	if alg != EIA0 && len(key) != 16 {
+		return 0, fmt.Errorf("invalid NAS integrity key length %d", len(key))
+	}
+	switch alg {
+	case EIA0:
+		return 0, nil
+	case EIA1:
+		return eia1(key, count, nasBearer, dir, msg, len(msg)*8), nil
+	case EIA2:
+		return eia2(key, count, nasBearer, dir, msg, len(msg)*8), nil
+	case EIA3:
+		return eia3(key, count, nasBearer, dir, msg, len(msg)*8), nil
+	}
+	return 0, fmt.Errorf("unsupported integrity algorithm EIA%d", alg)
+}
+
+// Cipher ciphers or deciphers the message with the ciphering algorithm.
+func Cipher(alg uint8, key []byte, count uint32, dir uint8, msg []byte) ([]byte, error) {
+	if alg != EEA0 && len(key) != 16 {
+		return nil, fmt.Errorf("invalid NAS encryption key length %d", len(key))
+	}
+	switch alg {
+	case EEA0:
+		return append([]byte{}, msg...), nil
+	case EEA1:
+		return eea1(key, count, nasBearer, dir, msg, len(msg)*8), nil
+	case EEA2:
+		return eea2(key, count, nasBearer, dir, msg, len(msg)*8), nil
+	case EEA3:
+		return eea3(key, count, nasBearer, dir, msg, len(msg)*8), nil
+	}
+	return nil, fmt.Errorf("unsupported ciphering algorithm EEA%d", alg)
+}
+
+// macInput returns the sequence number and the payload which MAC is
+// calculated over.
+func (m *SecurityProtectedMessage) macInput() []byte {
+	return append([]byte{m.SequenceNumber}, m.Payload...)
+}
+
+// Protect returns security protected message of the plain NAS message
+// encoded in pdu. The payload is ciphered when the security header type
+// requires it. count is the NAS COUNT of the message.
+func Protect(pdu []byte, sh uint8, algs SecurityAlgorithms, kint, kenc []byte,
+	count uint32, dir uint8) (*SecurityProtectedMessage, error) {
+	m := &SecurityProtectedMessage{
+		SecurityHeaderType: sh,
+		SequenceNumber:     uint8(count),
+		Payload:            pdu,
+	}
+	if m.Ciphered() {
+		var err error
+		if m.Payload, err = Cipher(algs.Ciphering, kenc, count, dir, pdu); err != nil {
+			return nil, err
+		}
+	}
+	mac, err := ComputeMAC(algs.Integrity, kint, count, dir, m.macInput())
+	if err != nil {
+		return nil, err
+	}
+	m.MAC = mac
+	return m, nil
+}
+
+// Verify checks MAC of the message. ErrIntegrity is returned on mismatch.
+func (m *SecurityProtectedMessage) Verify(alg uint8, kint []byte, count uint32, dir uint8) error {
+	if alg == EIA0 {
+		return nil
+	}
+	mac, err := ComputeMAC(alg, kint, count, dir, m.macInput())
+	if err != nil {
+		return err
+	}
+	if mac != m.MAC {
+		return ErrIntegrity
+	}
+	return nil
+}
+
+// Unprotect verifies MAC of the message and returns the deciphered plain
+// NAS message.
+func (m *SecurityProtectedMessage) Unprotect(algs SecurityAlgorithms, kint, kenc []byte,
+	count uint32, dir uint8) ([]byte, error) {
+	if err := m.Verify(algs.Integrity, kint, count, dir); err != nil {
+		return nil, err
+	}
+	if !m.Ciphered() {
+		return m.Payload, nil
+	}
+	return Cipher(algs.Ciphering, kenc, count, dir, m.Payload)
+}
@@ -0,0 +1,176 @@
+package nas
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func unhex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// cipheringTests are test set 1 of 128-EEA1 and 128-EEA2 of TS 33.401
+// Annex C and of 128-EEA3 of ETSI/SAGE 128-EEA3 & 128-EIA3 Document 3.
+var cipheringTests = []struct {
+	name   string
+	eea    func(key []byte, count uint32, bearer, dir uint8, msg []byte, length int) []byte
+	key    string
+	count  uint32
+	bearer uint8
+	dir    uint8
+	length int
+	plain  string
+	cipher string
+}{
+	{
+		name:   "128-EEA1",
+		eea:    eea1,
+		key:    "d3c5d592327fb11c4035c6680af8c6d1",
+		count:  0x398a59b4,
+		bearer: 0x15,
+		dir:    1,
+		length: 253,
+		plain:  "981ba6824c1bfb1ab485472029b71d808ce33e2cc3c0b5fc1f3de8a6dc66b1f0",
+		cipher: "5d5bfe75eb04f68ce0a12377ea00b37d47c6a0ba06309155086a859c4341b378",
+	},
+	{
+		name:   "128-EEA2",
+		eea:    eea2,
+		key:    "d3c5d592327fb11c4035c6680af8c6d1",
+		count:  0x398a59b4,
+		bearer: 0x15,
+		dir:    1,
+		length: 253,
+		plain:  "981ba6824c1bfb1ab485472029b71d808ce33e2cc3c0b5fc1f3de8a6dc66b1f0",
+		cipher: "e9fed8a63d155304d71df20bf3e82214b20ed7dad2f233dc3c22d7bdeeed8e78",
+	},
+	{
+		name:   "128-EEA3",
+		eea:    eea3,
+		key:    "173d14ba5003731d7a60049470f00a29",
+		count:  0x66035492,
+		bearer: 0x0f,
+		dir:    0,
+		length: 193,
+		plain:  "6cf65340735552ab0c9752fa6f9025fe0bd675d9005875b200",
+		cipher: "a6c85fc66afb8533aafc2518dfe784940ee1e4b030238cc800",
+	},
+}
+
+func TestCipheringAlgorithms(t *testing.T) {
+	for _, tt := range cipheringTests {
+		key, plain, want := unhex(tt.key), unhex(tt.plain), unhex(tt.cipher)
+		got := tt.eea(key, tt.count, tt.bearer, tt.dir, plain, tt.length)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: ciphered %x, want %x", tt.name, got, want)
+		}
+		got = tt.eea(key, tt.count, tt.bearer, tt.dir, want, tt.length)
+		if !bytes.Equal(got, plain) {
+			t.Errorf("%s: deciphered %x, want %x", tt.name, got, plain)
+		}
+	}
+}
+
+// integrityTests are test set 1 of 128-EIA1 and 128-EIA2 of TS 33.401
+// Annex C and of 128-EIA3 of ETSI/SAGE 128-EEA3 & 128-EIA3 Document 3.
+var integrityTests = []struct {
+	name   string
+	eia    func(key []byte, count uint32, bearer, dir uint8, msg []byte, length int) uint32
+	key    string
+	count  uint32
+	bearer uint8
+	dir    uint8
+	length int
+	msg    string
+	mac    uint32
+}{
+	{
+		name:   "128-EIA1",
+		eia:    eia1,
+		key:    "2bd6459f82c5b300952c49104881ff48",
+		count:  0x38a6f056,
+		bearer: 0x1f,
+		dir:    0,
+		length: 88,
+		msg:    "3332346263393861373479",
+		mac:    0x731f1165,
+	},
+	{
+		name:   "128-EIA2",
+		eia:    eia2,
+		key:    "2bd6459f82c5b300952c49104881ff48",
+		count:  0x38a6f056,
+		bearer: 0x18,
+		dir:    0,
+		length: 58,
+		msg:    "3332346263393840",
+		mac:    0x118c6eb8,
+	},
+	{
+		name:   "128-EIA3",
+		eia:    eia3,
+		key:    "00000000000000000000000000000000",
+		count:  0,
+		bearer: 0,
+		dir:    0,
+		length: 1,
+		msg:    "00000000",
+		mac:    0xc8a9595e,
+	},
+}
+
+func TestIntegrityAlgorithms(t *testing.T) {
+	for _, tt := range integrityTests {
+		mac := tt.eia(unhex(tt.key), tt.count, tt.bearer, tt.dir, unhex(tt.msg), tt.length)
+		if mac != tt.mac {
+			t.Errorf("%s: MAC %08x, want %08x", tt.name, mac, tt.mac)
+		}
+	}
+}
+
+// TestUIA2 checks the f9 function of 128-EIA1 with test set 1 of ETSI/SAGE
+// UEA2 & UIA2 Document 4. Its FRESH has low order bits which the bearer of
+// 128-EIA1 never sets.
+func TestUIA2(t *testing.T) {
+	key := unhex("2bd6459f82c5b300952c49104881ff48")
+	msg := unhex("6b227737296f393c8079353edc87e2e805d2ec49a4f2d8e0")
+	if mac := uia2(key, 0x38a6f056, 0x05d2ec49, 0, msg, 189); mac != 0x2bce1820 {
+		t.Errorf("MAC %08x, want 2bce1820", mac)
+	}
+}
+
+func TestProtect(t *testing.T) {
+	pdu, _ := Encode(&AttachComplete{ESMMessageContainer: []byte{0x52, 0x00, 0xc2}})
+	kint := unhex("2bd6459f82c5b300952c49104881ff48")
+	kenc := unhex("d3c5d592327fb11c4035c6680af8c6d1")
+	for alg := uint8(EEA0); alg <= EEA3; alg++ {
+		algs := SecurityAlgorithms{Ciphering: alg, Integrity: alg}
+		if alg == EEA0 {
+			algs.Integrity = EIA2
+		}
+		m, err := Protect(pdu, SECURITY_HEADER_INTEGRITY_CIPHERED, algs, kint, kenc, 0x105, DIRECTION_UPLINK)
+		if err != nil {
+			t.Fatalf("%+v: %v", algs, err)
+		}
+		if m.SequenceNumber != 0x05 || (alg != EEA0 && bytes.Equal(m.Payload, pdu)) {
+			t.Errorf("%+v: protected %+v", algs, m)
+		}
+		b, _ := Encode(m)
+		d, err := Decode(b)
+		if err != nil {
+			t.Fatalf("%+v: %v", algs, err)
+		}
+		plain, err := d.(*SecurityProtectedMessage).Unprotect(algs, kint, kenc, 0x105, DIRECTION_UPLINK)
+		if err != nil || !bytes.Equal(plain, pdu) {
+			t.Errorf("%+v: unprotected % x, %v", algs, plain, err)
+		}
+		if _, err := d.(*SecurityProtectedMessage).Unprotect(algs, kint, kenc, 0x105, DIRECTION_DOWNLINK); err != ErrIntegrity {
+			t.Errorf("%+v: MAC of other direction is %v", algs, err)
+		}
+	}
+}
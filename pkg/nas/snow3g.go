@@ -0,0 +1,240 @@
+package nas
+
+import (
+	"encoding/binary"
+)
+
+// SNOW 3G stream cipher (ETSI/SAGE UEA2 & UIA2 Document 2) which is the
+// core of 128-EEA1 and 128-EIA1.
+
+var (
+	snow3gSR [256]uint8
+	snow3gSQ [256]uint8
+	snow3gMA [256]uint32
+	snow3gDA [256]uint32
+)
+
+// gfMul multiplies a and b in GF(2^8) reduced by poly.
+func gfMul(a, b uint8, poly uint16) uint8 {
+	var p uint16
+	x := uint16(a)
+	for ; b != 0; b >>= 1 {
+		if b&1 != 0 {
+			p ^= x
+		}
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= poly
+		}
+	}
+	return uint8(p)
+}
+
+// gfPow returns a^n in GF(2^8) reduced by poly.
+func gfPow(a uint8, n int, poly uint16) uint8 {
+	r := uint8(1)
+	for ; n > 0; n-- {
+		r = gfMul(r, a, poly)
+	}
+	return r
+}
+
+// aesSbox returns the AES S-box value of x.
+func aesSbox(x uint8) uint8 {
+	// Multiplicative inverse x^254 followed by the affine transformation.
+	b := gfPow(x, 254, 0x11b)
+	rotl := func(v uint8, n uint) uint8 { return v<<n | v>>(8-n) }
+	return b ^ rotl(b, 1) ^ rotl(b, 2) ^ rotl(b, 3) ^ rotl(b, 4) ^ 0x63
+}
+
+func snow3gMULx(v, c uint8) uint8 {
+	if v&0x80 != 0 {
+		return v<<1 ^ c
+	}
+	return v << 1
+}
+
+func snow3gMULxPOW(v uint8, i int, c uint8) uint8 {
+	for ; i > 0; i-- {
+		v = snow3gMULx(v, c)
+	}
+	return v
+}
+
+func init() {
+	for i := 0; i < 256; i++ {
+		x := uint8(i)
+		snow3gSR[i] = aesSbox(x)
+
+		// SQ is Dickson polynomial g49 over GF(2^8) with x^8+x^6+x^5+x^3+1.
+		var y uint8
+		for _, e := range []int{1, 9, 13, 15, 33, 41, 45, 47, 49} {
+			y ^= gfPow(x, e, 0x169)
+		}
+		snow3gSQ[i] = y ^ 0x25
+
+		snow3gMA[i] = uint32(snow3gMULxPOW(x, 23, 0xa9))<<24 |
+			uint32(snow3gMULxPOW(x, 245, 0xa9))<<16 |
+			uint32(snow3gMULxPOW(x, 48, 0xa9))<<8 |
+			uint32(snow3gMULxPOW(x, 239, 0xa9))
+		snow3gDA[i] = uint32(snow3gMULxPOW(x, 16, 0xa9))<<24 |
+			uint32(snow3gMULxPOW(x, 39, 0xa9))<<16 |
+			uint32(snow3gMULxPOW(x, 6, 0xa9))<<8 |
+			uint32(snow3gMULxPOW(x, 64, 0xa9))
+	}
+}
+
+// snow3gS is S-Box S1 or S2 of SNOW 3G built on the byte S-box and the
+// reduction constant.
+func snow3gS(w uint32, box *[256]uint8, c uint8) uint32 {
+	s0 := box[w>>24]
+	s1 := box[w>>16&0xff]
+	s2 := box[w>>8&0xff]
+	s3 := box[w&0xff]
+	r0 := snow3gMULx(s0, c) ^ s1 ^ s2 ^ snow3gMULx(s3, c) ^ s3
+	r1 := snow3gMULx(s0, c) ^ s0 ^ snow3gMULx(s1, c) ^ s2 ^ s3
+	r2 := s0 ^ snow3gMULx(s1, c) ^ s1 ^ snow3gMULx(s2, c) ^ s3
+	r3 := s0 ^ s1 ^ snow3gMULx(s2, c) ^ s2 ^ snow3gMULx(s3, c)
+	return uint32(r0)<<24 | uint32(r1)<<16 | uint32(r2)<<8 | uint32(r3)
+}
+
+type snow3g struct {
+	s          [16]uint32
+	r1, r2, r3 uint32
+}
+
+func (g *snow3g) clockFSM() uint32 {
+	f := (g.s[15] + g.r1) ^ g.r2
+	r := g.r2 + (g.r3 ^ g.s[5])
+	g.r3 = snow3gS(g.r2, &snow3gSQ, 0x69)
+	g.r2 = snow3gS(g.r1, &snow3gSR, 0x1b)
+	g.r1 = r
+	return f
+}
+
+func (g *snow3g) clockLFSR(f uint32) {
+	s0, s11 := g.s[0], g.s[11]
+	v := s0<<8 ^ snow3gMA[s0>>24] ^ g.s[2] ^ s11>>8 ^ snow3gDA[s11&0xff] ^ f
+	copy(g.s[:15], g.s[1:])
+	g.s[15] = v
+}
+
+// newSNOW3G initializes SNOW 3G with the 128-bit key and IV words.
+func newSNOW3G(key []byte, iv [4]uint32) *snow3g {
+	var k [4]uint32
+	for i := 0; i < 4; i++ {
+		k[3-i] = binary.BigEndian.Uint32(key[i*4:])
+	}
+	g := &snow3g{}
+	g.s[15] = k[3] ^ iv[0]
+	g.s[14] = k[2]
+	g.s[13] = k[1]
+	g.s[12] = k[0] ^ iv[1]
+	g.s[11] = k[3] ^ 0xffffffff
+	g.s[10] = k[2] ^ 0xffffffff ^ iv[2]
+	g.s[9] = k[1] ^ 0xffffffff ^ iv[3]
+	g.s[8] = k[0] ^ 0xffffffff
+	g.s[7] = k[3]
+	g.s[6] = k[2]
+	g.s[5] = k[1]
+	g.s[4] = k[0]
+	g.s[3] = k[3] ^ 0xffffffff
+	g.s[2] = k[2] ^ 0xffffffff
+	g.s[1] = k[1] ^ 0xffffffff
+	g.s[0] = k[0] ^ 0xffffffff
+	for i := 0; i < 32; i++ {
+		g.clockLFSR(g.clockFSM())
+	}
+	g.clockFSM()
+	g.clockLFSR(0)
+	return g
+}
+
+// next returns next keystream word.
+func (g *snow3g) next() uint32 {
+	z := g.clockFSM() ^ g.s[0]
+	g.clockLFSR(0)
+	return z
+}
+
+// eea1 is 128-EEA1. length is in bits.
+func eea1(key []byte, count uint32, bearer, dir uint8, msg []byte, length int) []byte {
+	iv2 := uint32(bearer&0x1f)<<27 | uint32(dir&1)<<26
+	g := newSNOW3G(key, [4]uint32{iv2, count, iv2, count})
+	return xorKeystream(msg, length, g.next)
+}
+
+// snow3gMUL64 multiplies v and p in GF(2^64) reduced by c.
+func snow3gMUL64(v, p, c uint64) uint64 {
+	var r uint64
+	for i := 0; i < 64; i++ {
+		if p>>uint(i)&1 != 0 {
+			r ^= v
+		}
+		if v&0x8000000000000000 != 0 {
+			v = v<<1 ^ c
+		} else {
+			v <<= 1
+		}
+	}
+	return r
+}
+
+// eia1 is 128-EIA1. length is in bits.
+func eia1(key []byte, count uint32, bearer, dir uint8, msg []byte, length int) uint32 {
+	return uia2(key, count, uint32(bearer&0x1f)<<27, dir, msg, length)
+}
+
+// uia2 is UIA2 f9 function which 128-EIA1 calls with the bearer as FRESH.
+func uia2(key []byte, count, fresh uint32, dir uint8, msg []byte, length int) uint32 {
+	g := newSNOW3G(key, [4]uint32{
+		fresh ^ uint32(dir&1)<<15,
+		count ^ uint32(dir&1)<<31,
+		fresh,
+		count,
+	})
+	z1, z2, z3, z4, z5 := g.next(), g.next(), g.next(), g.next(), g.next()
+	p := uint64(z1)<<32 | uint64(z2)
+	q := uint64(z3)<<32 | uint64(z4)
+
+	var eval uint64
+	blocks := (length + 63) / 64
+	for i := 0; i < blocks; i++ {
+		var b [8]byte
+		copy(b[:], msg[i*8:min(len(msg), i*8+8)])
+		m := binary.BigEndian.Uint64(b[:])
+		if rest := length - i*64; rest < 64 {
+			m &^= (1<<uint(64-rest) - 1)
+		}
+		eval = snow3gMUL64(eval^m, p, 0x1b)
+	}
+	eval ^= uint64(length)
+	eval = snow3gMUL64(eval, q, 0x1b)
+	return uint32(eval>>32) ^ z5
+}
+
+// xorKeystream xors the first length bits of msg with the keystream words.
+// Trailing bits of the last byte are cleared.
+func xorKeystream(msg []byte, length int, next func() uint32) []byte {
+	n := (length + 7) / 8
+	out := make([]byte, n)
+	copy(out, msg)
+	var ks [4]byte
+	for i := 0; i < n; i += 4 {
+		binary.BigEndian.PutUint32(ks[:], next())
+		for j := 0; j < 4 && i+j < n; j++ {
+			out[i+j] ^= ks[j]
+		}
+	}
+	if length%8 != 0 {
+		out[n-1] &= 0xff << uint(8-length%8)
+	}
+	return out
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -1,63 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "AssistanceDataForCECapableUEs.h"
-
-#include "ProtocolExtensionContainer.h"
-asn_TYPE_member_t asn_MBR_AssistanceDataForCECapableUEs_1[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForCECapableUEs, cellIdentifierAndCELevelForCECapableUEs),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_CellIdentifierAndCELevelForCECapableUEs,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"cellIdentifierAndCELevelForCECapableUEs"
-		},
-	{ ATF_POINTER, 1, offsetof(struct AssistanceDataForCECapableUEs, iE_Extensions),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionContainer_6602P22,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"iE-Extensions"
-		},
-};
-static const int asn_MAP_AssistanceDataForCECapableUEs_oms_1[] = { 1 };
-static const ber_tlv_tag_t asn_DEF_AssistanceDataForCECapableUEs_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_AssistanceDataForCECapableUEs_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* cellIdentifierAndCELevelForCECapableUEs */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 } /* iE-Extensions */
-};
-asn_SEQUENCE_specifics_t asn_SPC_AssistanceDataForCECapableUEs_specs_1 = {
-	sizeof(struct AssistanceDataForCECapableUEs),
-	offsetof(struct AssistanceDataForCECapableUEs, _asn_ctx),
-	asn_MAP_AssistanceDataForCECapableUEs_tag2el_1,
-	2,	/* Count of tags in the map */
-	asn_MAP_AssistanceDataForCECapableUEs_oms_1,	/* Optional members */
-	1, 0,	/* Root/Additions */
-	2,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_AssistanceDataForCECapableUEs = {
-	"AssistanceDataForCECapableUEs",
-	"AssistanceDataForCECapableUEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_AssistanceDataForCECapableUEs_tags_1,
-	sizeof(asn_DEF_AssistanceDataForCECapableUEs_tags_1)
-		/sizeof(asn_DEF_AssistanceDataForCECapableUEs_tags_1[0]), /* 1 */
-	asn_DEF_AssistanceDataForCECapableUEs_tags_1,	/* Same as above */
-	sizeof(asn_DEF_AssistanceDataForCECapableUEs_tags_1)
-		/sizeof(asn_DEF_AssistanceDataForCECapableUEs_tags_1[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_AssistanceDataForCECapableUEs_1,
-	2,	/* Elements count */
-	&asn_SPC_AssistanceDataForCECapableUEs_specs_1	/* Additional specs */
-};
-
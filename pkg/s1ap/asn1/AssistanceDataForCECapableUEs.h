@@ -1,48 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_AssistanceDataForCECapableUEs_H_
-#define	_AssistanceDataForCECapableUEs_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "CellIdentifierAndCELevelForCECapableUEs.h"
-#include <constr_SEQUENCE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Forward declarations */
-struct ProtocolExtensionContainer;
-
-/* AssistanceDataForCECapableUEs */
-typedef struct AssistanceDataForCECapableUEs {
-	CellIdentifierAndCELevelForCECapableUEs_t	 cellIdentifierAndCELevelForCECapableUEs;
-	struct ProtocolExtensionContainer	*iE_Extensions;	/* OPTIONAL */
-	/*
-	 * This type is extensible,
-	 * possible extensions are below.
-	 */
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} AssistanceDataForCECapableUEs_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_AssistanceDataForCECapableUEs;
-extern asn_SEQUENCE_specifics_t asn_SPC_AssistanceDataForCECapableUEs_specs_1;
-extern asn_TYPE_member_t asn_MBR_AssistanceDataForCECapableUEs_1[2];
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _AssistanceDataForCECapableUEs_H_ */
-#include <asn_internal.h>
@@ -1,53 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_CSGMembershipStatus_H_
-#define	_CSGMembershipStatus_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeEnumerated.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum CSGMembershipStatus {
-	CSGMembershipStatus_member	= 0,
-	CSGMembershipStatus_not_member	= 1
-} e_CSGMembershipStatus;
-
-/* CSGMembershipStatus */
-typedef long	 CSGMembershipStatus_t;
-
-/* Implementation */
-extern asn_per_constraints_t asn_PER_type_CSGMembershipStatus_constr_1;
-extern asn_TYPE_descriptor_t asn_DEF_CSGMembershipStatus;
-extern const asn_INTEGER_specifics_t asn_SPC_CSGMembershipStatus_specs_1;
-asn_struct_free_f CSGMembershipStatus_free;
-asn_struct_print_f CSGMembershipStatus_print;
-asn_constr_check_f CSGMembershipStatus_constraint;
-ber_type_decoder_f CSGMembershipStatus_decode_ber;
-der_type_encoder_f CSGMembershipStatus_encode_der;
-xer_type_decoder_f CSGMembershipStatus_decode_xer;
-xer_type_encoder_f CSGMembershipStatus_encode_xer;
-oer_type_decoder_f CSGMembershipStatus_decode_oer;
-oer_type_encoder_f CSGMembershipStatus_encode_oer;
-per_type_decoder_f CSGMembershipStatus_decode_uper;
-per_type_encoder_f CSGMembershipStatus_encode_uper;
-per_type_decoder_f CSGMembershipStatus_decode_aper;
-per_type_encoder_f CSGMembershipStatus_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _CSGMembershipStatus_H_ */
-#include <asn_internal.h>
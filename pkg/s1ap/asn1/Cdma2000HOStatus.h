@@ -1,54 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_Cdma2000HOStatus_H_
-#define	_Cdma2000HOStatus_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeEnumerated.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum Cdma2000HOStatus {
-	Cdma2000HOStatus_hOSuccess	= 0,
-	Cdma2000HOStatus_hOFailure	= 1
-	/*
-	 * Enumeration is extensible
-	 */
-} e_Cdma2000HOStatus;
-
-/* Cdma2000HOStatus */
-typedef long	 Cdma2000HOStatus_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_Cdma2000HOStatus;
-asn_struct_free_f Cdma2000HOStatus_free;
-asn_struct_print_f Cdma2000HOStatus_print;
-asn_constr_check_f Cdma2000HOStatus_constraint;
-ber_type_decoder_f Cdma2000HOStatus_decode_ber;
-der_type_encoder_f Cdma2000HOStatus_encode_der;
-xer_type_decoder_f Cdma2000HOStatus_decode_xer;
-xer_type_encoder_f Cdma2000HOStatus_encode_xer;
-oer_type_decoder_f Cdma2000HOStatus_decode_oer;
-oer_type_encoder_f Cdma2000HOStatus_encode_oer;
-per_type_decoder_f Cdma2000HOStatus_decode_uper;
-per_type_encoder_f Cdma2000HOStatus_encode_uper;
-per_type_decoder_f Cdma2000HOStatus_decode_aper;
-per_type_encoder_f Cdma2000HOStatus_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _Cdma2000HOStatus_H_ */
-#include <asn_internal.h>
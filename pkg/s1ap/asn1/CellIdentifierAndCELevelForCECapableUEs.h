@@ -1,50 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_CellIdentifierAndCELevelForCECapableUEs_H_
-#define	_CellIdentifierAndCELevelForCECapableUEs_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "EUTRAN-CGI.h"
-#include "CELevel.h"
-#include <constr_SEQUENCE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Forward declarations */
-struct ProtocolExtensionContainer;
-
-/* CellIdentifierAndCELevelForCECapableUEs */
-typedef struct CellIdentifierAndCELevelForCECapableUEs {
-	EUTRAN_CGI_t	 global_Cell_ID;
-	CELevel_t	 cELevel;
-	struct ProtocolExtensionContainer	*iE_Extensions;	/* OPTIONAL */
-	/*
-	 * This type is extensible,
-	 * possible extensions are below.
-	 */
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} CellIdentifierAndCELevelForCECapableUEs_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_CellIdentifierAndCELevelForCECapableUEs;
-extern asn_SEQUENCE_specifics_t asn_SPC_CellIdentifierAndCELevelForCECapableUEs_specs_1;
-extern asn_TYPE_member_t asn_MBR_CellIdentifierAndCELevelForCECapableUEs_1[3];
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _CellIdentifierAndCELevelForCECapableUEs_H_ */
-#include <asn_internal.h>
@@ -1,190 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-PDU-Contents"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_E_RAB_IE_ContainerList_H_
-#define	_E_RAB_IE_ContainerList_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "ProtocolIE-ContainerList.h"
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* E-RAB-IE-ContainerList */
-typedef ProtocolIE_ContainerList_6588P0_t	 E_RAB_IE_ContainerList_1142P0_t;
-typedef ProtocolIE_ContainerList_6588P1_t	 E_RAB_IE_ContainerList_1142P1_t;
-typedef ProtocolIE_ContainerList_6588P2_t	 E_RAB_IE_ContainerList_1142P2_t;
-typedef ProtocolIE_ContainerList_6588P3_t	 E_RAB_IE_ContainerList_1142P3_t;
-typedef ProtocolIE_ContainerList_6588P4_t	 E_RAB_IE_ContainerList_1142P4_t;
-typedef ProtocolIE_ContainerList_6588P5_t	 E_RAB_IE_ContainerList_1142P5_t;
-typedef ProtocolIE_ContainerList_6588P6_t	 E_RAB_IE_ContainerList_1142P6_t;
-typedef ProtocolIE_ContainerList_6588P7_t	 E_RAB_IE_ContainerList_1142P7_t;
-typedef ProtocolIE_ContainerList_6588P8_t	 E_RAB_IE_ContainerList_1142P8_t;
-typedef ProtocolIE_ContainerList_6588P9_t	 E_RAB_IE_ContainerList_1142P9_t;
-
-/* Implementation */
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P0_constr_1;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P0;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P0_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P0_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P0_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P0_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P0_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P0_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P0_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P0_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P0_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P0_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P0_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P0_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P0_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P1_constr_2;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P1;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P1_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P1_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P1_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P1_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P1_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P1_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P1_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P1_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P1_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P1_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P1_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P1_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P1_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P2_constr_3;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P2;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P2_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P2_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P2_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P2_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P2_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P2_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P2_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P2_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P2_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P2_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P2_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P2_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P2_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P3_constr_4;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P3;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P3_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P3_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P3_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P3_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P3_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P3_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P3_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P3_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P3_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P3_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P3_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P3_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P3_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P4_constr_5;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P4;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P4_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P4_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P4_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P4_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P4_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P4_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P4_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P4_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P4_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P4_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P4_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P4_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P4_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P5_constr_6;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P5;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P5_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P5_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P5_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P5_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P5_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P5_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P5_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P5_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P5_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P5_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P5_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P5_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P5_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P6_constr_7;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P6;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P6_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P6_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P6_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P6_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P6_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P6_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P6_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P6_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P6_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P6_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P6_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P6_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P6_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P7_constr_8;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P7;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P7_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P7_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P7_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P7_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P7_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P7_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P7_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P7_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P7_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P7_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P7_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P7_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P7_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P8_constr_9;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P8;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P8_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P8_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P8_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P8_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P8_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P8_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P8_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P8_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P8_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P8_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P8_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P8_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P8_encode_aper;
-extern asn_per_constraints_t asn_PER_type_E_RAB_IE_ContainerList_1142P9_constr_10;
-extern asn_TYPE_descriptor_t asn_DEF_E_RAB_IE_ContainerList_1142P9;
-asn_struct_free_f E_RAB_IE_ContainerList_1142P9_free;
-asn_struct_print_f E_RAB_IE_ContainerList_1142P9_print;
-asn_constr_check_f E_RAB_IE_ContainerList_1142P9_constraint;
-ber_type_decoder_f E_RAB_IE_ContainerList_1142P9_decode_ber;
-der_type_encoder_f E_RAB_IE_ContainerList_1142P9_encode_der;
-xer_type_decoder_f E_RAB_IE_ContainerList_1142P9_decode_xer;
-xer_type_encoder_f E_RAB_IE_ContainerList_1142P9_encode_xer;
-oer_type_decoder_f E_RAB_IE_ContainerList_1142P9_decode_oer;
-oer_type_encoder_f E_RAB_IE_ContainerList_1142P9_encode_oer;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P9_decode_uper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P9_encode_uper;
-per_type_decoder_f E_RAB_IE_ContainerList_1142P9_decode_aper;
-per_type_encoder_f E_RAB_IE_ContainerList_1142P9_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _E_RAB_IE_ContainerList_H_ */
-#include <asn_internal.h>
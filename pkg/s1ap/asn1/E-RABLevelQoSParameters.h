@@ -1,52 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_E_RABLevelQoSParameters_H_
-#define	_E_RABLevelQoSParameters_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "QCI.h"
-#include "AllocationAndRetentionPriority.h"
-#include <constr_SEQUENCE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Forward declarations */
-struct GBR_QosInformation;
-struct ProtocolExtensionContainer;
-
-/* E-RABLevelQoSParameters */
-typedef struct E_RABLevelQoSParameters {
-	QCI_t	 qCI;
-	AllocationAndRetentionPriority_t	 allocationRetentionPriority;
-	struct GBR_QosInformation	*gbrQosInformation;	/* OPTIONAL */
-	struct ProtocolExtensionContainer	*iE_Extensions;	/* OPTIONAL */
-	/*
-	 * This type is extensible,
-	 * possible extensions are below.
-	 */
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} E_RABLevelQoSParameters_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_E_RABLevelQoSParameters;
-extern asn_SEQUENCE_specifics_t asn_SPC_E_RABLevelQoSParameters_specs_1;
-extern asn_TYPE_member_t asn_MBR_E_RABLevelQoSParameters_1[4];
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _E_RABLevelQoSParameters_H_ */
-#include <asn_internal.h>
@@ -1,46 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_IntendedNumberOfPagingAttempts_H_
-#define	_IntendedNumberOfPagingAttempts_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeInteger.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* IntendedNumberOfPagingAttempts */
-typedef long	 IntendedNumberOfPagingAttempts_t;
-
-/* Implementation */
-extern asn_per_constraints_t asn_PER_type_IntendedNumberOfPagingAttempts_constr_1;
-extern asn_TYPE_descriptor_t asn_DEF_IntendedNumberOfPagingAttempts;
-asn_struct_free_f IntendedNumberOfPagingAttempts_free;
-asn_struct_print_f IntendedNumberOfPagingAttempts_print;
-asn_constr_check_f IntendedNumberOfPagingAttempts_constraint;
-ber_type_decoder_f IntendedNumberOfPagingAttempts_decode_ber;
-der_type_encoder_f IntendedNumberOfPagingAttempts_encode_der;
-xer_type_decoder_f IntendedNumberOfPagingAttempts_decode_xer;
-xer_type_encoder_f IntendedNumberOfPagingAttempts_encode_xer;
-oer_type_decoder_f IntendedNumberOfPagingAttempts_decode_oer;
-oer_type_encoder_f IntendedNumberOfPagingAttempts_encode_oer;
-per_type_decoder_f IntendedNumberOfPagingAttempts_decode_uper;
-per_type_encoder_f IntendedNumberOfPagingAttempts_encode_uper;
-per_type_decoder_f IntendedNumberOfPagingAttempts_decode_aper;
-per_type_encoder_f IntendedNumberOfPagingAttempts_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _IntendedNumberOfPagingAttempts_H_ */
-#include <asn_internal.h>
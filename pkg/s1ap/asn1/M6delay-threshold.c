@@ -1,78 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "M6delay-threshold.h"
-
-/*
- * This type is implemented using NativeEnumerated,
- * so here we adjust the DEF accordingly.
- */
-static asn_oer_constraints_t asn_OER_type_M6delay_threshold_constr_1 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-asn_per_constraints_t asn_PER_type_M6delay_threshold_constr_1 CC_NOTUSED = {
-	{ APC_CONSTRAINED | APC_EXTENSIBLE,  4,  4,  0,  11 }	/* (0..11,...) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static const asn_INTEGER_enum_map_t asn_MAP_M6delay_threshold_value2enum_1[] = {
-	{ 0,	4,	"ms30" },
-	{ 1,	4,	"ms40" },
-	{ 2,	4,	"ms50" },
-	{ 3,	4,	"ms60" },
-	{ 4,	4,	"ms70" },
-	{ 5,	4,	"ms80" },
-	{ 6,	4,	"ms90" },
-	{ 7,	5,	"ms100" },
-	{ 8,	5,	"ms150" },
-	{ 9,	5,	"ms300" },
-	{ 10,	5,	"ms500" },
-	{ 11,	5,	"ms750" }
-	/* This list is extensible */
-};
-static const unsigned int asn_MAP_M6delay_threshold_enum2value_1[] = {
-	7,	/* ms100(7) */
-	8,	/* ms150(8) */
-	0,	/* ms30(0) */
-	9,	/* ms300(9) */
-	1,	/* ms40(1) */
-	2,	/* ms50(2) */
-	10,	/* ms500(10) */
-	3,	/* ms60(3) */
-	4,	/* ms70(4) */
-	11,	/* ms750(11) */
-	5,	/* ms80(5) */
-	6	/* ms90(6) */
-	/* This list is extensible */
-};
-const asn_INTEGER_specifics_t asn_SPC_M6delay_threshold_specs_1 = {
-	asn_MAP_M6delay_threshold_value2enum_1,	/* "tag" => N; sorted by tag */
-	asn_MAP_M6delay_threshold_enum2value_1,	/* N => "tag"; sorted by N */
-	12,	/* Number of elements in the maps */
-	13,	/* Extensions before this member */
-	1,	/* Strict enumeration */
-	0,	/* Native long size */
-	0
-};
-static const ber_tlv_tag_t asn_DEF_M6delay_threshold_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (10 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_M6delay_threshold = {
-	"M6delay-threshold",
-	"M6delay-threshold",
-	&asn_OP_NativeEnumerated,
-	asn_DEF_M6delay_threshold_tags_1,
-	sizeof(asn_DEF_M6delay_threshold_tags_1)
-		/sizeof(asn_DEF_M6delay_threshold_tags_1[0]), /* 1 */
-	asn_DEF_M6delay_threshold_tags_1,	/* Same as above */
-	sizeof(asn_DEF_M6delay_threshold_tags_1)
-		/sizeof(asn_DEF_M6delay_threshold_tags_1[0]), /* 1 */
-	{ &asn_OER_type_M6delay_threshold_constr_1, &asn_PER_type_M6delay_threshold_constr_1, NativeEnumerated_constraint },
-	0, 0,	/* Defined elsewhere */
-	&asn_SPC_M6delay_threshold_specs_1	/* Additional specs */
-};
-
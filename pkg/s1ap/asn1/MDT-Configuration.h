@@ -1,50 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_MDT_Configuration_H_
-#define	_MDT_Configuration_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "MDT-Activation.h"
-#include "AreaScopeOfMDT.h"
-#include "MDTMode.h"
-#include <constr_SEQUENCE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Forward declarations */
-struct ProtocolExtensionContainer;
-
-/* MDT-Configuration */
-typedef struct MDT_Configuration {
-	MDT_Activation_t	 mdt_Activation;
-	AreaScopeOfMDT_t	 areaScopeOfMDT;
-	MDTMode_t	 mDTMode;
-	struct ProtocolExtensionContainer	*iE_Extensions;	/* OPTIONAL */
-	/*
-	 * This type is extensible,
-	 * possible extensions are below.
-	 */
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} MDT_Configuration_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_MDT_Configuration;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _MDT_Configuration_H_ */
-#include <asn_internal.h>
@@ -1,78 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "MDTMode.h"
-
-#include "ImmediateMDT.h"
-#include "LoggedMDT.h"
-#include "MDTMode-Extension.h"
-static asn_oer_constraints_t asn_OER_type_MDTMode_constr_1 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-asn_per_constraints_t asn_PER_type_MDTMode_constr_1 CC_NOTUSED = {
-	{ APC_CONSTRAINED | APC_EXTENSIBLE,  1,  1,  0,  1 }	/* (0..1,...) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-asn_TYPE_member_t asn_MBR_MDTMode_1[] = {
-	{ ATF_POINTER, 0, offsetof(struct MDTMode, choice.immediateMDT),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ImmediateMDT,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"immediateMDT"
-		},
-	{ ATF_POINTER, 0, offsetof(struct MDTMode, choice.loggedMDT),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_LoggedMDT,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"loggedMDT"
-		},
-	{ ATF_POINTER, 0, offsetof(struct MDTMode, choice.mDTMode_Extension),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_MDTMode_Extension,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"mDTMode-Extension"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_MDTMode_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* immediateMDT */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* loggedMDT */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* mDTMode-Extension */
-};
-asn_CHOICE_specifics_t asn_SPC_MDTMode_specs_1 = {
-	sizeof(struct MDTMode),
-	offsetof(struct MDTMode, _asn_ctx),
-	offsetof(struct MDTMode, present),
-	sizeof(((struct MDTMode *)0)->present),
-	asn_MAP_MDTMode_tag2el_1,
-	3,	/* Count of tags in the map */
-	0, 0,
-	2	/* Extensions start */
-};
-asn_TYPE_descriptor_t asn_DEF_MDTMode = {
-	"MDTMode",
-	"MDTMode",
-	&asn_OP_CHOICE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ &asn_OER_type_MDTMode_constr_1, &asn_PER_type_MDTMode_constr_1, CHOICE_constraint },
-	asn_MBR_MDTMode_1,
-	3,	/* Elements count */
-	&asn_SPC_MDTMode_specs_1	/* Additional specs */
-};
-
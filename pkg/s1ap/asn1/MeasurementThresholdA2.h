@@ -1,59 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_MeasurementThresholdA2_H_
-#define	_MeasurementThresholdA2_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "Threshold-RSRP.h"
-#include "Threshold-RSRQ.h"
-#include <constr_CHOICE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum MeasurementThresholdA2_PR {
-	MeasurementThresholdA2_PR_NOTHING,	/* No components present */
-	MeasurementThresholdA2_PR_threshold_RSRP,
-	MeasurementThresholdA2_PR_threshold_RSRQ
-	/* Extensions may appear below */
-	
-} MeasurementThresholdA2_PR;
-
-/* MeasurementThresholdA2 */
-typedef struct MeasurementThresholdA2 {
-	MeasurementThresholdA2_PR present;
-	union MeasurementThresholdA2_u {
-		Threshold_RSRP_t	 threshold_RSRP;
-		Threshold_RSRQ_t	 threshold_RSRQ;
-		/*
-		 * This type is extensible,
-		 * possible extensions are below.
-		 */
-	} choice;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} MeasurementThresholdA2_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_MeasurementThresholdA2;
-extern asn_CHOICE_specifics_t asn_SPC_MeasurementThresholdA2_specs_1;
-extern asn_TYPE_member_t asn_MBR_MeasurementThresholdA2_1[2];
-extern asn_per_constraints_t asn_PER_type_MeasurementThresholdA2_constr_1;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _MeasurementThresholdA2_H_ */
-#include <asn_internal.h>
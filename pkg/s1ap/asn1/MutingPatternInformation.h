@@ -1,61 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_MutingPatternInformation_H_
-#define	_MutingPatternInformation_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeEnumerated.h>
-#include <NativeInteger.h>
-#include <constr_SEQUENCE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum MutingPatternInformation__muting_pattern_period {
-	MutingPatternInformation__muting_pattern_period_ms0	= 0,
-	MutingPatternInformation__muting_pattern_period_ms1280	= 1,
-	MutingPatternInformation__muting_pattern_period_ms2560	= 2,
-	MutingPatternInformation__muting_pattern_period_ms5120	= 3,
-	MutingPatternInformation__muting_pattern_period_ms10240	= 4
-	/*
-	 * Enumeration is extensible
-	 */
-} e_MutingPatternInformation__muting_pattern_period;
-
-/* Forward declarations */
-struct ProtocolExtensionContainer;
-
-/* MutingPatternInformation */
-typedef struct MutingPatternInformation {
-	long	 muting_pattern_period;
-	long	*muting_pattern_offset;	/* OPTIONAL */
-	struct ProtocolExtensionContainer	*iE_Extensions;	/* OPTIONAL */
-	/*
-	 * This type is extensible,
-	 * possible extensions are below.
-	 */
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} MutingPatternInformation_t;
-
-/* Implementation */
-/* extern asn_TYPE_descriptor_t asn_DEF_muting_pattern_period_2;	// (Use -fall-defs-global to expose) */
-extern asn_TYPE_descriptor_t asn_DEF_MutingPatternInformation;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _MutingPatternInformation_H_ */
-#include <asn_internal.h>
@@ -1,53 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_Pre_emptionVulnerability_H_
-#define	_Pre_emptionVulnerability_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeEnumerated.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum Pre_emptionVulnerability {
-	Pre_emptionVulnerability_not_pre_emptable	= 0,
-	Pre_emptionVulnerability_pre_emptable	= 1
-} e_Pre_emptionVulnerability;
-
-/* Pre-emptionVulnerability */
-typedef long	 Pre_emptionVulnerability_t;
-
-/* Implementation */
-extern asn_per_constraints_t asn_PER_type_Pre_emptionVulnerability_constr_1;
-extern asn_TYPE_descriptor_t asn_DEF_Pre_emptionVulnerability;
-extern const asn_INTEGER_specifics_t asn_SPC_Pre_emptionVulnerability_specs_1;
-asn_struct_free_f Pre_emptionVulnerability_free;
-asn_struct_print_f Pre_emptionVulnerability_print;
-asn_constr_check_f Pre_emptionVulnerability_constraint;
-ber_type_decoder_f Pre_emptionVulnerability_decode_ber;
-der_type_encoder_f Pre_emptionVulnerability_encode_der;
-xer_type_decoder_f Pre_emptionVulnerability_decode_xer;
-xer_type_encoder_f Pre_emptionVulnerability_encode_xer;
-oer_type_decoder_f Pre_emptionVulnerability_decode_oer;
-oer_type_encoder_f Pre_emptionVulnerability_encode_oer;
-per_type_decoder_f Pre_emptionVulnerability_decode_uper;
-per_type_encoder_f Pre_emptionVulnerability_encode_uper;
-per_type_decoder_f Pre_emptionVulnerability_decode_aper;
-per_type_encoder_f Pre_emptionVulnerability_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _Pre_emptionVulnerability_H_ */
-#include <asn_internal.h>
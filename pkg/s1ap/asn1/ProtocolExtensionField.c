@@ -1,22123 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-Containers"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "ProtocolExtensionField.h"
-
-static const long asn_VAL_1_id_Data_Forwarding_Not_Possible = 143;
-static const long asn_VAL_1_ignore = 1;
-static const long asn_VAL_1_optional = 0;
-static const long asn_VAL_2_id_BearerType = 233;
-static const long asn_VAL_2_reject = 0;
-static const long asn_VAL_2_optional = 0;
-static const asn_ioc_cell_t asn_IOS_E_RABToBeSetupItemHOReq_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_1_id_Data_Forwarding_Not_Possible },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_1_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Data_Forwarding_Not_Possible },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_1_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_2_id_BearerType },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_2_reject },
-	{ "&Extension", aioc__type, &asn_DEF_BearerType },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_2_optional }
-};
-static const asn_ioc_set_t asn_IOS_E_RABToBeSetupItemHOReq_ExtIEs_1[] = {
-	{ 2, 4, asn_IOS_E_RABToBeSetupItemHOReq_ExtIEs_1_rows }
-};
-static const long asn_VAL_3_id_Correlation_ID = 156;
-static const long asn_VAL_3_ignore = 1;
-static const long asn_VAL_3_optional = 0;
-static const long asn_VAL_4_id_SIPTO_Correlation_ID = 183;
-static const long asn_VAL_4_ignore = 1;
-static const long asn_VAL_4_optional = 0;
-static const long asn_VAL_5_id_BearerType = 233;
-static const long asn_VAL_5_reject = 0;
-static const long asn_VAL_5_optional = 0;
-static const asn_ioc_cell_t asn_IOS_E_RABToBeSetupItemBearerSUReqExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_3_id_Correlation_ID },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_3_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Correlation_ID },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_3_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_4_id_SIPTO_Correlation_ID },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_4_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Correlation_ID },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_4_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_5_id_BearerType },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_5_reject },
-	{ "&Extension", aioc__type, &asn_DEF_BearerType },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_5_optional }
-};
-static const asn_ioc_set_t asn_IOS_E_RABToBeSetupItemBearerSUReqExtIEs_1[] = {
-	{ 3, 4, asn_IOS_E_RABToBeSetupItemBearerSUReqExtIEs_1_rows }
-};
-static const long asn_VAL_6_id_TransportInformation = 185;
-static const long asn_VAL_6_reject = 0;
-static const long asn_VAL_6_optional = 0;
-static const asn_ioc_cell_t asn_IOS_E_RABToBeModifyItemBearerModReqExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_6_id_TransportInformation },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_6_reject },
-	{ "&Extension", aioc__type, &asn_DEF_TransportInformation },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_6_optional }
-};
-static const asn_ioc_set_t asn_IOS_E_RABToBeModifyItemBearerModReqExtIEs_1[] = {
-	{ 1, 4, asn_IOS_E_RABToBeModifyItemBearerModReqExtIEs_1_rows }
-};
-static const long asn_VAL_7_id_Correlation_ID = 156;
-static const long asn_VAL_7_ignore = 1;
-static const long asn_VAL_7_optional = 0;
-static const long asn_VAL_8_id_SIPTO_Correlation_ID = 183;
-static const long asn_VAL_8_ignore = 1;
-static const long asn_VAL_8_optional = 0;
-static const long asn_VAL_9_id_BearerType = 233;
-static const long asn_VAL_9_reject = 0;
-static const long asn_VAL_9_optional = 0;
-static const asn_ioc_cell_t asn_IOS_E_RABToBeSetupItemCtxtSUReqExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_7_id_Correlation_ID },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_7_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Correlation_ID },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_7_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_8_id_SIPTO_Correlation_ID },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_8_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Correlation_ID },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_8_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_9_id_BearerType },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_9_reject },
-	{ "&Extension", aioc__type, &asn_DEF_BearerType },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_9_optional }
-};
-static const asn_ioc_set_t asn_IOS_E_RABToBeSetupItemCtxtSUReqExtIEs_1[] = {
-	{ 3, 4, asn_IOS_E_RABToBeSetupItemCtxtSUReqExtIEs_1_rows }
-};
-static const long asn_VAL_10_id_ULCOUNTValueExtended = 179;
-static const long asn_VAL_10_ignore = 1;
-static const long asn_VAL_10_optional = 0;
-static const long asn_VAL_11_id_DLCOUNTValueExtended = 180;
-static const long asn_VAL_11_ignore = 1;
-static const long asn_VAL_11_optional = 0;
-static const long asn_VAL_12_id_ReceiveStatusOfULPDCPSDUsExtended = 181;
-static const long asn_VAL_12_ignore = 1;
-static const long asn_VAL_12_optional = 0;
-static const long asn_VAL_13_id_ULCOUNTValuePDCP_SNlength18 = 217;
-static const long asn_VAL_13_ignore = 1;
-static const long asn_VAL_13_optional = 0;
-static const long asn_VAL_14_id_DLCOUNTValuePDCP_SNlength18 = 218;
-static const long asn_VAL_14_ignore = 1;
-static const long asn_VAL_14_optional = 0;
-static const long asn_VAL_15_id_ReceiveStatusOfULPDCPSDUsPDCP_SNlength18 = 219;
-static const long asn_VAL_15_ignore = 1;
-static const long asn_VAL_15_optional = 0;
-static const asn_ioc_cell_t asn_IOS_Bearers_SubjectToStatusTransfer_ItemExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_10_id_ULCOUNTValueExtended },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_10_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_COUNTValueExtended },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_10_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_11_id_DLCOUNTValueExtended },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_11_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_COUNTValueExtended },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_11_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_12_id_ReceiveStatusOfULPDCPSDUsExtended },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_12_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_ReceiveStatusOfULPDCPSDUsExtended },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_12_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_13_id_ULCOUNTValuePDCP_SNlength18 },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_13_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_COUNTvaluePDCP_SNlength18 },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_13_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_14_id_DLCOUNTValuePDCP_SNlength18 },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_14_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_COUNTvaluePDCP_SNlength18 },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_14_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_15_id_ReceiveStatusOfULPDCPSDUsPDCP_SNlength18 },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_15_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_ReceiveStatusOfULPDCPSDUsPDCP_SNlength18 },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_15_optional }
-};
-static const asn_ioc_set_t asn_IOS_Bearers_SubjectToStatusTransfer_ItemExtIEs_1[] = {
-	{ 6, 4, asn_IOS_Bearers_SubjectToStatusTransfer_ItemExtIEs_1_rows }
-};
-static const long asn_VAL_16_id_M3Configuration = 171;
-static const long asn_VAL_16_ignore = 1;
-static const long asn_VAL_16_conditional = 1;
-static const long asn_VAL_17_id_M4Configuration = 172;
-static const long asn_VAL_17_ignore = 1;
-static const long asn_VAL_17_conditional = 1;
-static const long asn_VAL_18_id_M5Configuration = 173;
-static const long asn_VAL_18_ignore = 1;
-static const long asn_VAL_18_conditional = 1;
-static const long asn_VAL_19_id_MDT_Location_Info = 174;
-static const long asn_VAL_19_ignore = 1;
-static const long asn_VAL_19_optional = 0;
-static const long asn_VAL_20_id_M6Configuration = 220;
-static const long asn_VAL_20_ignore = 1;
-static const long asn_VAL_20_conditional = 1;
-static const long asn_VAL_21_id_M7Configuration = 221;
-static const long asn_VAL_21_ignore = 1;
-static const long asn_VAL_21_conditional = 1;
-static const asn_ioc_cell_t asn_IOS_ImmediateMDT_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_16_id_M3Configuration },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_16_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_M3Configuration },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_16_conditional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_17_id_M4Configuration },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_17_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_M4Configuration },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_17_conditional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_18_id_M5Configuration },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_18_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_M5Configuration },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_18_conditional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_19_id_MDT_Location_Info },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_19_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_MDT_Location_Info },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_19_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_20_id_M6Configuration },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_20_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_M6Configuration },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_20_conditional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_21_id_M7Configuration },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_21_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_M7Configuration },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_21_conditional }
-};
-static const asn_ioc_set_t asn_IOS_ImmediateMDT_ExtIEs_1[] = {
-	{ 6, 4, asn_IOS_ImmediateMDT_ExtIEs_1_rows }
-};
-static const long asn_VAL_22_id_Time_UE_StayedInCell_EnhancedGranularity = 167;
-static const long asn_VAL_22_ignore = 1;
-static const long asn_VAL_22_optional = 0;
-static const long asn_VAL_23_id_HO_Cause = 168;
-static const long asn_VAL_23_ignore = 1;
-static const long asn_VAL_23_optional = 0;
-static const asn_ioc_cell_t asn_IOS_LastVisitedEUTRANCellInformation_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_22_id_Time_UE_StayedInCell_EnhancedGranularity },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_22_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Time_UE_StayedInCell_EnhancedGranularity },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_22_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_23_id_HO_Cause },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_23_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_Cause },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_23_optional }
-};
-static const asn_ioc_set_t asn_IOS_LastVisitedEUTRANCellInformation_ExtIEs_1[] = {
-	{ 2, 4, asn_IOS_LastVisitedEUTRANCellInformation_ExtIEs_1_rows }
-};
-static const long asn_VAL_24_id_SignallingBasedMDTPLMNList = 178;
-static const long asn_VAL_24_ignore = 1;
-static const long asn_VAL_24_optional = 0;
-static const asn_ioc_cell_t asn_IOS_MDT_Configuration_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_24_id_SignallingBasedMDTPLMNList },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_24_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_MDTPLMNList },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_24_optional }
-};
-static const asn_ioc_set_t asn_IOS_MDT_Configuration_ExtIEs_1[] = {
-	{ 1, 4, asn_IOS_MDT_Configuration_ExtIEs_1_rows }
-};
-static const long asn_VAL_25_id_ProSeUEtoNetworkRelaying = 216;
-static const long asn_VAL_25_ignore = 1;
-static const long asn_VAL_25_optional = 0;
-static const asn_ioc_cell_t asn_IOS_ProSeAuthorized_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_25_id_ProSeUEtoNetworkRelaying },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_25_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_ProSeUEtoNetworkRelaying },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_25_optional }
-};
-static const asn_ioc_set_t asn_IOS_ProSeAuthorized_ExtIEs_1[] = {
-	{ 1, 4, asn_IOS_ProSeAuthorized_ExtIEs_1_rows }
-};
-static const long asn_VAL_26_id_Time_Synchronisation_Info = 149;
-static const long asn_VAL_26_ignore = 1;
-static const long asn_VAL_26_optional = 0;
-static const long asn_VAL_27_id_Muting_Pattern_Information = 208;
-static const long asn_VAL_27_ignore = 1;
-static const long asn_VAL_27_optional = 0;
-static const asn_ioc_cell_t asn_IOS_SONInformationReply_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_26_id_Time_Synchronisation_Info },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_26_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_TimeSynchronisationInfo },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_26_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_27_id_Muting_Pattern_Information },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_27_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_MutingPatternInformation },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_27_optional }
-};
-static const asn_ioc_set_t asn_IOS_SONInformationReply_ExtIEs_1[] = {
-	{ 2, 4, asn_IOS_SONInformationReply_ExtIEs_1_rows }
-};
-static const long asn_VAL_28_id_x2TNLConfigurationInfo = 152;
-static const long asn_VAL_28_ignore = 1;
-static const long asn_VAL_28_conditional = 1;
-static const long asn_VAL_29_id_Synchronisation_Information = 209;
-static const long asn_VAL_29_ignore = 1;
-static const long asn_VAL_29_conditional = 1;
-static const asn_ioc_cell_t asn_IOS_SONConfigurationTransfer_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_28_id_x2TNLConfigurationInfo },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_28_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_X2TNLConfigurationInfo },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_28_conditional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_29_id_Synchronisation_Information },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_29_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_SynchronisationInformation },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_29_conditional }
-};
-static const asn_ioc_set_t asn_IOS_SONConfigurationTransfer_ExtIEs_1[] = {
-	{ 2, 4, asn_IOS_SONConfigurationTransfer_ExtIEs_1_rows }
-};
-static const long asn_VAL_30_id_MobilityInformation = 175;
-static const long asn_VAL_30_ignore = 1;
-static const long asn_VAL_30_optional = 0;
-static const long asn_VAL_31_id_uE_HistoryInformationFromTheUE = 194;
-static const long asn_VAL_31_ignore = 1;
-static const long asn_VAL_31_optional = 0;
-static const asn_ioc_cell_t asn_IOS_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_30_id_MobilityInformation },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_30_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_MobilityInformation },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_30_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_31_id_uE_HistoryInformationFromTheUE },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_31_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_UE_HistoryInformationFromTheUE },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_31_optional }
-};
-static const asn_ioc_set_t asn_IOS_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_1[] = {
-	{ 2, 4, asn_IOS_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_1_rows }
-};
-static const long asn_VAL_32_id_RAT_Type = 232;
-static const long asn_VAL_32_reject = 0;
-static const long asn_VAL_32_optional = 0;
-static const asn_ioc_cell_t asn_IOS_SupportedTAs_Item_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_32_id_RAT_Type },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_32_reject },
-	{ "&Extension", aioc__type, &asn_DEF_RAT_Type },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_32_optional }
-};
-static const asn_ioc_set_t asn_IOS_SupportedTAs_Item_ExtIEs_1[] = {
-	{ 1, 4, asn_IOS_SupportedTAs_Item_ExtIEs_1_rows }
-};
-static const long asn_VAL_33_id_Muting_Availability_Indication = 207;
-static const long asn_VAL_33_ignore = 1;
-static const long asn_VAL_33_optional = 0;
-static const asn_ioc_cell_t asn_IOS_TimeSynchronisationInfo_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_33_id_Muting_Availability_Indication },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_33_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_MutingAvailabilityIndication },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_33_optional }
-};
-static const asn_ioc_set_t asn_IOS_TimeSynchronisationInfo_ExtIEs_1[] = {
-	{ 1, 4, asn_IOS_TimeSynchronisationInfo_ExtIEs_1_rows }
-};
-static const long asn_VAL_34_id_MDTConfiguration = 162;
-static const long asn_VAL_34_ignore = 1;
-static const long asn_VAL_34_optional = 0;
-static const asn_ioc_cell_t asn_IOS_TraceActivation_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_34_id_MDTConfiguration },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_34_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_MDT_Configuration },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_34_optional }
-};
-static const asn_ioc_set_t asn_IOS_TraceActivation_ExtIEs_1[] = {
-	{ 1, 4, asn_IOS_TraceActivation_ExtIEs_1_rows }
-};
-static const long asn_VAL_35_id_eNBX2ExtendedTransportLayerAddresses = 153;
-static const long asn_VAL_35_ignore = 1;
-static const long asn_VAL_35_optional = 0;
-static const long asn_VAL_36_id_eNBIndirectX2TransportLayerAddresses = 193;
-static const long asn_VAL_36_ignore = 1;
-static const long asn_VAL_36_optional = 0;
-static const asn_ioc_cell_t asn_IOS_X2TNLConfigurationInfo_ExtIEs_1_rows[] = {
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_35_id_eNBX2ExtendedTransportLayerAddresses },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_35_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_ENBX2ExtTLAs },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_35_optional },
-	{ "&id", aioc__value, &asn_DEF_ProtocolExtensionID, &asn_VAL_36_id_eNBIndirectX2TransportLayerAddresses },
-	{ "&criticality", aioc__value, &asn_DEF_Criticality, &asn_VAL_36_ignore },
-	{ "&Extension", aioc__type, &asn_DEF_ENBIndirectX2TransportLayerAddresses },
-	{ "&presence", aioc__value, &asn_DEF_Presence, &asn_VAL_36_optional }
-};
-static const asn_ioc_set_t asn_IOS_X2TNLConfigurationInfo_ExtIEs_1[] = {
-	{ 2, 4, asn_IOS_X2TNLConfigurationInfo_ExtIEs_1_rows }
-};
-static int
-memb_id_constraint_1(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_1(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_1(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_5(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeSetupItemHOReq_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeSetupItemHOReq_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_5(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeSetupItemHOReq_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeSetupItemHOReq_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_5(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_9(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_9(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_9(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_13(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_13(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_13(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_17(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_17(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_17(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_21(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_21(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_21(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_25(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeSetupItemBearerSUReqExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeSetupItemBearerSUReqExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_25(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeSetupItemBearerSUReqExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeSetupItemBearerSUReqExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_25(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_29(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_29(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_29(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_33(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeModifyItemBearerModReqExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeModifyItemBearerModReqExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_33(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeModifyItemBearerModReqExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeModifyItemBearerModReqExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_33(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_37(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_37(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_37(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_41(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_41(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_41(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_45(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeSetupItemCtxtSUReqExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeSetupItemCtxtSUReqExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_45(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_E_RABToBeSetupItemCtxtSUReqExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_E_RABToBeSetupItemCtxtSUReqExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_45(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_49(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_49(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_49(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_53(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_53(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_53(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_57(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_57(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_57(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_61(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_61(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_61(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_65(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_65(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_65(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_69(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_69(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_69(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_73(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_73(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_73(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_77(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_77(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_77(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_81(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_81(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_81(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_85(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_85(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_85(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_89(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_89(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_89(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_93(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_93(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_93(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_97(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_97(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_97(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_101(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_Bearers_SubjectToStatusTransfer_ItemExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_Bearers_SubjectToStatusTransfer_ItemExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_101(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_Bearers_SubjectToStatusTransfer_ItemExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_Bearers_SubjectToStatusTransfer_ItemExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_101(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_105(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_105(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_105(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_109(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_109(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_109(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_113(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_113(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_113(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_117(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_117(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_117(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_121(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_121(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_121(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_125(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_125(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_125(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_129(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_129(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_129(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_133(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_133(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_133(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_137(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_137(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_137(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_141(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_141(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_141(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_145(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_145(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_145(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_149(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_149(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_149(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_153(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_153(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_153(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_157(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_157(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_157(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_161(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_161(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_161(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_165(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_165(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_165(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_169(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_169(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_169(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_173(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_173(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_173(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_177(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_177(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_177(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_181(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_181(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_181(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_185(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_185(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_185(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_189(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_189(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_189(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_193(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_193(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_193(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_197(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_197(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_197(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_201(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_201(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_201(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_205(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_205(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_205(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_209(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_209(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_209(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_213(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_213(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_213(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_217(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_217(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_217(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_221(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_221(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_221(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_225(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_225(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_225(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_229(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_229(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_229(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_233(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_233(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_233(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_237(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_237(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_237(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_241(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_ImmediateMDT_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_ImmediateMDT_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct ImmediateMDT_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_241(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_ImmediateMDT_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_ImmediateMDT_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct ImmediateMDT_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_241(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_245(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_245(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_245(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_249(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_249(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_249(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_253(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_LastVisitedEUTRANCellInformation_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_LastVisitedEUTRANCellInformation_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_253(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_LastVisitedEUTRANCellInformation_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_LastVisitedEUTRANCellInformation_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_253(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_257(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_257(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_257(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_261(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_261(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_261(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_265(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_265(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_265(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_269(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_269(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_269(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_273(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_273(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_273(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_277(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_277(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_277(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_281(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_281(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_281(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_285(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_285(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_285(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_289(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_MDT_Configuration_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_MDT_Configuration_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct MDT_Configuration_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_289(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_MDT_Configuration_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_MDT_Configuration_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct MDT_Configuration_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_289(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_293(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_293(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_293(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_297(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_297(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_297(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_301(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_301(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_301(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_305(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_305(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_305(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_309(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_309(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_309(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_313(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_313(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_313(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_317(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_ProSeAuthorized_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_ProSeAuthorized_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct ProSeAuthorized_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_317(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_ProSeAuthorized_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_ProSeAuthorized_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct ProSeAuthorized_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_317(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_321(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_321(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_321(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_325(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_325(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_325(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_329(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_329(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_329(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_333(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_333(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_333(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_337(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_337(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_337(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_341(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_341(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_341(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_345(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_345(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_345(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_349(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_349(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_349(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_353(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_SONInformationReply_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SONInformationReply_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SONInformationReply_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_353(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_SONInformationReply_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SONInformationReply_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SONInformationReply_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_353(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_357(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_SONConfigurationTransfer_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SONConfigurationTransfer_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SONConfigurationTransfer_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_357(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_SONConfigurationTransfer_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SONConfigurationTransfer_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SONConfigurationTransfer_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_357(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_361(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_361(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_361(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_365(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_365(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_365(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_369(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_369(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_369(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_373(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_373(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_373(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_377(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_SupportedTAs_Item_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SupportedTAs_Item_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SupportedTAs_Item_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_377(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_SupportedTAs_Item_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_SupportedTAs_Item_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct SupportedTAs_Item_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_377(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_381(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_TimeSynchronisationInfo_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_TimeSynchronisationInfo_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct TimeSynchronisationInfo_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_381(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_TimeSynchronisationInfo_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_TimeSynchronisationInfo_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct TimeSynchronisationInfo_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_381(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_385(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_385(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_385(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_389(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_389(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_389(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_393(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_393(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_393(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_397(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_397(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_397(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_401(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_401(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_401(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_405(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_405(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_405(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_409(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_409(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_409(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_413(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_413(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_413(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_417(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_417(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_417(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_421(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_421(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_421(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_425(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_425(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_425(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_429(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_TraceActivation_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_TraceActivation_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct TraceActivation_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_429(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_TraceActivation_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_TraceActivation_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct TraceActivation_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_429(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_433(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_433(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_433(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_437(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_437(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_437(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_441(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_441(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_441(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_445(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_445(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_445(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_449(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_449(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_449(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_453(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_453(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_453(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_457(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_457(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_457(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_461(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_461(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_461(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_465(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_465(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_465(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_469(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_type_selector_result_t
-select_X2TNLConfigurationInfo_ExtIEs_criticality_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_X2TNLConfigurationInfo_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 1; /* &criticality */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct X2TNLConfigurationInfo_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_criticality_constraint_469(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_type_selector_result_t
-select_X2TNLConfigurationInfo_ExtIEs_extensionValue_type(const asn_TYPE_descriptor_t *parent_type, const void *parent_sptr) {
-	asn_type_selector_result_t result = {0, 0};
-	const asn_ioc_set_t *itable = asn_IOS_X2TNLConfigurationInfo_ExtIEs_1;
-	size_t constraining_column = 0; /* &id */
-	size_t for_column = 2; /* &Extension */
-	size_t row, presence_index = 0;
-	const long *constraining_value = (const long *)((const char *)parent_sptr + offsetof(struct X2TNLConfigurationInfo_ExtIEs, id));
-	
-	for(row=0; row < itable->rows_count; row++) {
-	    const asn_ioc_cell_t *constraining_cell = &itable->rows[row * itable->columns_count + constraining_column];
-	    const asn_ioc_cell_t *type_cell = &itable->rows[row * itable->columns_count + for_column];
-	
-	    if(type_cell->cell_kind == aioc__undefined)
-	        continue;
-	
-	    presence_index++;
-	    if(constraining_cell->type_descriptor->op->compare_struct(constraining_cell->type_descriptor, constraining_value, constraining_cell->value_sptr) == 0) {
-	        result.type_descriptor = type_cell->type_descriptor;
-	        result.presence_index = presence_index;
-	        break;
-	    }
-	}
-	
-	return result;
-}
-
-static int
-memb_extensionValue_constraint_469(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_id_constraint_473(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 65535)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static int
-memb_criticality_constraint_473(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static int
-memb_extensionValue_constraint_473(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	
-	if(1 /* No applicable constraints whatsoever */) {
-		/* Nothing is here. See below */
-	}
-	
-	return td->encoding_constraints.general_constraints(td, sptr, ctfailcb, app_key);
-}
-
-static asn_oer_constraints_t asn_OER_memb_id_constr_2 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_2 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_3 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_3 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_4 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_4 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_6 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_6 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_7 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_7 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_8 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_8 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_10 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_10 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_11 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_11 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_12 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_12 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_14 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_14 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_15 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_15 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_16 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_16 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_18 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_18 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_19 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_19 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_20 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_20 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_22 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_22 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_23 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_23 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_24 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_24 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_26 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_26 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_27 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_27 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_28 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_28 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_30 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_30 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_31 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_31 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_32 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_32 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_34 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_34 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_35 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_35 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_36 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_36 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_38 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_38 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_39 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_39 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_40 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_40 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_42 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_42 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_43 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_43 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_44 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_44 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_46 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_46 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_47 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_47 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_48 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_48 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_50 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_50 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_51 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_51 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_52 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_52 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_54 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_54 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_55 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_55 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_56 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_56 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_58 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_58 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_59 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_59 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_60 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_60 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_62 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_62 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_63 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_63 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_64 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_64 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_66 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_66 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_67 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_67 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_68 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_68 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_70 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_70 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_71 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_71 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_72 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_72 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_74 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_74 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_75 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_75 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_76 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_76 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_78 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_78 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_79 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_79 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_80 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_80 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_82 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_82 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_83 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_83 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_84 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_84 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_86 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_86 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_87 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_87 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_88 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_88 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_90 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_90 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_91 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_91 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_92 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_92 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_94 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_94 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_95 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_95 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_96 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_96 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_98 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_98 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_99 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_99 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_100 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_100 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_102 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_102 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_103 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_103 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_104 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_104 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_106 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_106 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_107 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_107 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_108 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_108 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_110 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_110 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_111 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_111 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_112 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_112 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_114 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_114 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_115 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_115 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_116 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_116 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_118 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_118 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_119 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_119 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_120 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_120 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_122 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_122 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_123 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_123 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_124 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_124 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_126 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_126 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_127 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_127 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_128 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_128 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_130 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_130 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_131 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_131 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_132 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_132 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_134 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_134 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_135 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_135 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_136 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_136 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_138 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_138 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_139 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_139 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_140 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_140 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_142 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_142 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_143 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_143 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_144 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_144 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_146 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_146 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_147 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_147 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_148 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_148 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_150 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_150 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_151 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_151 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_152 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_152 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_154 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_154 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_155 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_155 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_156 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_156 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_158 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_158 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_159 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_159 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_160 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_160 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_162 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_162 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_163 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_163 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_164 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_164 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_166 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_166 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_167 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_167 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_168 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_168 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_170 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_170 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_171 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_171 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_172 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_172 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_174 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_174 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_175 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_175 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_176 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_176 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_178 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_178 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_179 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_179 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_180 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_180 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_182 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_182 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_183 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_183 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_184 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_184 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_186 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_186 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_187 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_187 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_188 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_188 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_190 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_190 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_191 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_191 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_192 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_192 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_194 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_194 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_195 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_195 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_196 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_196 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_198 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_198 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_199 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_199 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_200 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_200 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_202 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_202 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_203 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_203 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_204 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_204 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_206 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_206 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_207 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_207 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_208 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_208 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_210 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_210 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_211 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_211 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_212 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_212 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_214 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_214 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_215 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_215 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_216 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_216 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_218 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_218 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_219 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_219 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_220 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_220 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_222 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_222 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_223 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_223 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_224 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_224 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_226 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_226 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_227 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_227 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_228 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_228 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_230 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_230 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_231 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_231 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_232 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_232 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_234 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_234 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_235 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_235 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_236 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_236 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_238 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_238 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_239 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_239 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_240 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_240 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_242 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_242 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_243 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_243 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_244 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_244 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_246 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_246 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_247 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_247 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_248 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_248 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_250 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_250 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_251 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_251 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_252 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_252 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_254 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_254 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_255 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_255 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_256 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_256 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_258 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_258 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_259 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_259 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_260 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_260 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_262 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_262 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_263 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_263 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_264 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_264 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_266 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_266 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_267 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_267 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_268 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_268 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_270 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_270 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_271 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_271 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_272 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_272 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_274 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_274 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_275 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_275 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_276 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_276 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_278 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_278 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_279 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_279 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_280 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_280 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_282 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_282 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_283 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_283 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_284 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_284 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_286 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_286 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_287 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_287 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_288 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_288 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_290 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_290 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_291 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_291 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_292 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_292 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_294 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_294 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_295 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_295 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_296 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_296 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_298 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_298 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_299 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_299 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_300 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_300 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_302 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_302 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_303 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_303 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_304 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_304 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_306 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_306 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_307 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_307 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_308 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_308 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_310 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_310 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_311 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_311 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_312 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_312 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_314 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_314 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_315 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_315 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_316 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_316 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_318 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_318 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_319 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_319 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_320 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_320 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_322 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_322 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_323 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_323 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_324 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_324 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_326 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_326 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_327 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_327 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_328 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_328 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_330 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_330 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_331 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_331 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_332 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_332 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_334 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_334 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_335 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_335 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_336 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_336 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_338 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_338 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_339 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_339 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_340 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_340 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_342 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_342 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_343 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_343 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_344 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_344 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_346 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_346 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_347 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_347 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_348 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_348 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_350 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_350 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_351 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_351 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_352 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_352 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_354 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_354 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_355 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_355 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_356 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_356 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_358 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_358 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_359 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_359 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_360 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_360 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_362 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_362 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_363 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_363 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_364 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_364 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_366 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_366 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_367 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_367 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_368 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_368 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_370 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_370 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_371 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_371 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_372 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_372 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_374 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_374 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_375 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_375 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_376 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_376 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_378 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_378 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_379 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_379 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_380 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_380 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_382 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_382 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_383 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_383 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_384 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_384 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_386 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_386 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_387 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_387 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_388 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_388 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_390 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_390 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_391 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_391 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_392 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_392 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_394 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_394 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_395 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_395 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_396 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_396 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_398 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_398 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_399 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_399 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_400 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_400 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_402 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_402 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_403 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_403 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_404 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_404 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_406 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_406 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_407 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_407 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_408 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_408 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_410 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_410 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_411 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_411 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_412 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_412 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_414 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_414 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_415 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_415 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_416 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_416 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_418 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_418 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_419 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_419 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_420 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_420 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_422 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_422 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_423 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_423 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_424 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_424 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_426 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_426 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_427 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_427 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_428 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_428 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_430 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_430 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_431 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_431 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_432 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_432 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_434 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_434 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_435 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_435 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_436 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_436 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_438 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_438 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_439 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_439 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_440 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_440 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_442 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_442 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_443 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_443 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_444 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_444 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_446 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_446 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_447 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_447 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_448 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_448 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_450 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_450 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_451 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_451 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_452 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_452 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_454 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_454 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_455 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_455 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_456 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_456 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_458 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_458 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_459 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_459 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_460 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_460 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_462 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_462 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_463 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_463 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_464 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_464 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_466 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_466 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_467 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_467 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_468 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_468 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_470 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_470 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_471 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_471 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_472 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_472 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_id_constr_474 CC_NOTUSED = {
-	{ 2, 1 }	/* (0..65535) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_id_constr_474 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 16,  16,  0,  65535 }	/* (0..65535) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_criticality_constr_475 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_criticality_constr_475 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 2,  2,  0,  2 }	/* (0..2) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_memb_extensionValue_constr_476 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_memb_extensionValue_constr_476 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_4 = {
-	sizeof(struct E_RABDataForwardingItem_ExtIEs__extensionValue),
-	offsetof(struct E_RABDataForwardingItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABDataForwardingItem_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABDataForwardingItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_4 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_4	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABDataForwardingItem_ExtIEs_1[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABDataForwardingItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_2, &asn_PER_memb_id_constr_2,  memb_id_constraint_1 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABDataForwardingItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_3, &asn_PER_memb_criticality_constr_3,  memb_criticality_constraint_1 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABDataForwardingItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_4,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_4, &asn_PER_memb_extensionValue_constr_4,  memb_extensionValue_constraint_1 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABDataForwardingItem_ExtIEs_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABDataForwardingItem_ExtIEs_specs_1 = {
-	sizeof(struct E_RABDataForwardingItem_ExtIEs),
-	offsetof(struct E_RABDataForwardingItem_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABDataForwardingItem_ExtIEs_tag2el_1,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABDataForwardingItem_ExtIEs = {
-	"E-RABDataForwardingItem-ExtIEs",
-	"E-RABDataForwardingItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1,
-	sizeof(asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1)
-		/sizeof(asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1[0]), /* 1 */
-	asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1,	/* Same as above */
-	sizeof(asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1)
-		/sizeof(asn_DEF_E_RABDataForwardingItem_ExtIEs_tags_1[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABDataForwardingItem_ExtIEs_1,
-	3,	/* Elements count */
-	&asn_SPC_E_RABDataForwardingItem_ExtIEs_specs_1	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_8[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs__extensionValue, choice.Data_Forwarding_Not_Possible),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_Data_Forwarding_Not_Possible,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Data-Forwarding-Not-Possible"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs__extensionValue, choice.BearerType),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_BearerType,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"BearerType"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_8[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 0, 0, 1 }, /* Data-Forwarding-Not-Possible */
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 1, -1, 0 } /* BearerType */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_8 = {
-	sizeof(struct E_RABToBeSetupItemHOReq_ExtIEs__extensionValue),
-	offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeSetupItemHOReq_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_8,
-	2,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_8 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_8,
-	2,	/* Elements count */
-	&asn_SPC_extensionValue_specs_8	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeSetupItemHOReq_ExtIEs_5[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_6, &asn_PER_memb_id_constr_6,  memb_id_constraint_5 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_E_RABToBeSetupItemHOReq_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_7, &asn_PER_memb_criticality_constr_7,  memb_criticality_constraint_5 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_8,
-		select_E_RABToBeSetupItemHOReq_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_8, &asn_PER_memb_extensionValue_constr_8,  memb_extensionValue_constraint_5 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeSetupItemHOReq_ExtIEs_tag2el_5[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeSetupItemHOReq_ExtIEs_specs_5 = {
-	sizeof(struct E_RABToBeSetupItemHOReq_ExtIEs),
-	offsetof(struct E_RABToBeSetupItemHOReq_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeSetupItemHOReq_ExtIEs_tag2el_5,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs = {
-	"E-RABToBeSetupItemHOReq-ExtIEs",
-	"E-RABToBeSetupItemHOReq-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5,
-	sizeof(asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5)
-		/sizeof(asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5[0]), /* 1 */
-	asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5)
-		/sizeof(asn_DEF_E_RABToBeSetupItemHOReq_ExtIEs_tags_5[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSetupItemHOReq_ExtIEs_5,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSetupItemHOReq_ExtIEs_specs_5	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_12 = {
-	sizeof(struct E_RABAdmittedItem_ExtIEs__extensionValue),
-	offsetof(struct E_RABAdmittedItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABAdmittedItem_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABAdmittedItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_12 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_12	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABAdmittedItem_ExtIEs_9[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABAdmittedItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_10, &asn_PER_memb_id_constr_10,  memb_id_constraint_9 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABAdmittedItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_11, &asn_PER_memb_criticality_constr_11,  memb_criticality_constraint_9 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABAdmittedItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_12,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_12, &asn_PER_memb_extensionValue_constr_12,  memb_extensionValue_constraint_9 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABAdmittedItem_ExtIEs_tag2el_9[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABAdmittedItem_ExtIEs_specs_9 = {
-	sizeof(struct E_RABAdmittedItem_ExtIEs),
-	offsetof(struct E_RABAdmittedItem_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABAdmittedItem_ExtIEs_tag2el_9,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABAdmittedItem_ExtIEs = {
-	"E-RABAdmittedItem-ExtIEs",
-	"E-RABAdmittedItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9,
-	sizeof(asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9)
-		/sizeof(asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9[0]), /* 1 */
-	asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9,	/* Same as above */
-	sizeof(asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9)
-		/sizeof(asn_DEF_E_RABAdmittedItem_ExtIEs_tags_9[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABAdmittedItem_ExtIEs_9,
-	3,	/* Elements count */
-	&asn_SPC_E_RABAdmittedItem_ExtIEs_specs_9	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_16 = {
-	sizeof(struct E_RABFailedToSetupItemHOReqAckExtIEs__extensionValue),
-	offsetof(struct E_RABFailedToSetupItemHOReqAckExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABFailedToSetupItemHOReqAckExtIEs__extensionValue, present),
-	sizeof(((struct E_RABFailedToSetupItemHOReqAckExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_16 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_16	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABFailedToSetupItemHOReqAckExtIEs_13[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToSetupItemHOReqAckExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_14, &asn_PER_memb_id_constr_14,  memb_id_constraint_13 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToSetupItemHOReqAckExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_15, &asn_PER_memb_criticality_constr_15,  memb_criticality_constraint_13 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToSetupItemHOReqAckExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_16,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_16, &asn_PER_memb_extensionValue_constr_16,  memb_extensionValue_constraint_13 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABFailedToSetupItemHOReqAckExtIEs_tag2el_13[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABFailedToSetupItemHOReqAckExtIEs_specs_13 = {
-	sizeof(struct E_RABFailedToSetupItemHOReqAckExtIEs),
-	offsetof(struct E_RABFailedToSetupItemHOReqAckExtIEs, _asn_ctx),
-	asn_MAP_E_RABFailedToSetupItemHOReqAckExtIEs_tag2el_13,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs = {
-	"E-RABFailedToSetupItemHOReqAckExtIEs",
-	"E-RABFailedToSetupItemHOReqAckExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13,
-	sizeof(asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13)
-		/sizeof(asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13[0]), /* 1 */
-	asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13,	/* Same as above */
-	sizeof(asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13)
-		/sizeof(asn_DEF_E_RABFailedToSetupItemHOReqAckExtIEs_tags_13[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABFailedToSetupItemHOReqAckExtIEs_13,
-	3,	/* Elements count */
-	&asn_SPC_E_RABFailedToSetupItemHOReqAckExtIEs_specs_13	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_20 = {
-	sizeof(struct E_RABToBeSwitchedDLItem_ExtIEs__extensionValue),
-	offsetof(struct E_RABToBeSwitchedDLItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeSwitchedDLItem_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeSwitchedDLItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_20 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_20	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeSwitchedDLItem_ExtIEs_17[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSwitchedDLItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_18, &asn_PER_memb_id_constr_18,  memb_id_constraint_17 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSwitchedDLItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_19, &asn_PER_memb_criticality_constr_19,  memb_criticality_constraint_17 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSwitchedDLItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_20,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_20, &asn_PER_memb_extensionValue_constr_20,  memb_extensionValue_constraint_17 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeSwitchedDLItem_ExtIEs_tag2el_17[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeSwitchedDLItem_ExtIEs_specs_17 = {
-	sizeof(struct E_RABToBeSwitchedDLItem_ExtIEs),
-	offsetof(struct E_RABToBeSwitchedDLItem_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeSwitchedDLItem_ExtIEs_tag2el_17,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs = {
-	"E-RABToBeSwitchedDLItem-ExtIEs",
-	"E-RABToBeSwitchedDLItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17,
-	sizeof(asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17)
-		/sizeof(asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17[0]), /* 1 */
-	asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17)
-		/sizeof(asn_DEF_E_RABToBeSwitchedDLItem_ExtIEs_tags_17[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSwitchedDLItem_ExtIEs_17,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSwitchedDLItem_ExtIEs_specs_17	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_24 = {
-	sizeof(struct E_RABToBeSwitchedULItem_ExtIEs__extensionValue),
-	offsetof(struct E_RABToBeSwitchedULItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeSwitchedULItem_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeSwitchedULItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_24 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_24	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeSwitchedULItem_ExtIEs_21[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSwitchedULItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_22, &asn_PER_memb_id_constr_22,  memb_id_constraint_21 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSwitchedULItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_23, &asn_PER_memb_criticality_constr_23,  memb_criticality_constraint_21 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSwitchedULItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_24,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_24, &asn_PER_memb_extensionValue_constr_24,  memb_extensionValue_constraint_21 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeSwitchedULItem_ExtIEs_tag2el_21[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeSwitchedULItem_ExtIEs_specs_21 = {
-	sizeof(struct E_RABToBeSwitchedULItem_ExtIEs),
-	offsetof(struct E_RABToBeSwitchedULItem_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeSwitchedULItem_ExtIEs_tag2el_21,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeSwitchedULItem_ExtIEs = {
-	"E-RABToBeSwitchedULItem-ExtIEs",
-	"E-RABToBeSwitchedULItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21,
-	sizeof(asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21)
-		/sizeof(asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21[0]), /* 1 */
-	asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21)
-		/sizeof(asn_DEF_E_RABToBeSwitchedULItem_ExtIEs_tags_21[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSwitchedULItem_ExtIEs_21,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSwitchedULItem_ExtIEs_specs_21	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_28[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue, choice.Correlation_ID),
-		(ASN_TAG_CLASS_UNIVERSAL | (4 << 2)),
-		0,
-		&asn_DEF_Correlation_ID,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Correlation-ID"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue, choice.Correlation_ID_1),
-		(ASN_TAG_CLASS_UNIVERSAL | (4 << 2)),
-		0,
-		&asn_DEF_Correlation_ID,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Correlation-ID"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue, choice.BearerType),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_BearerType,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"BearerType"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_28[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (4 << 2)), 0, 0, 1 }, /* Correlation-ID */
-    { (ASN_TAG_CLASS_UNIVERSAL | (4 << 2)), 1, -1, 0 }, /* Correlation-ID */
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 2, 0, 0 } /* BearerType */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_28 = {
-	sizeof(struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue),
-	offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeSetupItemBearerSUReqExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_28,
-	3,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_28 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_28,
-	3,	/* Elements count */
-	&asn_SPC_extensionValue_specs_28	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeSetupItemBearerSUReqExtIEs_25[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_26, &asn_PER_memb_id_constr_26,  memb_id_constraint_25 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_E_RABToBeSetupItemBearerSUReqExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_27, &asn_PER_memb_criticality_constr_27,  memb_criticality_constraint_25 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_28,
-		select_E_RABToBeSetupItemBearerSUReqExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_28, &asn_PER_memb_extensionValue_constr_28,  memb_extensionValue_constraint_25 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeSetupItemBearerSUReqExtIEs_tag2el_25[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeSetupItemBearerSUReqExtIEs_specs_25 = {
-	sizeof(struct E_RABToBeSetupItemBearerSUReqExtIEs),
-	offsetof(struct E_RABToBeSetupItemBearerSUReqExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeSetupItemBearerSUReqExtIEs_tag2el_25,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs = {
-	"E-RABToBeSetupItemBearerSUReqExtIEs",
-	"E-RABToBeSetupItemBearerSUReqExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25,
-	sizeof(asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25)
-		/sizeof(asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25[0]), /* 1 */
-	asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25)
-		/sizeof(asn_DEF_E_RABToBeSetupItemBearerSUReqExtIEs_tags_25[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSetupItemBearerSUReqExtIEs_25,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSetupItemBearerSUReqExtIEs_specs_25	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_32 = {
-	sizeof(struct E_RABSetupItemBearerSUResExtIEs__extensionValue),
-	offsetof(struct E_RABSetupItemBearerSUResExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABSetupItemBearerSUResExtIEs__extensionValue, present),
-	sizeof(((struct E_RABSetupItemBearerSUResExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_32 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_32	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABSetupItemBearerSUResExtIEs_29[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABSetupItemBearerSUResExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_30, &asn_PER_memb_id_constr_30,  memb_id_constraint_29 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABSetupItemBearerSUResExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_31, &asn_PER_memb_criticality_constr_31,  memb_criticality_constraint_29 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABSetupItemBearerSUResExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_32,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_32, &asn_PER_memb_extensionValue_constr_32,  memb_extensionValue_constraint_29 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABSetupItemBearerSUResExtIEs_tag2el_29[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABSetupItemBearerSUResExtIEs_specs_29 = {
-	sizeof(struct E_RABSetupItemBearerSUResExtIEs),
-	offsetof(struct E_RABSetupItemBearerSUResExtIEs, _asn_ctx),
-	asn_MAP_E_RABSetupItemBearerSUResExtIEs_tag2el_29,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABSetupItemBearerSUResExtIEs = {
-	"E-RABSetupItemBearerSUResExtIEs",
-	"E-RABSetupItemBearerSUResExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29,
-	sizeof(asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29)
-		/sizeof(asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29[0]), /* 1 */
-	asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29,	/* Same as above */
-	sizeof(asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29)
-		/sizeof(asn_DEF_E_RABSetupItemBearerSUResExtIEs_tags_29[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABSetupItemBearerSUResExtIEs_29,
-	3,	/* Elements count */
-	&asn_SPC_E_RABSetupItemBearerSUResExtIEs_specs_29	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_36[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs__extensionValue, choice.TransportInformation),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_TransportInformation,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"TransportInformation"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_36[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 0 } /* TransportInformation */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_36 = {
-	sizeof(struct E_RABToBeModifyItemBearerModReqExtIEs__extensionValue),
-	offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeModifyItemBearerModReqExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_36,
-	1,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_36 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_36,
-	1,	/* Elements count */
-	&asn_SPC_extensionValue_specs_36	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeModifyItemBearerModReqExtIEs_33[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_34, &asn_PER_memb_id_constr_34,  memb_id_constraint_33 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_E_RABToBeModifyItemBearerModReqExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_35, &asn_PER_memb_criticality_constr_35,  memb_criticality_constraint_33 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_36,
-		select_E_RABToBeModifyItemBearerModReqExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_36, &asn_PER_memb_extensionValue_constr_36,  memb_extensionValue_constraint_33 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeModifyItemBearerModReqExtIEs_tag2el_33[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeModifyItemBearerModReqExtIEs_specs_33 = {
-	sizeof(struct E_RABToBeModifyItemBearerModReqExtIEs),
-	offsetof(struct E_RABToBeModifyItemBearerModReqExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeModifyItemBearerModReqExtIEs_tag2el_33,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs = {
-	"E-RABToBeModifyItemBearerModReqExtIEs",
-	"E-RABToBeModifyItemBearerModReqExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33,
-	sizeof(asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33)
-		/sizeof(asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33[0]), /* 1 */
-	asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33)
-		/sizeof(asn_DEF_E_RABToBeModifyItemBearerModReqExtIEs_tags_33[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeModifyItemBearerModReqExtIEs_33,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeModifyItemBearerModReqExtIEs_specs_33	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_40 = {
-	sizeof(struct E_RABModifyItemBearerModResExtIEs__extensionValue),
-	offsetof(struct E_RABModifyItemBearerModResExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABModifyItemBearerModResExtIEs__extensionValue, present),
-	sizeof(((struct E_RABModifyItemBearerModResExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_40 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_40	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABModifyItemBearerModResExtIEs_37[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABModifyItemBearerModResExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_38, &asn_PER_memb_id_constr_38,  memb_id_constraint_37 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABModifyItemBearerModResExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_39, &asn_PER_memb_criticality_constr_39,  memb_criticality_constraint_37 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABModifyItemBearerModResExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_40,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_40, &asn_PER_memb_extensionValue_constr_40,  memb_extensionValue_constraint_37 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABModifyItemBearerModResExtIEs_tag2el_37[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABModifyItemBearerModResExtIEs_specs_37 = {
-	sizeof(struct E_RABModifyItemBearerModResExtIEs),
-	offsetof(struct E_RABModifyItemBearerModResExtIEs, _asn_ctx),
-	asn_MAP_E_RABModifyItemBearerModResExtIEs_tag2el_37,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABModifyItemBearerModResExtIEs = {
-	"E-RABModifyItemBearerModResExtIEs",
-	"E-RABModifyItemBearerModResExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37,
-	sizeof(asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37)
-		/sizeof(asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37[0]), /* 1 */
-	asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37,	/* Same as above */
-	sizeof(asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37)
-		/sizeof(asn_DEF_E_RABModifyItemBearerModResExtIEs_tags_37[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABModifyItemBearerModResExtIEs_37,
-	3,	/* Elements count */
-	&asn_SPC_E_RABModifyItemBearerModResExtIEs_specs_37	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_44 = {
-	sizeof(struct E_RABReleaseItemBearerRelCompExtIEs__extensionValue),
-	offsetof(struct E_RABReleaseItemBearerRelCompExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABReleaseItemBearerRelCompExtIEs__extensionValue, present),
-	sizeof(((struct E_RABReleaseItemBearerRelCompExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_44 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_44	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABReleaseItemBearerRelCompExtIEs_41[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABReleaseItemBearerRelCompExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_42, &asn_PER_memb_id_constr_42,  memb_id_constraint_41 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABReleaseItemBearerRelCompExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_43, &asn_PER_memb_criticality_constr_43,  memb_criticality_constraint_41 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABReleaseItemBearerRelCompExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_44,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_44, &asn_PER_memb_extensionValue_constr_44,  memb_extensionValue_constraint_41 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABReleaseItemBearerRelCompExtIEs_tag2el_41[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABReleaseItemBearerRelCompExtIEs_specs_41 = {
-	sizeof(struct E_RABReleaseItemBearerRelCompExtIEs),
-	offsetof(struct E_RABReleaseItemBearerRelCompExtIEs, _asn_ctx),
-	asn_MAP_E_RABReleaseItemBearerRelCompExtIEs_tag2el_41,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABReleaseItemBearerRelCompExtIEs = {
-	"E-RABReleaseItemBearerRelCompExtIEs",
-	"E-RABReleaseItemBearerRelCompExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41,
-	sizeof(asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41)
-		/sizeof(asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41[0]), /* 1 */
-	asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41,	/* Same as above */
-	sizeof(asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41)
-		/sizeof(asn_DEF_E_RABReleaseItemBearerRelCompExtIEs_tags_41[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABReleaseItemBearerRelCompExtIEs_41,
-	3,	/* Elements count */
-	&asn_SPC_E_RABReleaseItemBearerRelCompExtIEs_specs_41	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_48[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue, choice.Correlation_ID),
-		(ASN_TAG_CLASS_UNIVERSAL | (4 << 2)),
-		0,
-		&asn_DEF_Correlation_ID,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Correlation-ID"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue, choice.Correlation_ID_1),
-		(ASN_TAG_CLASS_UNIVERSAL | (4 << 2)),
-		0,
-		&asn_DEF_Correlation_ID,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Correlation-ID"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue, choice.BearerType),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_BearerType,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"BearerType"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_48[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (4 << 2)), 0, 0, 1 }, /* Correlation-ID */
-    { (ASN_TAG_CLASS_UNIVERSAL | (4 << 2)), 1, -1, 0 }, /* Correlation-ID */
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 2, 0, 0 } /* BearerType */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_48 = {
-	sizeof(struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue),
-	offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeSetupItemCtxtSUReqExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_48,
-	3,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_48 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_48,
-	3,	/* Elements count */
-	&asn_SPC_extensionValue_specs_48	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeSetupItemCtxtSUReqExtIEs_45[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_46, &asn_PER_memb_id_constr_46,  memb_id_constraint_45 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_E_RABToBeSetupItemCtxtSUReqExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_47, &asn_PER_memb_criticality_constr_47,  memb_criticality_constraint_45 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_48,
-		select_E_RABToBeSetupItemCtxtSUReqExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_48, &asn_PER_memb_extensionValue_constr_48,  memb_extensionValue_constraint_45 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeSetupItemCtxtSUReqExtIEs_tag2el_45[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeSetupItemCtxtSUReqExtIEs_specs_45 = {
-	sizeof(struct E_RABToBeSetupItemCtxtSUReqExtIEs),
-	offsetof(struct E_RABToBeSetupItemCtxtSUReqExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeSetupItemCtxtSUReqExtIEs_tag2el_45,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs = {
-	"E-RABToBeSetupItemCtxtSUReqExtIEs",
-	"E-RABToBeSetupItemCtxtSUReqExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45,
-	sizeof(asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45)
-		/sizeof(asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45[0]), /* 1 */
-	asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45)
-		/sizeof(asn_DEF_E_RABToBeSetupItemCtxtSUReqExtIEs_tags_45[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSetupItemCtxtSUReqExtIEs_45,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSetupItemCtxtSUReqExtIEs_specs_45	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_52 = {
-	sizeof(struct E_RABSetupItemCtxtSUResExtIEs__extensionValue),
-	offsetof(struct E_RABSetupItemCtxtSUResExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABSetupItemCtxtSUResExtIEs__extensionValue, present),
-	sizeof(((struct E_RABSetupItemCtxtSUResExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_52 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_52	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABSetupItemCtxtSUResExtIEs_49[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABSetupItemCtxtSUResExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_50, &asn_PER_memb_id_constr_50,  memb_id_constraint_49 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABSetupItemCtxtSUResExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_51, &asn_PER_memb_criticality_constr_51,  memb_criticality_constraint_49 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABSetupItemCtxtSUResExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_52,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_52, &asn_PER_memb_extensionValue_constr_52,  memb_extensionValue_constraint_49 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABSetupItemCtxtSUResExtIEs_tag2el_49[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABSetupItemCtxtSUResExtIEs_specs_49 = {
-	sizeof(struct E_RABSetupItemCtxtSUResExtIEs),
-	offsetof(struct E_RABSetupItemCtxtSUResExtIEs, _asn_ctx),
-	asn_MAP_E_RABSetupItemCtxtSUResExtIEs_tag2el_49,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABSetupItemCtxtSUResExtIEs = {
-	"E-RABSetupItemCtxtSUResExtIEs",
-	"E-RABSetupItemCtxtSUResExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49,
-	sizeof(asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49)
-		/sizeof(asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49[0]), /* 1 */
-	asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49,	/* Same as above */
-	sizeof(asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49)
-		/sizeof(asn_DEF_E_RABSetupItemCtxtSUResExtIEs_tags_49[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABSetupItemCtxtSUResExtIEs_49,
-	3,	/* Elements count */
-	&asn_SPC_E_RABSetupItemCtxtSUResExtIEs_specs_49	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_56 = {
-	sizeof(struct TAIItemExtIEs__extensionValue),
-	offsetof(struct TAIItemExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TAIItemExtIEs__extensionValue, present),
-	sizeof(((struct TAIItemExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_56 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_56	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TAIItemExtIEs_53[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TAIItemExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_54, &asn_PER_memb_id_constr_54,  memb_id_constraint_53 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TAIItemExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_55, &asn_PER_memb_criticality_constr_55,  memb_criticality_constraint_53 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TAIItemExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_56,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_56, &asn_PER_memb_extensionValue_constr_56,  memb_extensionValue_constraint_53 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TAIItemExtIEs_tags_53[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TAIItemExtIEs_tag2el_53[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TAIItemExtIEs_specs_53 = {
-	sizeof(struct TAIItemExtIEs),
-	offsetof(struct TAIItemExtIEs, _asn_ctx),
-	asn_MAP_TAIItemExtIEs_tag2el_53,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TAIItemExtIEs = {
-	"TAIItemExtIEs",
-	"TAIItemExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TAIItemExtIEs_tags_53,
-	sizeof(asn_DEF_TAIItemExtIEs_tags_53)
-		/sizeof(asn_DEF_TAIItemExtIEs_tags_53[0]), /* 1 */
-	asn_DEF_TAIItemExtIEs_tags_53,	/* Same as above */
-	sizeof(asn_DEF_TAIItemExtIEs_tags_53)
-		/sizeof(asn_DEF_TAIItemExtIEs_tags_53[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TAIItemExtIEs_53,
-	3,	/* Elements count */
-	&asn_SPC_TAIItemExtIEs_specs_53	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_60 = {
-	sizeof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs__extensionValue),
-	offsetof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABToBeModifiedItemBearerModInd_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_60 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_60	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABToBeModifiedItemBearerModInd_ExtIEs_57[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_58, &asn_PER_memb_id_constr_58,  memb_id_constraint_57 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_59, &asn_PER_memb_criticality_constr_59,  memb_criticality_constraint_57 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_60,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_60, &asn_PER_memb_extensionValue_constr_60,  memb_extensionValue_constraint_57 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABToBeModifiedItemBearerModInd_ExtIEs_tag2el_57[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABToBeModifiedItemBearerModInd_ExtIEs_specs_57 = {
-	sizeof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs),
-	offsetof(struct E_RABToBeModifiedItemBearerModInd_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABToBeModifiedItemBearerModInd_ExtIEs_tag2el_57,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs = {
-	"E-RABToBeModifiedItemBearerModInd-ExtIEs",
-	"E-RABToBeModifiedItemBearerModInd-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57,
-	sizeof(asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57)
-		/sizeof(asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57[0]), /* 1 */
-	asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57,	/* Same as above */
-	sizeof(asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57)
-		/sizeof(asn_DEF_E_RABToBeModifiedItemBearerModInd_ExtIEs_tags_57[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeModifiedItemBearerModInd_ExtIEs_57,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeModifiedItemBearerModInd_ExtIEs_specs_57	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_64 = {
-	sizeof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs__extensionValue),
-	offsetof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_64 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_64	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_61[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_62, &asn_PER_memb_id_constr_62,  memb_id_constraint_61 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_63, &asn_PER_memb_criticality_constr_63,  memb_criticality_constraint_61 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_64,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_64, &asn_PER_memb_extensionValue_constr_64,  memb_extensionValue_constraint_61 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tag2el_61[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_specs_61 = {
-	sizeof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs),
-	offsetof(struct E_RABNotToBeModifiedItemBearerModInd_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tag2el_61,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs = {
-	"E-RABNotToBeModifiedItemBearerModInd-ExtIEs",
-	"E-RABNotToBeModifiedItemBearerModInd-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61,
-	sizeof(asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61)
-		/sizeof(asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61[0]), /* 1 */
-	asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61,	/* Same as above */
-	sizeof(asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61)
-		/sizeof(asn_DEF_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_tags_61[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_61,
-	3,	/* Elements count */
-	&asn_SPC_E_RABNotToBeModifiedItemBearerModInd_ExtIEs_specs_61	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_68 = {
-	sizeof(struct CSGMembershipInfo_ExtIEs__extensionValue),
-	offsetof(struct CSGMembershipInfo_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CSGMembershipInfo_ExtIEs__extensionValue, present),
-	sizeof(((struct CSGMembershipInfo_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_68 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_68	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CSGMembershipInfo_ExtIEs_65[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CSGMembershipInfo_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_66, &asn_PER_memb_id_constr_66,  memb_id_constraint_65 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CSGMembershipInfo_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_67, &asn_PER_memb_criticality_constr_67,  memb_criticality_constraint_65 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CSGMembershipInfo_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_68,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_68, &asn_PER_memb_extensionValue_constr_68,  memb_extensionValue_constraint_65 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CSGMembershipInfo_ExtIEs_tags_65[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CSGMembershipInfo_ExtIEs_tag2el_65[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CSGMembershipInfo_ExtIEs_specs_65 = {
-	sizeof(struct CSGMembershipInfo_ExtIEs),
-	offsetof(struct CSGMembershipInfo_ExtIEs, _asn_ctx),
-	asn_MAP_CSGMembershipInfo_ExtIEs_tag2el_65,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CSGMembershipInfo_ExtIEs = {
-	"CSGMembershipInfo-ExtIEs",
-	"CSGMembershipInfo-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CSGMembershipInfo_ExtIEs_tags_65,
-	sizeof(asn_DEF_CSGMembershipInfo_ExtIEs_tags_65)
-		/sizeof(asn_DEF_CSGMembershipInfo_ExtIEs_tags_65[0]), /* 1 */
-	asn_DEF_CSGMembershipInfo_ExtIEs_tags_65,	/* Same as above */
-	sizeof(asn_DEF_CSGMembershipInfo_ExtIEs_tags_65)
-		/sizeof(asn_DEF_CSGMembershipInfo_ExtIEs_tags_65[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CSGMembershipInfo_ExtIEs_65,
-	3,	/* Elements count */
-	&asn_SPC_CSGMembershipInfo_ExtIEs_specs_65	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_72 = {
-	sizeof(struct E_RABModifyItemBearerModConfExtIEs__extensionValue),
-	offsetof(struct E_RABModifyItemBearerModConfExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABModifyItemBearerModConfExtIEs__extensionValue, present),
-	sizeof(((struct E_RABModifyItemBearerModConfExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_72 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_72	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABModifyItemBearerModConfExtIEs_69[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABModifyItemBearerModConfExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_70, &asn_PER_memb_id_constr_70,  memb_id_constraint_69 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABModifyItemBearerModConfExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_71, &asn_PER_memb_criticality_constr_71,  memb_criticality_constraint_69 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABModifyItemBearerModConfExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_72,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_72, &asn_PER_memb_extensionValue_constr_72,  memb_extensionValue_constraint_69 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABModifyItemBearerModConfExtIEs_tag2el_69[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABModifyItemBearerModConfExtIEs_specs_69 = {
-	sizeof(struct E_RABModifyItemBearerModConfExtIEs),
-	offsetof(struct E_RABModifyItemBearerModConfExtIEs, _asn_ctx),
-	asn_MAP_E_RABModifyItemBearerModConfExtIEs_tag2el_69,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABModifyItemBearerModConfExtIEs = {
-	"E-RABModifyItemBearerModConfExtIEs",
-	"E-RABModifyItemBearerModConfExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69,
-	sizeof(asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69)
-		/sizeof(asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69[0]), /* 1 */
-	asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69,	/* Same as above */
-	sizeof(asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69)
-		/sizeof(asn_DEF_E_RABModifyItemBearerModConfExtIEs_tags_69[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABModifyItemBearerModConfExtIEs_69,
-	3,	/* Elements count */
-	&asn_SPC_E_RABModifyItemBearerModConfExtIEs_specs_69	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_76 = {
-	sizeof(struct E_RABFailedToResumeItemResumeReq_ExtIEs__extensionValue),
-	offsetof(struct E_RABFailedToResumeItemResumeReq_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABFailedToResumeItemResumeReq_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABFailedToResumeItemResumeReq_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_76 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_76	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABFailedToResumeItemResumeReq_ExtIEs_73[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToResumeItemResumeReq_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_74, &asn_PER_memb_id_constr_74,  memb_id_constraint_73 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToResumeItemResumeReq_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_75, &asn_PER_memb_criticality_constr_75,  memb_criticality_constraint_73 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToResumeItemResumeReq_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_76,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_76, &asn_PER_memb_extensionValue_constr_76,  memb_extensionValue_constraint_73 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABFailedToResumeItemResumeReq_ExtIEs_tag2el_73[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABFailedToResumeItemResumeReq_ExtIEs_specs_73 = {
-	sizeof(struct E_RABFailedToResumeItemResumeReq_ExtIEs),
-	offsetof(struct E_RABFailedToResumeItemResumeReq_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABFailedToResumeItemResumeReq_ExtIEs_tag2el_73,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs = {
-	"E-RABFailedToResumeItemResumeReq-ExtIEs",
-	"E-RABFailedToResumeItemResumeReq-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73,
-	sizeof(asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73)
-		/sizeof(asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73[0]), /* 1 */
-	asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73,	/* Same as above */
-	sizeof(asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73)
-		/sizeof(asn_DEF_E_RABFailedToResumeItemResumeReq_ExtIEs_tags_73[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABFailedToResumeItemResumeReq_ExtIEs_73,
-	3,	/* Elements count */
-	&asn_SPC_E_RABFailedToResumeItemResumeReq_ExtIEs_specs_73	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_80 = {
-	sizeof(struct E_RABFailedToResumeItemResumeRes_ExtIEs__extensionValue),
-	offsetof(struct E_RABFailedToResumeItemResumeRes_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABFailedToResumeItemResumeRes_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABFailedToResumeItemResumeRes_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_80 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_80	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABFailedToResumeItemResumeRes_ExtIEs_77[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToResumeItemResumeRes_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_78, &asn_PER_memb_id_constr_78,  memb_id_constraint_77 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToResumeItemResumeRes_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_79, &asn_PER_memb_criticality_constr_79,  memb_criticality_constraint_77 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABFailedToResumeItemResumeRes_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_80,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_80, &asn_PER_memb_extensionValue_constr_80,  memb_extensionValue_constraint_77 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABFailedToResumeItemResumeRes_ExtIEs_tag2el_77[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABFailedToResumeItemResumeRes_ExtIEs_specs_77 = {
-	sizeof(struct E_RABFailedToResumeItemResumeRes_ExtIEs),
-	offsetof(struct E_RABFailedToResumeItemResumeRes_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABFailedToResumeItemResumeRes_ExtIEs_tag2el_77,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs = {
-	"E-RABFailedToResumeItemResumeRes-ExtIEs",
-	"E-RABFailedToResumeItemResumeRes-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77,
-	sizeof(asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77)
-		/sizeof(asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77[0]), /* 1 */
-	asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77,	/* Same as above */
-	sizeof(asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77)
-		/sizeof(asn_DEF_E_RABFailedToResumeItemResumeRes_ExtIEs_tags_77[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABFailedToResumeItemResumeRes_ExtIEs_77,
-	3,	/* Elements count */
-	&asn_SPC_E_RABFailedToResumeItemResumeRes_ExtIEs_specs_77	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_84 = {
-	sizeof(struct Additional_GUTI_ExtIEs__extensionValue),
-	offsetof(struct Additional_GUTI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct Additional_GUTI_ExtIEs__extensionValue, present),
-	sizeof(((struct Additional_GUTI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_84 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_84	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_Additional_GUTI_ExtIEs_81[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct Additional_GUTI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_82, &asn_PER_memb_id_constr_82,  memb_id_constraint_81 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Additional_GUTI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_83, &asn_PER_memb_criticality_constr_83,  memb_criticality_constraint_81 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct Additional_GUTI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_84,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_84, &asn_PER_memb_extensionValue_constr_84,  memb_extensionValue_constraint_81 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_Additional_GUTI_ExtIEs_tags_81[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_Additional_GUTI_ExtIEs_tag2el_81[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_Additional_GUTI_ExtIEs_specs_81 = {
-	sizeof(struct Additional_GUTI_ExtIEs),
-	offsetof(struct Additional_GUTI_ExtIEs, _asn_ctx),
-	asn_MAP_Additional_GUTI_ExtIEs_tag2el_81,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_Additional_GUTI_ExtIEs = {
-	"Additional-GUTI-ExtIEs",
-	"Additional-GUTI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_Additional_GUTI_ExtIEs_tags_81,
-	sizeof(asn_DEF_Additional_GUTI_ExtIEs_tags_81)
-		/sizeof(asn_DEF_Additional_GUTI_ExtIEs_tags_81[0]), /* 1 */
-	asn_DEF_Additional_GUTI_ExtIEs_tags_81,	/* Same as above */
-	sizeof(asn_DEF_Additional_GUTI_ExtIEs_tags_81)
-		/sizeof(asn_DEF_Additional_GUTI_ExtIEs_tags_81[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_Additional_GUTI_ExtIEs_81,
-	3,	/* Elements count */
-	&asn_SPC_Additional_GUTI_ExtIEs_specs_81	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_88 = {
-	sizeof(struct AllocationAndRetentionPriority_ExtIEs__extensionValue),
-	offsetof(struct AllocationAndRetentionPriority_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct AllocationAndRetentionPriority_ExtIEs__extensionValue, present),
-	sizeof(((struct AllocationAndRetentionPriority_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_88 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_88	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_AllocationAndRetentionPriority_ExtIEs_85[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct AllocationAndRetentionPriority_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_86, &asn_PER_memb_id_constr_86,  memb_id_constraint_85 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct AllocationAndRetentionPriority_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_87, &asn_PER_memb_criticality_constr_87,  memb_criticality_constraint_85 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct AllocationAndRetentionPriority_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_88,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_88, &asn_PER_memb_extensionValue_constr_88,  memb_extensionValue_constraint_85 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_AllocationAndRetentionPriority_ExtIEs_tag2el_85[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_AllocationAndRetentionPriority_ExtIEs_specs_85 = {
-	sizeof(struct AllocationAndRetentionPriority_ExtIEs),
-	offsetof(struct AllocationAndRetentionPriority_ExtIEs, _asn_ctx),
-	asn_MAP_AllocationAndRetentionPriority_ExtIEs_tag2el_85,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_AllocationAndRetentionPriority_ExtIEs = {
-	"AllocationAndRetentionPriority-ExtIEs",
-	"AllocationAndRetentionPriority-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85,
-	sizeof(asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85)
-		/sizeof(asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85[0]), /* 1 */
-	asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85,	/* Same as above */
-	sizeof(asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85)
-		/sizeof(asn_DEF_AllocationAndRetentionPriority_ExtIEs_tags_85[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_AllocationAndRetentionPriority_ExtIEs_85,
-	3,	/* Elements count */
-	&asn_SPC_AllocationAndRetentionPriority_ExtIEs_specs_85	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_92 = {
-	sizeof(struct InformationForCECapableUEs_ExtIEs__extensionValue),
-	offsetof(struct InformationForCECapableUEs_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct InformationForCECapableUEs_ExtIEs__extensionValue, present),
-	sizeof(((struct InformationForCECapableUEs_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_92 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_92	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_InformationForCECapableUEs_ExtIEs_89[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct InformationForCECapableUEs_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_90, &asn_PER_memb_id_constr_90,  memb_id_constraint_89 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct InformationForCECapableUEs_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_91, &asn_PER_memb_criticality_constr_91,  memb_criticality_constraint_89 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct InformationForCECapableUEs_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_92,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_92, &asn_PER_memb_extensionValue_constr_92,  memb_extensionValue_constraint_89 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_InformationForCECapableUEs_ExtIEs_tag2el_89[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_InformationForCECapableUEs_ExtIEs_specs_89 = {
-	sizeof(struct InformationForCECapableUEs_ExtIEs),
-	offsetof(struct InformationForCECapableUEs_ExtIEs, _asn_ctx),
-	asn_MAP_InformationForCECapableUEs_ExtIEs_tag2el_89,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_InformationForCECapableUEs_ExtIEs = {
-	"InformationForCECapableUEs-ExtIEs",
-	"InformationForCECapableUEs-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89,
-	sizeof(asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89)
-		/sizeof(asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89[0]), /* 1 */
-	asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89,	/* Same as above */
-	sizeof(asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89)
-		/sizeof(asn_DEF_InformationForCECapableUEs_ExtIEs_tags_89[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_InformationForCECapableUEs_ExtIEs_89,
-	3,	/* Elements count */
-	&asn_SPC_InformationForCECapableUEs_ExtIEs_specs_89	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_96 = {
-	sizeof(struct AssistanceDataForPaging_ExtIEs__extensionValue),
-	offsetof(struct AssistanceDataForPaging_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct AssistanceDataForPaging_ExtIEs__extensionValue, present),
-	sizeof(((struct AssistanceDataForPaging_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_96 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_96	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_AssistanceDataForPaging_ExtIEs_93[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForPaging_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_94, &asn_PER_memb_id_constr_94,  memb_id_constraint_93 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForPaging_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_95, &asn_PER_memb_criticality_constr_95,  memb_criticality_constraint_93 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForPaging_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_96,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_96, &asn_PER_memb_extensionValue_constr_96,  memb_extensionValue_constraint_93 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_AssistanceDataForPaging_ExtIEs_tag2el_93[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_AssistanceDataForPaging_ExtIEs_specs_93 = {
-	sizeof(struct AssistanceDataForPaging_ExtIEs),
-	offsetof(struct AssistanceDataForPaging_ExtIEs, _asn_ctx),
-	asn_MAP_AssistanceDataForPaging_ExtIEs_tag2el_93,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_AssistanceDataForPaging_ExtIEs = {
-	"AssistanceDataForPaging-ExtIEs",
-	"AssistanceDataForPaging-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93,
-	sizeof(asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93)
-		/sizeof(asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93[0]), /* 1 */
-	asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93,	/* Same as above */
-	sizeof(asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93)
-		/sizeof(asn_DEF_AssistanceDataForPaging_ExtIEs_tags_93[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_AssistanceDataForPaging_ExtIEs_93,
-	3,	/* Elements count */
-	&asn_SPC_AssistanceDataForPaging_ExtIEs_specs_93	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_100 = {
-	sizeof(struct AssistanceDataForRecommendedCells_ExtIEs__extensionValue),
-	offsetof(struct AssistanceDataForRecommendedCells_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct AssistanceDataForRecommendedCells_ExtIEs__extensionValue, present),
-	sizeof(((struct AssistanceDataForRecommendedCells_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_100 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_100	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_AssistanceDataForRecommendedCells_ExtIEs_97[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForRecommendedCells_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_98, &asn_PER_memb_id_constr_98,  memb_id_constraint_97 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForRecommendedCells_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_99, &asn_PER_memb_criticality_constr_99,  memb_criticality_constraint_97 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct AssistanceDataForRecommendedCells_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_100,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_100, &asn_PER_memb_extensionValue_constr_100,  memb_extensionValue_constraint_97 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_AssistanceDataForRecommendedCells_ExtIEs_tag2el_97[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_AssistanceDataForRecommendedCells_ExtIEs_specs_97 = {
-	sizeof(struct AssistanceDataForRecommendedCells_ExtIEs),
-	offsetof(struct AssistanceDataForRecommendedCells_ExtIEs, _asn_ctx),
-	asn_MAP_AssistanceDataForRecommendedCells_ExtIEs_tag2el_97,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_AssistanceDataForRecommendedCells_ExtIEs = {
-	"AssistanceDataForRecommendedCells-ExtIEs",
-	"AssistanceDataForRecommendedCells-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97,
-	sizeof(asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97)
-		/sizeof(asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97[0]), /* 1 */
-	asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97,	/* Same as above */
-	sizeof(asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97)
-		/sizeof(asn_DEF_AssistanceDataForRecommendedCells_ExtIEs_tags_97[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_AssistanceDataForRecommendedCells_ExtIEs_97,
-	3,	/* Elements count */
-	&asn_SPC_AssistanceDataForRecommendedCells_ExtIEs_specs_97	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_104[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, choice.COUNTValueExtended),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_COUNTValueExtended,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"COUNTValueExtended"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, choice.COUNTValueExtended_1),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_COUNTValueExtended,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"COUNTValueExtended"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, choice.ReceiveStatusOfULPDCPSDUsExtended),
-		(ASN_TAG_CLASS_UNIVERSAL | (3 << 2)),
-		0,
-		&asn_DEF_ReceiveStatusOfULPDCPSDUsExtended,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"ReceiveStatusOfULPDCPSDUsExtended"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, choice.COUNTvaluePDCP_SNlength18),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_COUNTvaluePDCP_SNlength18,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"COUNTvaluePDCP-SNlength18"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, choice.COUNTvaluePDCP_SNlength18_1),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_COUNTvaluePDCP_SNlength18,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"COUNTvaluePDCP-SNlength18"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, choice.ReceiveStatusOfULPDCPSDUsPDCP_SNlength18),
-		(ASN_TAG_CLASS_UNIVERSAL | (3 << 2)),
-		0,
-		&asn_DEF_ReceiveStatusOfULPDCPSDUsPDCP_SNlength18,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"ReceiveStatusOfULPDCPSDUsPDCP-SNlength18"
-		},
-};
-static const unsigned asn_MAP_extensionValue_to_canonical_104[] = { 2, 5, 0, 1, 3, 4 };
-static const unsigned asn_MAP_extensionValue_from_canonical_104[] = { 2, 3, 0, 4, 5, 1 };
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_104[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (3 << 2)), 2, 0, 1 }, /* ReceiveStatusOfULPDCPSDUsExtended */
-    { (ASN_TAG_CLASS_UNIVERSAL | (3 << 2)), 5, -1, 0 }, /* ReceiveStatusOfULPDCPSDUsPDCP-SNlength18 */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 3 }, /* COUNTValueExtended */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 1, -1, 2 }, /* COUNTValueExtended */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 3, -2, 1 }, /* COUNTvaluePDCP-SNlength18 */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 4, -3, 0 } /* COUNTvaluePDCP-SNlength18 */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_104 = {
-	sizeof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue),
-	offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue, present),
-	sizeof(((struct Bearers_SubjectToStatusTransfer_ItemExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_104,
-	6,	/* Count of tags in the map */
-	asn_MAP_extensionValue_to_canonical_104,
-	asn_MAP_extensionValue_from_canonical_104,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_104 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_104,
-	6,	/* Elements count */
-	&asn_SPC_extensionValue_specs_104	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_Bearers_SubjectToStatusTransfer_ItemExtIEs_101[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_102, &asn_PER_memb_id_constr_102,  memb_id_constraint_101 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_Bearers_SubjectToStatusTransfer_ItemExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_103, &asn_PER_memb_criticality_constr_103,  memb_criticality_constraint_101 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_104,
-		select_Bearers_SubjectToStatusTransfer_ItemExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_104, &asn_PER_memb_extensionValue_constr_104,  memb_extensionValue_constraint_101 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_Bearers_SubjectToStatusTransfer_ItemExtIEs_tag2el_101[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_Bearers_SubjectToStatusTransfer_ItemExtIEs_specs_101 = {
-	sizeof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs),
-	offsetof(struct Bearers_SubjectToStatusTransfer_ItemExtIEs, _asn_ctx),
-	asn_MAP_Bearers_SubjectToStatusTransfer_ItemExtIEs_tag2el_101,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs = {
-	"Bearers-SubjectToStatusTransfer-ItemExtIEs",
-	"Bearers-SubjectToStatusTransfer-ItemExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101,
-	sizeof(asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101)
-		/sizeof(asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101[0]), /* 1 */
-	asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101,	/* Same as above */
-	sizeof(asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101)
-		/sizeof(asn_DEF_Bearers_SubjectToStatusTransfer_ItemExtIEs_tags_101[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_Bearers_SubjectToStatusTransfer_ItemExtIEs_101,
-	3,	/* Elements count */
-	&asn_SPC_Bearers_SubjectToStatusTransfer_ItemExtIEs_specs_101	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_108 = {
-	sizeof(struct CancelledCellinEAI_Item_ExtIEs__extensionValue),
-	offsetof(struct CancelledCellinEAI_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CancelledCellinEAI_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CancelledCellinEAI_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_108 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_108	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CancelledCellinEAI_Item_ExtIEs_105[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CancelledCellinEAI_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_106, &asn_PER_memb_id_constr_106,  memb_id_constraint_105 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CancelledCellinEAI_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_107, &asn_PER_memb_criticality_constr_107,  memb_criticality_constraint_105 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CancelledCellinEAI_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_108,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_108, &asn_PER_memb_extensionValue_constr_108,  memb_extensionValue_constraint_105 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CancelledCellinEAI_Item_ExtIEs_tag2el_105[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CancelledCellinEAI_Item_ExtIEs_specs_105 = {
-	sizeof(struct CancelledCellinEAI_Item_ExtIEs),
-	offsetof(struct CancelledCellinEAI_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CancelledCellinEAI_Item_ExtIEs_tag2el_105,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CancelledCellinEAI_Item_ExtIEs = {
-	"CancelledCellinEAI-Item-ExtIEs",
-	"CancelledCellinEAI-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105,
-	sizeof(asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105)
-		/sizeof(asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105[0]), /* 1 */
-	asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105,	/* Same as above */
-	sizeof(asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105)
-		/sizeof(asn_DEF_CancelledCellinEAI_Item_ExtIEs_tags_105[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CancelledCellinEAI_Item_ExtIEs_105,
-	3,	/* Elements count */
-	&asn_SPC_CancelledCellinEAI_Item_ExtIEs_specs_105	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_112 = {
-	sizeof(struct CancelledCellinTAI_Item_ExtIEs__extensionValue),
-	offsetof(struct CancelledCellinTAI_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CancelledCellinTAI_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CancelledCellinTAI_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_112 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_112	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CancelledCellinTAI_Item_ExtIEs_109[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CancelledCellinTAI_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_110, &asn_PER_memb_id_constr_110,  memb_id_constraint_109 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CancelledCellinTAI_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_111, &asn_PER_memb_criticality_constr_111,  memb_criticality_constraint_109 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CancelledCellinTAI_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_112,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_112, &asn_PER_memb_extensionValue_constr_112,  memb_extensionValue_constraint_109 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CancelledCellinTAI_Item_ExtIEs_tag2el_109[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CancelledCellinTAI_Item_ExtIEs_specs_109 = {
-	sizeof(struct CancelledCellinTAI_Item_ExtIEs),
-	offsetof(struct CancelledCellinTAI_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CancelledCellinTAI_Item_ExtIEs_tag2el_109,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CancelledCellinTAI_Item_ExtIEs = {
-	"CancelledCellinTAI-Item-ExtIEs",
-	"CancelledCellinTAI-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109,
-	sizeof(asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109)
-		/sizeof(asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109[0]), /* 1 */
-	asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109,	/* Same as above */
-	sizeof(asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109)
-		/sizeof(asn_DEF_CancelledCellinTAI_Item_ExtIEs_tags_109[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CancelledCellinTAI_Item_ExtIEs_109,
-	3,	/* Elements count */
-	&asn_SPC_CancelledCellinTAI_Item_ExtIEs_specs_109	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_116 = {
-	sizeof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs__extensionValue),
-	offsetof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs__extensionValue, present),
-	sizeof(((struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_116 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_116	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_113[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_114, &asn_PER_memb_id_constr_114,  memb_id_constraint_113 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_115, &asn_PER_memb_criticality_constr_115,  memb_criticality_constraint_113 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_116,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_116, &asn_PER_memb_extensionValue_constr_116,  memb_extensionValue_constraint_113 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tag2el_113[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_specs_113 = {
-	sizeof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs),
-	offsetof(struct CellIdentifierAndCELevelForCECapableUEs_ExtIEs, _asn_ctx),
-	asn_MAP_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tag2el_113,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs = {
-	"CellIdentifierAndCELevelForCECapableUEs-ExtIEs",
-	"CellIdentifierAndCELevelForCECapableUEs-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113,
-	sizeof(asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113)
-		/sizeof(asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113[0]), /* 1 */
-	asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113,	/* Same as above */
-	sizeof(asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113)
-		/sizeof(asn_DEF_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_tags_113[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_113,
-	3,	/* Elements count */
-	&asn_SPC_CellIdentifierAndCELevelForCECapableUEs_ExtIEs_specs_113	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_120 = {
-	sizeof(struct CellID_Broadcast_Item_ExtIEs__extensionValue),
-	offsetof(struct CellID_Broadcast_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CellID_Broadcast_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CellID_Broadcast_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_120 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_120	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CellID_Broadcast_Item_ExtIEs_117[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CellID_Broadcast_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_118, &asn_PER_memb_id_constr_118,  memb_id_constraint_117 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CellID_Broadcast_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_119, &asn_PER_memb_criticality_constr_119,  memb_criticality_constraint_117 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CellID_Broadcast_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_120,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_120, &asn_PER_memb_extensionValue_constr_120,  memb_extensionValue_constraint_117 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CellID_Broadcast_Item_ExtIEs_tag2el_117[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CellID_Broadcast_Item_ExtIEs_specs_117 = {
-	sizeof(struct CellID_Broadcast_Item_ExtIEs),
-	offsetof(struct CellID_Broadcast_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CellID_Broadcast_Item_ExtIEs_tag2el_117,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CellID_Broadcast_Item_ExtIEs = {
-	"CellID-Broadcast-Item-ExtIEs",
-	"CellID-Broadcast-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117,
-	sizeof(asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117)
-		/sizeof(asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117[0]), /* 1 */
-	asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117,	/* Same as above */
-	sizeof(asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117)
-		/sizeof(asn_DEF_CellID_Broadcast_Item_ExtIEs_tags_117[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CellID_Broadcast_Item_ExtIEs_117,
-	3,	/* Elements count */
-	&asn_SPC_CellID_Broadcast_Item_ExtIEs_specs_117	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_124 = {
-	sizeof(struct CellID_Cancelled_Item_ExtIEs__extensionValue),
-	offsetof(struct CellID_Cancelled_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CellID_Cancelled_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CellID_Cancelled_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_124 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_124	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CellID_Cancelled_Item_ExtIEs_121[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CellID_Cancelled_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_122, &asn_PER_memb_id_constr_122,  memb_id_constraint_121 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CellID_Cancelled_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_123, &asn_PER_memb_criticality_constr_123,  memb_criticality_constraint_121 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CellID_Cancelled_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_124,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_124, &asn_PER_memb_extensionValue_constr_124,  memb_extensionValue_constraint_121 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CellID_Cancelled_Item_ExtIEs_tag2el_121[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CellID_Cancelled_Item_ExtIEs_specs_121 = {
-	sizeof(struct CellID_Cancelled_Item_ExtIEs),
-	offsetof(struct CellID_Cancelled_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CellID_Cancelled_Item_ExtIEs_tag2el_121,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CellID_Cancelled_Item_ExtIEs = {
-	"CellID-Cancelled-Item-ExtIEs",
-	"CellID-Cancelled-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121,
-	sizeof(asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121)
-		/sizeof(asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121[0]), /* 1 */
-	asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121,	/* Same as above */
-	sizeof(asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121)
-		/sizeof(asn_DEF_CellID_Cancelled_Item_ExtIEs_tags_121[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CellID_Cancelled_Item_ExtIEs_121,
-	3,	/* Elements count */
-	&asn_SPC_CellID_Cancelled_Item_ExtIEs_specs_121	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_128 = {
-	sizeof(struct CellBasedMDT_ExtIEs__extensionValue),
-	offsetof(struct CellBasedMDT_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CellBasedMDT_ExtIEs__extensionValue, present),
-	sizeof(((struct CellBasedMDT_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_128 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_128	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CellBasedMDT_ExtIEs_125[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CellBasedMDT_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_126, &asn_PER_memb_id_constr_126,  memb_id_constraint_125 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CellBasedMDT_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_127, &asn_PER_memb_criticality_constr_127,  memb_criticality_constraint_125 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CellBasedMDT_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_128,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_128, &asn_PER_memb_extensionValue_constr_128,  memb_extensionValue_constraint_125 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CellBasedMDT_ExtIEs_tags_125[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CellBasedMDT_ExtIEs_tag2el_125[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CellBasedMDT_ExtIEs_specs_125 = {
-	sizeof(struct CellBasedMDT_ExtIEs),
-	offsetof(struct CellBasedMDT_ExtIEs, _asn_ctx),
-	asn_MAP_CellBasedMDT_ExtIEs_tag2el_125,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CellBasedMDT_ExtIEs = {
-	"CellBasedMDT-ExtIEs",
-	"CellBasedMDT-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CellBasedMDT_ExtIEs_tags_125,
-	sizeof(asn_DEF_CellBasedMDT_ExtIEs_tags_125)
-		/sizeof(asn_DEF_CellBasedMDT_ExtIEs_tags_125[0]), /* 1 */
-	asn_DEF_CellBasedMDT_ExtIEs_tags_125,	/* Same as above */
-	sizeof(asn_DEF_CellBasedMDT_ExtIEs_tags_125)
-		/sizeof(asn_DEF_CellBasedMDT_ExtIEs_tags_125[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CellBasedMDT_ExtIEs_125,
-	3,	/* Elements count */
-	&asn_SPC_CellBasedMDT_ExtIEs_specs_125	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_132 = {
-	sizeof(struct Cdma2000OneXSRVCCInfo_ExtIEs__extensionValue),
-	offsetof(struct Cdma2000OneXSRVCCInfo_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct Cdma2000OneXSRVCCInfo_ExtIEs__extensionValue, present),
-	sizeof(((struct Cdma2000OneXSRVCCInfo_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_132 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_132	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_Cdma2000OneXSRVCCInfo_ExtIEs_129[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct Cdma2000OneXSRVCCInfo_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_130, &asn_PER_memb_id_constr_130,  memb_id_constraint_129 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Cdma2000OneXSRVCCInfo_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_131, &asn_PER_memb_criticality_constr_131,  memb_criticality_constraint_129 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct Cdma2000OneXSRVCCInfo_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_132,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_132, &asn_PER_memb_extensionValue_constr_132,  memb_extensionValue_constraint_129 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_Cdma2000OneXSRVCCInfo_ExtIEs_tag2el_129[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_Cdma2000OneXSRVCCInfo_ExtIEs_specs_129 = {
-	sizeof(struct Cdma2000OneXSRVCCInfo_ExtIEs),
-	offsetof(struct Cdma2000OneXSRVCCInfo_ExtIEs, _asn_ctx),
-	asn_MAP_Cdma2000OneXSRVCCInfo_ExtIEs_tag2el_129,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs = {
-	"Cdma2000OneXSRVCCInfo-ExtIEs",
-	"Cdma2000OneXSRVCCInfo-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129,
-	sizeof(asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129)
-		/sizeof(asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129[0]), /* 1 */
-	asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129,	/* Same as above */
-	sizeof(asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129)
-		/sizeof(asn_DEF_Cdma2000OneXSRVCCInfo_ExtIEs_tags_129[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_Cdma2000OneXSRVCCInfo_ExtIEs_129,
-	3,	/* Elements count */
-	&asn_SPC_Cdma2000OneXSRVCCInfo_ExtIEs_specs_129	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_136 = {
-	sizeof(struct CellType_ExtIEs__extensionValue),
-	offsetof(struct CellType_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CellType_ExtIEs__extensionValue, present),
-	sizeof(((struct CellType_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_136 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_136	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CellType_ExtIEs_133[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CellType_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_134, &asn_PER_memb_id_constr_134,  memb_id_constraint_133 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CellType_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_135, &asn_PER_memb_criticality_constr_135,  memb_criticality_constraint_133 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CellType_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_136,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_136, &asn_PER_memb_extensionValue_constr_136,  memb_extensionValue_constraint_133 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CellType_ExtIEs_tags_133[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CellType_ExtIEs_tag2el_133[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CellType_ExtIEs_specs_133 = {
-	sizeof(struct CellType_ExtIEs),
-	offsetof(struct CellType_ExtIEs, _asn_ctx),
-	asn_MAP_CellType_ExtIEs_tag2el_133,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CellType_ExtIEs = {
-	"CellType-ExtIEs",
-	"CellType-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CellType_ExtIEs_tags_133,
-	sizeof(asn_DEF_CellType_ExtIEs_tags_133)
-		/sizeof(asn_DEF_CellType_ExtIEs_tags_133[0]), /* 1 */
-	asn_DEF_CellType_ExtIEs_tags_133,	/* Same as above */
-	sizeof(asn_DEF_CellType_ExtIEs_tags_133)
-		/sizeof(asn_DEF_CellType_ExtIEs_tags_133[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CellType_ExtIEs_133,
-	3,	/* Elements count */
-	&asn_SPC_CellType_ExtIEs_specs_133	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_140 = {
-	sizeof(struct CGI_ExtIEs__extensionValue),
-	offsetof(struct CGI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CGI_ExtIEs__extensionValue, present),
-	sizeof(((struct CGI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_140 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_140	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CGI_ExtIEs_137[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CGI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_138, &asn_PER_memb_id_constr_138,  memb_id_constraint_137 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CGI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_139, &asn_PER_memb_criticality_constr_139,  memb_criticality_constraint_137 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CGI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_140,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_140, &asn_PER_memb_extensionValue_constr_140,  memb_extensionValue_constraint_137 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CGI_ExtIEs_tags_137[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CGI_ExtIEs_tag2el_137[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CGI_ExtIEs_specs_137 = {
-	sizeof(struct CGI_ExtIEs),
-	offsetof(struct CGI_ExtIEs, _asn_ctx),
-	asn_MAP_CGI_ExtIEs_tag2el_137,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CGI_ExtIEs = {
-	"CGI-ExtIEs",
-	"CGI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CGI_ExtIEs_tags_137,
-	sizeof(asn_DEF_CGI_ExtIEs_tags_137)
-		/sizeof(asn_DEF_CGI_ExtIEs_tags_137[0]), /* 1 */
-	asn_DEF_CGI_ExtIEs_tags_137,	/* Same as above */
-	sizeof(asn_DEF_CGI_ExtIEs_tags_137)
-		/sizeof(asn_DEF_CGI_ExtIEs_tags_137[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CGI_ExtIEs_137,
-	3,	/* Elements count */
-	&asn_SPC_CGI_ExtIEs_specs_137	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_144 = {
-	sizeof(struct CSG_IdList_Item_ExtIEs__extensionValue),
-	offsetof(struct CSG_IdList_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CSG_IdList_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CSG_IdList_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_144 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_144	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CSG_IdList_Item_ExtIEs_141[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CSG_IdList_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_142, &asn_PER_memb_id_constr_142,  memb_id_constraint_141 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CSG_IdList_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_143, &asn_PER_memb_criticality_constr_143,  memb_criticality_constraint_141 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CSG_IdList_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_144,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_144, &asn_PER_memb_extensionValue_constr_144,  memb_extensionValue_constraint_141 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CSG_IdList_Item_ExtIEs_tags_141[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CSG_IdList_Item_ExtIEs_tag2el_141[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CSG_IdList_Item_ExtIEs_specs_141 = {
-	sizeof(struct CSG_IdList_Item_ExtIEs),
-	offsetof(struct CSG_IdList_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CSG_IdList_Item_ExtIEs_tag2el_141,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CSG_IdList_Item_ExtIEs = {
-	"CSG-IdList-Item-ExtIEs",
-	"CSG-IdList-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CSG_IdList_Item_ExtIEs_tags_141,
-	sizeof(asn_DEF_CSG_IdList_Item_ExtIEs_tags_141)
-		/sizeof(asn_DEF_CSG_IdList_Item_ExtIEs_tags_141[0]), /* 1 */
-	asn_DEF_CSG_IdList_Item_ExtIEs_tags_141,	/* Same as above */
-	sizeof(asn_DEF_CSG_IdList_Item_ExtIEs_tags_141)
-		/sizeof(asn_DEF_CSG_IdList_Item_ExtIEs_tags_141[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CSG_IdList_Item_ExtIEs_141,
-	3,	/* Elements count */
-	&asn_SPC_CSG_IdList_Item_ExtIEs_specs_141	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_148 = {
-	sizeof(struct COUNTvalue_ExtIEs__extensionValue),
-	offsetof(struct COUNTvalue_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct COUNTvalue_ExtIEs__extensionValue, present),
-	sizeof(((struct COUNTvalue_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_148 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_148	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_COUNTvalue_ExtIEs_145[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct COUNTvalue_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_146, &asn_PER_memb_id_constr_146,  memb_id_constraint_145 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct COUNTvalue_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_147, &asn_PER_memb_criticality_constr_147,  memb_criticality_constraint_145 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct COUNTvalue_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_148,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_148, &asn_PER_memb_extensionValue_constr_148,  memb_extensionValue_constraint_145 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_COUNTvalue_ExtIEs_tags_145[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_COUNTvalue_ExtIEs_tag2el_145[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_COUNTvalue_ExtIEs_specs_145 = {
-	sizeof(struct COUNTvalue_ExtIEs),
-	offsetof(struct COUNTvalue_ExtIEs, _asn_ctx),
-	asn_MAP_COUNTvalue_ExtIEs_tag2el_145,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_COUNTvalue_ExtIEs = {
-	"COUNTvalue-ExtIEs",
-	"COUNTvalue-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_COUNTvalue_ExtIEs_tags_145,
-	sizeof(asn_DEF_COUNTvalue_ExtIEs_tags_145)
-		/sizeof(asn_DEF_COUNTvalue_ExtIEs_tags_145[0]), /* 1 */
-	asn_DEF_COUNTvalue_ExtIEs_tags_145,	/* Same as above */
-	sizeof(asn_DEF_COUNTvalue_ExtIEs_tags_145)
-		/sizeof(asn_DEF_COUNTvalue_ExtIEs_tags_145[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_COUNTvalue_ExtIEs_145,
-	3,	/* Elements count */
-	&asn_SPC_COUNTvalue_ExtIEs_specs_145	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_152 = {
-	sizeof(struct COUNTValueExtended_ExtIEs__extensionValue),
-	offsetof(struct COUNTValueExtended_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct COUNTValueExtended_ExtIEs__extensionValue, present),
-	sizeof(((struct COUNTValueExtended_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_152 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_152	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_COUNTValueExtended_ExtIEs_149[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct COUNTValueExtended_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_150, &asn_PER_memb_id_constr_150,  memb_id_constraint_149 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct COUNTValueExtended_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_151, &asn_PER_memb_criticality_constr_151,  memb_criticality_constraint_149 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct COUNTValueExtended_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_152,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_152, &asn_PER_memb_extensionValue_constr_152,  memb_extensionValue_constraint_149 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_COUNTValueExtended_ExtIEs_tags_149[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_COUNTValueExtended_ExtIEs_tag2el_149[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_COUNTValueExtended_ExtIEs_specs_149 = {
-	sizeof(struct COUNTValueExtended_ExtIEs),
-	offsetof(struct COUNTValueExtended_ExtIEs, _asn_ctx),
-	asn_MAP_COUNTValueExtended_ExtIEs_tag2el_149,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_COUNTValueExtended_ExtIEs = {
-	"COUNTValueExtended-ExtIEs",
-	"COUNTValueExtended-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_COUNTValueExtended_ExtIEs_tags_149,
-	sizeof(asn_DEF_COUNTValueExtended_ExtIEs_tags_149)
-		/sizeof(asn_DEF_COUNTValueExtended_ExtIEs_tags_149[0]), /* 1 */
-	asn_DEF_COUNTValueExtended_ExtIEs_tags_149,	/* Same as above */
-	sizeof(asn_DEF_COUNTValueExtended_ExtIEs_tags_149)
-		/sizeof(asn_DEF_COUNTValueExtended_ExtIEs_tags_149[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_COUNTValueExtended_ExtIEs_149,
-	3,	/* Elements count */
-	&asn_SPC_COUNTValueExtended_ExtIEs_specs_149	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_156 = {
-	sizeof(struct COUNTvaluePDCP_SNlength18_ExtIEs__extensionValue),
-	offsetof(struct COUNTvaluePDCP_SNlength18_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct COUNTvaluePDCP_SNlength18_ExtIEs__extensionValue, present),
-	sizeof(((struct COUNTvaluePDCP_SNlength18_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_156 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_156	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_COUNTvaluePDCP_SNlength18_ExtIEs_153[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct COUNTvaluePDCP_SNlength18_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_154, &asn_PER_memb_id_constr_154,  memb_id_constraint_153 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct COUNTvaluePDCP_SNlength18_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_155, &asn_PER_memb_criticality_constr_155,  memb_criticality_constraint_153 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct COUNTvaluePDCP_SNlength18_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_156,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_156, &asn_PER_memb_extensionValue_constr_156,  memb_extensionValue_constraint_153 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_COUNTvaluePDCP_SNlength18_ExtIEs_tag2el_153[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_COUNTvaluePDCP_SNlength18_ExtIEs_specs_153 = {
-	sizeof(struct COUNTvaluePDCP_SNlength18_ExtIEs),
-	offsetof(struct COUNTvaluePDCP_SNlength18_ExtIEs, _asn_ctx),
-	asn_MAP_COUNTvaluePDCP_SNlength18_ExtIEs_tag2el_153,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs = {
-	"COUNTvaluePDCP-SNlength18-ExtIEs",
-	"COUNTvaluePDCP-SNlength18-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153,
-	sizeof(asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153)
-		/sizeof(asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153[0]), /* 1 */
-	asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153,	/* Same as above */
-	sizeof(asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153)
-		/sizeof(asn_DEF_COUNTvaluePDCP_SNlength18_ExtIEs_tags_153[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_COUNTvaluePDCP_SNlength18_ExtIEs_153,
-	3,	/* Elements count */
-	&asn_SPC_COUNTvaluePDCP_SNlength18_ExtIEs_specs_153	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_160 = {
-	sizeof(struct CriticalityDiagnostics_ExtIEs__extensionValue),
-	offsetof(struct CriticalityDiagnostics_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CriticalityDiagnostics_ExtIEs__extensionValue, present),
-	sizeof(((struct CriticalityDiagnostics_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_160 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_160	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CriticalityDiagnostics_ExtIEs_157[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CriticalityDiagnostics_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_158, &asn_PER_memb_id_constr_158,  memb_id_constraint_157 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CriticalityDiagnostics_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_159, &asn_PER_memb_criticality_constr_159,  memb_criticality_constraint_157 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CriticalityDiagnostics_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_160,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_160, &asn_PER_memb_extensionValue_constr_160,  memb_extensionValue_constraint_157 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CriticalityDiagnostics_ExtIEs_tag2el_157[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CriticalityDiagnostics_ExtIEs_specs_157 = {
-	sizeof(struct CriticalityDiagnostics_ExtIEs),
-	offsetof(struct CriticalityDiagnostics_ExtIEs, _asn_ctx),
-	asn_MAP_CriticalityDiagnostics_ExtIEs_tag2el_157,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CriticalityDiagnostics_ExtIEs = {
-	"CriticalityDiagnostics-ExtIEs",
-	"CriticalityDiagnostics-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157,
-	sizeof(asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157)
-		/sizeof(asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157[0]), /* 1 */
-	asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157,	/* Same as above */
-	sizeof(asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157)
-		/sizeof(asn_DEF_CriticalityDiagnostics_ExtIEs_tags_157[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CriticalityDiagnostics_ExtIEs_157,
-	3,	/* Elements count */
-	&asn_SPC_CriticalityDiagnostics_ExtIEs_specs_157	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_164 = {
-	sizeof(struct CriticalityDiagnostics_IE_Item_ExtIEs__extensionValue),
-	offsetof(struct CriticalityDiagnostics_IE_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CriticalityDiagnostics_IE_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CriticalityDiagnostics_IE_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_164 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_164	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CriticalityDiagnostics_IE_Item_ExtIEs_161[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CriticalityDiagnostics_IE_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_162, &asn_PER_memb_id_constr_162,  memb_id_constraint_161 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CriticalityDiagnostics_IE_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_163, &asn_PER_memb_criticality_constr_163,  memb_criticality_constraint_161 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CriticalityDiagnostics_IE_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_164,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_164, &asn_PER_memb_extensionValue_constr_164,  memb_extensionValue_constraint_161 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CriticalityDiagnostics_IE_Item_ExtIEs_tag2el_161[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CriticalityDiagnostics_IE_Item_ExtIEs_specs_161 = {
-	sizeof(struct CriticalityDiagnostics_IE_Item_ExtIEs),
-	offsetof(struct CriticalityDiagnostics_IE_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CriticalityDiagnostics_IE_Item_ExtIEs_tag2el_161,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs = {
-	"CriticalityDiagnostics-IE-Item-ExtIEs",
-	"CriticalityDiagnostics-IE-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161,
-	sizeof(asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161)
-		/sizeof(asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161[0]), /* 1 */
-	asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161,	/* Same as above */
-	sizeof(asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161)
-		/sizeof(asn_DEF_CriticalityDiagnostics_IE_Item_ExtIEs_tags_161[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CriticalityDiagnostics_IE_Item_ExtIEs_161,
-	3,	/* Elements count */
-	&asn_SPC_CriticalityDiagnostics_IE_Item_ExtIEs_specs_161	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_168 = {
-	sizeof(struct ServedDCNsItem_ExtIEs__extensionValue),
-	offsetof(struct ServedDCNsItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ServedDCNsItem_ExtIEs__extensionValue, present),
-	sizeof(((struct ServedDCNsItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_168 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_168	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ServedDCNsItem_ExtIEs_165[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ServedDCNsItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_166, &asn_PER_memb_id_constr_166,  memb_id_constraint_165 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ServedDCNsItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_167, &asn_PER_memb_criticality_constr_167,  memb_criticality_constraint_165 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ServedDCNsItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_168,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_168, &asn_PER_memb_extensionValue_constr_168,  memb_extensionValue_constraint_165 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ServedDCNsItem_ExtIEs_tags_165[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ServedDCNsItem_ExtIEs_tag2el_165[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ServedDCNsItem_ExtIEs_specs_165 = {
-	sizeof(struct ServedDCNsItem_ExtIEs),
-	offsetof(struct ServedDCNsItem_ExtIEs, _asn_ctx),
-	asn_MAP_ServedDCNsItem_ExtIEs_tag2el_165,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ServedDCNsItem_ExtIEs = {
-	"ServedDCNsItem-ExtIEs",
-	"ServedDCNsItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ServedDCNsItem_ExtIEs_tags_165,
-	sizeof(asn_DEF_ServedDCNsItem_ExtIEs_tags_165)
-		/sizeof(asn_DEF_ServedDCNsItem_ExtIEs_tags_165[0]), /* 1 */
-	asn_DEF_ServedDCNsItem_ExtIEs_tags_165,	/* Same as above */
-	sizeof(asn_DEF_ServedDCNsItem_ExtIEs_tags_165)
-		/sizeof(asn_DEF_ServedDCNsItem_ExtIEs_tags_165[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ServedDCNsItem_ExtIEs_165,
-	3,	/* Elements count */
-	&asn_SPC_ServedDCNsItem_ExtIEs_specs_165	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_172 = {
-	sizeof(struct DL_CP_SecurityInformation_ExtIEs__extensionValue),
-	offsetof(struct DL_CP_SecurityInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct DL_CP_SecurityInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct DL_CP_SecurityInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_172 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_172	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_DL_CP_SecurityInformation_ExtIEs_169[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct DL_CP_SecurityInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_170, &asn_PER_memb_id_constr_170,  memb_id_constraint_169 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct DL_CP_SecurityInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_171, &asn_PER_memb_criticality_constr_171,  memb_criticality_constraint_169 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct DL_CP_SecurityInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_172,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_172, &asn_PER_memb_extensionValue_constr_172,  memb_extensionValue_constraint_169 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_DL_CP_SecurityInformation_ExtIEs_tag2el_169[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_DL_CP_SecurityInformation_ExtIEs_specs_169 = {
-	sizeof(struct DL_CP_SecurityInformation_ExtIEs),
-	offsetof(struct DL_CP_SecurityInformation_ExtIEs, _asn_ctx),
-	asn_MAP_DL_CP_SecurityInformation_ExtIEs_tag2el_169,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_DL_CP_SecurityInformation_ExtIEs = {
-	"DL-CP-SecurityInformation-ExtIEs",
-	"DL-CP-SecurityInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169,
-	sizeof(asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169)
-		/sizeof(asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169[0]), /* 1 */
-	asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169,	/* Same as above */
-	sizeof(asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169)
-		/sizeof(asn_DEF_DL_CP_SecurityInformation_ExtIEs_tags_169[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_DL_CP_SecurityInformation_ExtIEs_169,
-	3,	/* Elements count */
-	&asn_SPC_DL_CP_SecurityInformation_ExtIEs_specs_169	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_176 = {
-	sizeof(struct EmergencyAreaID_Broadcast_Item_ExtIEs__extensionValue),
-	offsetof(struct EmergencyAreaID_Broadcast_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct EmergencyAreaID_Broadcast_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct EmergencyAreaID_Broadcast_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_176 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_176	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_EmergencyAreaID_Broadcast_Item_ExtIEs_173[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct EmergencyAreaID_Broadcast_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_174, &asn_PER_memb_id_constr_174,  memb_id_constraint_173 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct EmergencyAreaID_Broadcast_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_175, &asn_PER_memb_criticality_constr_175,  memb_criticality_constraint_173 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct EmergencyAreaID_Broadcast_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_176,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_176, &asn_PER_memb_extensionValue_constr_176,  memb_extensionValue_constraint_173 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_EmergencyAreaID_Broadcast_Item_ExtIEs_tag2el_173[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_EmergencyAreaID_Broadcast_Item_ExtIEs_specs_173 = {
-	sizeof(struct EmergencyAreaID_Broadcast_Item_ExtIEs),
-	offsetof(struct EmergencyAreaID_Broadcast_Item_ExtIEs, _asn_ctx),
-	asn_MAP_EmergencyAreaID_Broadcast_Item_ExtIEs_tag2el_173,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs = {
-	"EmergencyAreaID-Broadcast-Item-ExtIEs",
-	"EmergencyAreaID-Broadcast-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173,
-	sizeof(asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173)
-		/sizeof(asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173[0]), /* 1 */
-	asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173,	/* Same as above */
-	sizeof(asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173)
-		/sizeof(asn_DEF_EmergencyAreaID_Broadcast_Item_ExtIEs_tags_173[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_EmergencyAreaID_Broadcast_Item_ExtIEs_173,
-	3,	/* Elements count */
-	&asn_SPC_EmergencyAreaID_Broadcast_Item_ExtIEs_specs_173	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_180 = {
-	sizeof(struct EmergencyAreaID_Cancelled_Item_ExtIEs__extensionValue),
-	offsetof(struct EmergencyAreaID_Cancelled_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct EmergencyAreaID_Cancelled_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct EmergencyAreaID_Cancelled_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_180 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_180	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_EmergencyAreaID_Cancelled_Item_ExtIEs_177[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct EmergencyAreaID_Cancelled_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_178, &asn_PER_memb_id_constr_178,  memb_id_constraint_177 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct EmergencyAreaID_Cancelled_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_179, &asn_PER_memb_criticality_constr_179,  memb_criticality_constraint_177 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct EmergencyAreaID_Cancelled_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_180,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_180, &asn_PER_memb_extensionValue_constr_180,  memb_extensionValue_constraint_177 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_EmergencyAreaID_Cancelled_Item_ExtIEs_tag2el_177[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_EmergencyAreaID_Cancelled_Item_ExtIEs_specs_177 = {
-	sizeof(struct EmergencyAreaID_Cancelled_Item_ExtIEs),
-	offsetof(struct EmergencyAreaID_Cancelled_Item_ExtIEs, _asn_ctx),
-	asn_MAP_EmergencyAreaID_Cancelled_Item_ExtIEs_tag2el_177,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs = {
-	"EmergencyAreaID-Cancelled-Item-ExtIEs",
-	"EmergencyAreaID-Cancelled-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177,
-	sizeof(asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177)
-		/sizeof(asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177[0]), /* 1 */
-	asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177,	/* Same as above */
-	sizeof(asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177)
-		/sizeof(asn_DEF_EmergencyAreaID_Cancelled_Item_ExtIEs_tags_177[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_EmergencyAreaID_Cancelled_Item_ExtIEs_177,
-	3,	/* Elements count */
-	&asn_SPC_EmergencyAreaID_Cancelled_Item_ExtIEs_specs_177	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_184 = {
-	sizeof(struct CompletedCellinEAI_Item_ExtIEs__extensionValue),
-	offsetof(struct CompletedCellinEAI_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CompletedCellinEAI_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CompletedCellinEAI_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_184 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_184	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CompletedCellinEAI_Item_ExtIEs_181[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CompletedCellinEAI_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_182, &asn_PER_memb_id_constr_182,  memb_id_constraint_181 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CompletedCellinEAI_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_183, &asn_PER_memb_criticality_constr_183,  memb_criticality_constraint_181 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CompletedCellinEAI_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_184,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_184, &asn_PER_memb_extensionValue_constr_184,  memb_extensionValue_constraint_181 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CompletedCellinEAI_Item_ExtIEs_tag2el_181[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CompletedCellinEAI_Item_ExtIEs_specs_181 = {
-	sizeof(struct CompletedCellinEAI_Item_ExtIEs),
-	offsetof(struct CompletedCellinEAI_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CompletedCellinEAI_Item_ExtIEs_tag2el_181,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CompletedCellinEAI_Item_ExtIEs = {
-	"CompletedCellinEAI-Item-ExtIEs",
-	"CompletedCellinEAI-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181,
-	sizeof(asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181)
-		/sizeof(asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181[0]), /* 1 */
-	asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181,	/* Same as above */
-	sizeof(asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181)
-		/sizeof(asn_DEF_CompletedCellinEAI_Item_ExtIEs_tags_181[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CompletedCellinEAI_Item_ExtIEs_181,
-	3,	/* Elements count */
-	&asn_SPC_CompletedCellinEAI_Item_ExtIEs_specs_181	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_188 = {
-	sizeof(struct GERAN_Cell_ID_ExtIEs__extensionValue),
-	offsetof(struct GERAN_Cell_ID_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct GERAN_Cell_ID_ExtIEs__extensionValue, present),
-	sizeof(((struct GERAN_Cell_ID_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_188 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_188	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_GERAN_Cell_ID_ExtIEs_185[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct GERAN_Cell_ID_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_186, &asn_PER_memb_id_constr_186,  memb_id_constraint_185 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct GERAN_Cell_ID_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_187, &asn_PER_memb_criticality_constr_187,  memb_criticality_constraint_185 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct GERAN_Cell_ID_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_188,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_188, &asn_PER_memb_extensionValue_constr_188,  memb_extensionValue_constraint_185 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_GERAN_Cell_ID_ExtIEs_tag2el_185[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_GERAN_Cell_ID_ExtIEs_specs_185 = {
-	sizeof(struct GERAN_Cell_ID_ExtIEs),
-	offsetof(struct GERAN_Cell_ID_ExtIEs, _asn_ctx),
-	asn_MAP_GERAN_Cell_ID_ExtIEs_tag2el_185,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_GERAN_Cell_ID_ExtIEs = {
-	"GERAN-Cell-ID-ExtIEs",
-	"GERAN-Cell-ID-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185,
-	sizeof(asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185)
-		/sizeof(asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185[0]), /* 1 */
-	asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185,	/* Same as above */
-	sizeof(asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185)
-		/sizeof(asn_DEF_GERAN_Cell_ID_ExtIEs_tags_185[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_GERAN_Cell_ID_ExtIEs_185,
-	3,	/* Elements count */
-	&asn_SPC_GERAN_Cell_ID_ExtIEs_specs_185	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_192 = {
-	sizeof(struct GlobalENB_ID_ExtIEs__extensionValue),
-	offsetof(struct GlobalENB_ID_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct GlobalENB_ID_ExtIEs__extensionValue, present),
-	sizeof(((struct GlobalENB_ID_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_192 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_192	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_GlobalENB_ID_ExtIEs_189[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct GlobalENB_ID_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_190, &asn_PER_memb_id_constr_190,  memb_id_constraint_189 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct GlobalENB_ID_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_191, &asn_PER_memb_criticality_constr_191,  memb_criticality_constraint_189 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct GlobalENB_ID_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_192,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_192, &asn_PER_memb_extensionValue_constr_192,  memb_extensionValue_constraint_189 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_GlobalENB_ID_ExtIEs_tags_189[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_GlobalENB_ID_ExtIEs_tag2el_189[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_GlobalENB_ID_ExtIEs_specs_189 = {
-	sizeof(struct GlobalENB_ID_ExtIEs),
-	offsetof(struct GlobalENB_ID_ExtIEs, _asn_ctx),
-	asn_MAP_GlobalENB_ID_ExtIEs_tag2el_189,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_GlobalENB_ID_ExtIEs = {
-	"GlobalENB-ID-ExtIEs",
-	"GlobalENB-ID-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_GlobalENB_ID_ExtIEs_tags_189,
-	sizeof(asn_DEF_GlobalENB_ID_ExtIEs_tags_189)
-		/sizeof(asn_DEF_GlobalENB_ID_ExtIEs_tags_189[0]), /* 1 */
-	asn_DEF_GlobalENB_ID_ExtIEs_tags_189,	/* Same as above */
-	sizeof(asn_DEF_GlobalENB_ID_ExtIEs_tags_189)
-		/sizeof(asn_DEF_GlobalENB_ID_ExtIEs_tags_189[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_GlobalENB_ID_ExtIEs_189,
-	3,	/* Elements count */
-	&asn_SPC_GlobalENB_ID_ExtIEs_specs_189	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_196 = {
-	sizeof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs__extensionValue),
-	offsetof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs__extensionValue, present),
-	sizeof(((struct ENB_StatusTransfer_TransparentContainer_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_196 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_196	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ENB_StatusTransfer_TransparentContainer_ExtIEs_193[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_194, &asn_PER_memb_id_constr_194,  memb_id_constraint_193 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_195, &asn_PER_memb_criticality_constr_195,  memb_criticality_constraint_193 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_196,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_196, &asn_PER_memb_extensionValue_constr_196,  memb_extensionValue_constraint_193 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ENB_StatusTransfer_TransparentContainer_ExtIEs_tag2el_193[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ENB_StatusTransfer_TransparentContainer_ExtIEs_specs_193 = {
-	sizeof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs),
-	offsetof(struct ENB_StatusTransfer_TransparentContainer_ExtIEs, _asn_ctx),
-	asn_MAP_ENB_StatusTransfer_TransparentContainer_ExtIEs_tag2el_193,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs = {
-	"ENB-StatusTransfer-TransparentContainer-ExtIEs",
-	"ENB-StatusTransfer-TransparentContainer-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193,
-	sizeof(asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193)
-		/sizeof(asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193[0]), /* 1 */
-	asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193,	/* Same as above */
-	sizeof(asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193)
-		/sizeof(asn_DEF_ENB_StatusTransfer_TransparentContainer_ExtIEs_tags_193[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ENB_StatusTransfer_TransparentContainer_ExtIEs_193,
-	3,	/* Elements count */
-	&asn_SPC_ENB_StatusTransfer_TransparentContainer_ExtIEs_specs_193	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_200 = {
-	sizeof(struct E_RABInformationListItem_ExtIEs__extensionValue),
-	offsetof(struct E_RABInformationListItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABInformationListItem_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABInformationListItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_200 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_200	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABInformationListItem_ExtIEs_197[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABInformationListItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_198, &asn_PER_memb_id_constr_198,  memb_id_constraint_197 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABInformationListItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_199, &asn_PER_memb_criticality_constr_199,  memb_criticality_constraint_197 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABInformationListItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_200,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_200, &asn_PER_memb_extensionValue_constr_200,  memb_extensionValue_constraint_197 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABInformationListItem_ExtIEs_tags_197[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABInformationListItem_ExtIEs_tag2el_197[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABInformationListItem_ExtIEs_specs_197 = {
-	sizeof(struct E_RABInformationListItem_ExtIEs),
-	offsetof(struct E_RABInformationListItem_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABInformationListItem_ExtIEs_tag2el_197,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABInformationListItem_ExtIEs = {
-	"E-RABInformationListItem-ExtIEs",
-	"E-RABInformationListItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABInformationListItem_ExtIEs_tags_197,
-	sizeof(asn_DEF_E_RABInformationListItem_ExtIEs_tags_197)
-		/sizeof(asn_DEF_E_RABInformationListItem_ExtIEs_tags_197[0]), /* 1 */
-	asn_DEF_E_RABInformationListItem_ExtIEs_tags_197,	/* Same as above */
-	sizeof(asn_DEF_E_RABInformationListItem_ExtIEs_tags_197)
-		/sizeof(asn_DEF_E_RABInformationListItem_ExtIEs_tags_197[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABInformationListItem_ExtIEs_197,
-	3,	/* Elements count */
-	&asn_SPC_E_RABInformationListItem_ExtIEs_specs_197	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_204 = {
-	sizeof(struct E_RABItem_ExtIEs__extensionValue),
-	offsetof(struct E_RABItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABItem_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_204 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_204	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABItem_ExtIEs_201[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_202, &asn_PER_memb_id_constr_202,  memb_id_constraint_201 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_203, &asn_PER_memb_criticality_constr_203,  memb_criticality_constraint_201 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_204,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_204, &asn_PER_memb_extensionValue_constr_204,  memb_extensionValue_constraint_201 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABItem_ExtIEs_tags_201[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABItem_ExtIEs_tag2el_201[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABItem_ExtIEs_specs_201 = {
-	sizeof(struct E_RABItem_ExtIEs),
-	offsetof(struct E_RABItem_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABItem_ExtIEs_tag2el_201,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABItem_ExtIEs = {
-	"E-RABItem-ExtIEs",
-	"E-RABItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABItem_ExtIEs_tags_201,
-	sizeof(asn_DEF_E_RABItem_ExtIEs_tags_201)
-		/sizeof(asn_DEF_E_RABItem_ExtIEs_tags_201[0]), /* 1 */
-	asn_DEF_E_RABItem_ExtIEs_tags_201,	/* Same as above */
-	sizeof(asn_DEF_E_RABItem_ExtIEs_tags_201)
-		/sizeof(asn_DEF_E_RABItem_ExtIEs_tags_201[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABItem_ExtIEs_201,
-	3,	/* Elements count */
-	&asn_SPC_E_RABItem_ExtIEs_specs_201	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_208 = {
-	sizeof(struct E_RABQoSParameters_ExtIEs__extensionValue),
-	offsetof(struct E_RABQoSParameters_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct E_RABQoSParameters_ExtIEs__extensionValue, present),
-	sizeof(((struct E_RABQoSParameters_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_208 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_208	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_E_RABQoSParameters_ExtIEs_205[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABQoSParameters_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_206, &asn_PER_memb_id_constr_206,  memb_id_constraint_205 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct E_RABQoSParameters_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_207, &asn_PER_memb_criticality_constr_207,  memb_criticality_constraint_205 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct E_RABQoSParameters_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_208,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_208, &asn_PER_memb_extensionValue_constr_208,  memb_extensionValue_constraint_205 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_E_RABQoSParameters_ExtIEs_tags_205[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_E_RABQoSParameters_ExtIEs_tag2el_205[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_E_RABQoSParameters_ExtIEs_specs_205 = {
-	sizeof(struct E_RABQoSParameters_ExtIEs),
-	offsetof(struct E_RABQoSParameters_ExtIEs, _asn_ctx),
-	asn_MAP_E_RABQoSParameters_ExtIEs_tag2el_205,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_E_RABQoSParameters_ExtIEs = {
-	"E-RABQoSParameters-ExtIEs",
-	"E-RABQoSParameters-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_E_RABQoSParameters_ExtIEs_tags_205,
-	sizeof(asn_DEF_E_RABQoSParameters_ExtIEs_tags_205)
-		/sizeof(asn_DEF_E_RABQoSParameters_ExtIEs_tags_205[0]), /* 1 */
-	asn_DEF_E_RABQoSParameters_ExtIEs_tags_205,	/* Same as above */
-	sizeof(asn_DEF_E_RABQoSParameters_ExtIEs_tags_205)
-		/sizeof(asn_DEF_E_RABQoSParameters_ExtIEs_tags_205[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABQoSParameters_ExtIEs_205,
-	3,	/* Elements count */
-	&asn_SPC_E_RABQoSParameters_ExtIEs_specs_205	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_212 = {
-	sizeof(struct EUTRAN_CGI_ExtIEs__extensionValue),
-	offsetof(struct EUTRAN_CGI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct EUTRAN_CGI_ExtIEs__extensionValue, present),
-	sizeof(((struct EUTRAN_CGI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_212 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_212	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_EUTRAN_CGI_ExtIEs_209[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct EUTRAN_CGI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_210, &asn_PER_memb_id_constr_210,  memb_id_constraint_209 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct EUTRAN_CGI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_211, &asn_PER_memb_criticality_constr_211,  memb_criticality_constraint_209 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct EUTRAN_CGI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_212,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_212, &asn_PER_memb_extensionValue_constr_212,  memb_extensionValue_constraint_209 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_EUTRAN_CGI_ExtIEs_tags_209[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_EUTRAN_CGI_ExtIEs_tag2el_209[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_EUTRAN_CGI_ExtIEs_specs_209 = {
-	sizeof(struct EUTRAN_CGI_ExtIEs),
-	offsetof(struct EUTRAN_CGI_ExtIEs, _asn_ctx),
-	asn_MAP_EUTRAN_CGI_ExtIEs_tag2el_209,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_EUTRAN_CGI_ExtIEs = {
-	"EUTRAN-CGI-ExtIEs",
-	"EUTRAN-CGI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_EUTRAN_CGI_ExtIEs_tags_209,
-	sizeof(asn_DEF_EUTRAN_CGI_ExtIEs_tags_209)
-		/sizeof(asn_DEF_EUTRAN_CGI_ExtIEs_tags_209[0]), /* 1 */
-	asn_DEF_EUTRAN_CGI_ExtIEs_tags_209,	/* Same as above */
-	sizeof(asn_DEF_EUTRAN_CGI_ExtIEs_tags_209)
-		/sizeof(asn_DEF_EUTRAN_CGI_ExtIEs_tags_209[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_EUTRAN_CGI_ExtIEs_209,
-	3,	/* Elements count */
-	&asn_SPC_EUTRAN_CGI_ExtIEs_specs_209	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_216 = {
-	sizeof(struct ExpectedUEBehaviour_ExtIEs__extensionValue),
-	offsetof(struct ExpectedUEBehaviour_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ExpectedUEBehaviour_ExtIEs__extensionValue, present),
-	sizeof(((struct ExpectedUEBehaviour_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_216 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_216	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ExpectedUEBehaviour_ExtIEs_213[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ExpectedUEBehaviour_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_214, &asn_PER_memb_id_constr_214,  memb_id_constraint_213 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ExpectedUEBehaviour_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_215, &asn_PER_memb_criticality_constr_215,  memb_criticality_constraint_213 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ExpectedUEBehaviour_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_216,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_216, &asn_PER_memb_extensionValue_constr_216,  memb_extensionValue_constraint_213 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ExpectedUEBehaviour_ExtIEs_tag2el_213[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ExpectedUEBehaviour_ExtIEs_specs_213 = {
-	sizeof(struct ExpectedUEBehaviour_ExtIEs),
-	offsetof(struct ExpectedUEBehaviour_ExtIEs, _asn_ctx),
-	asn_MAP_ExpectedUEBehaviour_ExtIEs_tag2el_213,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ExpectedUEBehaviour_ExtIEs = {
-	"ExpectedUEBehaviour-ExtIEs",
-	"ExpectedUEBehaviour-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213,
-	sizeof(asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213)
-		/sizeof(asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213[0]), /* 1 */
-	asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213,	/* Same as above */
-	sizeof(asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213)
-		/sizeof(asn_DEF_ExpectedUEBehaviour_ExtIEs_tags_213[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ExpectedUEBehaviour_ExtIEs_213,
-	3,	/* Elements count */
-	&asn_SPC_ExpectedUEBehaviour_ExtIEs_specs_213	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_220 = {
-	sizeof(struct ExpectedUEActivityBehaviour_ExtIEs__extensionValue),
-	offsetof(struct ExpectedUEActivityBehaviour_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ExpectedUEActivityBehaviour_ExtIEs__extensionValue, present),
-	sizeof(((struct ExpectedUEActivityBehaviour_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_220 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_220	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ExpectedUEActivityBehaviour_ExtIEs_217[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ExpectedUEActivityBehaviour_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_218, &asn_PER_memb_id_constr_218,  memb_id_constraint_217 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ExpectedUEActivityBehaviour_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_219, &asn_PER_memb_criticality_constr_219,  memb_criticality_constraint_217 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ExpectedUEActivityBehaviour_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_220,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_220, &asn_PER_memb_extensionValue_constr_220,  memb_extensionValue_constraint_217 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ExpectedUEActivityBehaviour_ExtIEs_tag2el_217[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ExpectedUEActivityBehaviour_ExtIEs_specs_217 = {
-	sizeof(struct ExpectedUEActivityBehaviour_ExtIEs),
-	offsetof(struct ExpectedUEActivityBehaviour_ExtIEs, _asn_ctx),
-	asn_MAP_ExpectedUEActivityBehaviour_ExtIEs_tag2el_217,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ExpectedUEActivityBehaviour_ExtIEs = {
-	"ExpectedUEActivityBehaviour-ExtIEs",
-	"ExpectedUEActivityBehaviour-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217,
-	sizeof(asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217)
-		/sizeof(asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217[0]), /* 1 */
-	asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217,	/* Same as above */
-	sizeof(asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217)
-		/sizeof(asn_DEF_ExpectedUEActivityBehaviour_ExtIEs_tags_217[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ExpectedUEActivityBehaviour_ExtIEs_217,
-	3,	/* Elements count */
-	&asn_SPC_ExpectedUEActivityBehaviour_ExtIEs_specs_217	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_224 = {
-	sizeof(struct ForbiddenTAs_Item_ExtIEs__extensionValue),
-	offsetof(struct ForbiddenTAs_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ForbiddenTAs_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct ForbiddenTAs_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_224 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_224	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ForbiddenTAs_Item_ExtIEs_221[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ForbiddenTAs_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_222, &asn_PER_memb_id_constr_222,  memb_id_constraint_221 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ForbiddenTAs_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_223, &asn_PER_memb_criticality_constr_223,  memb_criticality_constraint_221 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ForbiddenTAs_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_224,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_224, &asn_PER_memb_extensionValue_constr_224,  memb_extensionValue_constraint_221 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ForbiddenTAs_Item_ExtIEs_tag2el_221[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ForbiddenTAs_Item_ExtIEs_specs_221 = {
-	sizeof(struct ForbiddenTAs_Item_ExtIEs),
-	offsetof(struct ForbiddenTAs_Item_ExtIEs, _asn_ctx),
-	asn_MAP_ForbiddenTAs_Item_ExtIEs_tag2el_221,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ForbiddenTAs_Item_ExtIEs = {
-	"ForbiddenTAs-Item-ExtIEs",
-	"ForbiddenTAs-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221,
-	sizeof(asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221)
-		/sizeof(asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221[0]), /* 1 */
-	asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221,	/* Same as above */
-	sizeof(asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221)
-		/sizeof(asn_DEF_ForbiddenTAs_Item_ExtIEs_tags_221[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ForbiddenTAs_Item_ExtIEs_221,
-	3,	/* Elements count */
-	&asn_SPC_ForbiddenTAs_Item_ExtIEs_specs_221	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_228 = {
-	sizeof(struct ForbiddenLAs_Item_ExtIEs__extensionValue),
-	offsetof(struct ForbiddenLAs_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ForbiddenLAs_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct ForbiddenLAs_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_228 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_228	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ForbiddenLAs_Item_ExtIEs_225[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ForbiddenLAs_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_226, &asn_PER_memb_id_constr_226,  memb_id_constraint_225 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ForbiddenLAs_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_227, &asn_PER_memb_criticality_constr_227,  memb_criticality_constraint_225 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ForbiddenLAs_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_228,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_228, &asn_PER_memb_extensionValue_constr_228,  memb_extensionValue_constraint_225 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ForbiddenLAs_Item_ExtIEs_tag2el_225[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ForbiddenLAs_Item_ExtIEs_specs_225 = {
-	sizeof(struct ForbiddenLAs_Item_ExtIEs),
-	offsetof(struct ForbiddenLAs_Item_ExtIEs, _asn_ctx),
-	asn_MAP_ForbiddenLAs_Item_ExtIEs_tag2el_225,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ForbiddenLAs_Item_ExtIEs = {
-	"ForbiddenLAs-Item-ExtIEs",
-	"ForbiddenLAs-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225,
-	sizeof(asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225)
-		/sizeof(asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225[0]), /* 1 */
-	asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225,	/* Same as above */
-	sizeof(asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225)
-		/sizeof(asn_DEF_ForbiddenLAs_Item_ExtIEs_tags_225[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ForbiddenLAs_Item_ExtIEs_225,
-	3,	/* Elements count */
-	&asn_SPC_ForbiddenLAs_Item_ExtIEs_specs_225	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_232 = {
-	sizeof(struct GBR_QosInformation_ExtIEs__extensionValue),
-	offsetof(struct GBR_QosInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct GBR_QosInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct GBR_QosInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_232 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_232	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_GBR_QosInformation_ExtIEs_229[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct GBR_QosInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_230, &asn_PER_memb_id_constr_230,  memb_id_constraint_229 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct GBR_QosInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_231, &asn_PER_memb_criticality_constr_231,  memb_criticality_constraint_229 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct GBR_QosInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_232,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_232, &asn_PER_memb_extensionValue_constr_232,  memb_extensionValue_constraint_229 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_GBR_QosInformation_ExtIEs_tags_229[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_GBR_QosInformation_ExtIEs_tag2el_229[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_GBR_QosInformation_ExtIEs_specs_229 = {
-	sizeof(struct GBR_QosInformation_ExtIEs),
-	offsetof(struct GBR_QosInformation_ExtIEs, _asn_ctx),
-	asn_MAP_GBR_QosInformation_ExtIEs_tag2el_229,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_GBR_QosInformation_ExtIEs = {
-	"GBR-QosInformation-ExtIEs",
-	"GBR-QosInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_GBR_QosInformation_ExtIEs_tags_229,
-	sizeof(asn_DEF_GBR_QosInformation_ExtIEs_tags_229)
-		/sizeof(asn_DEF_GBR_QosInformation_ExtIEs_tags_229[0]), /* 1 */
-	asn_DEF_GBR_QosInformation_ExtIEs_tags_229,	/* Same as above */
-	sizeof(asn_DEF_GBR_QosInformation_ExtIEs_tags_229)
-		/sizeof(asn_DEF_GBR_QosInformation_ExtIEs_tags_229[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_GBR_QosInformation_ExtIEs_229,
-	3,	/* Elements count */
-	&asn_SPC_GBR_QosInformation_ExtIEs_specs_229	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_236 = {
-	sizeof(struct GUMMEI_ExtIEs__extensionValue),
-	offsetof(struct GUMMEI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct GUMMEI_ExtIEs__extensionValue, present),
-	sizeof(((struct GUMMEI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_236 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_236	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_GUMMEI_ExtIEs_233[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct GUMMEI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_234, &asn_PER_memb_id_constr_234,  memb_id_constraint_233 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct GUMMEI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_235, &asn_PER_memb_criticality_constr_235,  memb_criticality_constraint_233 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct GUMMEI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_236,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_236, &asn_PER_memb_extensionValue_constr_236,  memb_extensionValue_constraint_233 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_GUMMEI_ExtIEs_tags_233[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_GUMMEI_ExtIEs_tag2el_233[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_GUMMEI_ExtIEs_specs_233 = {
-	sizeof(struct GUMMEI_ExtIEs),
-	offsetof(struct GUMMEI_ExtIEs, _asn_ctx),
-	asn_MAP_GUMMEI_ExtIEs_tag2el_233,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_GUMMEI_ExtIEs = {
-	"GUMMEI-ExtIEs",
-	"GUMMEI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_GUMMEI_ExtIEs_tags_233,
-	sizeof(asn_DEF_GUMMEI_ExtIEs_tags_233)
-		/sizeof(asn_DEF_GUMMEI_ExtIEs_tags_233[0]), /* 1 */
-	asn_DEF_GUMMEI_ExtIEs_tags_233,	/* Same as above */
-	sizeof(asn_DEF_GUMMEI_ExtIEs_tags_233)
-		/sizeof(asn_DEF_GUMMEI_ExtIEs_tags_233[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_GUMMEI_ExtIEs_233,
-	3,	/* Elements count */
-	&asn_SPC_GUMMEI_ExtIEs_specs_233	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_240 = {
-	sizeof(struct HandoverRestrictionList_ExtIEs__extensionValue),
-	offsetof(struct HandoverRestrictionList_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct HandoverRestrictionList_ExtIEs__extensionValue, present),
-	sizeof(((struct HandoverRestrictionList_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_240 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_240	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_HandoverRestrictionList_ExtIEs_237[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct HandoverRestrictionList_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_238, &asn_PER_memb_id_constr_238,  memb_id_constraint_237 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct HandoverRestrictionList_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_239, &asn_PER_memb_criticality_constr_239,  memb_criticality_constraint_237 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct HandoverRestrictionList_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_240,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_240, &asn_PER_memb_extensionValue_constr_240,  memb_extensionValue_constraint_237 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_HandoverRestrictionList_ExtIEs_tags_237[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_HandoverRestrictionList_ExtIEs_tag2el_237[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_HandoverRestrictionList_ExtIEs_specs_237 = {
-	sizeof(struct HandoverRestrictionList_ExtIEs),
-	offsetof(struct HandoverRestrictionList_ExtIEs, _asn_ctx),
-	asn_MAP_HandoverRestrictionList_ExtIEs_tag2el_237,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_HandoverRestrictionList_ExtIEs = {
-	"HandoverRestrictionList-ExtIEs",
-	"HandoverRestrictionList-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_HandoverRestrictionList_ExtIEs_tags_237,
-	sizeof(asn_DEF_HandoverRestrictionList_ExtIEs_tags_237)
-		/sizeof(asn_DEF_HandoverRestrictionList_ExtIEs_tags_237[0]), /* 1 */
-	asn_DEF_HandoverRestrictionList_ExtIEs_tags_237,	/* Same as above */
-	sizeof(asn_DEF_HandoverRestrictionList_ExtIEs_tags_237)
-		/sizeof(asn_DEF_HandoverRestrictionList_ExtIEs_tags_237[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_HandoverRestrictionList_ExtIEs_237,
-	3,	/* Elements count */
-	&asn_SPC_HandoverRestrictionList_ExtIEs_specs_237	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_244[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs__extensionValue, choice.M3Configuration),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_M3Configuration,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"M3Configuration"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs__extensionValue, choice.M4Configuration),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_M4Configuration,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"M4Configuration"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs__extensionValue, choice.M5Configuration),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_M5Configuration,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"M5Configuration"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs__extensionValue, choice.MDT_Location_Info),
-		(ASN_TAG_CLASS_UNIVERSAL | (3 << 2)),
-		0,
-		&asn_DEF_MDT_Location_Info,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"MDT-Location-Info"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs__extensionValue, choice.M6Configuration),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_M6Configuration,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"M6Configuration"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs__extensionValue, choice.M7Configuration),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_M7Configuration,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"M7Configuration"
-		},
-};
-static const unsigned asn_MAP_extensionValue_to_canonical_244[] = { 3, 0, 1, 2, 4, 5 };
-static const unsigned asn_MAP_extensionValue_from_canonical_244[] = { 1, 2, 3, 0, 4, 5 };
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_244[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (3 << 2)), 3, 0, 0 }, /* MDT-Location-Info */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 4 }, /* M3Configuration */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 1, -1, 3 }, /* M4Configuration */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 2, -2, 2 }, /* M5Configuration */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 4, -3, 1 }, /* M6Configuration */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 5, -4, 0 } /* M7Configuration */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_244 = {
-	sizeof(struct ImmediateMDT_ExtIEs__extensionValue),
-	offsetof(struct ImmediateMDT_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ImmediateMDT_ExtIEs__extensionValue, present),
-	sizeof(((struct ImmediateMDT_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_244,
-	6,	/* Count of tags in the map */
-	asn_MAP_extensionValue_to_canonical_244,
-	asn_MAP_extensionValue_from_canonical_244,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_244 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_244,
-	6,	/* Elements count */
-	&asn_SPC_extensionValue_specs_244	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ImmediateMDT_ExtIEs_241[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_242, &asn_PER_memb_id_constr_242,  memb_id_constraint_241 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_ImmediateMDT_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_243, &asn_PER_memb_criticality_constr_243,  memb_criticality_constraint_241 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ImmediateMDT_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_244,
-		select_ImmediateMDT_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_244, &asn_PER_memb_extensionValue_constr_244,  memb_extensionValue_constraint_241 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ImmediateMDT_ExtIEs_tags_241[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ImmediateMDT_ExtIEs_tag2el_241[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ImmediateMDT_ExtIEs_specs_241 = {
-	sizeof(struct ImmediateMDT_ExtIEs),
-	offsetof(struct ImmediateMDT_ExtIEs, _asn_ctx),
-	asn_MAP_ImmediateMDT_ExtIEs_tag2el_241,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ImmediateMDT_ExtIEs = {
-	"ImmediateMDT-ExtIEs",
-	"ImmediateMDT-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ImmediateMDT_ExtIEs_tags_241,
-	sizeof(asn_DEF_ImmediateMDT_ExtIEs_tags_241)
-		/sizeof(asn_DEF_ImmediateMDT_ExtIEs_tags_241[0]), /* 1 */
-	asn_DEF_ImmediateMDT_ExtIEs_tags_241,	/* Same as above */
-	sizeof(asn_DEF_ImmediateMDT_ExtIEs_tags_241)
-		/sizeof(asn_DEF_ImmediateMDT_ExtIEs_tags_241[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ImmediateMDT_ExtIEs_241,
-	3,	/* Elements count */
-	&asn_SPC_ImmediateMDT_ExtIEs_specs_241	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_248 = {
-	sizeof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs__extensionValue),
-	offsetof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs__extensionValue, present),
-	sizeof(((struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_248 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_248	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_245[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_246, &asn_PER_memb_id_constr_246,  memb_id_constraint_245 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_247, &asn_PER_memb_criticality_constr_247,  memb_criticality_constraint_245 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_248,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_248, &asn_PER_memb_extensionValue_constr_248,  memb_extensionValue_constraint_245 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tag2el_245[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_specs_245 = {
-	sizeof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs),
-	offsetof(struct InformationOnRecommendedCellsAndENBsForPaging_ExtIEs, _asn_ctx),
-	asn_MAP_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tag2el_245,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs = {
-	"InformationOnRecommendedCellsAndENBsForPaging-ExtIEs",
-	"InformationOnRecommendedCellsAndENBsForPaging-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245,
-	sizeof(asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245)
-		/sizeof(asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245[0]), /* 1 */
-	asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245,	/* Same as above */
-	sizeof(asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245)
-		/sizeof(asn_DEF_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_tags_245[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_245,
-	3,	/* Elements count */
-	&asn_SPC_InformationOnRecommendedCellsAndENBsForPaging_ExtIEs_specs_245	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_252 = {
-	sizeof(struct LAI_ExtIEs__extensionValue),
-	offsetof(struct LAI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct LAI_ExtIEs__extensionValue, present),
-	sizeof(((struct LAI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_252 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_252	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_LAI_ExtIEs_249[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct LAI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_250, &asn_PER_memb_id_constr_250,  memb_id_constraint_249 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct LAI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_251, &asn_PER_memb_criticality_constr_251,  memb_criticality_constraint_249 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct LAI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_252,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_252, &asn_PER_memb_extensionValue_constr_252,  memb_extensionValue_constraint_249 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_LAI_ExtIEs_tags_249[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_LAI_ExtIEs_tag2el_249[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_LAI_ExtIEs_specs_249 = {
-	sizeof(struct LAI_ExtIEs),
-	offsetof(struct LAI_ExtIEs, _asn_ctx),
-	asn_MAP_LAI_ExtIEs_tag2el_249,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_LAI_ExtIEs = {
-	"LAI-ExtIEs",
-	"LAI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_LAI_ExtIEs_tags_249,
-	sizeof(asn_DEF_LAI_ExtIEs_tags_249)
-		/sizeof(asn_DEF_LAI_ExtIEs_tags_249[0]), /* 1 */
-	asn_DEF_LAI_ExtIEs_tags_249,	/* Same as above */
-	sizeof(asn_DEF_LAI_ExtIEs_tags_249)
-		/sizeof(asn_DEF_LAI_ExtIEs_tags_249[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_LAI_ExtIEs_249,
-	3,	/* Elements count */
-	&asn_SPC_LAI_ExtIEs_specs_249	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_256[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs__extensionValue, choice.Time_UE_StayedInCell_EnhancedGranularity),
-		(ASN_TAG_CLASS_UNIVERSAL | (2 << 2)),
-		0,
-		&asn_DEF_Time_UE_StayedInCell_EnhancedGranularity,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Time-UE-StayedInCell-EnhancedGranularity"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs__extensionValue, choice.Cause),
-		-1 /* Ambiguous tag (CHOICE?) */,
-		0,
-		&asn_DEF_Cause,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"Cause"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_256[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (2 << 2)), 0, 0, 0 }, /* Time-UE-StayedInCell-EnhancedGranularity */
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 1, 0, 0 }, /* radioNetwork */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* transport */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 1, 0, 0 }, /* nas */
-    { (ASN_TAG_CLASS_CONTEXT | (3 << 2)), 1, 0, 0 }, /* protocol */
-    { (ASN_TAG_CLASS_CONTEXT | (4 << 2)), 1, 0, 0 } /* misc */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_256 = {
-	sizeof(struct LastVisitedEUTRANCellInformation_ExtIEs__extensionValue),
-	offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct LastVisitedEUTRANCellInformation_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_256,
-	6,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_256 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_256,
-	2,	/* Elements count */
-	&asn_SPC_extensionValue_specs_256	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_LastVisitedEUTRANCellInformation_ExtIEs_253[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_254, &asn_PER_memb_id_constr_254,  memb_id_constraint_253 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_LastVisitedEUTRANCellInformation_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_255, &asn_PER_memb_criticality_constr_255,  memb_criticality_constraint_253 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_256,
-		select_LastVisitedEUTRANCellInformation_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_256, &asn_PER_memb_extensionValue_constr_256,  memb_extensionValue_constraint_253 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_LastVisitedEUTRANCellInformation_ExtIEs_tag2el_253[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_LastVisitedEUTRANCellInformation_ExtIEs_specs_253 = {
-	sizeof(struct LastVisitedEUTRANCellInformation_ExtIEs),
-	offsetof(struct LastVisitedEUTRANCellInformation_ExtIEs, _asn_ctx),
-	asn_MAP_LastVisitedEUTRANCellInformation_ExtIEs_tag2el_253,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs = {
-	"LastVisitedEUTRANCellInformation-ExtIEs",
-	"LastVisitedEUTRANCellInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253,
-	sizeof(asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253)
-		/sizeof(asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253[0]), /* 1 */
-	asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253,	/* Same as above */
-	sizeof(asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253)
-		/sizeof(asn_DEF_LastVisitedEUTRANCellInformation_ExtIEs_tags_253[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_LastVisitedEUTRANCellInformation_ExtIEs_253,
-	3,	/* Elements count */
-	&asn_SPC_LastVisitedEUTRANCellInformation_ExtIEs_specs_253	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_260 = {
-	sizeof(struct ListeningSubframePattern_ExtIEs__extensionValue),
-	offsetof(struct ListeningSubframePattern_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ListeningSubframePattern_ExtIEs__extensionValue, present),
-	sizeof(((struct ListeningSubframePattern_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_260 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_260	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ListeningSubframePattern_ExtIEs_257[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ListeningSubframePattern_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_258, &asn_PER_memb_id_constr_258,  memb_id_constraint_257 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ListeningSubframePattern_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_259, &asn_PER_memb_criticality_constr_259,  memb_criticality_constraint_257 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ListeningSubframePattern_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_260,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_260, &asn_PER_memb_extensionValue_constr_260,  memb_extensionValue_constraint_257 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ListeningSubframePattern_ExtIEs_tags_257[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ListeningSubframePattern_ExtIEs_tag2el_257[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ListeningSubframePattern_ExtIEs_specs_257 = {
-	sizeof(struct ListeningSubframePattern_ExtIEs),
-	offsetof(struct ListeningSubframePattern_ExtIEs, _asn_ctx),
-	asn_MAP_ListeningSubframePattern_ExtIEs_tag2el_257,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ListeningSubframePattern_ExtIEs = {
-	"ListeningSubframePattern-ExtIEs",
-	"ListeningSubframePattern-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ListeningSubframePattern_ExtIEs_tags_257,
-	sizeof(asn_DEF_ListeningSubframePattern_ExtIEs_tags_257)
-		/sizeof(asn_DEF_ListeningSubframePattern_ExtIEs_tags_257[0]), /* 1 */
-	asn_DEF_ListeningSubframePattern_ExtIEs_tags_257,	/* Same as above */
-	sizeof(asn_DEF_ListeningSubframePattern_ExtIEs_tags_257)
-		/sizeof(asn_DEF_ListeningSubframePattern_ExtIEs_tags_257[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ListeningSubframePattern_ExtIEs_257,
-	3,	/* Elements count */
-	&asn_SPC_ListeningSubframePattern_ExtIEs_specs_257	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_264 = {
-	sizeof(struct LoggedMDT_ExtIEs__extensionValue),
-	offsetof(struct LoggedMDT_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct LoggedMDT_ExtIEs__extensionValue, present),
-	sizeof(((struct LoggedMDT_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_264 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_264	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_LoggedMDT_ExtIEs_261[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct LoggedMDT_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_262, &asn_PER_memb_id_constr_262,  memb_id_constraint_261 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct LoggedMDT_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_263, &asn_PER_memb_criticality_constr_263,  memb_criticality_constraint_261 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct LoggedMDT_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_264,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_264, &asn_PER_memb_extensionValue_constr_264,  memb_extensionValue_constraint_261 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_LoggedMDT_ExtIEs_tags_261[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_LoggedMDT_ExtIEs_tag2el_261[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_LoggedMDT_ExtIEs_specs_261 = {
-	sizeof(struct LoggedMDT_ExtIEs),
-	offsetof(struct LoggedMDT_ExtIEs, _asn_ctx),
-	asn_MAP_LoggedMDT_ExtIEs_tag2el_261,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_LoggedMDT_ExtIEs = {
-	"LoggedMDT-ExtIEs",
-	"LoggedMDT-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_LoggedMDT_ExtIEs_tags_261,
-	sizeof(asn_DEF_LoggedMDT_ExtIEs_tags_261)
-		/sizeof(asn_DEF_LoggedMDT_ExtIEs_tags_261[0]), /* 1 */
-	asn_DEF_LoggedMDT_ExtIEs_tags_261,	/* Same as above */
-	sizeof(asn_DEF_LoggedMDT_ExtIEs_tags_261)
-		/sizeof(asn_DEF_LoggedMDT_ExtIEs_tags_261[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_LoggedMDT_ExtIEs_261,
-	3,	/* Elements count */
-	&asn_SPC_LoggedMDT_ExtIEs_specs_261	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_268 = {
-	sizeof(struct LoggedMBSFNMDT_ExtIEs__extensionValue),
-	offsetof(struct LoggedMBSFNMDT_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct LoggedMBSFNMDT_ExtIEs__extensionValue, present),
-	sizeof(((struct LoggedMBSFNMDT_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_268 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_268	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_LoggedMBSFNMDT_ExtIEs_265[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct LoggedMBSFNMDT_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_266, &asn_PER_memb_id_constr_266,  memb_id_constraint_265 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct LoggedMBSFNMDT_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_267, &asn_PER_memb_criticality_constr_267,  memb_criticality_constraint_265 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct LoggedMBSFNMDT_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_268,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_268, &asn_PER_memb_extensionValue_constr_268,  memb_extensionValue_constraint_265 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_LoggedMBSFNMDT_ExtIEs_tag2el_265[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_LoggedMBSFNMDT_ExtIEs_specs_265 = {
-	sizeof(struct LoggedMBSFNMDT_ExtIEs),
-	offsetof(struct LoggedMBSFNMDT_ExtIEs, _asn_ctx),
-	asn_MAP_LoggedMBSFNMDT_ExtIEs_tag2el_265,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_LoggedMBSFNMDT_ExtIEs = {
-	"LoggedMBSFNMDT-ExtIEs",
-	"LoggedMBSFNMDT-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265,
-	sizeof(asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265)
-		/sizeof(asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265[0]), /* 1 */
-	asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265,	/* Same as above */
-	sizeof(asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265)
-		/sizeof(asn_DEF_LoggedMBSFNMDT_ExtIEs_tags_265[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_LoggedMBSFNMDT_ExtIEs_265,
-	3,	/* Elements count */
-	&asn_SPC_LoggedMBSFNMDT_ExtIEs_specs_265	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_272 = {
-	sizeof(struct M3Configuration_ExtIEs__extensionValue),
-	offsetof(struct M3Configuration_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M3Configuration_ExtIEs__extensionValue, present),
-	sizeof(((struct M3Configuration_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_272 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_272	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M3Configuration_ExtIEs_269[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M3Configuration_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_270, &asn_PER_memb_id_constr_270,  memb_id_constraint_269 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M3Configuration_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_271, &asn_PER_memb_criticality_constr_271,  memb_criticality_constraint_269 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M3Configuration_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_272,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_272, &asn_PER_memb_extensionValue_constr_272,  memb_extensionValue_constraint_269 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M3Configuration_ExtIEs_tags_269[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M3Configuration_ExtIEs_tag2el_269[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M3Configuration_ExtIEs_specs_269 = {
-	sizeof(struct M3Configuration_ExtIEs),
-	offsetof(struct M3Configuration_ExtIEs, _asn_ctx),
-	asn_MAP_M3Configuration_ExtIEs_tag2el_269,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M3Configuration_ExtIEs = {
-	"M3Configuration-ExtIEs",
-	"M3Configuration-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M3Configuration_ExtIEs_tags_269,
-	sizeof(asn_DEF_M3Configuration_ExtIEs_tags_269)
-		/sizeof(asn_DEF_M3Configuration_ExtIEs_tags_269[0]), /* 1 */
-	asn_DEF_M3Configuration_ExtIEs_tags_269,	/* Same as above */
-	sizeof(asn_DEF_M3Configuration_ExtIEs_tags_269)
-		/sizeof(asn_DEF_M3Configuration_ExtIEs_tags_269[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M3Configuration_ExtIEs_269,
-	3,	/* Elements count */
-	&asn_SPC_M3Configuration_ExtIEs_specs_269	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_276 = {
-	sizeof(struct M4Configuration_ExtIEs__extensionValue),
-	offsetof(struct M4Configuration_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M4Configuration_ExtIEs__extensionValue, present),
-	sizeof(((struct M4Configuration_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_276 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_276	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M4Configuration_ExtIEs_273[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M4Configuration_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_274, &asn_PER_memb_id_constr_274,  memb_id_constraint_273 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M4Configuration_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_275, &asn_PER_memb_criticality_constr_275,  memb_criticality_constraint_273 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M4Configuration_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_276,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_276, &asn_PER_memb_extensionValue_constr_276,  memb_extensionValue_constraint_273 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M4Configuration_ExtIEs_tags_273[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M4Configuration_ExtIEs_tag2el_273[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M4Configuration_ExtIEs_specs_273 = {
-	sizeof(struct M4Configuration_ExtIEs),
-	offsetof(struct M4Configuration_ExtIEs, _asn_ctx),
-	asn_MAP_M4Configuration_ExtIEs_tag2el_273,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M4Configuration_ExtIEs = {
-	"M4Configuration-ExtIEs",
-	"M4Configuration-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M4Configuration_ExtIEs_tags_273,
-	sizeof(asn_DEF_M4Configuration_ExtIEs_tags_273)
-		/sizeof(asn_DEF_M4Configuration_ExtIEs_tags_273[0]), /* 1 */
-	asn_DEF_M4Configuration_ExtIEs_tags_273,	/* Same as above */
-	sizeof(asn_DEF_M4Configuration_ExtIEs_tags_273)
-		/sizeof(asn_DEF_M4Configuration_ExtIEs_tags_273[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M4Configuration_ExtIEs_273,
-	3,	/* Elements count */
-	&asn_SPC_M4Configuration_ExtIEs_specs_273	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_280 = {
-	sizeof(struct M5Configuration_ExtIEs__extensionValue),
-	offsetof(struct M5Configuration_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M5Configuration_ExtIEs__extensionValue, present),
-	sizeof(((struct M5Configuration_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_280 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_280	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M5Configuration_ExtIEs_277[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M5Configuration_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_278, &asn_PER_memb_id_constr_278,  memb_id_constraint_277 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M5Configuration_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_279, &asn_PER_memb_criticality_constr_279,  memb_criticality_constraint_277 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M5Configuration_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_280,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_280, &asn_PER_memb_extensionValue_constr_280,  memb_extensionValue_constraint_277 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M5Configuration_ExtIEs_tags_277[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M5Configuration_ExtIEs_tag2el_277[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M5Configuration_ExtIEs_specs_277 = {
-	sizeof(struct M5Configuration_ExtIEs),
-	offsetof(struct M5Configuration_ExtIEs, _asn_ctx),
-	asn_MAP_M5Configuration_ExtIEs_tag2el_277,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M5Configuration_ExtIEs = {
-	"M5Configuration-ExtIEs",
-	"M5Configuration-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M5Configuration_ExtIEs_tags_277,
-	sizeof(asn_DEF_M5Configuration_ExtIEs_tags_277)
-		/sizeof(asn_DEF_M5Configuration_ExtIEs_tags_277[0]), /* 1 */
-	asn_DEF_M5Configuration_ExtIEs_tags_277,	/* Same as above */
-	sizeof(asn_DEF_M5Configuration_ExtIEs_tags_277)
-		/sizeof(asn_DEF_M5Configuration_ExtIEs_tags_277[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M5Configuration_ExtIEs_277,
-	3,	/* Elements count */
-	&asn_SPC_M5Configuration_ExtIEs_specs_277	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_284 = {
-	sizeof(struct M6Configuration_ExtIEs__extensionValue),
-	offsetof(struct M6Configuration_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M6Configuration_ExtIEs__extensionValue, present),
-	sizeof(((struct M6Configuration_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_284 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_284	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M6Configuration_ExtIEs_281[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M6Configuration_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_282, &asn_PER_memb_id_constr_282,  memb_id_constraint_281 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M6Configuration_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_283, &asn_PER_memb_criticality_constr_283,  memb_criticality_constraint_281 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M6Configuration_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_284,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_284, &asn_PER_memb_extensionValue_constr_284,  memb_extensionValue_constraint_281 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M6Configuration_ExtIEs_tags_281[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M6Configuration_ExtIEs_tag2el_281[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M6Configuration_ExtIEs_specs_281 = {
-	sizeof(struct M6Configuration_ExtIEs),
-	offsetof(struct M6Configuration_ExtIEs, _asn_ctx),
-	asn_MAP_M6Configuration_ExtIEs_tag2el_281,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M6Configuration_ExtIEs = {
-	"M6Configuration-ExtIEs",
-	"M6Configuration-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M6Configuration_ExtIEs_tags_281,
-	sizeof(asn_DEF_M6Configuration_ExtIEs_tags_281)
-		/sizeof(asn_DEF_M6Configuration_ExtIEs_tags_281[0]), /* 1 */
-	asn_DEF_M6Configuration_ExtIEs_tags_281,	/* Same as above */
-	sizeof(asn_DEF_M6Configuration_ExtIEs_tags_281)
-		/sizeof(asn_DEF_M6Configuration_ExtIEs_tags_281[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M6Configuration_ExtIEs_281,
-	3,	/* Elements count */
-	&asn_SPC_M6Configuration_ExtIEs_specs_281	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_288 = {
-	sizeof(struct M7Configuration_ExtIEs__extensionValue),
-	offsetof(struct M7Configuration_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M7Configuration_ExtIEs__extensionValue, present),
-	sizeof(((struct M7Configuration_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_288 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_288	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M7Configuration_ExtIEs_285[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M7Configuration_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_286, &asn_PER_memb_id_constr_286,  memb_id_constraint_285 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M7Configuration_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_287, &asn_PER_memb_criticality_constr_287,  memb_criticality_constraint_285 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M7Configuration_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_288,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_288, &asn_PER_memb_extensionValue_constr_288,  memb_extensionValue_constraint_285 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M7Configuration_ExtIEs_tags_285[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M7Configuration_ExtIEs_tag2el_285[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M7Configuration_ExtIEs_specs_285 = {
-	sizeof(struct M7Configuration_ExtIEs),
-	offsetof(struct M7Configuration_ExtIEs, _asn_ctx),
-	asn_MAP_M7Configuration_ExtIEs_tag2el_285,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M7Configuration_ExtIEs = {
-	"M7Configuration-ExtIEs",
-	"M7Configuration-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M7Configuration_ExtIEs_tags_285,
-	sizeof(asn_DEF_M7Configuration_ExtIEs_tags_285)
-		/sizeof(asn_DEF_M7Configuration_ExtIEs_tags_285[0]), /* 1 */
-	asn_DEF_M7Configuration_ExtIEs_tags_285,	/* Same as above */
-	sizeof(asn_DEF_M7Configuration_ExtIEs_tags_285)
-		/sizeof(asn_DEF_M7Configuration_ExtIEs_tags_285[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M7Configuration_ExtIEs_285,
-	3,	/* Elements count */
-	&asn_SPC_M7Configuration_ExtIEs_specs_285	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_292[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct MDT_Configuration_ExtIEs__extensionValue, choice.MDTPLMNList),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_MDTPLMNList,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"MDTPLMNList"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_292[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 0 } /* MDTPLMNList */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_292 = {
-	sizeof(struct MDT_Configuration_ExtIEs__extensionValue),
-	offsetof(struct MDT_Configuration_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct MDT_Configuration_ExtIEs__extensionValue, present),
-	sizeof(((struct MDT_Configuration_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_292,
-	1,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_292 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_292,
-	1,	/* Elements count */
-	&asn_SPC_extensionValue_specs_292	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_MDT_Configuration_ExtIEs_289[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct MDT_Configuration_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_290, &asn_PER_memb_id_constr_290,  memb_id_constraint_289 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct MDT_Configuration_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_MDT_Configuration_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_291, &asn_PER_memb_criticality_constr_291,  memb_criticality_constraint_289 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct MDT_Configuration_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_292,
-		select_MDT_Configuration_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_292, &asn_PER_memb_extensionValue_constr_292,  memb_extensionValue_constraint_289 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_MDT_Configuration_ExtIEs_tags_289[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_MDT_Configuration_ExtIEs_tag2el_289[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_MDT_Configuration_ExtIEs_specs_289 = {
-	sizeof(struct MDT_Configuration_ExtIEs),
-	offsetof(struct MDT_Configuration_ExtIEs, _asn_ctx),
-	asn_MAP_MDT_Configuration_ExtIEs_tag2el_289,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_MDT_Configuration_ExtIEs = {
-	"MDT-Configuration-ExtIEs",
-	"MDT-Configuration-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_MDT_Configuration_ExtIEs_tags_289,
-	sizeof(asn_DEF_MDT_Configuration_ExtIEs_tags_289)
-		/sizeof(asn_DEF_MDT_Configuration_ExtIEs_tags_289[0]), /* 1 */
-	asn_DEF_MDT_Configuration_ExtIEs_tags_289,	/* Same as above */
-	sizeof(asn_DEF_MDT_Configuration_ExtIEs_tags_289)
-		/sizeof(asn_DEF_MDT_Configuration_ExtIEs_tags_289[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_MDT_Configuration_ExtIEs_289,
-	3,	/* Elements count */
-	&asn_SPC_MDT_Configuration_ExtIEs_specs_289	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_296 = {
-	sizeof(struct MBSFN_ResultToLogInfo_ExtIEs__extensionValue),
-	offsetof(struct MBSFN_ResultToLogInfo_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct MBSFN_ResultToLogInfo_ExtIEs__extensionValue, present),
-	sizeof(((struct MBSFN_ResultToLogInfo_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_296 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_296	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_MBSFN_ResultToLogInfo_ExtIEs_293[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct MBSFN_ResultToLogInfo_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_294, &asn_PER_memb_id_constr_294,  memb_id_constraint_293 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct MBSFN_ResultToLogInfo_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_295, &asn_PER_memb_criticality_constr_295,  memb_criticality_constraint_293 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct MBSFN_ResultToLogInfo_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_296,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_296, &asn_PER_memb_extensionValue_constr_296,  memb_extensionValue_constraint_293 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_MBSFN_ResultToLogInfo_ExtIEs_tag2el_293[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_MBSFN_ResultToLogInfo_ExtIEs_specs_293 = {
-	sizeof(struct MBSFN_ResultToLogInfo_ExtIEs),
-	offsetof(struct MBSFN_ResultToLogInfo_ExtIEs, _asn_ctx),
-	asn_MAP_MBSFN_ResultToLogInfo_ExtIEs_tag2el_293,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_MBSFN_ResultToLogInfo_ExtIEs = {
-	"MBSFN-ResultToLogInfo-ExtIEs",
-	"MBSFN-ResultToLogInfo-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293,
-	sizeof(asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293)
-		/sizeof(asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293[0]), /* 1 */
-	asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293,	/* Same as above */
-	sizeof(asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293)
-		/sizeof(asn_DEF_MBSFN_ResultToLogInfo_ExtIEs_tags_293[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_MBSFN_ResultToLogInfo_ExtIEs_293,
-	3,	/* Elements count */
-	&asn_SPC_MBSFN_ResultToLogInfo_ExtIEs_specs_293	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_300 = {
-	sizeof(struct MutingPatternInformation_ExtIEs__extensionValue),
-	offsetof(struct MutingPatternInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct MutingPatternInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct MutingPatternInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_300 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_300	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_MutingPatternInformation_ExtIEs_297[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct MutingPatternInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_298, &asn_PER_memb_id_constr_298,  memb_id_constraint_297 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct MutingPatternInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_299, &asn_PER_memb_criticality_constr_299,  memb_criticality_constraint_297 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct MutingPatternInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_300,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_300, &asn_PER_memb_extensionValue_constr_300,  memb_extensionValue_constraint_297 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_MutingPatternInformation_ExtIEs_tags_297[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_MutingPatternInformation_ExtIEs_tag2el_297[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_MutingPatternInformation_ExtIEs_specs_297 = {
-	sizeof(struct MutingPatternInformation_ExtIEs),
-	offsetof(struct MutingPatternInformation_ExtIEs, _asn_ctx),
-	asn_MAP_MutingPatternInformation_ExtIEs_tag2el_297,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_MutingPatternInformation_ExtIEs = {
-	"MutingPatternInformation-ExtIEs",
-	"MutingPatternInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_MutingPatternInformation_ExtIEs_tags_297,
-	sizeof(asn_DEF_MutingPatternInformation_ExtIEs_tags_297)
-		/sizeof(asn_DEF_MutingPatternInformation_ExtIEs_tags_297[0]), /* 1 */
-	asn_DEF_MutingPatternInformation_ExtIEs_tags_297,	/* Same as above */
-	sizeof(asn_DEF_MutingPatternInformation_ExtIEs_tags_297)
-		/sizeof(asn_DEF_MutingPatternInformation_ExtIEs_tags_297[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_MutingPatternInformation_ExtIEs_297,
-	3,	/* Elements count */
-	&asn_SPC_MutingPatternInformation_ExtIEs_specs_297	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_304 = {
-	sizeof(struct NB_IoT_Paging_eDRXInformation_ExtIEs__extensionValue),
-	offsetof(struct NB_IoT_Paging_eDRXInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct NB_IoT_Paging_eDRXInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct NB_IoT_Paging_eDRXInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_304 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_304	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_NB_IoT_Paging_eDRXInformation_ExtIEs_301[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct NB_IoT_Paging_eDRXInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_302, &asn_PER_memb_id_constr_302,  memb_id_constraint_301 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct NB_IoT_Paging_eDRXInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_303, &asn_PER_memb_criticality_constr_303,  memb_criticality_constraint_301 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct NB_IoT_Paging_eDRXInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_304,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_304, &asn_PER_memb_extensionValue_constr_304,  memb_extensionValue_constraint_301 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_NB_IoT_Paging_eDRXInformation_ExtIEs_tag2el_301[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_NB_IoT_Paging_eDRXInformation_ExtIEs_specs_301 = {
-	sizeof(struct NB_IoT_Paging_eDRXInformation_ExtIEs),
-	offsetof(struct NB_IoT_Paging_eDRXInformation_ExtIEs, _asn_ctx),
-	asn_MAP_NB_IoT_Paging_eDRXInformation_ExtIEs_tag2el_301,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs = {
-	"NB-IoT-Paging-eDRXInformation-ExtIEs",
-	"NB-IoT-Paging-eDRXInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301,
-	sizeof(asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301)
-		/sizeof(asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301[0]), /* 1 */
-	asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301,	/* Same as above */
-	sizeof(asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301)
-		/sizeof(asn_DEF_NB_IoT_Paging_eDRXInformation_ExtIEs_tags_301[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_NB_IoT_Paging_eDRXInformation_ExtIEs_301,
-	3,	/* Elements count */
-	&asn_SPC_NB_IoT_Paging_eDRXInformation_ExtIEs_specs_301	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_308 = {
-	sizeof(struct PagingAttemptInformation_ExtIEs__extensionValue),
-	offsetof(struct PagingAttemptInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct PagingAttemptInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct PagingAttemptInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_308 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_308	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_PagingAttemptInformation_ExtIEs_305[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct PagingAttemptInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_306, &asn_PER_memb_id_constr_306,  memb_id_constraint_305 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct PagingAttemptInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_307, &asn_PER_memb_criticality_constr_307,  memb_criticality_constraint_305 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct PagingAttemptInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_308,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_308, &asn_PER_memb_extensionValue_constr_308,  memb_extensionValue_constraint_305 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_PagingAttemptInformation_ExtIEs_tags_305[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_PagingAttemptInformation_ExtIEs_tag2el_305[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_PagingAttemptInformation_ExtIEs_specs_305 = {
-	sizeof(struct PagingAttemptInformation_ExtIEs),
-	offsetof(struct PagingAttemptInformation_ExtIEs, _asn_ctx),
-	asn_MAP_PagingAttemptInformation_ExtIEs_tag2el_305,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_PagingAttemptInformation_ExtIEs = {
-	"PagingAttemptInformation-ExtIEs",
-	"PagingAttemptInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_PagingAttemptInformation_ExtIEs_tags_305,
-	sizeof(asn_DEF_PagingAttemptInformation_ExtIEs_tags_305)
-		/sizeof(asn_DEF_PagingAttemptInformation_ExtIEs_tags_305[0]), /* 1 */
-	asn_DEF_PagingAttemptInformation_ExtIEs_tags_305,	/* Same as above */
-	sizeof(asn_DEF_PagingAttemptInformation_ExtIEs_tags_305)
-		/sizeof(asn_DEF_PagingAttemptInformation_ExtIEs_tags_305[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_PagingAttemptInformation_ExtIEs_305,
-	3,	/* Elements count */
-	&asn_SPC_PagingAttemptInformation_ExtIEs_specs_305	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_312 = {
-	sizeof(struct Paging_eDRXInformation_ExtIEs__extensionValue),
-	offsetof(struct Paging_eDRXInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct Paging_eDRXInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct Paging_eDRXInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_312 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_312	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_Paging_eDRXInformation_ExtIEs_309[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct Paging_eDRXInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_310, &asn_PER_memb_id_constr_310,  memb_id_constraint_309 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Paging_eDRXInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_311, &asn_PER_memb_criticality_constr_311,  memb_criticality_constraint_309 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct Paging_eDRXInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_312,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_312, &asn_PER_memb_extensionValue_constr_312,  memb_extensionValue_constraint_309 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_Paging_eDRXInformation_ExtIEs_tag2el_309[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_Paging_eDRXInformation_ExtIEs_specs_309 = {
-	sizeof(struct Paging_eDRXInformation_ExtIEs),
-	offsetof(struct Paging_eDRXInformation_ExtIEs, _asn_ctx),
-	asn_MAP_Paging_eDRXInformation_ExtIEs_tag2el_309,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_Paging_eDRXInformation_ExtIEs = {
-	"Paging-eDRXInformation-ExtIEs",
-	"Paging-eDRXInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309,
-	sizeof(asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309)
-		/sizeof(asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309[0]), /* 1 */
-	asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309,	/* Same as above */
-	sizeof(asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309)
-		/sizeof(asn_DEF_Paging_eDRXInformation_ExtIEs_tags_309[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_Paging_eDRXInformation_ExtIEs_309,
-	3,	/* Elements count */
-	&asn_SPC_Paging_eDRXInformation_ExtIEs_specs_309	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_316 = {
-	sizeof(struct M1PeriodicReporting_ExtIEs__extensionValue),
-	offsetof(struct M1PeriodicReporting_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M1PeriodicReporting_ExtIEs__extensionValue, present),
-	sizeof(((struct M1PeriodicReporting_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_316 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_316	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M1PeriodicReporting_ExtIEs_313[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M1PeriodicReporting_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_314, &asn_PER_memb_id_constr_314,  memb_id_constraint_313 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M1PeriodicReporting_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_315, &asn_PER_memb_criticality_constr_315,  memb_criticality_constraint_313 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M1PeriodicReporting_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_316,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_316, &asn_PER_memb_extensionValue_constr_316,  memb_extensionValue_constraint_313 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M1PeriodicReporting_ExtIEs_tags_313[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M1PeriodicReporting_ExtIEs_tag2el_313[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M1PeriodicReporting_ExtIEs_specs_313 = {
-	sizeof(struct M1PeriodicReporting_ExtIEs),
-	offsetof(struct M1PeriodicReporting_ExtIEs, _asn_ctx),
-	asn_MAP_M1PeriodicReporting_ExtIEs_tag2el_313,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M1PeriodicReporting_ExtIEs = {
-	"M1PeriodicReporting-ExtIEs",
-	"M1PeriodicReporting-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M1PeriodicReporting_ExtIEs_tags_313,
-	sizeof(asn_DEF_M1PeriodicReporting_ExtIEs_tags_313)
-		/sizeof(asn_DEF_M1PeriodicReporting_ExtIEs_tags_313[0]), /* 1 */
-	asn_DEF_M1PeriodicReporting_ExtIEs_tags_313,	/* Same as above */
-	sizeof(asn_DEF_M1PeriodicReporting_ExtIEs_tags_313)
-		/sizeof(asn_DEF_M1PeriodicReporting_ExtIEs_tags_313[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M1PeriodicReporting_ExtIEs_313,
-	3,	/* Elements count */
-	&asn_SPC_M1PeriodicReporting_ExtIEs_specs_313	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_320[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ProSeAuthorized_ExtIEs__extensionValue, choice.ProSeUEtoNetworkRelaying),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_ProSeUEtoNetworkRelaying,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"ProSeUEtoNetworkRelaying"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_320[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 0, 0, 0 } /* ProSeUEtoNetworkRelaying */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_320 = {
-	sizeof(struct ProSeAuthorized_ExtIEs__extensionValue),
-	offsetof(struct ProSeAuthorized_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ProSeAuthorized_ExtIEs__extensionValue, present),
-	sizeof(((struct ProSeAuthorized_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_320,
-	1,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_320 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_320,
-	1,	/* Elements count */
-	&asn_SPC_extensionValue_specs_320	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProSeAuthorized_ExtIEs_317[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ProSeAuthorized_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_318, &asn_PER_memb_id_constr_318,  memb_id_constraint_317 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ProSeAuthorized_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_ProSeAuthorized_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_319, &asn_PER_memb_criticality_constr_319,  memb_criticality_constraint_317 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ProSeAuthorized_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_320,
-		select_ProSeAuthorized_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_320, &asn_PER_memb_extensionValue_constr_320,  memb_extensionValue_constraint_317 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProSeAuthorized_ExtIEs_tags_317[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ProSeAuthorized_ExtIEs_tag2el_317[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ProSeAuthorized_ExtIEs_specs_317 = {
-	sizeof(struct ProSeAuthorized_ExtIEs),
-	offsetof(struct ProSeAuthorized_ExtIEs, _asn_ctx),
-	asn_MAP_ProSeAuthorized_ExtIEs_tag2el_317,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ProSeAuthorized_ExtIEs = {
-	"ProSeAuthorized-ExtIEs",
-	"ProSeAuthorized-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProSeAuthorized_ExtIEs_tags_317,
-	sizeof(asn_DEF_ProSeAuthorized_ExtIEs_tags_317)
-		/sizeof(asn_DEF_ProSeAuthorized_ExtIEs_tags_317[0]), /* 1 */
-	asn_DEF_ProSeAuthorized_ExtIEs_tags_317,	/* Same as above */
-	sizeof(asn_DEF_ProSeAuthorized_ExtIEs_tags_317)
-		/sizeof(asn_DEF_ProSeAuthorized_ExtIEs_tags_317[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ProSeAuthorized_ExtIEs_317,
-	3,	/* Elements count */
-	&asn_SPC_ProSeAuthorized_ExtIEs_specs_317	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_324 = {
-	sizeof(struct RecommendedCellsForPaging_ExtIEs__extensionValue),
-	offsetof(struct RecommendedCellsForPaging_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RecommendedCellsForPaging_ExtIEs__extensionValue, present),
-	sizeof(((struct RecommendedCellsForPaging_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_324 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_324	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RecommendedCellsForPaging_ExtIEs_321[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedCellsForPaging_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_322, &asn_PER_memb_id_constr_322,  memb_id_constraint_321 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedCellsForPaging_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_323, &asn_PER_memb_criticality_constr_323,  memb_criticality_constraint_321 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RecommendedCellsForPaging_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_324,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_324, &asn_PER_memb_extensionValue_constr_324,  memb_extensionValue_constraint_321 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RecommendedCellsForPaging_ExtIEs_tag2el_321[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RecommendedCellsForPaging_ExtIEs_specs_321 = {
-	sizeof(struct RecommendedCellsForPaging_ExtIEs),
-	offsetof(struct RecommendedCellsForPaging_ExtIEs, _asn_ctx),
-	asn_MAP_RecommendedCellsForPaging_ExtIEs_tag2el_321,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RecommendedCellsForPaging_ExtIEs = {
-	"RecommendedCellsForPaging-ExtIEs",
-	"RecommendedCellsForPaging-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321,
-	sizeof(asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321)
-		/sizeof(asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321[0]), /* 1 */
-	asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321,	/* Same as above */
-	sizeof(asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321)
-		/sizeof(asn_DEF_RecommendedCellsForPaging_ExtIEs_tags_321[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RecommendedCellsForPaging_ExtIEs_321,
-	3,	/* Elements count */
-	&asn_SPC_RecommendedCellsForPaging_ExtIEs_specs_321	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_328 = {
-	sizeof(struct RecommendedCellsForPagingItem_ExtIEs__extensionValue),
-	offsetof(struct RecommendedCellsForPagingItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RecommendedCellsForPagingItem_ExtIEs__extensionValue, present),
-	sizeof(((struct RecommendedCellsForPagingItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_328 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_328	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RecommendedCellsForPagingItem_ExtIEs_325[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedCellsForPagingItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_326, &asn_PER_memb_id_constr_326,  memb_id_constraint_325 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedCellsForPagingItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_327, &asn_PER_memb_criticality_constr_327,  memb_criticality_constraint_325 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RecommendedCellsForPagingItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_328,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_328, &asn_PER_memb_extensionValue_constr_328,  memb_extensionValue_constraint_325 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RecommendedCellsForPagingItem_ExtIEs_tag2el_325[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RecommendedCellsForPagingItem_ExtIEs_specs_325 = {
-	sizeof(struct RecommendedCellsForPagingItem_ExtIEs),
-	offsetof(struct RecommendedCellsForPagingItem_ExtIEs, _asn_ctx),
-	asn_MAP_RecommendedCellsForPagingItem_ExtIEs_tag2el_325,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RecommendedCellsForPagingItem_ExtIEs = {
-	"RecommendedCellsForPagingItem-ExtIEs",
-	"RecommendedCellsForPagingItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325,
-	sizeof(asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325)
-		/sizeof(asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325[0]), /* 1 */
-	asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325,	/* Same as above */
-	sizeof(asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325)
-		/sizeof(asn_DEF_RecommendedCellsForPagingItem_ExtIEs_tags_325[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RecommendedCellsForPagingItem_ExtIEs_325,
-	3,	/* Elements count */
-	&asn_SPC_RecommendedCellsForPagingItem_ExtIEs_specs_325	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_332 = {
-	sizeof(struct RecommendedENBsForPaging_ExtIEs__extensionValue),
-	offsetof(struct RecommendedENBsForPaging_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RecommendedENBsForPaging_ExtIEs__extensionValue, present),
-	sizeof(((struct RecommendedENBsForPaging_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_332 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_332	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RecommendedENBsForPaging_ExtIEs_329[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedENBsForPaging_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_330, &asn_PER_memb_id_constr_330,  memb_id_constraint_329 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedENBsForPaging_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_331, &asn_PER_memb_criticality_constr_331,  memb_criticality_constraint_329 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RecommendedENBsForPaging_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_332,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_332, &asn_PER_memb_extensionValue_constr_332,  memb_extensionValue_constraint_329 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RecommendedENBsForPaging_ExtIEs_tag2el_329[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RecommendedENBsForPaging_ExtIEs_specs_329 = {
-	sizeof(struct RecommendedENBsForPaging_ExtIEs),
-	offsetof(struct RecommendedENBsForPaging_ExtIEs, _asn_ctx),
-	asn_MAP_RecommendedENBsForPaging_ExtIEs_tag2el_329,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RecommendedENBsForPaging_ExtIEs = {
-	"RecommendedENBsForPaging-ExtIEs",
-	"RecommendedENBsForPaging-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329,
-	sizeof(asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329)
-		/sizeof(asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329[0]), /* 1 */
-	asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329,	/* Same as above */
-	sizeof(asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329)
-		/sizeof(asn_DEF_RecommendedENBsForPaging_ExtIEs_tags_329[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RecommendedENBsForPaging_ExtIEs_329,
-	3,	/* Elements count */
-	&asn_SPC_RecommendedENBsForPaging_ExtIEs_specs_329	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_336 = {
-	sizeof(struct RecommendedENBItem_ExtIEs__extensionValue),
-	offsetof(struct RecommendedENBItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RecommendedENBItem_ExtIEs__extensionValue, present),
-	sizeof(((struct RecommendedENBItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_336 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_336	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RecommendedENBItem_ExtIEs_333[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedENBItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_334, &asn_PER_memb_id_constr_334,  memb_id_constraint_333 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RecommendedENBItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_335, &asn_PER_memb_criticality_constr_335,  memb_criticality_constraint_333 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RecommendedENBItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_336,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_336, &asn_PER_memb_extensionValue_constr_336,  memb_extensionValue_constraint_333 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RecommendedENBItem_ExtIEs_tags_333[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RecommendedENBItem_ExtIEs_tag2el_333[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RecommendedENBItem_ExtIEs_specs_333 = {
-	sizeof(struct RecommendedENBItem_ExtIEs),
-	offsetof(struct RecommendedENBItem_ExtIEs, _asn_ctx),
-	asn_MAP_RecommendedENBItem_ExtIEs_tag2el_333,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RecommendedENBItem_ExtIEs = {
-	"RecommendedENBItem-ExtIEs",
-	"RecommendedENBItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RecommendedENBItem_ExtIEs_tags_333,
-	sizeof(asn_DEF_RecommendedENBItem_ExtIEs_tags_333)
-		/sizeof(asn_DEF_RecommendedENBItem_ExtIEs_tags_333[0]), /* 1 */
-	asn_DEF_RecommendedENBItem_ExtIEs_tags_333,	/* Same as above */
-	sizeof(asn_DEF_RecommendedENBItem_ExtIEs_tags_333)
-		/sizeof(asn_DEF_RecommendedENBItem_ExtIEs_tags_333[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RecommendedENBItem_ExtIEs_333,
-	3,	/* Elements count */
-	&asn_SPC_RecommendedENBItem_ExtIEs_specs_333	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_340 = {
-	sizeof(struct RequestType_ExtIEs__extensionValue),
-	offsetof(struct RequestType_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RequestType_ExtIEs__extensionValue, present),
-	sizeof(((struct RequestType_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_340 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_340	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RequestType_ExtIEs_337[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RequestType_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_338, &asn_PER_memb_id_constr_338,  memb_id_constraint_337 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RequestType_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_339, &asn_PER_memb_criticality_constr_339,  memb_criticality_constraint_337 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RequestType_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_340,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_340, &asn_PER_memb_extensionValue_constr_340,  memb_extensionValue_constraint_337 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RequestType_ExtIEs_tags_337[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RequestType_ExtIEs_tag2el_337[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RequestType_ExtIEs_specs_337 = {
-	sizeof(struct RequestType_ExtIEs),
-	offsetof(struct RequestType_ExtIEs, _asn_ctx),
-	asn_MAP_RequestType_ExtIEs_tag2el_337,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RequestType_ExtIEs = {
-	"RequestType-ExtIEs",
-	"RequestType-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RequestType_ExtIEs_tags_337,
-	sizeof(asn_DEF_RequestType_ExtIEs_tags_337)
-		/sizeof(asn_DEF_RequestType_ExtIEs_tags_337[0]), /* 1 */
-	asn_DEF_RequestType_ExtIEs_tags_337,	/* Same as above */
-	sizeof(asn_DEF_RequestType_ExtIEs_tags_337)
-		/sizeof(asn_DEF_RequestType_ExtIEs_tags_337[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RequestType_ExtIEs_337,
-	3,	/* Elements count */
-	&asn_SPC_RequestType_ExtIEs_specs_337	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_344 = {
-	sizeof(struct RIMTransfer_ExtIEs__extensionValue),
-	offsetof(struct RIMTransfer_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RIMTransfer_ExtIEs__extensionValue, present),
-	sizeof(((struct RIMTransfer_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_344 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_344	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RIMTransfer_ExtIEs_341[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RIMTransfer_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_342, &asn_PER_memb_id_constr_342,  memb_id_constraint_341 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RIMTransfer_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_343, &asn_PER_memb_criticality_constr_343,  memb_criticality_constraint_341 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RIMTransfer_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_344,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_344, &asn_PER_memb_extensionValue_constr_344,  memb_extensionValue_constraint_341 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RIMTransfer_ExtIEs_tags_341[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RIMTransfer_ExtIEs_tag2el_341[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RIMTransfer_ExtIEs_specs_341 = {
-	sizeof(struct RIMTransfer_ExtIEs),
-	offsetof(struct RIMTransfer_ExtIEs, _asn_ctx),
-	asn_MAP_RIMTransfer_ExtIEs_tag2el_341,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RIMTransfer_ExtIEs = {
-	"RIMTransfer-ExtIEs",
-	"RIMTransfer-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RIMTransfer_ExtIEs_tags_341,
-	sizeof(asn_DEF_RIMTransfer_ExtIEs_tags_341)
-		/sizeof(asn_DEF_RIMTransfer_ExtIEs_tags_341[0]), /* 1 */
-	asn_DEF_RIMTransfer_ExtIEs_tags_341,	/* Same as above */
-	sizeof(asn_DEF_RIMTransfer_ExtIEs_tags_341)
-		/sizeof(asn_DEF_RIMTransfer_ExtIEs_tags_341[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RIMTransfer_ExtIEs_341,
-	3,	/* Elements count */
-	&asn_SPC_RIMTransfer_ExtIEs_specs_341	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_348 = {
-	sizeof(struct RLFReportInformation_ExtIEs__extensionValue),
-	offsetof(struct RLFReportInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct RLFReportInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct RLFReportInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_348 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_348	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_RLFReportInformation_ExtIEs_345[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct RLFReportInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_346, &asn_PER_memb_id_constr_346,  memb_id_constraint_345 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct RLFReportInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_347, &asn_PER_memb_criticality_constr_347,  memb_criticality_constraint_345 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct RLFReportInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_348,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_348, &asn_PER_memb_extensionValue_constr_348,  memb_extensionValue_constraint_345 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_RLFReportInformation_ExtIEs_tags_345[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_RLFReportInformation_ExtIEs_tag2el_345[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_RLFReportInformation_ExtIEs_specs_345 = {
-	sizeof(struct RLFReportInformation_ExtIEs),
-	offsetof(struct RLFReportInformation_ExtIEs, _asn_ctx),
-	asn_MAP_RLFReportInformation_ExtIEs_tag2el_345,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_RLFReportInformation_ExtIEs = {
-	"RLFReportInformation-ExtIEs",
-	"RLFReportInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_RLFReportInformation_ExtIEs_tags_345,
-	sizeof(asn_DEF_RLFReportInformation_ExtIEs_tags_345)
-		/sizeof(asn_DEF_RLFReportInformation_ExtIEs_tags_345[0]), /* 1 */
-	asn_DEF_RLFReportInformation_ExtIEs_tags_345,	/* Same as above */
-	sizeof(asn_DEF_RLFReportInformation_ExtIEs_tags_345)
-		/sizeof(asn_DEF_RLFReportInformation_ExtIEs_tags_345[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RLFReportInformation_ExtIEs_345,
-	3,	/* Elements count */
-	&asn_SPC_RLFReportInformation_ExtIEs_specs_345	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_352 = {
-	sizeof(struct SecurityContext_ExtIEs__extensionValue),
-	offsetof(struct SecurityContext_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SecurityContext_ExtIEs__extensionValue, present),
-	sizeof(((struct SecurityContext_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_352 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_352	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SecurityContext_ExtIEs_349[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SecurityContext_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_350, &asn_PER_memb_id_constr_350,  memb_id_constraint_349 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SecurityContext_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_351, &asn_PER_memb_criticality_constr_351,  memb_criticality_constraint_349 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SecurityContext_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_352,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_352, &asn_PER_memb_extensionValue_constr_352,  memb_extensionValue_constraint_349 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SecurityContext_ExtIEs_tags_349[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SecurityContext_ExtIEs_tag2el_349[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SecurityContext_ExtIEs_specs_349 = {
-	sizeof(struct SecurityContext_ExtIEs),
-	offsetof(struct SecurityContext_ExtIEs, _asn_ctx),
-	asn_MAP_SecurityContext_ExtIEs_tag2el_349,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SecurityContext_ExtIEs = {
-	"SecurityContext-ExtIEs",
-	"SecurityContext-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SecurityContext_ExtIEs_tags_349,
-	sizeof(asn_DEF_SecurityContext_ExtIEs_tags_349)
-		/sizeof(asn_DEF_SecurityContext_ExtIEs_tags_349[0]), /* 1 */
-	asn_DEF_SecurityContext_ExtIEs_tags_349,	/* Same as above */
-	sizeof(asn_DEF_SecurityContext_ExtIEs_tags_349)
-		/sizeof(asn_DEF_SecurityContext_ExtIEs_tags_349[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SecurityContext_ExtIEs_349,
-	3,	/* Elements count */
-	&asn_SPC_SecurityContext_ExtIEs_specs_349	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_356[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SONInformationReply_ExtIEs__extensionValue, choice.TimeSynchronisationInfo),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_TimeSynchronisationInfo,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"TimeSynchronisationInfo"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SONInformationReply_ExtIEs__extensionValue, choice.MutingPatternInformation),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_MutingPatternInformation,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"MutingPatternInformation"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_356[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 1 }, /* TimeSynchronisationInfo */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 1, -1, 0 } /* MutingPatternInformation */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_356 = {
-	sizeof(struct SONInformationReply_ExtIEs__extensionValue),
-	offsetof(struct SONInformationReply_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SONInformationReply_ExtIEs__extensionValue, present),
-	sizeof(((struct SONInformationReply_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_356,
-	2,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_356 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_356,
-	2,	/* Elements count */
-	&asn_SPC_extensionValue_specs_356	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SONInformationReply_ExtIEs_353[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SONInformationReply_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_354, &asn_PER_memb_id_constr_354,  memb_id_constraint_353 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SONInformationReply_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_SONInformationReply_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_355, &asn_PER_memb_criticality_constr_355,  memb_criticality_constraint_353 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SONInformationReply_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_356,
-		select_SONInformationReply_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_356, &asn_PER_memb_extensionValue_constr_356,  memb_extensionValue_constraint_353 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SONInformationReply_ExtIEs_tags_353[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SONInformationReply_ExtIEs_tag2el_353[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SONInformationReply_ExtIEs_specs_353 = {
-	sizeof(struct SONInformationReply_ExtIEs),
-	offsetof(struct SONInformationReply_ExtIEs, _asn_ctx),
-	asn_MAP_SONInformationReply_ExtIEs_tag2el_353,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SONInformationReply_ExtIEs = {
-	"SONInformationReply-ExtIEs",
-	"SONInformationReply-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SONInformationReply_ExtIEs_tags_353,
-	sizeof(asn_DEF_SONInformationReply_ExtIEs_tags_353)
-		/sizeof(asn_DEF_SONInformationReply_ExtIEs_tags_353[0]), /* 1 */
-	asn_DEF_SONInformationReply_ExtIEs_tags_353,	/* Same as above */
-	sizeof(asn_DEF_SONInformationReply_ExtIEs_tags_353)
-		/sizeof(asn_DEF_SONInformationReply_ExtIEs_tags_353[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SONInformationReply_ExtIEs_353,
-	3,	/* Elements count */
-	&asn_SPC_SONInformationReply_ExtIEs_specs_353	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_360[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SONConfigurationTransfer_ExtIEs__extensionValue, choice.X2TNLConfigurationInfo),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_X2TNLConfigurationInfo,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"X2TNLConfigurationInfo"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SONConfigurationTransfer_ExtIEs__extensionValue, choice.SynchronisationInformation),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_SynchronisationInformation,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"SynchronisationInformation"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_360[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 1 }, /* X2TNLConfigurationInfo */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 1, -1, 0 } /* SynchronisationInformation */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_360 = {
-	sizeof(struct SONConfigurationTransfer_ExtIEs__extensionValue),
-	offsetof(struct SONConfigurationTransfer_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SONConfigurationTransfer_ExtIEs__extensionValue, present),
-	sizeof(((struct SONConfigurationTransfer_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_360,
-	2,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_360 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_360,
-	2,	/* Elements count */
-	&asn_SPC_extensionValue_specs_360	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SONConfigurationTransfer_ExtIEs_357[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SONConfigurationTransfer_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_358, &asn_PER_memb_id_constr_358,  memb_id_constraint_357 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SONConfigurationTransfer_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_SONConfigurationTransfer_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_359, &asn_PER_memb_criticality_constr_359,  memb_criticality_constraint_357 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SONConfigurationTransfer_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_360,
-		select_SONConfigurationTransfer_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_360, &asn_PER_memb_extensionValue_constr_360,  memb_extensionValue_constraint_357 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SONConfigurationTransfer_ExtIEs_tag2el_357[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SONConfigurationTransfer_ExtIEs_specs_357 = {
-	sizeof(struct SONConfigurationTransfer_ExtIEs),
-	offsetof(struct SONConfigurationTransfer_ExtIEs, _asn_ctx),
-	asn_MAP_SONConfigurationTransfer_ExtIEs_tag2el_357,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SONConfigurationTransfer_ExtIEs = {
-	"SONConfigurationTransfer-ExtIEs",
-	"SONConfigurationTransfer-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357,
-	sizeof(asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357)
-		/sizeof(asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357[0]), /* 1 */
-	asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357,	/* Same as above */
-	sizeof(asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357)
-		/sizeof(asn_DEF_SONConfigurationTransfer_ExtIEs_tags_357[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SONConfigurationTransfer_ExtIEs_357,
-	3,	/* Elements count */
-	&asn_SPC_SONConfigurationTransfer_ExtIEs_specs_357	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_364 = {
-	sizeof(struct SynchronisationInformation_ExtIEs__extensionValue),
-	offsetof(struct SynchronisationInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SynchronisationInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct SynchronisationInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_364 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_364	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SynchronisationInformation_ExtIEs_361[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SynchronisationInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_362, &asn_PER_memb_id_constr_362,  memb_id_constraint_361 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SynchronisationInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_363, &asn_PER_memb_criticality_constr_363,  memb_criticality_constraint_361 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SynchronisationInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_364,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_364, &asn_PER_memb_extensionValue_constr_364,  memb_extensionValue_constraint_361 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SynchronisationInformation_ExtIEs_tags_361[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SynchronisationInformation_ExtIEs_tag2el_361[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SynchronisationInformation_ExtIEs_specs_361 = {
-	sizeof(struct SynchronisationInformation_ExtIEs),
-	offsetof(struct SynchronisationInformation_ExtIEs, _asn_ctx),
-	asn_MAP_SynchronisationInformation_ExtIEs_tag2el_361,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SynchronisationInformation_ExtIEs = {
-	"SynchronisationInformation-ExtIEs",
-	"SynchronisationInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SynchronisationInformation_ExtIEs_tags_361,
-	sizeof(asn_DEF_SynchronisationInformation_ExtIEs_tags_361)
-		/sizeof(asn_DEF_SynchronisationInformation_ExtIEs_tags_361[0]), /* 1 */
-	asn_DEF_SynchronisationInformation_ExtIEs_tags_361,	/* Same as above */
-	sizeof(asn_DEF_SynchronisationInformation_ExtIEs_tags_361)
-		/sizeof(asn_DEF_SynchronisationInformation_ExtIEs_tags_361[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SynchronisationInformation_ExtIEs_361,
-	3,	/* Elements count */
-	&asn_SPC_SynchronisationInformation_ExtIEs_specs_361	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_368 = {
-	sizeof(struct SourceeNB_ID_ExtIEs__extensionValue),
-	offsetof(struct SourceeNB_ID_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SourceeNB_ID_ExtIEs__extensionValue, present),
-	sizeof(((struct SourceeNB_ID_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_368 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_368	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SourceeNB_ID_ExtIEs_365[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ID_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_366, &asn_PER_memb_id_constr_366,  memb_id_constraint_365 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ID_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_367, &asn_PER_memb_criticality_constr_367,  memb_criticality_constraint_365 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ID_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_368,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_368, &asn_PER_memb_extensionValue_constr_368,  memb_extensionValue_constraint_365 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SourceeNB_ID_ExtIEs_tags_365[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SourceeNB_ID_ExtIEs_tag2el_365[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SourceeNB_ID_ExtIEs_specs_365 = {
-	sizeof(struct SourceeNB_ID_ExtIEs),
-	offsetof(struct SourceeNB_ID_ExtIEs, _asn_ctx),
-	asn_MAP_SourceeNB_ID_ExtIEs_tag2el_365,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SourceeNB_ID_ExtIEs = {
-	"SourceeNB-ID-ExtIEs",
-	"SourceeNB-ID-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SourceeNB_ID_ExtIEs_tags_365,
-	sizeof(asn_DEF_SourceeNB_ID_ExtIEs_tags_365)
-		/sizeof(asn_DEF_SourceeNB_ID_ExtIEs_tags_365[0]), /* 1 */
-	asn_DEF_SourceeNB_ID_ExtIEs_tags_365,	/* Same as above */
-	sizeof(asn_DEF_SourceeNB_ID_ExtIEs_tags_365)
-		/sizeof(asn_DEF_SourceeNB_ID_ExtIEs_tags_365[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SourceeNB_ID_ExtIEs_365,
-	3,	/* Elements count */
-	&asn_SPC_SourceeNB_ID_ExtIEs_specs_365	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_372[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs__extensionValue, choice.MobilityInformation),
-		(ASN_TAG_CLASS_UNIVERSAL | (3 << 2)),
-		0,
-		&asn_DEF_MobilityInformation,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"MobilityInformation"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs__extensionValue, choice.UE_HistoryInformationFromTheUE),
-		(ASN_TAG_CLASS_UNIVERSAL | (4 << 2)),
-		0,
-		&asn_DEF_UE_HistoryInformationFromTheUE,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"UE-HistoryInformationFromTheUE"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_372[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (3 << 2)), 0, 0, 0 }, /* MobilityInformation */
-    { (ASN_TAG_CLASS_UNIVERSAL | (4 << 2)), 1, 0, 0 } /* UE-HistoryInformationFromTheUE */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_372 = {
-	sizeof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs__extensionValue),
-	offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs__extensionValue, present),
-	sizeof(((struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_372,
-	2,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_372 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_372,
-	2,	/* Elements count */
-	&asn_SPC_extensionValue_specs_372	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_369[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_370, &asn_PER_memb_id_constr_370,  memb_id_constraint_369 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_371, &asn_PER_memb_criticality_constr_371,  memb_criticality_constraint_369 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_372,
-		select_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_372, &asn_PER_memb_extensionValue_constr_372,  memb_extensionValue_constraint_369 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tag2el_369[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_specs_369 = {
-	sizeof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs),
-	offsetof(struct SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs, _asn_ctx),
-	asn_MAP_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tag2el_369,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs = {
-	"SourceeNB-ToTargeteNB-TransparentContainer-ExtIEs",
-	"SourceeNB-ToTargeteNB-TransparentContainer-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369,
-	sizeof(asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369)
-		/sizeof(asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369[0]), /* 1 */
-	asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369,	/* Same as above */
-	sizeof(asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369)
-		/sizeof(asn_DEF_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_tags_369[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_369,
-	3,	/* Elements count */
-	&asn_SPC_SourceeNB_ToTargeteNB_TransparentContainer_ExtIEs_specs_369	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_376 = {
-	sizeof(struct ServedGUMMEIsItem_ExtIEs__extensionValue),
-	offsetof(struct ServedGUMMEIsItem_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ServedGUMMEIsItem_ExtIEs__extensionValue, present),
-	sizeof(((struct ServedGUMMEIsItem_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_376 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_376	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ServedGUMMEIsItem_ExtIEs_373[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ServedGUMMEIsItem_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_374, &asn_PER_memb_id_constr_374,  memb_id_constraint_373 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ServedGUMMEIsItem_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_375, &asn_PER_memb_criticality_constr_375,  memb_criticality_constraint_373 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ServedGUMMEIsItem_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_376,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_376, &asn_PER_memb_extensionValue_constr_376,  memb_extensionValue_constraint_373 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ServedGUMMEIsItem_ExtIEs_tag2el_373[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ServedGUMMEIsItem_ExtIEs_specs_373 = {
-	sizeof(struct ServedGUMMEIsItem_ExtIEs),
-	offsetof(struct ServedGUMMEIsItem_ExtIEs, _asn_ctx),
-	asn_MAP_ServedGUMMEIsItem_ExtIEs_tag2el_373,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ServedGUMMEIsItem_ExtIEs = {
-	"ServedGUMMEIsItem-ExtIEs",
-	"ServedGUMMEIsItem-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373,
-	sizeof(asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373)
-		/sizeof(asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373[0]), /* 1 */
-	asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373,	/* Same as above */
-	sizeof(asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373)
-		/sizeof(asn_DEF_ServedGUMMEIsItem_ExtIEs_tags_373[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ServedGUMMEIsItem_ExtIEs_373,
-	3,	/* Elements count */
-	&asn_SPC_ServedGUMMEIsItem_ExtIEs_specs_373	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_380[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SupportedTAs_Item_ExtIEs__extensionValue, choice.RAT_Type),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_RAT_Type,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"RAT-Type"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_380[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 0, 0, 0 } /* RAT-Type */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_380 = {
-	sizeof(struct SupportedTAs_Item_ExtIEs__extensionValue),
-	offsetof(struct SupportedTAs_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct SupportedTAs_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct SupportedTAs_Item_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_380,
-	1,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_380 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_380,
-	1,	/* Elements count */
-	&asn_SPC_extensionValue_specs_380	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_SupportedTAs_Item_ExtIEs_377[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SupportedTAs_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_378, &asn_PER_memb_id_constr_378,  memb_id_constraint_377 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SupportedTAs_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_SupportedTAs_Item_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_379, &asn_PER_memb_criticality_constr_379,  memb_criticality_constraint_377 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct SupportedTAs_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_380,
-		select_SupportedTAs_Item_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_380, &asn_PER_memb_extensionValue_constr_380,  memb_extensionValue_constraint_377 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_SupportedTAs_Item_ExtIEs_tags_377[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SupportedTAs_Item_ExtIEs_tag2el_377[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SupportedTAs_Item_ExtIEs_specs_377 = {
-	sizeof(struct SupportedTAs_Item_ExtIEs),
-	offsetof(struct SupportedTAs_Item_ExtIEs, _asn_ctx),
-	asn_MAP_SupportedTAs_Item_ExtIEs_tag2el_377,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SupportedTAs_Item_ExtIEs = {
-	"SupportedTAs-Item-ExtIEs",
-	"SupportedTAs-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SupportedTAs_Item_ExtIEs_tags_377,
-	sizeof(asn_DEF_SupportedTAs_Item_ExtIEs_tags_377)
-		/sizeof(asn_DEF_SupportedTAs_Item_ExtIEs_tags_377[0]), /* 1 */
-	asn_DEF_SupportedTAs_Item_ExtIEs_tags_377,	/* Same as above */
-	sizeof(asn_DEF_SupportedTAs_Item_ExtIEs_tags_377)
-		/sizeof(asn_DEF_SupportedTAs_Item_ExtIEs_tags_377[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SupportedTAs_Item_ExtIEs_377,
-	3,	/* Elements count */
-	&asn_SPC_SupportedTAs_Item_ExtIEs_specs_377	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_384[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TimeSynchronisationInfo_ExtIEs__extensionValue, choice.MutingAvailabilityIndication),
-		(ASN_TAG_CLASS_UNIVERSAL | (10 << 2)),
-		0,
-		&asn_DEF_MutingAvailabilityIndication,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"MutingAvailabilityIndication"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_384[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (10 << 2)), 0, 0, 0 } /* MutingAvailabilityIndication */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_384 = {
-	sizeof(struct TimeSynchronisationInfo_ExtIEs__extensionValue),
-	offsetof(struct TimeSynchronisationInfo_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TimeSynchronisationInfo_ExtIEs__extensionValue, present),
-	sizeof(((struct TimeSynchronisationInfo_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_384,
-	1,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_384 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_384,
-	1,	/* Elements count */
-	&asn_SPC_extensionValue_specs_384	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TimeSynchronisationInfo_ExtIEs_381[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TimeSynchronisationInfo_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_382, &asn_PER_memb_id_constr_382,  memb_id_constraint_381 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TimeSynchronisationInfo_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_TimeSynchronisationInfo_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_383, &asn_PER_memb_criticality_constr_383,  memb_criticality_constraint_381 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TimeSynchronisationInfo_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_384,
-		select_TimeSynchronisationInfo_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_384, &asn_PER_memb_extensionValue_constr_384,  memb_extensionValue_constraint_381 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TimeSynchronisationInfo_ExtIEs_tag2el_381[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TimeSynchronisationInfo_ExtIEs_specs_381 = {
-	sizeof(struct TimeSynchronisationInfo_ExtIEs),
-	offsetof(struct TimeSynchronisationInfo_ExtIEs, _asn_ctx),
-	asn_MAP_TimeSynchronisationInfo_ExtIEs_tag2el_381,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TimeSynchronisationInfo_ExtIEs = {
-	"TimeSynchronisationInfo-ExtIEs",
-	"TimeSynchronisationInfo-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381,
-	sizeof(asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381)
-		/sizeof(asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381[0]), /* 1 */
-	asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381,	/* Same as above */
-	sizeof(asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381)
-		/sizeof(asn_DEF_TimeSynchronisationInfo_ExtIEs_tags_381[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TimeSynchronisationInfo_ExtIEs_381,
-	3,	/* Elements count */
-	&asn_SPC_TimeSynchronisationInfo_ExtIEs_specs_381	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_388 = {
-	sizeof(struct S_TMSI_ExtIEs__extensionValue),
-	offsetof(struct S_TMSI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct S_TMSI_ExtIEs__extensionValue, present),
-	sizeof(((struct S_TMSI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_388 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_388	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_S_TMSI_ExtIEs_385[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct S_TMSI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_386, &asn_PER_memb_id_constr_386,  memb_id_constraint_385 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct S_TMSI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_387, &asn_PER_memb_criticality_constr_387,  memb_criticality_constraint_385 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct S_TMSI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_388,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_388, &asn_PER_memb_extensionValue_constr_388,  memb_extensionValue_constraint_385 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_S_TMSI_ExtIEs_tags_385[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_S_TMSI_ExtIEs_tag2el_385[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_S_TMSI_ExtIEs_specs_385 = {
-	sizeof(struct S_TMSI_ExtIEs),
-	offsetof(struct S_TMSI_ExtIEs, _asn_ctx),
-	asn_MAP_S_TMSI_ExtIEs_tag2el_385,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_S_TMSI_ExtIEs = {
-	"S-TMSI-ExtIEs",
-	"S-TMSI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_S_TMSI_ExtIEs_tags_385,
-	sizeof(asn_DEF_S_TMSI_ExtIEs_tags_385)
-		/sizeof(asn_DEF_S_TMSI_ExtIEs_tags_385[0]), /* 1 */
-	asn_DEF_S_TMSI_ExtIEs_tags_385,	/* Same as above */
-	sizeof(asn_DEF_S_TMSI_ExtIEs_tags_385)
-		/sizeof(asn_DEF_S_TMSI_ExtIEs_tags_385[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_S_TMSI_ExtIEs_385,
-	3,	/* Elements count */
-	&asn_SPC_S_TMSI_ExtIEs_specs_385	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_392 = {
-	sizeof(struct TAIBasedMDT_ExtIEs__extensionValue),
-	offsetof(struct TAIBasedMDT_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TAIBasedMDT_ExtIEs__extensionValue, present),
-	sizeof(((struct TAIBasedMDT_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_392 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_392	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TAIBasedMDT_ExtIEs_389[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TAIBasedMDT_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_390, &asn_PER_memb_id_constr_390,  memb_id_constraint_389 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TAIBasedMDT_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_391, &asn_PER_memb_criticality_constr_391,  memb_criticality_constraint_389 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TAIBasedMDT_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_392,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_392, &asn_PER_memb_extensionValue_constr_392,  memb_extensionValue_constraint_389 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TAIBasedMDT_ExtIEs_tags_389[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TAIBasedMDT_ExtIEs_tag2el_389[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TAIBasedMDT_ExtIEs_specs_389 = {
-	sizeof(struct TAIBasedMDT_ExtIEs),
-	offsetof(struct TAIBasedMDT_ExtIEs, _asn_ctx),
-	asn_MAP_TAIBasedMDT_ExtIEs_tag2el_389,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TAIBasedMDT_ExtIEs = {
-	"TAIBasedMDT-ExtIEs",
-	"TAIBasedMDT-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TAIBasedMDT_ExtIEs_tags_389,
-	sizeof(asn_DEF_TAIBasedMDT_ExtIEs_tags_389)
-		/sizeof(asn_DEF_TAIBasedMDT_ExtIEs_tags_389[0]), /* 1 */
-	asn_DEF_TAIBasedMDT_ExtIEs_tags_389,	/* Same as above */
-	sizeof(asn_DEF_TAIBasedMDT_ExtIEs_tags_389)
-		/sizeof(asn_DEF_TAIBasedMDT_ExtIEs_tags_389[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TAIBasedMDT_ExtIEs_389,
-	3,	/* Elements count */
-	&asn_SPC_TAIBasedMDT_ExtIEs_specs_389	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_396 = {
-	sizeof(struct TAI_ExtIEs__extensionValue),
-	offsetof(struct TAI_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TAI_ExtIEs__extensionValue, present),
-	sizeof(((struct TAI_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_396 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_396	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TAI_ExtIEs_393[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TAI_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_394, &asn_PER_memb_id_constr_394,  memb_id_constraint_393 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TAI_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_395, &asn_PER_memb_criticality_constr_395,  memb_criticality_constraint_393 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TAI_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_396,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_396, &asn_PER_memb_extensionValue_constr_396,  memb_extensionValue_constraint_393 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TAI_ExtIEs_tags_393[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TAI_ExtIEs_tag2el_393[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TAI_ExtIEs_specs_393 = {
-	sizeof(struct TAI_ExtIEs),
-	offsetof(struct TAI_ExtIEs, _asn_ctx),
-	asn_MAP_TAI_ExtIEs_tag2el_393,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TAI_ExtIEs = {
-	"TAI-ExtIEs",
-	"TAI-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TAI_ExtIEs_tags_393,
-	sizeof(asn_DEF_TAI_ExtIEs_tags_393)
-		/sizeof(asn_DEF_TAI_ExtIEs_tags_393[0]), /* 1 */
-	asn_DEF_TAI_ExtIEs_tags_393,	/* Same as above */
-	sizeof(asn_DEF_TAI_ExtIEs_tags_393)
-		/sizeof(asn_DEF_TAI_ExtIEs_tags_393[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TAI_ExtIEs_393,
-	3,	/* Elements count */
-	&asn_SPC_TAI_ExtIEs_specs_393	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_400 = {
-	sizeof(struct TAI_Broadcast_Item_ExtIEs__extensionValue),
-	offsetof(struct TAI_Broadcast_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TAI_Broadcast_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct TAI_Broadcast_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_400 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_400	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TAI_Broadcast_Item_ExtIEs_397[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TAI_Broadcast_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_398, &asn_PER_memb_id_constr_398,  memb_id_constraint_397 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TAI_Broadcast_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_399, &asn_PER_memb_criticality_constr_399,  memb_criticality_constraint_397 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TAI_Broadcast_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_400,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_400, &asn_PER_memb_extensionValue_constr_400,  memb_extensionValue_constraint_397 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TAI_Broadcast_Item_ExtIEs_tag2el_397[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TAI_Broadcast_Item_ExtIEs_specs_397 = {
-	sizeof(struct TAI_Broadcast_Item_ExtIEs),
-	offsetof(struct TAI_Broadcast_Item_ExtIEs, _asn_ctx),
-	asn_MAP_TAI_Broadcast_Item_ExtIEs_tag2el_397,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TAI_Broadcast_Item_ExtIEs = {
-	"TAI-Broadcast-Item-ExtIEs",
-	"TAI-Broadcast-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397,
-	sizeof(asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397)
-		/sizeof(asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397[0]), /* 1 */
-	asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397,	/* Same as above */
-	sizeof(asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397)
-		/sizeof(asn_DEF_TAI_Broadcast_Item_ExtIEs_tags_397[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TAI_Broadcast_Item_ExtIEs_397,
-	3,	/* Elements count */
-	&asn_SPC_TAI_Broadcast_Item_ExtIEs_specs_397	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_404 = {
-	sizeof(struct TAI_Cancelled_Item_ExtIEs__extensionValue),
-	offsetof(struct TAI_Cancelled_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TAI_Cancelled_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct TAI_Cancelled_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_404 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_404	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TAI_Cancelled_Item_ExtIEs_401[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TAI_Cancelled_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_402, &asn_PER_memb_id_constr_402,  memb_id_constraint_401 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TAI_Cancelled_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_403, &asn_PER_memb_criticality_constr_403,  memb_criticality_constraint_401 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TAI_Cancelled_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_404,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_404, &asn_PER_memb_extensionValue_constr_404,  memb_extensionValue_constraint_401 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TAI_Cancelled_Item_ExtIEs_tag2el_401[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TAI_Cancelled_Item_ExtIEs_specs_401 = {
-	sizeof(struct TAI_Cancelled_Item_ExtIEs),
-	offsetof(struct TAI_Cancelled_Item_ExtIEs, _asn_ctx),
-	asn_MAP_TAI_Cancelled_Item_ExtIEs_tag2el_401,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TAI_Cancelled_Item_ExtIEs = {
-	"TAI-Cancelled-Item-ExtIEs",
-	"TAI-Cancelled-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401,
-	sizeof(asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401)
-		/sizeof(asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401[0]), /* 1 */
-	asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401,	/* Same as above */
-	sizeof(asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401)
-		/sizeof(asn_DEF_TAI_Cancelled_Item_ExtIEs_tags_401[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TAI_Cancelled_Item_ExtIEs_401,
-	3,	/* Elements count */
-	&asn_SPC_TAI_Cancelled_Item_ExtIEs_specs_401	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_408 = {
-	sizeof(struct TABasedMDT_ExtIEs__extensionValue),
-	offsetof(struct TABasedMDT_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TABasedMDT_ExtIEs__extensionValue, present),
-	sizeof(((struct TABasedMDT_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_408 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_408	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TABasedMDT_ExtIEs_405[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TABasedMDT_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_406, &asn_PER_memb_id_constr_406,  memb_id_constraint_405 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TABasedMDT_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_407, &asn_PER_memb_criticality_constr_407,  memb_criticality_constraint_405 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TABasedMDT_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_408,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_408, &asn_PER_memb_extensionValue_constr_408,  memb_extensionValue_constraint_405 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TABasedMDT_ExtIEs_tags_405[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TABasedMDT_ExtIEs_tag2el_405[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TABasedMDT_ExtIEs_specs_405 = {
-	sizeof(struct TABasedMDT_ExtIEs),
-	offsetof(struct TABasedMDT_ExtIEs, _asn_ctx),
-	asn_MAP_TABasedMDT_ExtIEs_tag2el_405,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TABasedMDT_ExtIEs = {
-	"TABasedMDT-ExtIEs",
-	"TABasedMDT-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TABasedMDT_ExtIEs_tags_405,
-	sizeof(asn_DEF_TABasedMDT_ExtIEs_tags_405)
-		/sizeof(asn_DEF_TABasedMDT_ExtIEs_tags_405[0]), /* 1 */
-	asn_DEF_TABasedMDT_ExtIEs_tags_405,	/* Same as above */
-	sizeof(asn_DEF_TABasedMDT_ExtIEs_tags_405)
-		/sizeof(asn_DEF_TABasedMDT_ExtIEs_tags_405[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TABasedMDT_ExtIEs_405,
-	3,	/* Elements count */
-	&asn_SPC_TABasedMDT_ExtIEs_specs_405	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_412 = {
-	sizeof(struct CompletedCellinTAI_Item_ExtIEs__extensionValue),
-	offsetof(struct CompletedCellinTAI_Item_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct CompletedCellinTAI_Item_ExtIEs__extensionValue, present),
-	sizeof(((struct CompletedCellinTAI_Item_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_412 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_412	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_CompletedCellinTAI_Item_ExtIEs_409[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct CompletedCellinTAI_Item_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_410, &asn_PER_memb_id_constr_410,  memb_id_constraint_409 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct CompletedCellinTAI_Item_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_411, &asn_PER_memb_criticality_constr_411,  memb_criticality_constraint_409 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct CompletedCellinTAI_Item_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_412,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_412, &asn_PER_memb_extensionValue_constr_412,  memb_extensionValue_constraint_409 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_CompletedCellinTAI_Item_ExtIEs_tag2el_409[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_CompletedCellinTAI_Item_ExtIEs_specs_409 = {
-	sizeof(struct CompletedCellinTAI_Item_ExtIEs),
-	offsetof(struct CompletedCellinTAI_Item_ExtIEs, _asn_ctx),
-	asn_MAP_CompletedCellinTAI_Item_ExtIEs_tag2el_409,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_CompletedCellinTAI_Item_ExtIEs = {
-	"CompletedCellinTAI-Item-ExtIEs",
-	"CompletedCellinTAI-Item-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409,
-	sizeof(asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409)
-		/sizeof(asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409[0]), /* 1 */
-	asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409,	/* Same as above */
-	sizeof(asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409)
-		/sizeof(asn_DEF_CompletedCellinTAI_Item_ExtIEs_tags_409[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_CompletedCellinTAI_Item_ExtIEs_409,
-	3,	/* Elements count */
-	&asn_SPC_CompletedCellinTAI_Item_ExtIEs_specs_409	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_416 = {
-	sizeof(struct TargeteNB_ID_ExtIEs__extensionValue),
-	offsetof(struct TargeteNB_ID_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TargeteNB_ID_ExtIEs__extensionValue, present),
-	sizeof(((struct TargeteNB_ID_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_416 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_416	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TargeteNB_ID_ExtIEs_413[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TargeteNB_ID_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_414, &asn_PER_memb_id_constr_414,  memb_id_constraint_413 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TargeteNB_ID_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_415, &asn_PER_memb_criticality_constr_415,  memb_criticality_constraint_413 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TargeteNB_ID_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_416,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_416, &asn_PER_memb_extensionValue_constr_416,  memb_extensionValue_constraint_413 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TargeteNB_ID_ExtIEs_tags_413[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TargeteNB_ID_ExtIEs_tag2el_413[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TargeteNB_ID_ExtIEs_specs_413 = {
-	sizeof(struct TargeteNB_ID_ExtIEs),
-	offsetof(struct TargeteNB_ID_ExtIEs, _asn_ctx),
-	asn_MAP_TargeteNB_ID_ExtIEs_tag2el_413,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TargeteNB_ID_ExtIEs = {
-	"TargeteNB-ID-ExtIEs",
-	"TargeteNB-ID-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TargeteNB_ID_ExtIEs_tags_413,
-	sizeof(asn_DEF_TargeteNB_ID_ExtIEs_tags_413)
-		/sizeof(asn_DEF_TargeteNB_ID_ExtIEs_tags_413[0]), /* 1 */
-	asn_DEF_TargeteNB_ID_ExtIEs_tags_413,	/* Same as above */
-	sizeof(asn_DEF_TargeteNB_ID_ExtIEs_tags_413)
-		/sizeof(asn_DEF_TargeteNB_ID_ExtIEs_tags_413[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TargeteNB_ID_ExtIEs_413,
-	3,	/* Elements count */
-	&asn_SPC_TargeteNB_ID_ExtIEs_specs_413	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_420 = {
-	sizeof(struct TargetRNC_ID_ExtIEs__extensionValue),
-	offsetof(struct TargetRNC_ID_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TargetRNC_ID_ExtIEs__extensionValue, present),
-	sizeof(((struct TargetRNC_ID_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_420 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_420	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TargetRNC_ID_ExtIEs_417[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TargetRNC_ID_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_418, &asn_PER_memb_id_constr_418,  memb_id_constraint_417 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TargetRNC_ID_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_419, &asn_PER_memb_criticality_constr_419,  memb_criticality_constraint_417 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TargetRNC_ID_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_420,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_420, &asn_PER_memb_extensionValue_constr_420,  memb_extensionValue_constraint_417 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TargetRNC_ID_ExtIEs_tags_417[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TargetRNC_ID_ExtIEs_tag2el_417[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TargetRNC_ID_ExtIEs_specs_417 = {
-	sizeof(struct TargetRNC_ID_ExtIEs),
-	offsetof(struct TargetRNC_ID_ExtIEs, _asn_ctx),
-	asn_MAP_TargetRNC_ID_ExtIEs_tag2el_417,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TargetRNC_ID_ExtIEs = {
-	"TargetRNC-ID-ExtIEs",
-	"TargetRNC-ID-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TargetRNC_ID_ExtIEs_tags_417,
-	sizeof(asn_DEF_TargetRNC_ID_ExtIEs_tags_417)
-		/sizeof(asn_DEF_TargetRNC_ID_ExtIEs_tags_417[0]), /* 1 */
-	asn_DEF_TargetRNC_ID_ExtIEs_tags_417,	/* Same as above */
-	sizeof(asn_DEF_TargetRNC_ID_ExtIEs_tags_417)
-		/sizeof(asn_DEF_TargetRNC_ID_ExtIEs_tags_417[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TargetRNC_ID_ExtIEs_417,
-	3,	/* Elements count */
-	&asn_SPC_TargetRNC_ID_ExtIEs_specs_417	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_424 = {
-	sizeof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs__extensionValue),
-	offsetof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs__extensionValue, present),
-	sizeof(((struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_424 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_424	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_421[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_422, &asn_PER_memb_id_constr_422,  memb_id_constraint_421 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_423, &asn_PER_memb_criticality_constr_423,  memb_criticality_constraint_421 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_424,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_424, &asn_PER_memb_extensionValue_constr_424,  memb_extensionValue_constraint_421 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tag2el_421[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_specs_421 = {
-	sizeof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs),
-	offsetof(struct TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs, _asn_ctx),
-	asn_MAP_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tag2el_421,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs = {
-	"TargeteNB-ToSourceeNB-TransparentContainer-ExtIEs",
-	"TargeteNB-ToSourceeNB-TransparentContainer-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421,
-	sizeof(asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421)
-		/sizeof(asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421[0]), /* 1 */
-	asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421,	/* Same as above */
-	sizeof(asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421)
-		/sizeof(asn_DEF_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_tags_421[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_421,
-	3,	/* Elements count */
-	&asn_SPC_TargeteNB_ToSourceeNB_TransparentContainer_ExtIEs_specs_421	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_428 = {
-	sizeof(struct M1ThresholdEventA2_ExtIEs__extensionValue),
-	offsetof(struct M1ThresholdEventA2_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct M1ThresholdEventA2_ExtIEs__extensionValue, present),
-	sizeof(((struct M1ThresholdEventA2_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_428 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_428	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_M1ThresholdEventA2_ExtIEs_425[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct M1ThresholdEventA2_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_426, &asn_PER_memb_id_constr_426,  memb_id_constraint_425 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct M1ThresholdEventA2_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_427, &asn_PER_memb_criticality_constr_427,  memb_criticality_constraint_425 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct M1ThresholdEventA2_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_428,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_428, &asn_PER_memb_extensionValue_constr_428,  memb_extensionValue_constraint_425 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_M1ThresholdEventA2_ExtIEs_tag2el_425[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_M1ThresholdEventA2_ExtIEs_specs_425 = {
-	sizeof(struct M1ThresholdEventA2_ExtIEs),
-	offsetof(struct M1ThresholdEventA2_ExtIEs, _asn_ctx),
-	asn_MAP_M1ThresholdEventA2_ExtIEs_tag2el_425,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_M1ThresholdEventA2_ExtIEs = {
-	"M1ThresholdEventA2-ExtIEs",
-	"M1ThresholdEventA2-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425,
-	sizeof(asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425)
-		/sizeof(asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425[0]), /* 1 */
-	asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425,	/* Same as above */
-	sizeof(asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425)
-		/sizeof(asn_DEF_M1ThresholdEventA2_ExtIEs_tags_425[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_M1ThresholdEventA2_ExtIEs_425,
-	3,	/* Elements count */
-	&asn_SPC_M1ThresholdEventA2_ExtIEs_specs_425	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_432[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TraceActivation_ExtIEs__extensionValue, choice.MDT_Configuration),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_MDT_Configuration,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"MDT-Configuration"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_432[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 0 } /* MDT-Configuration */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_432 = {
-	sizeof(struct TraceActivation_ExtIEs__extensionValue),
-	offsetof(struct TraceActivation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct TraceActivation_ExtIEs__extensionValue, present),
-	sizeof(((struct TraceActivation_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_432,
-	1,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_432 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_432,
-	1,	/* Elements count */
-	&asn_SPC_extensionValue_specs_432	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_TraceActivation_ExtIEs_429[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct TraceActivation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_430, &asn_PER_memb_id_constr_430,  memb_id_constraint_429 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct TraceActivation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_TraceActivation_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_431, &asn_PER_memb_criticality_constr_431,  memb_criticality_constraint_429 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct TraceActivation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_432,
-		select_TraceActivation_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_432, &asn_PER_memb_extensionValue_constr_432,  memb_extensionValue_constraint_429 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_TraceActivation_ExtIEs_tags_429[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_TraceActivation_ExtIEs_tag2el_429[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_TraceActivation_ExtIEs_specs_429 = {
-	sizeof(struct TraceActivation_ExtIEs),
-	offsetof(struct TraceActivation_ExtIEs, _asn_ctx),
-	asn_MAP_TraceActivation_ExtIEs_tag2el_429,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_TraceActivation_ExtIEs = {
-	"TraceActivation-ExtIEs",
-	"TraceActivation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_TraceActivation_ExtIEs_tags_429,
-	sizeof(asn_DEF_TraceActivation_ExtIEs_tags_429)
-		/sizeof(asn_DEF_TraceActivation_ExtIEs_tags_429[0]), /* 1 */
-	asn_DEF_TraceActivation_ExtIEs_tags_429,	/* Same as above */
-	sizeof(asn_DEF_TraceActivation_ExtIEs_tags_429)
-		/sizeof(asn_DEF_TraceActivation_ExtIEs_tags_429[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TraceActivation_ExtIEs_429,
-	3,	/* Elements count */
-	&asn_SPC_TraceActivation_ExtIEs_specs_429	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_436 = {
-	sizeof(struct Tunnel_Information_ExtIEs__extensionValue),
-	offsetof(struct Tunnel_Information_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct Tunnel_Information_ExtIEs__extensionValue, present),
-	sizeof(((struct Tunnel_Information_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_436 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_436	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_Tunnel_Information_ExtIEs_433[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct Tunnel_Information_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_434, &asn_PER_memb_id_constr_434,  memb_id_constraint_433 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct Tunnel_Information_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_435, &asn_PER_memb_criticality_constr_435,  memb_criticality_constraint_433 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct Tunnel_Information_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_436,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_436, &asn_PER_memb_extensionValue_constr_436,  memb_extensionValue_constraint_433 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_Tunnel_Information_ExtIEs_tags_433[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_Tunnel_Information_ExtIEs_tag2el_433[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_Tunnel_Information_ExtIEs_specs_433 = {
-	sizeof(struct Tunnel_Information_ExtIEs),
-	offsetof(struct Tunnel_Information_ExtIEs, _asn_ctx),
-	asn_MAP_Tunnel_Information_ExtIEs_tag2el_433,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_Tunnel_Information_ExtIEs = {
-	"Tunnel-Information-ExtIEs",
-	"Tunnel-Information-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_Tunnel_Information_ExtIEs_tags_433,
-	sizeof(asn_DEF_Tunnel_Information_ExtIEs_tags_433)
-		/sizeof(asn_DEF_Tunnel_Information_ExtIEs_tags_433[0]), /* 1 */
-	asn_DEF_Tunnel_Information_ExtIEs_tags_433,	/* Same as above */
-	sizeof(asn_DEF_Tunnel_Information_ExtIEs_tags_433)
-		/sizeof(asn_DEF_Tunnel_Information_ExtIEs_tags_433[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_Tunnel_Information_ExtIEs_433,
-	3,	/* Elements count */
-	&asn_SPC_Tunnel_Information_ExtIEs_specs_433	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_440 = {
-	sizeof(struct UEAggregate_MaximumBitrates_ExtIEs__extensionValue),
-	offsetof(struct UEAggregate_MaximumBitrates_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UEAggregate_MaximumBitrates_ExtIEs__extensionValue, present),
-	sizeof(((struct UEAggregate_MaximumBitrates_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_440 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_440	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UEAggregate_MaximumBitrates_ExtIEs_437[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UEAggregate_MaximumBitrates_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_438, &asn_PER_memb_id_constr_438,  memb_id_constraint_437 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UEAggregate_MaximumBitrates_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_439, &asn_PER_memb_criticality_constr_439,  memb_criticality_constraint_437 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UEAggregate_MaximumBitrates_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_440,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_440, &asn_PER_memb_extensionValue_constr_440,  memb_extensionValue_constraint_437 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UEAggregate_MaximumBitrates_ExtIEs_tag2el_437[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UEAggregate_MaximumBitrates_ExtIEs_specs_437 = {
-	sizeof(struct UEAggregate_MaximumBitrates_ExtIEs),
-	offsetof(struct UEAggregate_MaximumBitrates_ExtIEs, _asn_ctx),
-	asn_MAP_UEAggregate_MaximumBitrates_ExtIEs_tag2el_437,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UEAggregate_MaximumBitrates_ExtIEs = {
-	"UEAggregate-MaximumBitrates-ExtIEs",
-	"UEAggregate-MaximumBitrates-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437,
-	sizeof(asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437)
-		/sizeof(asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437[0]), /* 1 */
-	asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437,	/* Same as above */
-	sizeof(asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437)
-		/sizeof(asn_DEF_UEAggregate_MaximumBitrates_ExtIEs_tags_437[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UEAggregate_MaximumBitrates_ExtIEs_437,
-	3,	/* Elements count */
-	&asn_SPC_UEAggregate_MaximumBitrates_ExtIEs_specs_437	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_444 = {
-	sizeof(struct UE_S1AP_ID_pair_ExtIEs__extensionValue),
-	offsetof(struct UE_S1AP_ID_pair_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UE_S1AP_ID_pair_ExtIEs__extensionValue, present),
-	sizeof(((struct UE_S1AP_ID_pair_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_444 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_444	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UE_S1AP_ID_pair_ExtIEs_441[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UE_S1AP_ID_pair_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_442, &asn_PER_memb_id_constr_442,  memb_id_constraint_441 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UE_S1AP_ID_pair_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_443, &asn_PER_memb_criticality_constr_443,  memb_criticality_constraint_441 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UE_S1AP_ID_pair_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_444,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_444, &asn_PER_memb_extensionValue_constr_444,  memb_extensionValue_constraint_441 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UE_S1AP_ID_pair_ExtIEs_tag2el_441[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UE_S1AP_ID_pair_ExtIEs_specs_441 = {
-	sizeof(struct UE_S1AP_ID_pair_ExtIEs),
-	offsetof(struct UE_S1AP_ID_pair_ExtIEs, _asn_ctx),
-	asn_MAP_UE_S1AP_ID_pair_ExtIEs_tag2el_441,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UE_S1AP_ID_pair_ExtIEs = {
-	"UE-S1AP-ID-pair-ExtIEs",
-	"UE-S1AP-ID-pair-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441,
-	sizeof(asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441)
-		/sizeof(asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441[0]), /* 1 */
-	asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441,	/* Same as above */
-	sizeof(asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441)
-		/sizeof(asn_DEF_UE_S1AP_ID_pair_ExtIEs_tags_441[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UE_S1AP_ID_pair_ExtIEs_441,
-	3,	/* Elements count */
-	&asn_SPC_UE_S1AP_ID_pair_ExtIEs_specs_441	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_448 = {
-	sizeof(struct UE_associatedLogicalS1_ConnectionItemExtIEs__extensionValue),
-	offsetof(struct UE_associatedLogicalS1_ConnectionItemExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UE_associatedLogicalS1_ConnectionItemExtIEs__extensionValue, present),
-	sizeof(((struct UE_associatedLogicalS1_ConnectionItemExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_448 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_448	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UE_associatedLogicalS1_ConnectionItemExtIEs_445[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UE_associatedLogicalS1_ConnectionItemExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_446, &asn_PER_memb_id_constr_446,  memb_id_constraint_445 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UE_associatedLogicalS1_ConnectionItemExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_447, &asn_PER_memb_criticality_constr_447,  memb_criticality_constraint_445 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UE_associatedLogicalS1_ConnectionItemExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_448,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_448, &asn_PER_memb_extensionValue_constr_448,  memb_extensionValue_constraint_445 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UE_associatedLogicalS1_ConnectionItemExtIEs_tag2el_445[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UE_associatedLogicalS1_ConnectionItemExtIEs_specs_445 = {
-	sizeof(struct UE_associatedLogicalS1_ConnectionItemExtIEs),
-	offsetof(struct UE_associatedLogicalS1_ConnectionItemExtIEs, _asn_ctx),
-	asn_MAP_UE_associatedLogicalS1_ConnectionItemExtIEs_tag2el_445,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs = {
-	"UE-associatedLogicalS1-ConnectionItemExtIEs",
-	"UE-associatedLogicalS1-ConnectionItemExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445,
-	sizeof(asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445)
-		/sizeof(asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445[0]), /* 1 */
-	asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445,	/* Same as above */
-	sizeof(asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445)
-		/sizeof(asn_DEF_UE_associatedLogicalS1_ConnectionItemExtIEs_tags_445[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UE_associatedLogicalS1_ConnectionItemExtIEs_445,
-	3,	/* Elements count */
-	&asn_SPC_UE_associatedLogicalS1_ConnectionItemExtIEs_specs_445	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_452 = {
-	sizeof(struct UESecurityCapabilities_ExtIEs__extensionValue),
-	offsetof(struct UESecurityCapabilities_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UESecurityCapabilities_ExtIEs__extensionValue, present),
-	sizeof(((struct UESecurityCapabilities_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_452 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_452	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UESecurityCapabilities_ExtIEs_449[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UESecurityCapabilities_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_450, &asn_PER_memb_id_constr_450,  memb_id_constraint_449 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UESecurityCapabilities_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_451, &asn_PER_memb_criticality_constr_451,  memb_criticality_constraint_449 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UESecurityCapabilities_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_452,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_452, &asn_PER_memb_extensionValue_constr_452,  memb_extensionValue_constraint_449 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UESecurityCapabilities_ExtIEs_tags_449[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UESecurityCapabilities_ExtIEs_tag2el_449[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UESecurityCapabilities_ExtIEs_specs_449 = {
-	sizeof(struct UESecurityCapabilities_ExtIEs),
-	offsetof(struct UESecurityCapabilities_ExtIEs, _asn_ctx),
-	asn_MAP_UESecurityCapabilities_ExtIEs_tag2el_449,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UESecurityCapabilities_ExtIEs = {
-	"UESecurityCapabilities-ExtIEs",
-	"UESecurityCapabilities-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UESecurityCapabilities_ExtIEs_tags_449,
-	sizeof(asn_DEF_UESecurityCapabilities_ExtIEs_tags_449)
-		/sizeof(asn_DEF_UESecurityCapabilities_ExtIEs_tags_449[0]), /* 1 */
-	asn_DEF_UESecurityCapabilities_ExtIEs_tags_449,	/* Same as above */
-	sizeof(asn_DEF_UESecurityCapabilities_ExtIEs_tags_449)
-		/sizeof(asn_DEF_UESecurityCapabilities_ExtIEs_tags_449[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UESecurityCapabilities_ExtIEs_449,
-	3,	/* Elements count */
-	&asn_SPC_UESecurityCapabilities_ExtIEs_specs_449	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_456 = {
-	sizeof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs__extensionValue),
-	offsetof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs__extensionValue, present),
-	sizeof(((struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_456 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_456	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_453[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_454, &asn_PER_memb_id_constr_454,  memb_id_constraint_453 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_455, &asn_PER_memb_criticality_constr_455,  memb_criticality_constraint_453 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_456,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_456, &asn_PER_memb_extensionValue_constr_456,  memb_extensionValue_constraint_453 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tag2el_453[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_specs_453 = {
-	sizeof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs),
-	offsetof(struct UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs, _asn_ctx),
-	asn_MAP_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tag2el_453,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs = {
-	"UE-Sidelink-Aggregate-MaximumBitrates-ExtIEs",
-	"UE-Sidelink-Aggregate-MaximumBitrates-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453,
-	sizeof(asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453)
-		/sizeof(asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453[0]), /* 1 */
-	asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453,	/* Same as above */
-	sizeof(asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453)
-		/sizeof(asn_DEF_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_tags_453[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_453,
-	3,	/* Elements count */
-	&asn_SPC_UE_Sidelink_Aggregate_MaximumBitrates_ExtIEs_specs_453	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_460 = {
-	sizeof(struct UL_CP_SecurityInformation_ExtIEs__extensionValue),
-	offsetof(struct UL_CP_SecurityInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UL_CP_SecurityInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct UL_CP_SecurityInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_460 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_460	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UL_CP_SecurityInformation_ExtIEs_457[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UL_CP_SecurityInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_458, &asn_PER_memb_id_constr_458,  memb_id_constraint_457 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UL_CP_SecurityInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_459, &asn_PER_memb_criticality_constr_459,  memb_criticality_constraint_457 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UL_CP_SecurityInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_460,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_460, &asn_PER_memb_extensionValue_constr_460,  memb_extensionValue_constraint_457 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UL_CP_SecurityInformation_ExtIEs_tag2el_457[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UL_CP_SecurityInformation_ExtIEs_specs_457 = {
-	sizeof(struct UL_CP_SecurityInformation_ExtIEs),
-	offsetof(struct UL_CP_SecurityInformation_ExtIEs, _asn_ctx),
-	asn_MAP_UL_CP_SecurityInformation_ExtIEs_tag2el_457,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UL_CP_SecurityInformation_ExtIEs = {
-	"UL-CP-SecurityInformation-ExtIEs",
-	"UL-CP-SecurityInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457,
-	sizeof(asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457)
-		/sizeof(asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457[0]), /* 1 */
-	asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457,	/* Same as above */
-	sizeof(asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457)
-		/sizeof(asn_DEF_UL_CP_SecurityInformation_ExtIEs_tags_457[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UL_CP_SecurityInformation_ExtIEs_457,
-	3,	/* Elements count */
-	&asn_SPC_UL_CP_SecurityInformation_ExtIEs_specs_457	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_464 = {
-	sizeof(struct UserLocationInformation_ExtIEs__extensionValue),
-	offsetof(struct UserLocationInformation_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct UserLocationInformation_ExtIEs__extensionValue, present),
-	sizeof(((struct UserLocationInformation_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_464 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_464	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_UserLocationInformation_ExtIEs_461[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct UserLocationInformation_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_462, &asn_PER_memb_id_constr_462,  memb_id_constraint_461 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct UserLocationInformation_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_463, &asn_PER_memb_criticality_constr_463,  memb_criticality_constraint_461 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct UserLocationInformation_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_464,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_464, &asn_PER_memb_extensionValue_constr_464,  memb_extensionValue_constraint_461 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_UserLocationInformation_ExtIEs_tags_461[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_UserLocationInformation_ExtIEs_tag2el_461[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_UserLocationInformation_ExtIEs_specs_461 = {
-	sizeof(struct UserLocationInformation_ExtIEs),
-	offsetof(struct UserLocationInformation_ExtIEs, _asn_ctx),
-	asn_MAP_UserLocationInformation_ExtIEs_tag2el_461,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_UserLocationInformation_ExtIEs = {
-	"UserLocationInformation-ExtIEs",
-	"UserLocationInformation-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_UserLocationInformation_ExtIEs_tags_461,
-	sizeof(asn_DEF_UserLocationInformation_ExtIEs_tags_461)
-		/sizeof(asn_DEF_UserLocationInformation_ExtIEs_tags_461[0]), /* 1 */
-	asn_DEF_UserLocationInformation_ExtIEs_tags_461,	/* Same as above */
-	sizeof(asn_DEF_UserLocationInformation_ExtIEs_tags_461)
-		/sizeof(asn_DEF_UserLocationInformation_ExtIEs_tags_461[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UserLocationInformation_ExtIEs_461,
-	3,	/* Elements count */
-	&asn_SPC_UserLocationInformation_ExtIEs_specs_461	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_468 = {
-	sizeof(struct V2XServicesAuthorized_ExtIEs__extensionValue),
-	offsetof(struct V2XServicesAuthorized_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct V2XServicesAuthorized_ExtIEs__extensionValue, present),
-	sizeof(((struct V2XServicesAuthorized_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_468 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_468	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_V2XServicesAuthorized_ExtIEs_465[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct V2XServicesAuthorized_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_466, &asn_PER_memb_id_constr_466,  memb_id_constraint_465 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct V2XServicesAuthorized_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_467, &asn_PER_memb_criticality_constr_467,  memb_criticality_constraint_465 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct V2XServicesAuthorized_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_468,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_468, &asn_PER_memb_extensionValue_constr_468,  memb_extensionValue_constraint_465 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_V2XServicesAuthorized_ExtIEs_tag2el_465[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_V2XServicesAuthorized_ExtIEs_specs_465 = {
-	sizeof(struct V2XServicesAuthorized_ExtIEs),
-	offsetof(struct V2XServicesAuthorized_ExtIEs, _asn_ctx),
-	asn_MAP_V2XServicesAuthorized_ExtIEs_tag2el_465,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_V2XServicesAuthorized_ExtIEs = {
-	"V2XServicesAuthorized-ExtIEs",
-	"V2XServicesAuthorized-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465,
-	sizeof(asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465)
-		/sizeof(asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465[0]), /* 1 */
-	asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465,	/* Same as above */
-	sizeof(asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465)
-		/sizeof(asn_DEF_V2XServicesAuthorized_ExtIEs_tags_465[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_V2XServicesAuthorized_ExtIEs_465,
-	3,	/* Elements count */
-	&asn_SPC_V2XServicesAuthorized_ExtIEs_specs_465	/* Additional specs */
-};
-
-static asn_TYPE_member_t asn_MBR_extensionValue_472[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct X2TNLConfigurationInfo_ExtIEs__extensionValue, choice.ENBX2ExtTLAs),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ENBX2ExtTLAs,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"ENBX2ExtTLAs"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct X2TNLConfigurationInfo_ExtIEs__extensionValue, choice.ENBIndirectX2TransportLayerAddresses),
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ENBIndirectX2TransportLayerAddresses,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"ENBIndirectX2TransportLayerAddresses"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_extensionValue_tag2el_472[] = {
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 0, 0, 1 }, /* ENBX2ExtTLAs */
-    { (ASN_TAG_CLASS_UNIVERSAL | (16 << 2)), 1, -1, 0 } /* ENBIndirectX2TransportLayerAddresses */
-};
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_472 = {
-	sizeof(struct X2TNLConfigurationInfo_ExtIEs__extensionValue),
-	offsetof(struct X2TNLConfigurationInfo_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct X2TNLConfigurationInfo_ExtIEs__extensionValue, present),
-	sizeof(((struct X2TNLConfigurationInfo_ExtIEs__extensionValue *)0)->present),
-	asn_MAP_extensionValue_tag2el_472,
-	2,	/* Count of tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_472 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	asn_MBR_extensionValue_472,
-	2,	/* Elements count */
-	&asn_SPC_extensionValue_specs_472	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_X2TNLConfigurationInfo_ExtIEs_469[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct X2TNLConfigurationInfo_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_470, &asn_PER_memb_id_constr_470,  memb_id_constraint_469 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct X2TNLConfigurationInfo_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		select_X2TNLConfigurationInfo_ExtIEs_criticality_type,
-		{ &asn_OER_memb_criticality_constr_471, &asn_PER_memb_criticality_constr_471,  memb_criticality_constraint_469 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct X2TNLConfigurationInfo_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_472,
-		select_X2TNLConfigurationInfo_ExtIEs_extensionValue_type,
-		{ &asn_OER_memb_extensionValue_constr_472, &asn_PER_memb_extensionValue_constr_472,  memb_extensionValue_constraint_469 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_X2TNLConfigurationInfo_ExtIEs_tag2el_469[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_X2TNLConfigurationInfo_ExtIEs_specs_469 = {
-	sizeof(struct X2TNLConfigurationInfo_ExtIEs),
-	offsetof(struct X2TNLConfigurationInfo_ExtIEs, _asn_ctx),
-	asn_MAP_X2TNLConfigurationInfo_ExtIEs_tag2el_469,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_X2TNLConfigurationInfo_ExtIEs = {
-	"X2TNLConfigurationInfo-ExtIEs",
-	"X2TNLConfigurationInfo-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469,
-	sizeof(asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469)
-		/sizeof(asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469[0]), /* 1 */
-	asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469,	/* Same as above */
-	sizeof(asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469)
-		/sizeof(asn_DEF_X2TNLConfigurationInfo_ExtIEs_tags_469[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_X2TNLConfigurationInfo_ExtIEs_469,
-	3,	/* Elements count */
-	&asn_SPC_X2TNLConfigurationInfo_ExtIEs_specs_469	/* Additional specs */
-};
-
-static asn_CHOICE_specifics_t asn_SPC_extensionValue_specs_476 = {
-	sizeof(struct ENBX2ExtTLA_ExtIEs__extensionValue),
-	offsetof(struct ENBX2ExtTLA_ExtIEs__extensionValue, _asn_ctx),
-	offsetof(struct ENBX2ExtTLA_ExtIEs__extensionValue, present),
-	sizeof(((struct ENBX2ExtTLA_ExtIEs__extensionValue *)0)->present),
-	0,	/* No top level tags */
-	0,	/* No tags in the map */
-	0, 0,
-	-1	/* Extensions start */
-};
-static /* Use -fall-defs-global to expose */
-asn_TYPE_descriptor_t asn_DEF_extensionValue_476 = {
-	"extensionValue",
-	"extensionValue",
-	&asn_OP_OPEN_TYPE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ 0, 0, OPEN_TYPE_constraint },
-	0, 0,	/* No members */
-	&asn_SPC_extensionValue_specs_476	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ENBX2ExtTLA_ExtIEs_473[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ENBX2ExtTLA_ExtIEs, id),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionID,
-		0,
-		{ &asn_OER_memb_id_constr_474, &asn_PER_memb_id_constr_474,  memb_id_constraint_473 },
-		0, 0, /* No default value */
-		"id"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct ENBX2ExtTLA_ExtIEs, criticality),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_Criticality,
-		0,
-		{ &asn_OER_memb_criticality_constr_475, &asn_PER_memb_criticality_constr_475,  memb_criticality_constraint_473 },
-		0, 0, /* No default value */
-		"criticality"
-		},
-	{ ATF_OPEN_TYPE | ATF_NOFLAGS, 0, offsetof(struct ENBX2ExtTLA_ExtIEs, extensionValue),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		+1,	/* EXPLICIT tag at current level */
-		&asn_DEF_extensionValue_476,
-		0,
-		{ &asn_OER_memb_extensionValue_constr_476, &asn_PER_memb_extensionValue_constr_476,  memb_extensionValue_constraint_473 },
-		0, 0, /* No default value */
-		"extensionValue"
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_ENBX2ExtTLA_ExtIEs_tag2el_473[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* id */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* criticality */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* extensionValue */
-};
-asn_SEQUENCE_specifics_t asn_SPC_ENBX2ExtTLA_ExtIEs_specs_473 = {
-	sizeof(struct ENBX2ExtTLA_ExtIEs),
-	offsetof(struct ENBX2ExtTLA_ExtIEs, _asn_ctx),
-	asn_MAP_ENBX2ExtTLA_ExtIEs_tag2el_473,
-	3,	/* Count of tags in the map */
-	0, 0, 0,	/* Optional elements (not needed) */
-	-1,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_ENBX2ExtTLA_ExtIEs = {
-	"ENBX2ExtTLA-ExtIEs",
-	"ENBX2ExtTLA-ExtIEs",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473,
-	sizeof(asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473)
-		/sizeof(asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473[0]), /* 1 */
-	asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473,	/* Same as above */
-	sizeof(asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473)
-		/sizeof(asn_DEF_ENBX2ExtTLA_ExtIEs_tags_473[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_ENBX2ExtTLA_ExtIEs_473,
-	3,	/* Elements count */
-	&asn_SPC_ENBX2ExtTLA_ExtIEs_specs_473	/* Additional specs */
-};
-
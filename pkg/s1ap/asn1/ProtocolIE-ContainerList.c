@@ -1,440 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-Containers"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "ProtocolIE-ContainerList.h"
-
-#include "ProtocolIE-SingleContainer.h"
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P0_constr_1 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P0_constr_1 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P1_constr_3 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P1_constr_3 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P2_constr_5 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P2_constr_5 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P3_constr_7 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P3_constr_7 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P4_constr_9 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P4_constr_9 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P5_constr_11 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P5_constr_11 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P6_constr_13 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P6_constr_13 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P7_constr_15 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P7_constr_15 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P8_constr_17 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P8_constr_17 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-static asn_oer_constraints_t asn_OER_type_ProtocolIE_ContainerList_6588P9_constr_19 CC_NOTUSED = {
-	{ 0, 0 },
-	-1	/* (SIZE(1..256)) */};
-asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P9_constr_19 CC_NOTUSED = {
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	{ APC_CONSTRAINED,	 8,  8,  1,  256 }	/* (SIZE(1..256)) */,
-	0, 0	/* No PER value map */
-};
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P0_1[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P18,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P0_specs_1 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P0),
-	offsetof(struct ProtocolIE_ContainerList_6588P0, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P0 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P0_tags_1[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P0_constr_1, &asn_PER_type_ProtocolIE_ContainerList_6588P0_constr_1, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P0_1,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P0_specs_1	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P1_3[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P19,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P1_specs_3 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P1),
-	offsetof(struct ProtocolIE_ContainerList_6588P1, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P1 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P1_tags_3[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P1_constr_3, &asn_PER_type_ProtocolIE_ContainerList_6588P1_constr_3, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P1_3,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P1_specs_3	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P2_5[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P20,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P2_specs_5 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P2),
-	offsetof(struct ProtocolIE_ContainerList_6588P2, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P2 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P2_tags_5[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P2_constr_5, &asn_PER_type_ProtocolIE_ContainerList_6588P2_constr_5, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P2_5,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P2_specs_5	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P3_7[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P21,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P3_specs_7 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P3),
-	offsetof(struct ProtocolIE_ContainerList_6588P3, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P3 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P3_tags_7[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P3_constr_7, &asn_PER_type_ProtocolIE_ContainerList_6588P3_constr_7, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P3_7,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P3_specs_7	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P4_9[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P22,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P4_specs_9 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P4),
-	offsetof(struct ProtocolIE_ContainerList_6588P4, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P4 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P4_tags_9[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P4_constr_9, &asn_PER_type_ProtocolIE_ContainerList_6588P4_constr_9, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P4_9,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P4_specs_9	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P5_11[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P23,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P5_specs_11 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P5),
-	offsetof(struct ProtocolIE_ContainerList_6588P5, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P5 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P5_tags_11[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P5_constr_11, &asn_PER_type_ProtocolIE_ContainerList_6588P5_constr_11, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P5_11,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P5_specs_11	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P6_13[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P24,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P6_specs_13 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P6),
-	offsetof(struct ProtocolIE_ContainerList_6588P6, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P6 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P6_tags_13[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P6_constr_13, &asn_PER_type_ProtocolIE_ContainerList_6588P6_constr_13, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P6_13,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P6_specs_13	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P7_15[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P25,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P7_specs_15 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P7),
-	offsetof(struct ProtocolIE_ContainerList_6588P7, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P7 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P7_tags_15[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P7_constr_15, &asn_PER_type_ProtocolIE_ContainerList_6588P7_constr_15, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P7_15,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P7_specs_15	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P8_17[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P26,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P8_specs_17 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P8),
-	offsetof(struct ProtocolIE_ContainerList_6588P8, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P8 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P8_tags_17[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P8_constr_17, &asn_PER_type_ProtocolIE_ContainerList_6588P8_constr_17, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P8_17,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P8_specs_17	/* Additional specs */
-};
-
-asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P9_19[] = {
-	{ ATF_POINTER, 0, 0,
-		(ASN_TAG_CLASS_UNIVERSAL | (16 << 2)),
-		0,
-		&asn_DEF_ProtocolIE_SingleContainer_6554P27,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		""
-		},
-};
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P9_specs_19 = {
-	sizeof(struct ProtocolIE_ContainerList_6588P9),
-	offsetof(struct ProtocolIE_ContainerList_6588P9, _asn_ctx),
-	0,	/* XER encoding is XMLDelimitedItemList */
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P9 = {
-	"ProtocolIE-ContainerList",
-	"ProtocolIE-ContainerList",
-	&asn_OP_SEQUENCE_OF,
-	asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19,
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19[0]), /* 1 */
-	asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19)
-		/sizeof(asn_DEF_ProtocolIE_ContainerList_6588P9_tags_19[0]), /* 1 */
-	{ &asn_OER_type_ProtocolIE_ContainerList_6588P9_constr_19, &asn_PER_type_ProtocolIE_ContainerList_6588P9_constr_19, SEQUENCE_OF_constraint },
-	asn_MBR_ProtocolIE_ContainerList_6588P9_19,
-	1,	/* Single element */
-	&asn_SPC_ProtocolIE_ContainerList_6588P9_specs_19	/* Additional specs */
-};
-
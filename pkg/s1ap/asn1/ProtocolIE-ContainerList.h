@@ -1,134 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-Containers"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_ProtocolIE_ContainerList_H_
-#define	_ProtocolIE_ContainerList_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <asn_SEQUENCE_OF.h>
-#include <constr_SEQUENCE_OF.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Forward declarations */
-struct ProtocolIE_SingleContainer;
-
-/* ProtocolIE-ContainerList */
-typedef struct ProtocolIE_ContainerList_6588P0 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P0_t;
-typedef struct ProtocolIE_ContainerList_6588P1 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P1_t;
-typedef struct ProtocolIE_ContainerList_6588P2 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P2_t;
-typedef struct ProtocolIE_ContainerList_6588P3 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P3_t;
-typedef struct ProtocolIE_ContainerList_6588P4 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P4_t;
-typedef struct ProtocolIE_ContainerList_6588P5 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P5_t;
-typedef struct ProtocolIE_ContainerList_6588P6 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P6_t;
-typedef struct ProtocolIE_ContainerList_6588P7 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P7_t;
-typedef struct ProtocolIE_ContainerList_6588P8 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P8_t;
-typedef struct ProtocolIE_ContainerList_6588P9 {
-	A_SEQUENCE_OF(struct ProtocolIE_SingleContainer) list;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} ProtocolIE_ContainerList_6588P9_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P0;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P0_specs_1;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P0_1[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P0_constr_1;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P1;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P1_specs_3;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P1_3[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P1_constr_3;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P2;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P2_specs_5;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P2_5[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P2_constr_5;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P3;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P3_specs_7;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P3_7[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P3_constr_7;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P4;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P4_specs_9;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P4_9[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P4_constr_9;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P5;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P5_specs_11;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P5_11[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P5_constr_11;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P6;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P6_specs_13;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P6_13[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P6_constr_13;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P7;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P7_specs_15;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P7_15[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P7_constr_15;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P8;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P8_specs_17;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P8_17[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P8_constr_17;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_ContainerList_6588P9;
-extern asn_SET_OF_specifics_t asn_SPC_ProtocolIE_ContainerList_6588P9_specs_19;
-extern asn_TYPE_member_t asn_MBR_ProtocolIE_ContainerList_6588P9_19[1];
-extern asn_per_constraints_t asn_PER_type_ProtocolIE_ContainerList_6588P9_constr_19;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _ProtocolIE_ContainerList_H_ */
-#include <asn_internal.h>
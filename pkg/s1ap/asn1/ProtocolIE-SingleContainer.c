@@ -1,653 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-Containers"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "ProtocolIE-SingleContainer.h"
-
-/*
- * This type is implemented using E_RABToBeSetupItemBearerSUReqIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABSetupItemBearerSUResIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABToBeModifiedItemBearerModReqIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABModifyItemBearerModResIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABReleaseItemBearerRelCompIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABToBeSetupItemCtxtSUReqIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABSetupItemCtxtSUResIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using TAIItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using UE_associatedLogicalS1_ConnectionItemRes,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using UE_associatedLogicalS1_ConnectionItemResAck,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABModifyItemBearerModConfIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using Bearers_SubjectToStatusTransfer_ItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABInformationListIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using MDTMode_ExtensionIE,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using RecommendedCellItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using RecommendedENBItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using SONInformation_ExtensionIE,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABDataForwardingItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABToBeSetupItemHOReqIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABAdmittedItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABFailedtoSetupItemHOReqAckIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABToBeSwitchedDLItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABToBeSwitchedULItemIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABToBeModifiedItemBearerModIndIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABNotToBeModifiedItemBearerModIndIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABFailedToResumeItemResumeReqIEs,
- * so here we adjust the DEF accordingly.
- */
-/*
- * This type is implemented using E_RABFailedToResumeItemResumeResIEs,
- * so here we adjust the DEF accordingly.
- */
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P0 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P0_tags_1[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSetupItemBearerSUReqIEs_1,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSetupItemBearerSUReqIEs_specs_1	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P1 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P1_tags_2[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABSetupItemBearerSUResIEs_5,
-	3,	/* Elements count */
-	&asn_SPC_E_RABSetupItemBearerSUResIEs_specs_5	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P2 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P2_tags_3[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeModifiedItemBearerModReqIEs_9,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeModifiedItemBearerModReqIEs_specs_9	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P3 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P3_tags_4[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABModifyItemBearerModResIEs_13,
-	3,	/* Elements count */
-	&asn_SPC_E_RABModifyItemBearerModResIEs_specs_13	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P4 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P4_tags_5[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABReleaseItemBearerRelCompIEs_17,
-	3,	/* Elements count */
-	&asn_SPC_E_RABReleaseItemBearerRelCompIEs_specs_17	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P5 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P5_tags_6[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSetupItemCtxtSUReqIEs_21,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSetupItemCtxtSUReqIEs_specs_21	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P6 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P6_tags_7[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABSetupItemCtxtSUResIEs_25,
-	3,	/* Elements count */
-	&asn_SPC_E_RABSetupItemCtxtSUResIEs_specs_25	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P7 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P7_tags_8[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_TAIItemIEs_29,
-	3,	/* Elements count */
-	&asn_SPC_TAIItemIEs_specs_29	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P8 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P8_tags_9[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UE_associatedLogicalS1_ConnectionItemRes_33,
-	3,	/* Elements count */
-	&asn_SPC_UE_associatedLogicalS1_ConnectionItemRes_specs_33	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P9 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P9_tags_10[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_UE_associatedLogicalS1_ConnectionItemResAck_37,
-	3,	/* Elements count */
-	&asn_SPC_UE_associatedLogicalS1_ConnectionItemResAck_specs_37	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P10 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P10_tags_11[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABModifyItemBearerModConfIEs_41,
-	3,	/* Elements count */
-	&asn_SPC_E_RABModifyItemBearerModConfIEs_specs_41	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P11 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P11_tags_12[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_Bearers_SubjectToStatusTransfer_ItemIEs_45,
-	3,	/* Elements count */
-	&asn_SPC_Bearers_SubjectToStatusTransfer_ItemIEs_specs_45	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P12 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P12_tags_13[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABInformationListIEs_49,
-	3,	/* Elements count */
-	&asn_SPC_E_RABInformationListIEs_specs_49	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P13 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P13_tags_14[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABItemIEs_53,
-	3,	/* Elements count */
-	&asn_SPC_E_RABItemIEs_specs_53	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P14 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P14_tags_15[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_MDTMode_ExtensionIE_57,
-	3,	/* Elements count */
-	&asn_SPC_MDTMode_ExtensionIE_specs_57	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P15 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P15_tags_16[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RecommendedCellItemIEs_61,
-	3,	/* Elements count */
-	&asn_SPC_RecommendedCellItemIEs_specs_61	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P16 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P16_tags_17[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_RecommendedENBItemIEs_65,
-	3,	/* Elements count */
-	&asn_SPC_RecommendedENBItemIEs_specs_65	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P17 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P17_tags_18[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SONInformation_ExtensionIE_69,
-	3,	/* Elements count */
-	&asn_SPC_SONInformation_ExtensionIE_specs_69	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P18 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P18_tags_19[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABDataForwardingItemIEs_437,
-	3,	/* Elements count */
-	&asn_SPC_E_RABDataForwardingItemIEs_specs_437	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P19 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P19_tags_20[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSetupItemHOReqIEs_441,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSetupItemHOReqIEs_specs_441	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P20 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P20_tags_21[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABAdmittedItemIEs_445,
-	3,	/* Elements count */
-	&asn_SPC_E_RABAdmittedItemIEs_specs_445	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P21 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P21_tags_22[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABFailedtoSetupItemHOReqAckIEs_449,
-	3,	/* Elements count */
-	&asn_SPC_E_RABFailedtoSetupItemHOReqAckIEs_specs_449	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P22 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P22_tags_23[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSwitchedDLItemIEs_453,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSwitchedDLItemIEs_specs_453	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P23 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P23_tags_24[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeSwitchedULItemIEs_457,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeSwitchedULItemIEs_specs_457	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P24 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P24_tags_25[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABToBeModifiedItemBearerModIndIEs_461,
-	3,	/* Elements count */
-	&asn_SPC_E_RABToBeModifiedItemBearerModIndIEs_specs_461	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P25 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P25_tags_26[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABNotToBeModifiedItemBearerModIndIEs_465,
-	3,	/* Elements count */
-	&asn_SPC_E_RABNotToBeModifiedItemBearerModIndIEs_specs_465	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P26 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P26_tags_27[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABFailedToResumeItemResumeReqIEs_469,
-	3,	/* Elements count */
-	&asn_SPC_E_RABFailedToResumeItemResumeReqIEs_specs_469	/* Additional specs */
-};
-
-static const ber_tlv_tag_t asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P27 = {
-	"ProtocolIE-SingleContainer",
-	"ProtocolIE-SingleContainer",
-	&asn_OP_SEQUENCE,
-	asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28,
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28[0]), /* 1 */
-	asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28,	/* Same as above */
-	sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28)
-		/sizeof(asn_DEF_ProtocolIE_SingleContainer_6554P27_tags_28[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_E_RABFailedToResumeItemResumeResIEs_473,
-	3,	/* Elements count */
-	&asn_SPC_E_RABFailedToResumeItemResumeResIEs_specs_473	/* Additional specs */
-};
-
@@ -1,450 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-Containers"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_ProtocolIE_SingleContainer_H_
-#define	_ProtocolIE_SingleContainer_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "ProtocolIE-Field.h"
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* ProtocolIE-SingleContainer */
-typedef E_RABToBeSetupItemBearerSUReqIEs_t	 ProtocolIE_SingleContainer_6554P0_t;
-typedef E_RABSetupItemBearerSUResIEs_t	 ProtocolIE_SingleContainer_6554P1_t;
-typedef E_RABToBeModifiedItemBearerModReqIEs_t	 ProtocolIE_SingleContainer_6554P2_t;
-typedef E_RABModifyItemBearerModResIEs_t	 ProtocolIE_SingleContainer_6554P3_t;
-typedef E_RABReleaseItemBearerRelCompIEs_t	 ProtocolIE_SingleContainer_6554P4_t;
-typedef E_RABToBeSetupItemCtxtSUReqIEs_t	 ProtocolIE_SingleContainer_6554P5_t;
-typedef E_RABSetupItemCtxtSUResIEs_t	 ProtocolIE_SingleContainer_6554P6_t;
-typedef TAIItemIEs_t	 ProtocolIE_SingleContainer_6554P7_t;
-typedef UE_associatedLogicalS1_ConnectionItemRes_t	 ProtocolIE_SingleContainer_6554P8_t;
-typedef UE_associatedLogicalS1_ConnectionItemResAck_t	 ProtocolIE_SingleContainer_6554P9_t;
-typedef E_RABModifyItemBearerModConfIEs_t	 ProtocolIE_SingleContainer_6554P10_t;
-typedef Bearers_SubjectToStatusTransfer_ItemIEs_t	 ProtocolIE_SingleContainer_6554P11_t;
-typedef E_RABInformationListIEs_t	 ProtocolIE_SingleContainer_6554P12_t;
-typedef E_RABItemIEs_t	 ProtocolIE_SingleContainer_6554P13_t;
-typedef MDTMode_ExtensionIE_t	 ProtocolIE_SingleContainer_6554P14_t;
-typedef RecommendedCellItemIEs_t	 ProtocolIE_SingleContainer_6554P15_t;
-typedef RecommendedENBItemIEs_t	 ProtocolIE_SingleContainer_6554P16_t;
-typedef SONInformation_ExtensionIE_t	 ProtocolIE_SingleContainer_6554P17_t;
-typedef E_RABDataForwardingItemIEs_t	 ProtocolIE_SingleContainer_6554P18_t;
-typedef E_RABToBeSetupItemHOReqIEs_t	 ProtocolIE_SingleContainer_6554P19_t;
-typedef E_RABAdmittedItemIEs_t	 ProtocolIE_SingleContainer_6554P20_t;
-typedef E_RABFailedtoSetupItemHOReqAckIEs_t	 ProtocolIE_SingleContainer_6554P21_t;
-typedef E_RABToBeSwitchedDLItemIEs_t	 ProtocolIE_SingleContainer_6554P22_t;
-typedef E_RABToBeSwitchedULItemIEs_t	 ProtocolIE_SingleContainer_6554P23_t;
-typedef E_RABToBeModifiedItemBearerModIndIEs_t	 ProtocolIE_SingleContainer_6554P24_t;
-typedef E_RABNotToBeModifiedItemBearerModIndIEs_t	 ProtocolIE_SingleContainer_6554P25_t;
-typedef E_RABFailedToResumeItemResumeReqIEs_t	 ProtocolIE_SingleContainer_6554P26_t;
-typedef E_RABFailedToResumeItemResumeResIEs_t	 ProtocolIE_SingleContainer_6554P27_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P0;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P0_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P0_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P0_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P0_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P0_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P0_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P0_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P0_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P0_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P0_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P0_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P0_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P0_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P1;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P1_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P1_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P1_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P1_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P1_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P1_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P1_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P1_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P1_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P1_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P1_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P1_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P1_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P2;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P2_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P2_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P2_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P2_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P2_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P2_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P2_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P2_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P2_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P2_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P2_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P2_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P2_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P3;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P3_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P3_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P3_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P3_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P3_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P3_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P3_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P3_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P3_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P3_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P3_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P3_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P3_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P4;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P4_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P4_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P4_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P4_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P4_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P4_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P4_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P4_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P4_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P4_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P4_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P4_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P4_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P5;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P5_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P5_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P5_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P5_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P5_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P5_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P5_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P5_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P5_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P5_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P5_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P5_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P5_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P6;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P6_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P6_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P6_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P6_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P6_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P6_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P6_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P6_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P6_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P6_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P6_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P6_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P6_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P7;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P7_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P7_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P7_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P7_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P7_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P7_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P7_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P7_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P7_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P7_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P7_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P7_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P7_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P8;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P8_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P8_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P8_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P8_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P8_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P8_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P8_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P8_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P8_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P8_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P8_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P8_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P8_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P9;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P9_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P9_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P9_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P9_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P9_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P9_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P9_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P9_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P9_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P9_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P9_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P9_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P9_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P10;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P10_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P10_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P10_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P10_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P10_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P10_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P10_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P10_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P10_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P10_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P10_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P10_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P10_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P11;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P11_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P11_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P11_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P11_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P11_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P11_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P11_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P11_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P11_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P11_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P11_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P11_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P11_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P12;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P12_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P12_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P12_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P12_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P12_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P12_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P12_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P12_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P12_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P12_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P12_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P12_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P12_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P13;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P13_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P13_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P13_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P13_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P13_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P13_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P13_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P13_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P13_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P13_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P13_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P13_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P13_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P14;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P14_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P14_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P14_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P14_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P14_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P14_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P14_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P14_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P14_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P14_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P14_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P14_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P14_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P15;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P15_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P15_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P15_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P15_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P15_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P15_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P15_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P15_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P15_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P15_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P15_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P15_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P15_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P16;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P16_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P16_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P16_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P16_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P16_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P16_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P16_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P16_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P16_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P16_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P16_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P16_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P16_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P17;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P17_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P17_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P17_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P17_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P17_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P17_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P17_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P17_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P17_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P17_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P17_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P17_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P17_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P18;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P18_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P18_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P18_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P18_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P18_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P18_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P18_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P18_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P18_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P18_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P18_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P18_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P18_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P19;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P19_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P19_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P19_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P19_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P19_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P19_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P19_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P19_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P19_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P19_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P19_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P19_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P19_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P20;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P20_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P20_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P20_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P20_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P20_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P20_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P20_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P20_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P20_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P20_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P20_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P20_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P20_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P21;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P21_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P21_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P21_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P21_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P21_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P21_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P21_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P21_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P21_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P21_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P21_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P21_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P21_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P22;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P22_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P22_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P22_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P22_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P22_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P22_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P22_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P22_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P22_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P22_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P22_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P22_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P22_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P23;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P23_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P23_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P23_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P23_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P23_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P23_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P23_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P23_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P23_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P23_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P23_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P23_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P23_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P24;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P24_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P24_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P24_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P24_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P24_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P24_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P24_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P24_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P24_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P24_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P24_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P24_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P24_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P25;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P25_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P25_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P25_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P25_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P25_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P25_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P25_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P25_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P25_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P25_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P25_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P25_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P25_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P26;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P26_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P26_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P26_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P26_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P26_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P26_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P26_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P26_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P26_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P26_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P26_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P26_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P26_encode_aper;
-extern asn_TYPE_descriptor_t asn_DEF_ProtocolIE_SingleContainer_6554P27;
-asn_struct_free_f ProtocolIE_SingleContainer_6554P27_free;
-asn_struct_print_f ProtocolIE_SingleContainer_6554P27_print;
-asn_constr_check_f ProtocolIE_SingleContainer_6554P27_constraint;
-ber_type_decoder_f ProtocolIE_SingleContainer_6554P27_decode_ber;
-der_type_encoder_f ProtocolIE_SingleContainer_6554P27_encode_der;
-xer_type_decoder_f ProtocolIE_SingleContainer_6554P27_decode_xer;
-xer_type_encoder_f ProtocolIE_SingleContainer_6554P27_encode_xer;
-oer_type_decoder_f ProtocolIE_SingleContainer_6554P27_decode_oer;
-oer_type_encoder_f ProtocolIE_SingleContainer_6554P27_encode_oer;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P27_decode_uper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P27_encode_uper;
-per_type_decoder_f ProtocolIE_SingleContainer_6554P27_decode_aper;
-per_type_encoder_f ProtocolIE_SingleContainer_6554P27_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _ProtocolIE_SingleContainer_H_ */
-#include <asn_internal.h>
@@ -1,45 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_RepetitionPeriod_H_
-#define	_RepetitionPeriod_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeInteger.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* RepetitionPeriod */
-typedef long	 RepetitionPeriod_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_RepetitionPeriod;
-asn_struct_free_f RepetitionPeriod_free;
-asn_struct_print_f RepetitionPeriod_print;
-asn_constr_check_f RepetitionPeriod_constraint;
-ber_type_decoder_f RepetitionPeriod_decode_ber;
-der_type_encoder_f RepetitionPeriod_encode_der;
-xer_type_decoder_f RepetitionPeriod_decode_xer;
-xer_type_encoder_f RepetitionPeriod_encode_xer;
-oer_type_decoder_f RepetitionPeriod_decode_oer;
-oer_type_encoder_f RepetitionPeriod_encode_oer;
-per_type_decoder_f RepetitionPeriod_decode_uper;
-per_type_encoder_f RepetitionPeriod_encode_uper;
-per_type_decoder_f RepetitionPeriod_decode_aper;
-per_type_encoder_f RepetitionPeriod_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _RepetitionPeriod_H_ */
-#include <asn_internal.h>
@@ -1,66 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-PDU-Contents"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "ResetType.h"
-
-#include "UE-associatedLogicalS1-ConnectionListRes.h"
-static asn_oer_constraints_t asn_OER_type_ResetType_constr_1 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_type_ResetType_constr_1 CC_NOTUSED = {
-	{ APC_CONSTRAINED | APC_EXTENSIBLE,  1,  1,  0,  1 }	/* (0..1,...) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_TYPE_member_t asn_MBR_ResetType_1[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct ResetType, choice.s1_Interface),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ResetAll,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"s1-Interface"
-		},
-	{ ATF_POINTER, 0, offsetof(struct ResetType, choice.partOfS1_Interface),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_UE_associatedLogicalS1_ConnectionListRes,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"partOfS1-Interface"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_ResetType_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* s1-Interface */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 } /* partOfS1-Interface */
-};
-static asn_CHOICE_specifics_t asn_SPC_ResetType_specs_1 = {
-	sizeof(struct ResetType),
-	offsetof(struct ResetType, _asn_ctx),
-	offsetof(struct ResetType, present),
-	sizeof(((struct ResetType *)0)->present),
-	asn_MAP_ResetType_tag2el_1,
-	2,	/* Count of tags in the map */
-	0, 0,
-	2	/* Extensions start */
-};
-asn_TYPE_descriptor_t asn_DEF_ResetType = {
-	"ResetType",
-	"ResetType",
-	&asn_OP_CHOICE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ &asn_OER_type_ResetType_constr_1, &asn_PER_type_ResetType_constr_1, CHOICE_constraint },
-	asn_MBR_ResetType_1,
-	2,	/* Elements count */
-	&asn_SPC_ResetType_specs_1	/* Additional specs */
-};
-
@@ -1,63 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_SONInformation_H_
-#define	_SONInformation_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "SONInformationRequest.h"
-#include <constr_CHOICE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum SONInformation_PR {
-	SONInformation_PR_NOTHING,	/* No components present */
-	SONInformation_PR_sONInformationRequest,
-	SONInformation_PR_sONInformationReply,
-	/* Extensions may appear below */
-	SONInformation_PR_sONInformation_Extension
-} SONInformation_PR;
-
-/* Forward declarations */
-struct SONInformationReply;
-struct SONInformation_Extension;
-
-/* SONInformation */
-typedef struct SONInformation {
-	SONInformation_PR present;
-	union SONInformation_u {
-		SONInformationRequest_t	 sONInformationRequest;
-		struct SONInformationReply	*sONInformationReply;
-		/*
-		 * This type is extensible,
-		 * possible extensions are below.
-		 */
-		struct SONInformation_Extension	*sONInformation_Extension;
-	} choice;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} SONInformation_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_SONInformation;
-extern asn_CHOICE_specifics_t asn_SPC_SONInformation_specs_1;
-extern asn_TYPE_member_t asn_MBR_SONInformation_1[3];
-extern asn_per_constraints_t asn_PER_type_SONInformation_constr_1;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _SONInformation_H_ */
-#include <asn_internal.h>
@@ -1,64 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "SONInformationReply.h"
-
-#include "X2TNLConfigurationInfo.h"
-#include "ProtocolExtensionContainer.h"
-asn_TYPE_member_t asn_MBR_SONInformationReply_1[] = {
-	{ ATF_POINTER, 2, offsetof(struct SONInformationReply, x2TNLConfigurationInfo),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_X2TNLConfigurationInfo,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"x2TNLConfigurationInfo"
-		},
-	{ ATF_POINTER, 1, offsetof(struct SONInformationReply, iE_Extensions),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionContainer_6602P88,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"iE-Extensions"
-		},
-};
-static const int asn_MAP_SONInformationReply_oms_1[] = { 0, 1 };
-static const ber_tlv_tag_t asn_DEF_SONInformationReply_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SONInformationReply_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* x2TNLConfigurationInfo */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 } /* iE-Extensions */
-};
-asn_SEQUENCE_specifics_t asn_SPC_SONInformationReply_specs_1 = {
-	sizeof(struct SONInformationReply),
-	offsetof(struct SONInformationReply, _asn_ctx),
-	asn_MAP_SONInformationReply_tag2el_1,
-	2,	/* Count of tags in the map */
-	asn_MAP_SONInformationReply_oms_1,	/* Optional members */
-	2, 0,	/* Root/Additions */
-	2,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SONInformationReply = {
-	"SONInformationReply",
-	"SONInformationReply",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SONInformationReply_tags_1,
-	sizeof(asn_DEF_SONInformationReply_tags_1)
-		/sizeof(asn_DEF_SONInformationReply_tags_1[0]), /* 1 */
-	asn_DEF_SONInformationReply_tags_1,	/* Same as above */
-	sizeof(asn_DEF_SONInformationReply_tags_1)
-		/sizeof(asn_DEF_SONInformationReply_tags_1[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SONInformationReply_1,
-	2,	/* Elements count */
-	&asn_SPC_SONInformationReply_specs_1	/* Additional specs */
-};
-
@@ -1,56 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "SONInformationReport.h"
-
-#include "RLFReportInformation.h"
-static asn_oer_constraints_t asn_OER_type_SONInformationReport_constr_1 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_type_SONInformationReport_constr_1 CC_NOTUSED = {
-	{ APC_CONSTRAINED | APC_EXTENSIBLE,  0,  0,  0,  0 }	/* (0..0,...) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_TYPE_member_t asn_MBR_SONInformationReport_1[] = {
-	{ ATF_POINTER, 0, offsetof(struct SONInformationReport, choice.rLFReportInformation),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_RLFReportInformation,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"rLFReportInformation"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_SONInformationReport_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 } /* rLFReportInformation */
-};
-static asn_CHOICE_specifics_t asn_SPC_SONInformationReport_specs_1 = {
-	sizeof(struct SONInformationReport),
-	offsetof(struct SONInformationReport, _asn_ctx),
-	offsetof(struct SONInformationReport, present),
-	sizeof(((struct SONInformationReport *)0)->present),
-	asn_MAP_SONInformationReport_tag2el_1,
-	1,	/* Count of tags in the map */
-	0, 0,
-	1	/* Extensions start */
-};
-asn_TYPE_descriptor_t asn_DEF_SONInformationReport = {
-	"SONInformationReport",
-	"SONInformationReport",
-	&asn_OP_CHOICE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ &asn_OER_type_SONInformationReport_constr_1, &asn_PER_type_SONInformationReport_constr_1, CHOICE_constraint },
-	asn_MBR_SONInformationReport_1,
-	1,	/* Elements count */
-	&asn_SPC_SONInformationReport_specs_1	/* Additional specs */
-};
-
@@ -1,106 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "SecurityContext.h"
-
-#include "ProtocolExtensionContainer.h"
-static int
-memb_nextHopChainingCount_constraint_1(const asn_TYPE_descriptor_t *td, const void *sptr,
-			asn_app_constraint_failed_f *ctfailcb, void *app_key) {
-	long value;
-	
-	if(!sptr) {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: value not given (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-	
-	value = *(const long *)sptr;
-	
-	if((value >= 0 && value <= 7)) {
-		/* Constraint check succeeded */
-		return 0;
-	} else {
-		ASN__CTFAIL(app_key, td, sptr,
-			"%s: constraint failed (%s:%d)",
-			td->name, __FILE__, __LINE__);
-		return -1;
-	}
-}
-
-static asn_oer_constraints_t asn_OER_memb_nextHopChainingCount_constr_2 CC_NOTUSED = {
-	{ 1, 1 }	/* (0..7) */,
-	-1};
-static asn_per_constraints_t asn_PER_memb_nextHopChainingCount_constr_2 CC_NOTUSED = {
-	{ APC_CONSTRAINED,	 3,  3,  0,  7 }	/* (0..7) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_TYPE_member_t asn_MBR_SecurityContext_1[] = {
-	{ ATF_NOFLAGS, 0, offsetof(struct SecurityContext, nextHopChainingCount),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_NativeInteger,
-		0,
-		{ &asn_OER_memb_nextHopChainingCount_constr_2, &asn_PER_memb_nextHopChainingCount_constr_2,  memb_nextHopChainingCount_constraint_1 },
-		0, 0, /* No default value */
-		"nextHopChainingCount"
-		},
-	{ ATF_NOFLAGS, 0, offsetof(struct SecurityContext, nextHopParameter),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_SecurityKey,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"nextHopParameter"
-		},
-	{ ATF_POINTER, 1, offsetof(struct SecurityContext, iE_Extensions),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_ProtocolExtensionContainer_6602P87,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"iE-Extensions"
-		},
-};
-static const int asn_MAP_SecurityContext_oms_1[] = { 2 };
-static const ber_tlv_tag_t asn_DEF_SecurityContext_tags_1[] = {
-	(ASN_TAG_CLASS_UNIVERSAL | (16 << 2))
-};
-static const asn_TYPE_tag2member_t asn_MAP_SecurityContext_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* nextHopChainingCount */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* nextHopParameter */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* iE-Extensions */
-};
-static asn_SEQUENCE_specifics_t asn_SPC_SecurityContext_specs_1 = {
-	sizeof(struct SecurityContext),
-	offsetof(struct SecurityContext, _asn_ctx),
-	asn_MAP_SecurityContext_tag2el_1,
-	3,	/* Count of tags in the map */
-	asn_MAP_SecurityContext_oms_1,	/* Optional members */
-	1, 0,	/* Root/Additions */
-	3,	/* First extension addition */
-};
-asn_TYPE_descriptor_t asn_DEF_SecurityContext = {
-	"SecurityContext",
-	"SecurityContext",
-	&asn_OP_SEQUENCE,
-	asn_DEF_SecurityContext_tags_1,
-	sizeof(asn_DEF_SecurityContext_tags_1)
-		/sizeof(asn_DEF_SecurityContext_tags_1[0]), /* 1 */
-	asn_DEF_SecurityContext_tags_1,	/* Same as above */
-	sizeof(asn_DEF_SecurityContext_tags_1)
-		/sizeof(asn_DEF_SecurityContext_tags_1[0]), /* 1 */
-	{ 0, 0, SEQUENCE_constraint },
-	asn_MBR_SecurityContext_1,
-	3,	/* Elements count */
-	&asn_SPC_SecurityContext_specs_1	/* Additional specs */
-};
-
@@ -1,46 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_SubscriberProfileIDforRFP_H_
-#define	_SubscriberProfileIDforRFP_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <NativeInteger.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* SubscriberProfileIDforRFP */
-typedef long	 SubscriberProfileIDforRFP_t;
-
-/* Implementation */
-extern asn_per_constraints_t asn_PER_type_SubscriberProfileIDforRFP_constr_1;
-extern asn_TYPE_descriptor_t asn_DEF_SubscriberProfileIDforRFP;
-asn_struct_free_f SubscriberProfileIDforRFP_free;
-asn_struct_print_f SubscriberProfileIDforRFP_print;
-asn_constr_check_f SubscriberProfileIDforRFP_constraint;
-ber_type_decoder_f SubscriberProfileIDforRFP_decode_ber;
-der_type_encoder_f SubscriberProfileIDforRFP_encode_der;
-xer_type_decoder_f SubscriberProfileIDforRFP_decode_xer;
-xer_type_encoder_f SubscriberProfileIDforRFP_encode_xer;
-oer_type_decoder_f SubscriberProfileIDforRFP_decode_oer;
-oer_type_encoder_f SubscriberProfileIDforRFP_encode_oer;
-per_type_decoder_f SubscriberProfileIDforRFP_decode_uper;
-per_type_encoder_f SubscriberProfileIDforRFP_encode_uper;
-per_type_decoder_f SubscriberProfileIDforRFP_decode_aper;
-per_type_encoder_f SubscriberProfileIDforRFP_encode_aper;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _SubscriberProfileIDforRFP_H_ */
-#include <asn_internal.h>
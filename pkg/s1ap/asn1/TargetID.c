@@ -1,78 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#include "TargetID.h"
-
-#include "TargeteNB-ID.h"
-#include "TargetRNC-ID.h"
-#include "CGI.h"
-static asn_oer_constraints_t asn_OER_type_TargetID_constr_1 CC_NOTUSED = {
-	{ 0, 0 },
-	-1};
-static asn_per_constraints_t asn_PER_type_TargetID_constr_1 CC_NOTUSED = {
-	{ APC_CONSTRAINED | APC_EXTENSIBLE,  2,  2,  0,  2 }	/* (0..2,...) */,
-	{ APC_UNCONSTRAINED,	-1, -1,  0,  0 },
-	0, 0	/* No PER value map */
-};
-static asn_TYPE_member_t asn_MBR_TargetID_1[] = {
-	{ ATF_POINTER, 0, offsetof(struct TargetID, choice.targeteNB_ID),
-		(ASN_TAG_CLASS_CONTEXT | (0 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_TargeteNB_ID,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"targeteNB-ID"
-		},
-	{ ATF_POINTER, 0, offsetof(struct TargetID, choice.targetRNC_ID),
-		(ASN_TAG_CLASS_CONTEXT | (1 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_TargetRNC_ID,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"targetRNC-ID"
-		},
-	{ ATF_POINTER, 0, offsetof(struct TargetID, choice.cGI),
-		(ASN_TAG_CLASS_CONTEXT | (2 << 2)),
-		-1,	/* IMPLICIT tag at current level */
-		&asn_DEF_CGI,
-		0,
-		{ 0, 0, 0 },
-		0, 0, /* No default value */
-		"cGI"
-		},
-};
-static const asn_TYPE_tag2member_t asn_MAP_TargetID_tag2el_1[] = {
-    { (ASN_TAG_CLASS_CONTEXT | (0 << 2)), 0, 0, 0 }, /* targeteNB-ID */
-    { (ASN_TAG_CLASS_CONTEXT | (1 << 2)), 1, 0, 0 }, /* targetRNC-ID */
-    { (ASN_TAG_CLASS_CONTEXT | (2 << 2)), 2, 0, 0 } /* cGI */
-};
-static asn_CHOICE_specifics_t asn_SPC_TargetID_specs_1 = {
-	sizeof(struct TargetID),
-	offsetof(struct TargetID, _asn_ctx),
-	offsetof(struct TargetID, present),
-	sizeof(((struct TargetID *)0)->present),
-	asn_MAP_TargetID_tag2el_1,
-	3,	/* Count of tags in the map */
-	0, 0,
-	3	/* Extensions start */
-};
-asn_TYPE_descriptor_t asn_DEF_TargetID = {
-	"TargetID",
-	"TargetID",
-	&asn_OP_CHOICE,
-	0,	/* No effective tags (pointer) */
-	0,	/* No effective tags (count) */
-	0,	/* No tags (pointer) */
-	0,	/* No tags (count) */
-	{ &asn_OER_type_TargetID_constr_1, &asn_PER_type_TargetID_constr_1, CHOICE_constraint },
-	asn_MBR_TargetID_1,
-	3,	/* Elements count */
-	&asn_SPC_TargetID_specs_1	/* Additional specs */
-};
-
@@ -1,61 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_TargetID_H_
-#define	_TargetID_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include <constr_CHOICE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Dependencies */
-typedef enum TargetID_PR {
-	TargetID_PR_NOTHING,	/* No components present */
-	TargetID_PR_targeteNB_ID,
-	TargetID_PR_targetRNC_ID,
-	TargetID_PR_cGI
-	/* Extensions may appear below */
-	
-} TargetID_PR;
-
-/* Forward declarations */
-struct TargeteNB_ID;
-struct TargetRNC_ID;
-struct CGI;
-
-/* TargetID */
-typedef struct TargetID {
-	TargetID_PR present;
-	union TargetID_u {
-		struct TargeteNB_ID	*targeteNB_ID;
-		struct TargetRNC_ID	*targetRNC_ID;
-		struct CGI	*cGI;
-		/*
-		 * This type is extensible,
-		 * possible extensions are below.
-		 */
-	} choice;
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} TargetID_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_TargetID;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _TargetID_H_ */
-#include <asn_internal.h>
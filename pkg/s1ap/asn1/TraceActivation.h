@@ -1,52 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- * From ASN.1 module "S1AP-IEs"
- * 	found in "r14.4.0/36413-e40.asn"
- * 	`asn1c -pdu=all -fcompound-names -findirect-choice -fno-include-deps -no-gen-example`
- */
-
-#ifndef	_TraceActivation_H_
-#define	_TraceActivation_H_
-
-
-#include <asn_application.h>
-
-/* Including external dependencies */
-#include "E-UTRAN-Trace-ID.h"
-#include "InterfacesToTrace.h"
-#include "TraceDepth.h"
-#include "TransportLayerAddress.h"
-#include <constr_SEQUENCE.h>
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-/* Forward declarations */
-struct ProtocolExtensionContainer;
-
-/* TraceActivation */
-typedef struct TraceActivation {
-	E_UTRAN_Trace_ID_t	 e_UTRAN_Trace_ID;
-	InterfacesToTrace_t	 interfacesToTrace;
-	TraceDepth_t	 traceDepth;
-	TransportLayerAddress_t	 traceCollectionEntityIPAddress;
-	struct ProtocolExtensionContainer	*iE_Extensions;	/* OPTIONAL */
-	/*
-	 * This type is extensible,
-	 * possible extensions are below.
-	 */
-	
-	/* Context for parsing across buffer boundaries */
-	asn_struct_ctx_t _asn_ctx;
-} TraceActivation_t;
-
-/* Implementation */
-extern asn_TYPE_descriptor_t asn_DEF_TraceActivation;
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif	/* _TraceActivation_H_ */
-#include <asn_internal.h>
@@ -1,119 +0,0 @@
-/*
- * Generated by asn1c-0.9.29 (http://lionet.info/asn1c)
- */
-
-#ifndef _ASN_CONSTANT_H
-#define _ASN_CONSTANT_H
-
-#ifdef __cplusplus
-extern "C" {
-#endif
-
-#define min_val_BitRate (0)
-#define max_val_BitRate (10000000000)
-#define min_val_DCN_ID (0)
-#define max_val_DCN_ID (65535)
-#define min_val_ENB_UE_S1AP_ID (0)
-#define max_val_ENB_UE_S1AP_ID (16777215)
-#define min_val_EUTRANRoundTripDelayEstimationInfo (0)
-#define max_val_EUTRANRoundTripDelayEstimationInfo (2047)
-#define min_val_ExtendedRNC_ID (4096)
-#define max_val_ExtendedRNC_ID (65535)
-#define min_val_ExtendedRepetitionPeriod (4096)
-#define max_val_ExtendedRepetitionPeriod (131071)
-#define min_val_HFN (0)
-#define max_val_HFN (1048575)
-#define min_val_HFNModified (0)
-#define max_val_HFNModified (131071)
-#define min_val_HFNforPDCP_SNlength18 (0)
-#define max_val_HFNforPDCP_SNlength18 (16383)
-#define min_val_MME_UE_S1AP_ID (0)
-#define max_val_MME_UE_S1AP_ID (4294967295)
-#define min_val_NumberofBroadcastRequest (0)
-#define max_val_NumberofBroadcastRequest (65535)
-#define min_val_NumberOfBroadcasts (0)
-#define max_val_NumberOfBroadcasts (65535)
-#define min_val_PDCP_SN (0)
-#define max_val_PDCP_SN (4095)
-#define min_val_PDCP_SNExtended (0)
-#define max_val_PDCP_SNExtended (32767)
-#define min_val_PDCP_SNlength18 (0)
-#define max_val_PDCP_SNlength18 (262143)
-#define min_val_PriorityLevel (0)
-#define max_val_PriorityLevel (15)
-#define min_val_QCI (0)
-#define max_val_QCI (255)
-#define min_val_RelativeMMECapacity (0)
-#define max_val_RelativeMMECapacity (255)
-#define min_val_RepetitionPeriod (0)
-#define max_val_RepetitionPeriod (4095)
-#define min_val_RNC_ID (0)
-#define max_val_RNC_ID (4095)
-#define min_val_Routing_ID (0)
-#define max_val_Routing_ID (255)
-#define min_val_SubscriberProfileIDforRFP (1)
-#define max_val_SubscriberProfileIDforRFP (256)
-#define min_val_Threshold_RSRP (0)
-#define max_val_Threshold_RSRP (97)
-#define min_val_Threshold_RSRQ (0)
-#define max_val_Threshold_RSRQ (34)
-#define min_val_Time_UE_StayedInCell (0)
-#define max_val_Time_UE_StayedInCell (4095)
-#define min_val_Time_UE_StayedInCell_EnhancedGranularity (0)
-#define max_val_Time_UE_StayedInCell_EnhancedGranularity (40950)
-#define min_val_TrafficLoadReductionIndication (1)
-#define max_val_TrafficLoadReductionIndication (99)
-#define min_val_UE_Usage_Type (0)
-#define max_val_UE_Usage_Type (255)
-#define min_val_ProcedureCode (0)
-#define max_val_ProcedureCode (255)
-#define min_val_ProtocolExtensionID (0)
-#define max_val_ProtocolExtensionID (65535)
-#define min_val_ProtocolIE_ID (0)
-#define max_val_ProtocolIE_ID (65535)
-#define maxPrivateIEs (65535)
-#define maxProtocolExtensions (65535)
-#define maxProtocolIEs (65535)
-#define maxnoofCSGs (256)
-#define maxnoofE_RABs (256)
-#define maxnoofTAIs (256)
-#define maxnoofTACs (256)
-#define maxnoofErrors (256)
-#define maxnoofBPLMNs (6)
-#define maxnoofPLMNsPerMME (32)
-#define maxnoofEPLMNs (15)
-#define maxnoofEPLMNsPlusOne (16)
-#define maxnoofForbLACs (4096)
-#define maxnoofForbTACs (4096)
-#define maxnoofIndividualS1ConnectionsToReset (256)
-#define maxnoofCells (16)
-#define maxnoofCellsineNB (256)
-#define maxnoofTAIforWarning (65535)
-#define maxnoofCellID (65535)
-#define maxnoofDCNs (32)
-#define maxnoofEmergencyAreaID (65535)
-#define maxnoofCellinTAI (65535)
-#define maxnoofCellinEAI (65535)
-#define maxnoofeNBX2TLAs (2)
-#define maxnoofeNBX2ExtTLAs (16)
-#define maxnoofeNBX2GTPTLAs (16)
-#define maxnoofRATs (8)
-#define maxnoofGroupIDs (65535)
-#define maxnoofMMECs (256)
-#define maxnoofCellIDforMDT (32)
-#define maxnoofTAforMDT (8)
-#define maxnoofMDTPLMNs (16)
-#define maxnoofCellsforRestart (256)
-#define maxnoofRestartTAIs (2048)
-#define maxnoofRestartEmergencyAreaIDs (256)
-#define maxEARFCN (262143)
-#define maxnoofMBSFNAreaMDT (8)
-#define maxnoofRecommendedCells (16)
-#define maxnoofRecommendedENBs (16)
-
-
-#ifdef __cplusplus
-}
-#endif
-
-#endif /* _ASN_CONSTANT_H */
@@ -1,8 +0,0 @@
-void
-S1SetupResponseBuild(S1AP_PDU_t *pdu, int num_served_gummei);
-void
-DownlinkNASTransportBuild(S1AP_PDU_t *pdu, long enb_ie_s1ap_id, unsigned char *mmebuf, int mmebuf_len);
-void
-UplinkNASTransportBuild(S1AP_PDU_t *pdu);
-void
-InitialContextSetupRequestBuild(S1AP_PDU_t *pdu, long enb_ie_s1ap_id);
@@ -1,13 +1,206 @@
 package s1ap
 
+// Criticality of procedure and IE.
+type Criticality uint8
+
+const (
+	CRITICALITY_REJECT Criticality = iota
+	CRITICALITY_IGNORE
+	CRITICALITY_NOTIFY
+)
+
+// PDUType is the choice of S1AP-PDU.
+type PDUType uint8
+
+const (
+	PDU_INITIATING_MESSAGE PDUType = iota
+	PDU_SUCCESSFUL_OUTCOME
+	PDU_UNSUCCESSFUL_OUTCOME
+)
+
+// ProcedureCode of S1AP elementary procedure.
+type ProcedureCode uint8
+
+const (
+	PROC_HANDOVER_PREPARATION                      ProcedureCode = 0
+	PROC_HANDOVER_RESOURCE_ALLOCATION              ProcedureCode = 1
+	PROC_HANDOVER_NOTIFICATION                     ProcedureCode = 2
+	PROC_PATH_SWITCH_REQUEST                       ProcedureCode = 3
+	PROC_HANDOVER_CANCEL                           ProcedureCode = 4
+	PROC_E_RAB_SETUP                               ProcedureCode = 5
+	PROC_E_RAB_MODIFY                              ProcedureCode = 6
+	PROC_E_RAB_RELEASE                             ProcedureCode = 7
+	PROC_E_RAB_RELEASE_INDICATION                  ProcedureCode = 8
+	PROC_INITIAL_CONTEXT_SETUP                     ProcedureCode = 9
+	PROC_PAGING                                    ProcedureCode = 10
+	PROC_DOWNLINK_NAS_TRANSPORT                    ProcedureCode = 11
+	PROC_INITIAL_UE_MESSAGE                        ProcedureCode = 12
+	PROC_UPLINK_NAS_TRANSPORT                      ProcedureCode = 13
+	PROC_RESET                                     ProcedureCode = 14
+	PROC_ERROR_INDICATION                          ProcedureCode = 15
+	PROC_NAS_NON_DELIVERY_INDICATION               ProcedureCode = 16
+	PROC_S1_SETUP                                  ProcedureCode = 17
+	PROC_UE_CONTEXT_RELEASE_REQUEST                ProcedureCode = 18
+	PROC_DOWNLINK_S1_CDMA2000_TUNNELLING           ProcedureCode = 19
+	PROC_UPLINK_S1_CDMA2000_TUNNELLING             ProcedureCode = 20
+	PROC_UE_CONTEXT_MODIFICATION                   ProcedureCode = 21
+	PROC_UE_CAPABILITY_INFO_INDICATION             ProcedureCode = 22
+	PROC_UE_CONTEXT_RELEASE                        ProcedureCode = 23
+	PROC_ENB_STATUS_TRANSFER                       ProcedureCode = 24
+	PROC_MME_STATUS_TRANSFER                       ProcedureCode = 25
+	PROC_DEACTIVATE_TRACE                          ProcedureCode = 26
+	PROC_TRACE_START                               ProcedureCode = 27
+	PROC_TRACE_FAILURE_INDICATION                  ProcedureCode = 28
+	PROC_ENB_CONFIGURATION_UPDATE                  ProcedureCode = 29
+	PROC_MME_CONFIGURATION_UPDATE                  ProcedureCode = 30
+	PROC_LOCATION_REPORTING_CONTROL                ProcedureCode = 31
+	PROC_LOCATION_REPORTING_FAILURE_INDICATION     ProcedureCode = 32
+	PROC_LOCATION_REPORT                           ProcedureCode = 33
+	PROC_OVERLOAD_START                            ProcedureCode = 34
+	PROC_OVERLOAD_STOP                             ProcedureCode = 35
+	PROC_WRITE_REPLACE_WARNING                     ProcedureCode = 36
+	PROC_ENB_DIRECT_INFORMATION_TRANSFER           ProcedureCode = 37
+	PROC_MME_DIRECT_INFORMATION_TRANSFER           ProcedureCode = 38
+	PROC_PRIVATE_MESSAGE                           ProcedureCode = 39
+	PROC_ENB_CONFIGURATION_TRANSFER                ProcedureCode = 40
+	PROC_MME_CONFIGURATION_TRANSFER                ProcedureCode = 41
+	PROC_CELL_TRAFFIC_TRACE                        ProcedureCode = 42
+	PROC_KILL                                      ProcedureCode = 43
+	PROC_DOWNLINK_UE_ASSOCIATED_LPPA_TRANSPORT     ProcedureCode = 44
+	PROC_UPLINK_UE_ASSOCIATED_LPPA_TRANSPORT       ProcedureCode = 45
+	PROC_DOWNLINK_NON_UE_ASSOCIATED_LPPA_TRANSPORT ProcedureCode = 46
+	PROC_UPLINK_NON_UE_ASSOCIATED_LPPA_TRANSPORT   ProcedureCode = 47
+	PROC_UE_RADIO_CAPABILITY_MATCH                 ProcedureCode = 48
+	PROC_PWS_RESTART_INDICATION                    ProcedureCode = 49
+	PROC_E_RAB_MODIFICATION_INDICATION             ProcedureCode = 50
+	PROC_PWS_FAILURE_INDICATION                    ProcedureCode = 51
+	PROC_REROUTE_NAS_REQUEST                       ProcedureCode = 52
+	PROC_UE_CONTEXT_MODIFICATION_INDICATION        ProcedureCode = 53
+	PROC_CONNECTION_ESTABLISHMENT_INDICATION       ProcedureCode = 54
+	PROC_UE_CONTEXT_SUSPEND                        ProcedureCode = 55
+	PROC_UE_CONTEXT_RESUME                         ProcedureCode = 56
+	PROC_NAS_DELIVERY_INDICATION                   ProcedureCode = 57
+	PROC_RETRIEVE_UE_INFORMATION                   ProcedureCode = 58
+	PROC_UE_INFORMATION_TRANSFER                   ProcedureCode = 59
+	PROC_ENB_CP_RELOCATION_INDICATION              ProcedureCode = 60
+	PROC_MME_CP_RELOCATION_INDICATION              ProcedureCode = 61
+)
+
+// ProtocolIEID is ID of protocol IE.
+type ProtocolIEID uint16
+
 const (
-	S1_SETUP_REQUEST = iota + 1
-	S1_SETUP_RESPONSE
-	INITIAL_UE_MESSAGE
-	UPLINK_NAS_TRANSPORT
+	ID_MME_UE_S1AP_ID                            ProtocolIEID = 0
+	ID_HANDOVER_TYPE                             ProtocolIEID = 1
+	ID_CAUSE                                     ProtocolIEID = 2
+	ID_SOURCE_ID                                 ProtocolIEID = 3
+	ID_TARGET_ID                                 ProtocolIEID = 4
+	ID_ENB_UE_S1AP_ID                            ProtocolIEID = 8
+	ID_E_RAB_SUBJECT_TO_DATA_FORWARDING_LIST     ProtocolIEID = 12
+	ID_E_RAB_TO_RELEASE_LIST_HO_CMD              ProtocolIEID = 13
+	ID_E_RAB_DATA_FORWARDING_ITEM                ProtocolIEID = 14
+	ID_E_RAB_RELEASE_ITEM_BEARER_REL_COMP        ProtocolIEID = 15
+	ID_E_RAB_TO_BE_SETUP_LIST_BEARER_SU_REQ      ProtocolIEID = 16
+	ID_E_RAB_TO_BE_SETUP_ITEM_BEARER_SU_REQ      ProtocolIEID = 17
+	ID_E_RAB_ADMITTED_LIST                       ProtocolIEID = 18
+	ID_E_RAB_FAILED_TO_SETUP_LIST_HO_REQ_ACK     ProtocolIEID = 19
+	ID_E_RAB_ADMITTED_ITEM                       ProtocolIEID = 20
+	ID_E_RAB_FAILED_TO_SETUP_ITEM_HO_REQ_ACK     ProtocolIEID = 21
+	ID_E_RAB_TO_BE_SWITCHED_DL_LIST              ProtocolIEID = 22
+	ID_E_RAB_TO_BE_SWITCHED_DL_ITEM              ProtocolIEID = 23
+	ID_E_RAB_TO_BE_SETUP_LIST_CTXT_SU_REQ        ProtocolIEID = 24
+	ID_TRACE_ACTIVATION                          ProtocolIEID = 25
+	ID_NAS_PDU                                   ProtocolIEID = 26
+	ID_E_RAB_TO_BE_SETUP_ITEM_HO_REQ             ProtocolIEID = 27
+	ID_E_RAB_SETUP_LIST_BEARER_SU_RES            ProtocolIEID = 28
+	ID_E_RAB_FAILED_TO_SETUP_LIST_BEARER_SU_RES  ProtocolIEID = 29
+	ID_E_RAB_TO_BE_MODIFIED_LIST_BEARER_MOD_REQ  ProtocolIEID = 30
+	ID_E_RAB_MODIFY_LIST_BEARER_MOD_RES          ProtocolIEID = 31
+	ID_E_RAB_FAILED_TO_MODIFY_LIST               ProtocolIEID = 32
+	ID_E_RAB_TO_BE_RELEASED_LIST                 ProtocolIEID = 33
+	ID_E_RAB_FAILED_TO_RELEASE_LIST              ProtocolIEID = 34
+	ID_E_RAB_ITEM                                ProtocolIEID = 35
+	ID_E_RAB_TO_BE_MODIFIED_ITEM_BEARER_MOD_REQ  ProtocolIEID = 36
+	ID_E_RAB_MODIFY_ITEM_BEARER_MOD_RES          ProtocolIEID = 37
+	ID_E_RAB_RELEASE_ITEM                        ProtocolIEID = 38
+	ID_E_RAB_SETUP_ITEM_BEARER_SU_RES            ProtocolIEID = 39
+	ID_SECURITY_CONTEXT                          ProtocolIEID = 40
+	ID_HANDOVER_RESTRICTION_LIST                 ProtocolIEID = 41
+	ID_UE_PAGING_ID                              ProtocolIEID = 43
+	ID_PAGING_DRX                                ProtocolIEID = 44
+	ID_TAI_LIST                                  ProtocolIEID = 46
+	ID_TAI_ITEM                                  ProtocolIEID = 47
+	ID_E_RAB_FAILED_TO_SETUP_LIST_CTXT_SU_RES    ProtocolIEID = 48
+	ID_E_RAB_RELEASE_ITEM_HO_CMD                 ProtocolIEID = 49
+	ID_E_RAB_SETUP_ITEM_CTXT_SU_RES              ProtocolIEID = 50
+	ID_E_RAB_SETUP_LIST_CTXT_SU_RES              ProtocolIEID = 51
+	ID_E_RAB_TO_BE_SETUP_ITEM_CTXT_SU_REQ        ProtocolIEID = 52
+	ID_E_RAB_TO_BE_SETUP_LIST_HO_REQ             ProtocolIEID = 53
+	ID_CRITICALITY_DIAGNOSTICS                   ProtocolIEID = 58
+	ID_GLOBAL_ENB_ID                             ProtocolIEID = 59
+	ID_ENB_NAME                                  ProtocolIEID = 60
+	ID_MME_NAME                                  ProtocolIEID = 61
+	ID_SERVED_PLMNS                              ProtocolIEID = 63
+	ID_SUPPORTED_TAS                             ProtocolIEID = 64
+	ID_TIME_TO_WAIT                              ProtocolIEID = 65
+	ID_UE_AGGREGATE_MAXIMUM_BITRATE              ProtocolIEID = 66
+	ID_TAI                                       ProtocolIEID = 67
+	ID_E_RAB_RELEASE_LIST_BEARER_REL_COMP        ProtocolIEID = 69
+	ID_SECURITY_KEY                              ProtocolIEID = 73
+	ID_UE_RADIO_CAPABILITY                       ProtocolIEID = 74
+	ID_GUMMEI_ID                                 ProtocolIEID = 75
+	ID_E_RAB_INFORMATION_LIST_ITEM               ProtocolIEID = 78
+	ID_DIRECT_FORWARDING_PATH_AVAILABILITY       ProtocolIEID = 79
+	ID_UE_IDENTITY_INDEX_VALUE                   ProtocolIEID = 80
+	ID_RELATIVE_MME_CAPACITY                     ProtocolIEID = 87
+	ID_SOURCE_MME_UE_S1AP_ID                     ProtocolIEID = 88
+	ID_BEARERS_SUBJECT_TO_STATUS_TRANSFER_ITEM   ProtocolIEID = 89
+	ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER ProtocolIEID = 90
+	ID_RESET_TYPE                                ProtocolIEID = 92
+	ID_S_TMSI                                    ProtocolIEID = 96
+	ID_UE_S1AP_IDS                               ProtocolIEID = 99
+	ID_EUTRAN_CGI                                ProtocolIEID = 100
+	ID_E_RAB_FAILED_TO_BE_RELEASED_LIST          ProtocolIEID = 103
+	ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER    ProtocolIEID = 104
+	ID_SERVED_GUMMEIS                            ProtocolIEID = 105
+	ID_SUBSCRIBER_PROFILE_ID_FOR_RFP             ProtocolIEID = 106
+	ID_UE_SECURITY_CAPABILITIES                  ProtocolIEID = 107
+	ID_CS_FALLBACK_INDICATOR                     ProtocolIEID = 108
+	ID_CN_DOMAIN                                 ProtocolIEID = 109
+	ID_E_RAB_RELEASED_LIST                       ProtocolIEID = 110
+	ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER    ProtocolIEID = 123
+	ID_SRVCC_OPERATION_POSSIBLE                  ProtocolIEID = 124
+	ID_SRVCC_HO_INDICATION                       ProtocolIEID = 125
+	ID_NAS_DOWNLINK_COUNT                        ProtocolIEID = 126
+	ID_CSG_ID                                    ProtocolIEID = 127
+	ID_CSG_ID_LIST                               ProtocolIEID = 128
+	ID_RRC_ESTABLISHMENT_CAUSE                   ProtocolIEID = 134
+	ID_DEFAULT_PAGING_DRX                        ProtocolIEID = 137
+	ID_CELL_ACCESS_MODE                          ProtocolIEID = 145
+	ID_PAGING_PRIORITY                           ProtocolIEID = 151
+	ID_GW_TRANSPORT_LAYER_ADDRESS                ProtocolIEID = 155
+	ID_MME_UE_S1AP_ID_2                          ProtocolIEID = 158
+	ID_RELAY_NODE_INDICATOR                      ProtocolIEID = 160
+	ID_MME_RELAY_SUPPORT_INDICATOR               ProtocolIEID = 163
+	ID_GUMMEI_TYPE                               ProtocolIEID = 170
+	ID_MME_GROUP_ID                              ProtocolIEID = 223
 )
 
 const (
-	NAS_EPS_AUTH_RESPONSE = iota + 1
-	NAS_EPS_SECURITY_MODE_COMPLETE
+	maxProtocolIEs        = 65535
+	maxProtocolExtensions = 65535
+	maxnoofE_RABs         = 256
+	maxnoofTAIs           = 256
+	maxnoofTACs           = 256
+	maxnoofErrors         = 256
+	maxnoofBPLMNs         = 6
+	maxnoofPLMNsPerMME    = 32
+	maxnoofEPLMNs         = 15
+	maxnoofForbTACs       = 4096
+	maxnoofForbLACs       = 4096
+	maxnoofEPLMNsPlusOne  = 16
+	maxnoofRATs           = 8
+	maxnoofGroupIDs       = 65535
+	maxnoofMMECs          = 256
+	maxnoofCSGs           = 256
 )
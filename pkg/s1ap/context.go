@@ -0,0 +1,311 @@
+package s1ap
+
+// InitialContextSetupRequest is sent by MME to establish UE context in eNB.
+// HandoverRestrictionList, UERadioCapability and CSFallbackIndicator are
+// optional. SRVCCOperationPossible is sent when the UE and the network
+// support SRVCC.
+type InitialContextSetupRequest struct {
+	MMEUES1APID                MMEUES1APID
+	ENBUES1APID                ENBUES1APID
+	UEAggregateMaximumBitrate  UEAggregateMaximumBitrate
+	ERABToBeSetupListCtxtSUReq ERABToBeSetupListCtxtSUReq
+	UESecurityCapabilities     UESecurityCapabilities
+	SecurityKey                SecurityKey
+	HandoverRestrictionList    *HandoverRestrictionList
+	UERadioCapability          UERadioCapability
+	CSFallbackIndicator        *CSFallbackIndicator
+	SRVCCOperationPossible     bool
+}
+
+func (*InitialContextSetupRequest) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*InitialContextSetupRequest) ProcedureCode() ProcedureCode { return PROC_INITIAL_CONTEXT_SETUP }
+func (*InitialContextSetupRequest) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *InitialContextSetupRequest) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_UE_AGGREGATE_MAXIMUM_BITRATE, CRITICALITY_REJECT, &m.UEAggregateMaximumBitrate)
+	e.add(ID_E_RAB_TO_BE_SETUP_LIST_CTXT_SU_REQ, CRITICALITY_REJECT, &m.ERABToBeSetupListCtxtSUReq)
+	e.add(ID_UE_SECURITY_CAPABILITIES, CRITICALITY_REJECT, &m.UESecurityCapabilities)
+	e.add(ID_SECURITY_KEY, CRITICALITY_REJECT, &m.SecurityKey)
+	if m.HandoverRestrictionList != nil {
+		e.add(ID_HANDOVER_RESTRICTION_LIST, CRITICALITY_IGNORE, m.HandoverRestrictionList)
+	}
+	if len(m.UERadioCapability) > 0 {
+		e.add(ID_UE_RADIO_CAPABILITY, CRITICALITY_IGNORE, &m.UERadioCapability)
+	}
+	if m.CSFallbackIndicator != nil {
+		e.add(ID_CS_FALLBACK_INDICATOR, CRITICALITY_REJECT, m.CSFallbackIndicator)
+	}
+	if m.SRVCCOperationPossible {
+		e.add(ID_SRVCC_OPERATION_POSSIBLE, CRITICALITY_IGNORE, &trueEnum{})
+	}
+}
+
+func (m *InitialContextSetupRequest) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_UE_AGGREGATE_MAXIMUM_BITRATE:
+		return ie.DecodeValue(&m.UEAggregateMaximumBitrate)
+	case ID_E_RAB_TO_BE_SETUP_LIST_CTXT_SU_REQ:
+		return ie.DecodeValue(&m.ERABToBeSetupListCtxtSUReq)
+	case ID_UE_SECURITY_CAPABILITIES:
+		return ie.DecodeValue(&m.UESecurityCapabilities)
+	case ID_SECURITY_KEY:
+		return ie.DecodeValue(&m.SecurityKey)
+	case ID_HANDOVER_RESTRICTION_LIST:
+		m.HandoverRestrictionList = &HandoverRestrictionList{}
+		return ie.DecodeValue(m.HandoverRestrictionList)
+	case ID_UE_RADIO_CAPABILITY:
+		return ie.DecodeValue(&m.UERadioCapability)
+	case ID_CS_FALLBACK_INDICATOR:
+		m.CSFallbackIndicator = new(CSFallbackIndicator)
+		return ie.DecodeValue(m.CSFallbackIndicator)
+	case ID_SRVCC_OPERATION_POSSIBLE:
+		m.SRVCCOperationPossible = true
+		return ie.DecodeValue(&trueEnum{})
+	}
+	return nil
+}
+
+func (*InitialContextSetupRequest) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_UE_AGGREGATE_MAXIMUM_BITRATE,
+		ID_E_RAB_TO_BE_SETUP_LIST_CTXT_SU_REQ, ID_UE_SECURITY_CAPABILITIES, ID_SECURITY_KEY}
+}
+
+// InitialContextSetupResponse is the successful outcome of Initial Context
+// Setup. ERABFailedToSetupList is nil when all of E-RABs are set up.
+type InitialContextSetupResponse struct {
+	MMEUES1APID            MMEUES1APID
+	ENBUES1APID            ENBUES1APID
+	ERABSetupListCtxtSURes ERABSetupListCtxtSURes
+	ERABFailedToSetupList  ERABList
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*InitialContextSetupResponse) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*InitialContextSetupResponse) ProcedureCode() ProcedureCode { return PROC_INITIAL_CONTEXT_SETUP }
+func (*InitialContextSetupResponse) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *InitialContextSetupResponse) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	e.add(ID_E_RAB_SETUP_LIST_CTXT_SU_RES, CRITICALITY_IGNORE, &m.ERABSetupListCtxtSURes)
+	if len(m.ERABFailedToSetupList) > 0 {
+		e.add(ID_E_RAB_FAILED_TO_SETUP_LIST_CTXT_SU_RES, CRITICALITY_IGNORE, &m.ERABFailedToSetupList)
+	}
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *InitialContextSetupResponse) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_E_RAB_SETUP_LIST_CTXT_SU_RES:
+		return ie.DecodeValue(&m.ERABSetupListCtxtSURes)
+	case ID_E_RAB_FAILED_TO_SETUP_LIST_CTXT_SU_RES:
+		return ie.DecodeValue(&m.ERABFailedToSetupList)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*InitialContextSetupResponse) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_E_RAB_SETUP_LIST_CTXT_SU_RES}
+}
+
+// InitialContextSetupFailure is the unsuccessful outcome of Initial Context
+// Setup. The eNB has not set up any of E-RABs.
+type InitialContextSetupFailure struct {
+	MMEUES1APID            MMEUES1APID
+	ENBUES1APID            ENBUES1APID
+	Cause                  Cause
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*InitialContextSetupFailure) PDUType() PDUType             { return PDU_UNSUCCESSFUL_OUTCOME }
+func (*InitialContextSetupFailure) ProcedureCode() ProcedureCode { return PROC_INITIAL_CONTEXT_SETUP }
+func (*InitialContextSetupFailure) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *InitialContextSetupFailure) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *InitialContextSetupFailure) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*InitialContextSetupFailure) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_CAUSE}
+}
+
+// UEContextReleaseRequest is sent by eNB to request release of UE context.
+type UEContextReleaseRequest struct {
+	MMEUES1APID MMEUES1APID
+	ENBUES1APID ENBUES1APID
+	Cause       Cause
+}
+
+func (*UEContextReleaseRequest) PDUType() PDUType { return PDU_INITIATING_MESSAGE }
+func (*UEContextReleaseRequest) ProcedureCode() ProcedureCode {
+	return PROC_UE_CONTEXT_RELEASE_REQUEST
+}
+func (*UEContextReleaseRequest) Criticality() Criticality { return CRITICALITY_IGNORE }
+
+func (m *UEContextReleaseRequest) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+}
+
+func (m *UEContextReleaseRequest) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	}
+	return nil
+}
+
+func (*UEContextReleaseRequest) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_CAUSE}
+}
+
+// UEContextReleaseCommand is sent by MME to release UE context in eNB.
+type UEContextReleaseCommand struct {
+	UES1APIDs UES1APIDs
+	Cause     Cause
+}
+
+func (*UEContextReleaseCommand) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*UEContextReleaseCommand) ProcedureCode() ProcedureCode { return PROC_UE_CONTEXT_RELEASE }
+func (*UEContextReleaseCommand) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *UEContextReleaseCommand) encodeIEs(e *ieEncoder) {
+	e.add(ID_UE_S1AP_IDS, CRITICALITY_REJECT, &m.UES1APIDs)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+}
+
+func (m *UEContextReleaseCommand) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_UE_S1AP_IDS:
+		return ie.DecodeValue(&m.UES1APIDs)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	}
+	return nil
+}
+
+func (*UEContextReleaseCommand) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_UE_S1AP_IDS, ID_CAUSE}
+}
+
+// UEContextReleaseComplete is the successful outcome of UE Context Release.
+type UEContextReleaseComplete struct {
+	MMEUES1APID            MMEUES1APID
+	ENBUES1APID            ENBUES1APID
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*UEContextReleaseComplete) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*UEContextReleaseComplete) ProcedureCode() ProcedureCode { return PROC_UE_CONTEXT_RELEASE }
+func (*UEContextReleaseComplete) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *UEContextReleaseComplete) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *UEContextReleaseComplete) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*UEContextReleaseComplete) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID}
+}
+
+// UECapabilityInfoIndication is sent by eNB to report the UE radio
+// capability which the MME keeps for the following Initial Context Setup.
+type UECapabilityInfoIndication struct {
+	MMEUES1APID       MMEUES1APID
+	ENBUES1APID       ENBUES1APID
+	UERadioCapability UERadioCapability
+}
+
+func (*UECapabilityInfoIndication) PDUType() PDUType { return PDU_INITIATING_MESSAGE }
+func (*UECapabilityInfoIndication) ProcedureCode() ProcedureCode {
+	return PROC_UE_CAPABILITY_INFO_INDICATION
+}
+func (*UECapabilityInfoIndication) Criticality() Criticality { return CRITICALITY_IGNORE }
+
+func (m *UECapabilityInfoIndication) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_UE_RADIO_CAPABILITY, CRITICALITY_IGNORE, &m.UERadioCapability)
+}
+
+func (m *UECapabilityInfoIndication) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_UE_RADIO_CAPABILITY:
+		return ie.DecodeValue(&m.UERadioCapability)
+	}
+	return nil
+}
+
+func (*UECapabilityInfoIndication) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_UE_RADIO_CAPABILITY}
+}
+
+func init() {
+	registerMessage(func() Message { return &InitialContextSetupRequest{} })
+	registerMessage(func() Message { return &InitialContextSetupResponse{} })
+	registerMessage(func() Message { return &InitialContextSetupFailure{} })
+	registerMessage(func() Message { return &UEContextReleaseRequest{} })
+	registerMessage(func() Message { return &UEContextReleaseCommand{} })
+	registerMessage(func() Message { return &UEContextReleaseComplete{} })
+	registerMessage(func() Message { return &UECapabilityInfoIndication{} })
+}
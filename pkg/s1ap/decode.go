@@ -1,26 +1,16 @@
 package s1ap
 
-// #cgo CFLAGS: -I./asn1
-// #cgo LDFLAGS: -L/usr/local/lib -ls1ap
-// #include "S1AP-PDU.h"
-// #include "InitiatingMessage.h"
-// #include "ProtocolIE-Field.h"
-import "C"
 import (
-	"fmt"
 	"log"
-	"reflect"
-	"unsafe"
 )
 
-var S1AP_PDU2StringMap = map[C.S1AP_PDU_PR]string{
-	C.S1AP_PDU_PR_NOTHING:             "Nothing",
-	C.S1AP_PDU_PR_initiatingMessage:   "InitiatingMessage",
-	C.S1AP_PDU_PR_successfulOutcome:   "SuccessfulOutcome",
-	C.S1AP_PDU_PR_unsuccessfulOutcome: "UnsuccessfulOutcome",
+var S1AP_PDU2StringMap = map[PDUType]string{
+	PDU_INITIATING_MESSAGE:   "InitiatingMessage",
+	PDU_SUCCESSFUL_OUTCOME:   "SuccessfulOutcome",
+	PDU_UNSUCCESSFUL_OUTCOME: "UnsuccessfulOutcome",
 }
 
-func S1AP_PDU2String(k C.S1AP_PDU_PR) string {
+func S1AP_PDU2String(k PDUType) string {
 	if v, ok := S1AP_PDU2StringMap[k]; ok {
 		return v
 	} else {
@@ -28,73 +18,72 @@ func S1AP_PDU2String(k C.S1AP_PDU_PR) string {
 	}
 }
 
-var S1AP_Initiating2StringMap = map[C.InitiatingMessage__value_PR]string{
-	C.InitiatingMessage__value_PR_NOTHING:                              "NOTHING",
-	C.InitiatingMessage__value_PR_HandoverRequired:                     "HandoverRequired",
-	C.InitiatingMessage__value_PR_HandoverRequest:                      "HandoverRequest",
-	C.InitiatingMessage__value_PR_PathSwitchRequest:                    "PathSwitchRequest",
-	C.InitiatingMessage__value_PR_E_RABSetupRequest:                    "E_RABSetupRequest",
-	C.InitiatingMessage__value_PR_E_RABModifyRequest:                   "E_RABModifyRequest",
-	C.InitiatingMessage__value_PR_E_RABReleaseCommand:                  "E_RABReleaseCommand",
-	C.InitiatingMessage__value_PR_InitialContextSetupRequest:           "InitialContextSetupRequest",
-	C.InitiatingMessage__value_PR_HandoverCancel:                       "HandoverCancel",
-	C.InitiatingMessage__value_PR_KillRequest:                          "KillRequest",
-	C.InitiatingMessage__value_PR_Reset:                                "Reset",
-	C.InitiatingMessage__value_PR_S1SetupRequest:                       "S1SetupRequest",
-	C.InitiatingMessage__value_PR_UEContextModificationRequest:         "UEContextModificationRequest",
-	C.InitiatingMessage__value_PR_UEContextReleaseCommand:              "UEContextReleaseCommand",
-	C.InitiatingMessage__value_PR_ENBConfigurationUpdate:               "ENBConfigurationUpdate",
-	C.InitiatingMessage__value_PR_MMEConfigurationUpdate:               "MMEConfigurationUpdate",
-	C.InitiatingMessage__value_PR_WriteReplaceWarningRequest:           "WriteReplaceWarningRequest",
-	C.InitiatingMessage__value_PR_UERadioCapabilityMatchRequest:        "UERadioCapabilityMatchRequest",
-	C.InitiatingMessage__value_PR_E_RABModificationIndication:          "E_RABModificationIndication",
-	C.InitiatingMessage__value_PR_UEContextModificationIndication:      "UEContextModificationIndication",
-	C.InitiatingMessage__value_PR_UEContextSuspendRequest:              "UEContextSuspendRequest",
-	C.InitiatingMessage__value_PR_UEContextResumeRequest:               "UEContextResumeRequest",
-	C.InitiatingMessage__value_PR_HandoverNotify:                       "HandoverNotify",
-	C.InitiatingMessage__value_PR_E_RABReleaseIndication:               "E_RABReleaseIndication",
-	C.InitiatingMessage__value_PR_Paging:                               "Paging",
-	C.InitiatingMessage__value_PR_DownlinkNASTransport:                 "DownlinkNASTransport",
-	C.InitiatingMessage__value_PR_InitialUEMessage:                     "InitialUEMessage",
-	C.InitiatingMessage__value_PR_UplinkNASTransport:                   "UplinkNASTransport",
-	C.InitiatingMessage__value_PR_ErrorIndication:                      "ErrorIndication",
-	C.InitiatingMessage__value_PR_NASNonDeliveryIndication:             "NASNonDeliveryIndication",
-	C.InitiatingMessage__value_PR_UEContextReleaseRequest:              "UEContextReleaseRequest",
-	C.InitiatingMessage__value_PR_DownlinkS1cdma2000tunnelling:         "DownlinkS1cdma2000tunnelling",
-	C.InitiatingMessage__value_PR_UplinkS1cdma2000tunnelling:           "UplinkS1cdma2000tunnelling",
-	C.InitiatingMessage__value_PR_UECapabilityInfoIndication:           "UECapabilityInfoIndication",
-	C.InitiatingMessage__value_PR_ENBStatusTransfer:                    "ENBStatusTransfer",
-	C.InitiatingMessage__value_PR_MMEStatusTransfer:                    "MMEStatusTransfer",
-	C.InitiatingMessage__value_PR_DeactivateTrace:                      "DeactivateTrace",
-	C.InitiatingMessage__value_PR_TraceStart:                           "TraceStart",
-	C.InitiatingMessage__value_PR_TraceFailureIndication:               "TraceFailureIndication",
-	C.InitiatingMessage__value_PR_CellTrafficTrace:                     "CellTrafficTrace",
-	C.InitiatingMessage__value_PR_LocationReportingControl:             "LocationReportingControl",
-	C.InitiatingMessage__value_PR_LocationReportingFailureIndication:   "LocationReportingFailureIndication",
-	C.InitiatingMessage__value_PR_LocationReport:                       "LocationReport",
-	C.InitiatingMessage__value_PR_OverloadStart:                        "OverloadStart",
-	C.InitiatingMessage__value_PR_OverloadStop:                         "OverloadStop",
-	C.InitiatingMessage__value_PR_ENBDirectInformationTransfer:         "ENBDirectInformationTransfer",
-	C.InitiatingMessage__value_PR_MMEDirectInformationTransfer:         "MMEDirectInformationTransfer",
-	C.InitiatingMessage__value_PR_ENBConfigurationTransfer:             "ENBConfigurationTransfer",
-	C.InitiatingMessage__value_PR_MMEConfigurationTransfer:             "MMEConfigurationTransfer",
-	C.InitiatingMessage__value_PR_PrivateMessage:                       "PrivateMessage",
-	C.InitiatingMessage__value_PR_DownlinkUEAssociatedLPPaTransport:    "DownlinkUEAssociatedLPPaTransport",
-	C.InitiatingMessage__value_PR_UplinkUEAssociatedLPPaTransport:      "UplinkUEAssociatedLPPaTransport",
-	C.InitiatingMessage__value_PR_DownlinkNonUEAssociatedLPPaTransport: "DownlinkNonUEAssociatedLPPaTransport",
-	C.InitiatingMessage__value_PR_UplinkNonUEAssociatedLPPaTransport:   "UplinkNonUEAssociatedLPPaTransport",
-	C.InitiatingMessage__value_PR_PWSRestartIndication:                 "PWSRestartIndication",
-	C.InitiatingMessage__value_PR_RerouteNASRequest:                    "RerouteNASRequest",
-	C.InitiatingMessage__value_PR_PWSFailureIndication:                 "PWSFailureIndication",
-	C.InitiatingMessage__value_PR_ConnectionEstablishmentIndication:    "ConnectionEstablishmentIndication",
-	C.InitiatingMessage__value_PR_NASDeliveryIndication:                "NASDeliveryIndication",
-	C.InitiatingMessage__value_PR_RetrieveUEInformation:                "RetrieveUEInformation",
-	C.InitiatingMessage__value_PR_UEInformationTransfer:                "UEInformationTransfer",
-	C.InitiatingMessage__value_PR_ENBCPRelocationIndication:            "ENBCPRelocationIndication",
-	C.InitiatingMessage__value_PR_MMECPRelocationIndication:            "MMECPRelocationIndication",
+var S1AP_Initiating2StringMap = map[ProcedureCode]string{
+	PROC_HANDOVER_PREPARATION:                      "HandoverRequired",
+	PROC_HANDOVER_RESOURCE_ALLOCATION:              "HandoverRequest",
+	PROC_PATH_SWITCH_REQUEST:                       "PathSwitchRequest",
+	PROC_E_RAB_SETUP:                               "E_RABSetupRequest",
+	PROC_E_RAB_MODIFY:                              "E_RABModifyRequest",
+	PROC_E_RAB_RELEASE:                             "E_RABReleaseCommand",
+	PROC_INITIAL_CONTEXT_SETUP:                     "InitialContextSetupRequest",
+	PROC_HANDOVER_CANCEL:                           "HandoverCancel",
+	PROC_KILL:                                      "KillRequest",
+	PROC_RESET:                                     "Reset",
+	PROC_S1_SETUP:                                  "S1SetupRequest",
+	PROC_UE_CONTEXT_MODIFICATION:                   "UEContextModificationRequest",
+	PROC_UE_CONTEXT_RELEASE:                        "UEContextReleaseCommand",
+	PROC_ENB_CONFIGURATION_UPDATE:                  "ENBConfigurationUpdate",
+	PROC_MME_CONFIGURATION_UPDATE:                  "MMEConfigurationUpdate",
+	PROC_WRITE_REPLACE_WARNING:                     "WriteReplaceWarningRequest",
+	PROC_UE_RADIO_CAPABILITY_MATCH:                 "UERadioCapabilityMatchRequest",
+	PROC_E_RAB_MODIFICATION_INDICATION:             "E_RABModificationIndication",
+	PROC_UE_CONTEXT_MODIFICATION_INDICATION:        "UEContextModificationIndication",
+	PROC_UE_CONTEXT_SUSPEND:                        "UEContextSuspendRequest",
+	PROC_UE_CONTEXT_RESUME:                         "UEContextResumeRequest",
+	PROC_HANDOVER_NOTIFICATION:                     "HandoverNotify",
+	PROC_E_RAB_RELEASE_INDICATION:                  "E_RABReleaseIndication",
+	PROC_PAGING:                                    "Paging",
+	PROC_DOWNLINK_NAS_TRANSPORT:                    "DownlinkNASTransport",
+	PROC_INITIAL_UE_MESSAGE:                        "InitialUEMessage",
+	PROC_UPLINK_NAS_TRANSPORT:                      "UplinkNASTransport",
+	PROC_ERROR_INDICATION:                          "ErrorIndication",
+	PROC_NAS_NON_DELIVERY_INDICATION:               "NASNonDeliveryIndication",
+	PROC_UE_CONTEXT_RELEASE_REQUEST:                "UEContextReleaseRequest",
+	PROC_DOWNLINK_S1_CDMA2000_TUNNELLING:           "DownlinkS1cdma2000tunnelling",
+	PROC_UPLINK_S1_CDMA2000_TUNNELLING:             "UplinkS1cdma2000tunnelling",
+	PROC_UE_CAPABILITY_INFO_INDICATION:             "UECapabilityInfoIndication",
+	PROC_ENB_STATUS_TRANSFER:                       "ENBStatusTransfer",
+	PROC_MME_STATUS_TRANSFER:                       "MMEStatusTransfer",
+	PROC_DEACTIVATE_TRACE:                          "DeactivateTrace",
+	PROC_TRACE_START:                               "TraceStart",
+	PROC_TRACE_FAILURE_INDICATION:                  "TraceFailureIndication",
+	PROC_CELL_TRAFFIC_TRACE:                        "CellTrafficTrace",
+	PROC_LOCATION_REPORTING_CONTROL:                "LocationReportingControl",
+	PROC_LOCATION_REPORTING_FAILURE_INDICATION:     "LocationReportingFailureIndication",
+	PROC_LOCATION_REPORT:                           "LocationReport",
+	PROC_OVERLOAD_START:                            "OverloadStart",
+	PROC_OVERLOAD_STOP:                             "OverloadStop",
+	PROC_ENB_DIRECT_INFORMATION_TRANSFER:           "ENBDirectInformationTransfer",
+	PROC_MME_DIRECT_INFORMATION_TRANSFER:           "MMEDirectInformationTransfer",
+	PROC_ENB_CONFIGURATION_TRANSFER:                "ENBConfigurationTransfer",
+	PROC_MME_CONFIGURATION_TRANSFER:                "MMEConfigurationTransfer",
+	PROC_PRIVATE_MESSAGE:                           "PrivateMessage",
+	PROC_DOWNLINK_UE_ASSOCIATED_LPPA_TRANSPORT:     "DownlinkUEAssociatedLPPaTransport",
+	PROC_UPLINK_UE_ASSOCIATED_LPPA_TRANSPORT:       "UplinkUEAssociatedLPPaTransport",
+	PROC_DOWNLINK_NON_UE_ASSOCIATED_LPPA_TRANSPORT: "DownlinkNonUEAssociatedLPPaTransport",
+	PROC_UPLINK_NON_UE_ASSOCIATED_LPPA_TRANSPORT:   "UplinkNonUEAssociatedLPPaTransport",
+	PROC_PWS_RESTART_INDICATION:                    "PWSRestartIndication",
+	PROC_REROUTE_NAS_REQUEST:                       "RerouteNASRequest",
+	PROC_PWS_FAILURE_INDICATION:                    "PWSFailureIndication",
+	PROC_CONNECTION_ESTABLISHMENT_INDICATION:       "ConnectionEstablishmentIndication",
+	PROC_NAS_DELIVERY_INDICATION:                   "NASDeliveryIndication",
+	PROC_RETRIEVE_UE_INFORMATION:                   "RetrieveUEInformation",
+	PROC_UE_INFORMATION_TRANSFER:                   "UEInformationTransfer",
+	PROC_ENB_CP_RELOCATION_INDICATION:              "ENBCPRelocationIndication",
+	PROC_MME_CP_RELOCATION_INDICATION:              "MMECPRelocationIndication",
 }
 
-func S1AP_Initiating2String(k C.InitiatingMessage__value_PR) string {
+func S1AP_Initiating2String(k ProcedureCode) string {
 	if v, ok := S1AP_Initiating2StringMap[k]; ok {
 		return v
 	} else {
@@ -102,181 +91,15 @@ func S1AP_Initiating2String(k C.InitiatingMessage__value_PR) string {
 	}
 }
 
-func InitialUEMessageHandle(packet unsafe.Pointer) (int32, error) {
-	pdu := (*C.S1AP_PDU_t)(packet)
-	msg := *(**C.InitiatingMessage_t)(unsafe.Pointer(&pdu.choice))
-	val := (*C.InitialUEMessage_t)(unsafe.Pointer(&msg.value.choice))
-
-	var ies []*C.UplinkNASTransport_IEs_t
-	slice := (*reflect.SliceHeader)((unsafe.Pointer(&ies)))
-	slice.Cap = (int)(val.protocolIEs.list.count)
-	slice.Len = (int)(val.protocolIEs.list.count)
-	slice.Data = uintptr(unsafe.Pointer(val.protocolIEs.list.array))
-
-	var enb_ie_s1ap_id int32
-
-	for _, ie := range ies {
-		switch ie.id {
-		case C.ProtocolIE_ID_id_eNB_UE_S1AP_ID:
-			enb_ie_s1ap_id_c := (*C.ENB_UE_S1AP_ID_t)(unsafe.Pointer(&ie.value.choice))
-			enb_ie_s1ap_id = (int32)(*enb_ie_s1ap_id_c)
-		case C.ProtocolIE_ID_id_NAS_PDU:
-			//NAS_PDU = &ie->value.choice.NAS_PDU;
-		case C.ProtocolIE_ID_id_TAI:
-			//TAI = &ie->value.choice.TAI;
-		case C.ProtocolIE_ID_id_EUTRAN_CGI:
-			//EUTRAN_CGI = &ie->value.choice.EUTRAN_CGI;
-		case C.ProtocolIE_ID_id_S_TMSI:
-			//S_TMSI = &ie->value.choice.S_TMSI;
-		default:
-		}
-	}
-	return enb_ie_s1ap_id, nil
-}
-
-func NAS_PDU_Handle() {
-}
-
-func UplinkNASTransportHandle(packet unsafe.Pointer) (int32, int, error) {
-	pdu := (*C.S1AP_PDU_t)(packet)
-	msg := *(**C.InitiatingMessage_t)(unsafe.Pointer(&pdu.choice))
-	val := (*C.UplinkNASTransport_t)(unsafe.Pointer(&msg.value.choice))
-
-	var ies []*C.UplinkNASTransport_IEs_t
-	slice := (*reflect.SliceHeader)((unsafe.Pointer(&ies)))
-	slice.Cap = (int)(val.protocolIEs.list.count)
-	slice.Len = (int)(val.protocolIEs.list.count)
-	slice.Data = uintptr(unsafe.Pointer(val.protocolIEs.list.array))
-
-	var enb_ie_s1ap_id int32
-	var eps_mmm_type int
-
-	for _, ie := range ies {
-		switch ie.id {
-		case C.ProtocolIE_ID_id_eNB_UE_S1AP_ID:
-			fmt.Println("IE: eNB_UE_S1AP_ID")
-			enb_ie_s1ap_id_c := (*C.ENB_UE_S1AP_ID_t)(unsafe.Pointer(&ie.value.choice))
-			enb_ie_s1ap_id = (int32)(*enb_ie_s1ap_id_c)
-		case C.ProtocolIE_ID_id_NAS_PDU:
-			fmt.Println("IE: NAS_PDU")
-			// OCTET_STRING_T
-			// typedef struct OCTET_STRING {
-			// uint8_t *buf;   /* Buffer with consecutive OCTET_STRING bits */
-			// size_t size;    /* Size of the buffer */
-			//
-			// asn_struct_ctx_t _asn_ctx;      /* Parsing across buffer boundaries */
-			// } OCTET_STRING_t;
-			nas_pdu := (*C.NAS_PDU_t)(unsafe.Pointer(&ie.value.choice))
-
-			var nas_pdu_buf []byte
-			slice := (*reflect.SliceHeader)((unsafe.Pointer(&nas_pdu_buf)))
-			slice.Cap = (int)(nas_pdu.size)
-			slice.Len = (int)(nas_pdu.size)
-			slice.Data = uintptr(unsafe.Pointer(nas_pdu.buf))
-
-			fmt.Println("NAS_PDU_LEN", len(nas_pdu_buf))
-			for _, val := range nas_pdu_buf {
-				fmt.Printf("%02x ", val)
-			}
-			fmt.Printf("\n")
-			var securityHeaderType byte
-			var protocolDisc byte
-			for len(nas_pdu_buf) > 0 {
-				securityHeaderType = (nas_pdu_buf[0] & 0xf0) >> 4
-				protocolDisc = (nas_pdu_buf[0] & 0x0f)
-				nas_pdu_buf = nas_pdu_buf[1:]
-				fmt.Printf("securityHeaderType: %02x\n", securityHeaderType)
-				fmt.Printf("protocolDisc: %02x\n", protocolDisc)
-
-				if protocolDisc != 7 {
-					return 0, 0, fmt.Errorf("Protocol discrimiter is not EPS MMM")
-				}
-
-				switch securityHeaderType {
-				case 0:
-					fmt.Printf("MMM Type %02x\n", nas_pdu_buf[0])
-					typ := nas_pdu_buf[0]
-					nas_pdu_buf = nas_pdu_buf[1:]
-					switch typ {
-					case 0x53:
-						eps_mmm_type = NAS_EPS_AUTH_RESPONSE
-						if len(nas_pdu_buf) > 0 {
-							len := nas_pdu_buf[0]
-							nas_pdu_buf = nas_pdu_buf[len+1:]
-						}
-					case 0x5e:
-						eps_mmm_type = NAS_EPS_SECURITY_MODE_COMPLETE
-					default:
-						eps_mmm_type = 0
-					}
-				case 4:
-					nas_pdu_buf = nas_pdu_buf[5:]
-				default:
-					return 0, 0, fmt.Errorf("Security header type is not known %d", securityHeaderType)
-				}
-			}
-		case C.ProtocolIE_ID_id_TAI:
-			fmt.Println("IE: TAI")
-			//TAI = &ie->value.choice.TAI;
-		case C.ProtocolIE_ID_id_EUTRAN_CGI:
-			fmt.Println("IE: EUTRAN_CGI")
-			//EUTRAN_CGI = &ie->value.choice.EUTRAN_CGI;
-		case C.ProtocolIE_ID_id_S_TMSI:
-			fmt.Println("IE: S_TMSI")
-			//S_TMSI = &ie->value.choice.S_TMSI;
-		default:
-		}
-	}
-	return enb_ie_s1ap_id, eps_mmm_type, nil
-}
-
-func Decode(buf []byte) (unsafe.Pointer, int, error) {
-	packet := C.calloc(C.sizeof_struct_S1AP_PDU, 1)
-	var opt_codec *C.asn_codec_ctx_t = nil
-
-	ret := C.aper_decode(
-		opt_codec,
-		&C.asn_DEF_S1AP_PDU,
-		(*unsafe.Pointer)(&packet),
-		(unsafe.Pointer)(&buf[0]),
-		(C.size_t)(len(buf)),
-		0,
-		0)
-
-	if ret.code != C.RC_OK {
-		C.free(packet)
-		return nil, 0, fmt.Errorf("aper_decode failed: %d", ret)
+// Decode decodes S1AP-PDU and returns the message. When the procedure is not
+// supported, *UnknownMessage is returned so that the caller can report it.
+func Decode(buf []byte) (Message, error) {
+	pdu, err := DecodePDU(buf)
+	if err != nil {
+		return nil, err
 	}
+	log.Println("PDU type:", S1AP_PDU2String(pdu.Type))
+	log.Println("Message type:", S1AP_Initiating2String(pdu.ProcedureCode))
 
-	pdu := (*C.S1AP_PDU_t)(packet)
-	log.Println("PDU type:", S1AP_PDU2String(pdu.present))
-
-	typ := 0
-	switch pdu.present {
-	case C.S1AP_PDU_PR_NOTHING:
-	case C.S1AP_PDU_PR_initiatingMessage:
-		msg := *(**C.InitiatingMessage_t)(unsafe.Pointer(&pdu.choice))
-		log.Println("Message type:", S1AP_Initiating2String(msg.value.present))
-		switch msg.value.present {
-		case C.InitiatingMessage__value_PR_S1SetupRequest:
-			typ = S1_SETUP_REQUEST
-		case C.InitiatingMessage__value_PR_InitialUEMessage:
-			typ = INITIAL_UE_MESSAGE
-		case C.InitiatingMessage__value_PR_UplinkNASTransport:
-			typ = UPLINK_NAS_TRANSPORT
-		default:
-		}
-	case C.S1AP_PDU_PR_successfulOutcome:
-	case C.S1AP_PDU_PR_unsuccessfulOutcome:
-	default:
-	}
-	return packet, typ, nil
-}
-
-func XerPrint(message unsafe.Pointer) {
-	C.xer_fprint(C.stdout, &C.asn_DEF_S1AP_PDU, message)
-}
-
-func Free(packet unsafe.Pointer) {
-	C.free(packet)
+	return NewMessage(pdu)
 }
@@ -0,0 +1,323 @@
+package s1ap
+
+// ERABSetupRequest is sent by MME to set up E-RABs of dedicated bearers
+// in eNB. UEAggregateMaximumBitrate is optional.
+type ERABSetupRequest struct {
+	MMEUES1APID                  MMEUES1APID
+	ENBUES1APID                  ENBUES1APID
+	UEAggregateMaximumBitrate    *UEAggregateMaximumBitrate
+	ERABToBeSetupListBearerSUReq ERABToBeSetupListBearerSUReq
+}
+
+func (*ERABSetupRequest) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*ERABSetupRequest) ProcedureCode() ProcedureCode { return PROC_E_RAB_SETUP }
+func (*ERABSetupRequest) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *ERABSetupRequest) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	if m.UEAggregateMaximumBitrate != nil {
+		e.add(ID_UE_AGGREGATE_MAXIMUM_BITRATE, CRITICALITY_REJECT, m.UEAggregateMaximumBitrate)
+	}
+	e.add(ID_E_RAB_TO_BE_SETUP_LIST_BEARER_SU_REQ, CRITICALITY_REJECT, &m.ERABToBeSetupListBearerSUReq)
+}
+
+func (m *ERABSetupRequest) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_UE_AGGREGATE_MAXIMUM_BITRATE:
+		m.UEAggregateMaximumBitrate = &UEAggregateMaximumBitrate{}
+		return ie.DecodeValue(m.UEAggregateMaximumBitrate)
+	case ID_E_RAB_TO_BE_SETUP_LIST_BEARER_SU_REQ:
+		return ie.DecodeValue(&m.ERABToBeSetupListBearerSUReq)
+	}
+	return nil
+}
+
+func (*ERABSetupRequest) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_E_RAB_TO_BE_SETUP_LIST_BEARER_SU_REQ}
+}
+
+// ERABSetupResponse is the response of E-RAB Setup. E-RABs which the eNB
+// could not set up are in ERABFailedToSetupList with the cause.
+type ERABSetupResponse struct {
+	MMEUES1APID              MMEUES1APID
+	ENBUES1APID              ENBUES1APID
+	ERABSetupListBearerSURes ERABSetupListBearerSURes
+	ERABFailedToSetupList    ERABList
+	CriticalityDiagnostics   *CriticalityDiagnostics
+}
+
+func (*ERABSetupResponse) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*ERABSetupResponse) ProcedureCode() ProcedureCode { return PROC_E_RAB_SETUP }
+func (*ERABSetupResponse) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *ERABSetupResponse) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	if len(m.ERABSetupListBearerSURes) > 0 {
+		e.add(ID_E_RAB_SETUP_LIST_BEARER_SU_RES, CRITICALITY_IGNORE, &m.ERABSetupListBearerSURes)
+	}
+	if len(m.ERABFailedToSetupList) > 0 {
+		e.add(ID_E_RAB_FAILED_TO_SETUP_LIST_BEARER_SU_RES, CRITICALITY_IGNORE, &m.ERABFailedToSetupList)
+	}
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *ERABSetupResponse) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_E_RAB_SETUP_LIST_BEARER_SU_RES:
+		return ie.DecodeValue(&m.ERABSetupListBearerSURes)
+	case ID_E_RAB_FAILED_TO_SETUP_LIST_BEARER_SU_RES:
+		return ie.DecodeValue(&m.ERABFailedToSetupList)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*ERABSetupResponse) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID}
+}
+
+// ERABModifyRequest is sent by MME to modify QoS of E-RABs in eNB.
+// UEAggregateMaximumBitrate is optional.
+type ERABModifyRequest struct {
+	MMEUES1APID                      MMEUES1APID
+	ENBUES1APID                      ENBUES1APID
+	UEAggregateMaximumBitrate        *UEAggregateMaximumBitrate
+	ERABToBeModifiedListBearerModReq ERABToBeModifiedListBearerModReq
+}
+
+func (*ERABModifyRequest) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*ERABModifyRequest) ProcedureCode() ProcedureCode { return PROC_E_RAB_MODIFY }
+func (*ERABModifyRequest) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *ERABModifyRequest) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	if m.UEAggregateMaximumBitrate != nil {
+		e.add(ID_UE_AGGREGATE_MAXIMUM_BITRATE, CRITICALITY_REJECT, m.UEAggregateMaximumBitrate)
+	}
+	e.add(ID_E_RAB_TO_BE_MODIFIED_LIST_BEARER_MOD_REQ, CRITICALITY_REJECT, &m.ERABToBeModifiedListBearerModReq)
+}
+
+func (m *ERABModifyRequest) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_UE_AGGREGATE_MAXIMUM_BITRATE:
+		m.UEAggregateMaximumBitrate = &UEAggregateMaximumBitrate{}
+		return ie.DecodeValue(m.UEAggregateMaximumBitrate)
+	case ID_E_RAB_TO_BE_MODIFIED_LIST_BEARER_MOD_REQ:
+		return ie.DecodeValue(&m.ERABToBeModifiedListBearerModReq)
+	}
+	return nil
+}
+
+func (*ERABModifyRequest) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_E_RAB_TO_BE_MODIFIED_LIST_BEARER_MOD_REQ}
+}
+
+// ERABModifyResponse is the response of E-RAB Modify. E-RABs which the
+// eNB could not modify are in ERABFailedToModifyList with the cause.
+type ERABModifyResponse struct {
+	MMEUES1APID                MMEUES1APID
+	ENBUES1APID                ENBUES1APID
+	ERABModifyListBearerModRes ERABModifyListBearerModRes
+	ERABFailedToModifyList     ERABList
+	CriticalityDiagnostics     *CriticalityDiagnostics
+}
+
+func (*ERABModifyResponse) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*ERABModifyResponse) ProcedureCode() ProcedureCode { return PROC_E_RAB_MODIFY }
+func (*ERABModifyResponse) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *ERABModifyResponse) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	if len(m.ERABModifyListBearerModRes) > 0 {
+		e.add(ID_E_RAB_MODIFY_LIST_BEARER_MOD_RES, CRITICALITY_IGNORE, &m.ERABModifyListBearerModRes)
+	}
+	if len(m.ERABFailedToModifyList) > 0 {
+		e.add(ID_E_RAB_FAILED_TO_MODIFY_LIST, CRITICALITY_IGNORE, &m.ERABFailedToModifyList)
+	}
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *ERABModifyResponse) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_E_RAB_MODIFY_LIST_BEARER_MOD_RES:
+		return ie.DecodeValue(&m.ERABModifyListBearerModRes)
+	case ID_E_RAB_FAILED_TO_MODIFY_LIST:
+		return ie.DecodeValue(&m.ERABFailedToModifyList)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*ERABModifyResponse) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID}
+}
+
+// ERABReleaseCommand is sent by MME to release E-RABs in eNB.
+// UEAggregateMaximumBitrate is optional and NASPDU is omitted when empty.
+type ERABReleaseCommand struct {
+	MMEUES1APID               MMEUES1APID
+	ENBUES1APID               ENBUES1APID
+	UEAggregateMaximumBitrate *UEAggregateMaximumBitrate
+	ERABToBeReleasedList      ERABList
+	NASPDU                    NASPDU
+}
+
+func (*ERABReleaseCommand) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*ERABReleaseCommand) ProcedureCode() ProcedureCode { return PROC_E_RAB_RELEASE }
+func (*ERABReleaseCommand) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *ERABReleaseCommand) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	if m.UEAggregateMaximumBitrate != nil {
+		e.add(ID_UE_AGGREGATE_MAXIMUM_BITRATE, CRITICALITY_REJECT, m.UEAggregateMaximumBitrate)
+	}
+	e.add(ID_E_RAB_TO_BE_RELEASED_LIST, CRITICALITY_IGNORE, &m.ERABToBeReleasedList)
+	if len(m.NASPDU) > 0 {
+		e.add(ID_NAS_PDU, CRITICALITY_IGNORE, &m.NASPDU)
+	}
+}
+
+func (m *ERABReleaseCommand) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_UE_AGGREGATE_MAXIMUM_BITRATE:
+		m.UEAggregateMaximumBitrate = &UEAggregateMaximumBitrate{}
+		return ie.DecodeValue(m.UEAggregateMaximumBitrate)
+	case ID_E_RAB_TO_BE_RELEASED_LIST:
+		return ie.DecodeValue(&m.ERABToBeReleasedList)
+	case ID_NAS_PDU:
+		return ie.DecodeValue(&m.NASPDU)
+	}
+	return nil
+}
+
+func (*ERABReleaseCommand) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_E_RAB_TO_BE_RELEASED_LIST}
+}
+
+// ERABReleaseResponse is the response of E-RAB Release. E-RABs which the
+// eNB could not release are in ERABFailedToReleaseList with the cause.
+type ERABReleaseResponse struct {
+	MMEUES1APID                  MMEUES1APID
+	ENBUES1APID                  ENBUES1APID
+	ERABReleaseListBearerRelComp ERABReleaseListBearerRelComp
+	ERABFailedToReleaseList      ERABList
+	CriticalityDiagnostics       *CriticalityDiagnostics
+}
+
+func (*ERABReleaseResponse) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*ERABReleaseResponse) ProcedureCode() ProcedureCode { return PROC_E_RAB_RELEASE }
+func (*ERABReleaseResponse) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *ERABReleaseResponse) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	if len(m.ERABReleaseListBearerRelComp) > 0 {
+		e.add(ID_E_RAB_RELEASE_LIST_BEARER_REL_COMP, CRITICALITY_IGNORE, &m.ERABReleaseListBearerRelComp)
+	}
+	if len(m.ERABFailedToReleaseList) > 0 {
+		e.add(ID_E_RAB_FAILED_TO_RELEASE_LIST, CRITICALITY_IGNORE, &m.ERABFailedToReleaseList)
+	}
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *ERABReleaseResponse) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_E_RAB_RELEASE_LIST_BEARER_REL_COMP:
+		return ie.DecodeValue(&m.ERABReleaseListBearerRelComp)
+	case ID_E_RAB_FAILED_TO_RELEASE_LIST:
+		return ie.DecodeValue(&m.ERABFailedToReleaseList)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*ERABReleaseResponse) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID}
+}
+
+// ERABReleaseIndication is sent by eNB to indicate E-RABs which it has
+// released with the cause.
+type ERABReleaseIndication struct {
+	MMEUES1APID      MMEUES1APID
+	ENBUES1APID      ENBUES1APID
+	ERABReleasedList ERABList
+}
+
+func (*ERABReleaseIndication) PDUType() PDUType { return PDU_INITIATING_MESSAGE }
+func (*ERABReleaseIndication) ProcedureCode() ProcedureCode {
+	return PROC_E_RAB_RELEASE_INDICATION
+}
+func (*ERABReleaseIndication) Criticality() Criticality { return CRITICALITY_IGNORE }
+
+func (m *ERABReleaseIndication) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_E_RAB_RELEASED_LIST, CRITICALITY_IGNORE, &m.ERABReleasedList)
+}
+
+func (m *ERABReleaseIndication) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_E_RAB_RELEASED_LIST:
+		return ie.DecodeValue(&m.ERABReleasedList)
+	}
+	return nil
+}
+
+func (*ERABReleaseIndication) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_E_RAB_RELEASED_LIST}
+}
+
+func init() {
+	registerMessage(func() Message { return &ERABSetupRequest{} })
+	registerMessage(func() Message { return &ERABSetupResponse{} })
+	registerMessage(func() Message { return &ERABModifyRequest{} })
+	registerMessage(func() Message { return &ERABModifyResponse{} })
+	registerMessage(func() Message { return &ERABReleaseCommand{} })
+	registerMessage(func() Message { return &ERABReleaseResponse{} })
+	registerMessage(func() Message { return &ERABReleaseIndication{} })
+}
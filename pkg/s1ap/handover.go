@@ -0,0 +1,509 @@
+package s1ap
+
+// HandoverRequired is sent by the source eNB to start S1 handover.
+// DirectForwardingPathAvailability is set when direct data forwarding
+// between the eNBs is possible.
+type HandoverRequired struct {
+	MMEUES1APID                        MMEUES1APID
+	ENBUES1APID                        ENBUES1APID
+	HandoverType                       HandoverType
+	Cause                              Cause
+	TargetID                           TargetID
+	DirectForwardingPathAvailability   bool
+	SourceToTargetTransparentContainer TransparentContainer
+}
+
+func (*HandoverRequired) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*HandoverRequired) ProcedureCode() ProcedureCode { return PROC_HANDOVER_PREPARATION }
+func (*HandoverRequired) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *HandoverRequired) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_HANDOVER_TYPE, CRITICALITY_REJECT, &m.HandoverType)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+	e.add(ID_TARGET_ID, CRITICALITY_REJECT, &m.TargetID)
+	if m.DirectForwardingPathAvailability {
+		e.add(ID_DIRECT_FORWARDING_PATH_AVAILABILITY, CRITICALITY_IGNORE, &trueEnum{})
+	}
+	e.add(ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER, CRITICALITY_REJECT, &m.SourceToTargetTransparentContainer)
+}
+
+func (m *HandoverRequired) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_HANDOVER_TYPE:
+		return ie.DecodeValue(&m.HandoverType)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	case ID_TARGET_ID:
+		return ie.DecodeValue(&m.TargetID)
+	case ID_DIRECT_FORWARDING_PATH_AVAILABILITY:
+		m.DirectForwardingPathAvailability = true
+		return ie.DecodeValue(&trueEnum{})
+	case ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER:
+		return ie.DecodeValue(&m.SourceToTargetTransparentContainer)
+	}
+	return nil
+}
+
+func (*HandoverRequired) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_HANDOVER_TYPE, ID_CAUSE,
+		ID_TARGET_ID, ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER}
+}
+
+// HandoverCommand is the successful outcome of Handover Preparation sent
+// to the source eNB. E-RABs which the target eNB did not admit are in
+// ERABToReleaseList.
+type HandoverCommand struct {
+	MMEUES1APID                        MMEUES1APID
+	ENBUES1APID                        ENBUES1APID
+	HandoverType                       HandoverType
+	ERABSubjectToDataForwardingList    ERABSubjectToDataForwardingList
+	ERABToReleaseList                  ERABList
+	TargetToSourceTransparentContainer TransparentContainer
+	CriticalityDiagnostics             *CriticalityDiagnostics
+}
+
+func (*HandoverCommand) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*HandoverCommand) ProcedureCode() ProcedureCode { return PROC_HANDOVER_PREPARATION }
+func (*HandoverCommand) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *HandoverCommand) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_HANDOVER_TYPE, CRITICALITY_REJECT, &m.HandoverType)
+	if len(m.ERABSubjectToDataForwardingList) > 0 {
+		e.add(ID_E_RAB_SUBJECT_TO_DATA_FORWARDING_LIST, CRITICALITY_IGNORE, &m.ERABSubjectToDataForwardingList)
+	}
+	if len(m.ERABToReleaseList) > 0 {
+		e.add(ID_E_RAB_TO_RELEASE_LIST_HO_CMD, CRITICALITY_IGNORE, &m.ERABToReleaseList)
+	}
+	e.add(ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER, CRITICALITY_REJECT, &m.TargetToSourceTransparentContainer)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *HandoverCommand) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_HANDOVER_TYPE:
+		return ie.DecodeValue(&m.HandoverType)
+	case ID_E_RAB_SUBJECT_TO_DATA_FORWARDING_LIST:
+		return ie.DecodeValue(&m.ERABSubjectToDataForwardingList)
+	case ID_E_RAB_TO_RELEASE_LIST_HO_CMD:
+		return ie.DecodeValue(&m.ERABToReleaseList)
+	case ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER:
+		return ie.DecodeValue(&m.TargetToSourceTransparentContainer)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*HandoverCommand) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_HANDOVER_TYPE,
+		ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER}
+}
+
+// HandoverPreparationFailure is the unsuccessful outcome of Handover
+// Preparation sent to the source eNB.
+type HandoverPreparationFailure struct {
+	MMEUES1APID            MMEUES1APID
+	ENBUES1APID            ENBUES1APID
+	Cause                  Cause
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*HandoverPreparationFailure) PDUType() PDUType { return PDU_UNSUCCESSFUL_OUTCOME }
+func (*HandoverPreparationFailure) ProcedureCode() ProcedureCode {
+	return PROC_HANDOVER_PREPARATION
+}
+func (*HandoverPreparationFailure) Criticality() Criticality { return CRITICALITY_REJECT }
+
+func (m *HandoverPreparationFailure) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *HandoverPreparationFailure) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*HandoverPreparationFailure) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_CAUSE}
+}
+
+// HandoverRequest is sent by MME to the target eNB to allocate resources
+// for the UE. SecurityContext carries NH and NCC for the target KeNB.
+type HandoverRequest struct {
+	MMEUES1APID                        MMEUES1APID
+	HandoverType                       HandoverType
+	Cause                              Cause
+	UEAggregateMaximumBitrate          UEAggregateMaximumBitrate
+	ERABToBeSetupListHOReq             ERABToBeSetupListHOReq
+	SourceToTargetTransparentContainer TransparentContainer
+	UESecurityCapabilities             UESecurityCapabilities
+	HandoverRestrictionList            *HandoverRestrictionList
+	SRVCCOperationPossible             bool
+	SecurityContext                    SecurityContext
+}
+
+func (*HandoverRequest) PDUType() PDUType { return PDU_INITIATING_MESSAGE }
+func (*HandoverRequest) ProcedureCode() ProcedureCode {
+	return PROC_HANDOVER_RESOURCE_ALLOCATION
+}
+func (*HandoverRequest) Criticality() Criticality { return CRITICALITY_REJECT }
+
+func (m *HandoverRequest) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_HANDOVER_TYPE, CRITICALITY_REJECT, &m.HandoverType)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+	e.add(ID_UE_AGGREGATE_MAXIMUM_BITRATE, CRITICALITY_REJECT, &m.UEAggregateMaximumBitrate)
+	e.add(ID_E_RAB_TO_BE_SETUP_LIST_HO_REQ, CRITICALITY_REJECT, &m.ERABToBeSetupListHOReq)
+	e.add(ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER, CRITICALITY_REJECT, &m.SourceToTargetTransparentContainer)
+	e.add(ID_UE_SECURITY_CAPABILITIES, CRITICALITY_REJECT, &m.UESecurityCapabilities)
+	if m.HandoverRestrictionList != nil {
+		e.add(ID_HANDOVER_RESTRICTION_LIST, CRITICALITY_IGNORE, m.HandoverRestrictionList)
+	}
+	if m.SRVCCOperationPossible {
+		e.add(ID_SRVCC_OPERATION_POSSIBLE, CRITICALITY_IGNORE, &trueEnum{})
+	}
+	e.add(ID_SECURITY_CONTEXT, CRITICALITY_REJECT, &m.SecurityContext)
+}
+
+func (m *HandoverRequest) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_HANDOVER_TYPE:
+		return ie.DecodeValue(&m.HandoverType)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	case ID_UE_AGGREGATE_MAXIMUM_BITRATE:
+		return ie.DecodeValue(&m.UEAggregateMaximumBitrate)
+	case ID_E_RAB_TO_BE_SETUP_LIST_HO_REQ:
+		return ie.DecodeValue(&m.ERABToBeSetupListHOReq)
+	case ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER:
+		return ie.DecodeValue(&m.SourceToTargetTransparentContainer)
+	case ID_UE_SECURITY_CAPABILITIES:
+		return ie.DecodeValue(&m.UESecurityCapabilities)
+	case ID_HANDOVER_RESTRICTION_LIST:
+		m.HandoverRestrictionList = &HandoverRestrictionList{}
+		return ie.DecodeValue(m.HandoverRestrictionList)
+	case ID_SRVCC_OPERATION_POSSIBLE:
+		m.SRVCCOperationPossible = true
+		return ie.DecodeValue(&trueEnum{})
+	case ID_SECURITY_CONTEXT:
+		return ie.DecodeValue(&m.SecurityContext)
+	}
+	return nil
+}
+
+func (*HandoverRequest) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_HANDOVER_TYPE, ID_CAUSE, ID_UE_AGGREGATE_MAXIMUM_BITRATE,
+		ID_E_RAB_TO_BE_SETUP_LIST_HO_REQ, ID_SOURCE_TO_TARGET_TRANSPARENT_CONTAINER,
+		ID_UE_SECURITY_CAPABILITIES, ID_SECURITY_CONTEXT}
+}
+
+// HandoverRequestAcknowledge is the successful outcome of Handover Resource
+// Allocation with the S1-U endpoints of the target eNB.
+type HandoverRequestAcknowledge struct {
+	MMEUES1APID                        MMEUES1APID
+	ENBUES1APID                        ENBUES1APID
+	ERABAdmittedList                   ERABAdmittedList
+	ERABFailedToSetupList              ERABFailedToSetupListHOReqAck
+	TargetToSourceTransparentContainer TransparentContainer
+	CriticalityDiagnostics             *CriticalityDiagnostics
+}
+
+func (*HandoverRequestAcknowledge) PDUType() PDUType { return PDU_SUCCESSFUL_OUTCOME }
+func (*HandoverRequestAcknowledge) ProcedureCode() ProcedureCode {
+	return PROC_HANDOVER_RESOURCE_ALLOCATION
+}
+func (*HandoverRequestAcknowledge) Criticality() Criticality { return CRITICALITY_REJECT }
+
+func (m *HandoverRequestAcknowledge) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	e.add(ID_E_RAB_ADMITTED_LIST, CRITICALITY_IGNORE, &m.ERABAdmittedList)
+	if len(m.ERABFailedToSetupList) > 0 {
+		e.add(ID_E_RAB_FAILED_TO_SETUP_LIST_HO_REQ_ACK, CRITICALITY_IGNORE, &m.ERABFailedToSetupList)
+	}
+	e.add(ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER, CRITICALITY_REJECT, &m.TargetToSourceTransparentContainer)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *HandoverRequestAcknowledge) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_E_RAB_ADMITTED_LIST:
+		return ie.DecodeValue(&m.ERABAdmittedList)
+	case ID_E_RAB_FAILED_TO_SETUP_LIST_HO_REQ_ACK:
+		return ie.DecodeValue(&m.ERABFailedToSetupList)
+	case ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER:
+		return ie.DecodeValue(&m.TargetToSourceTransparentContainer)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*HandoverRequestAcknowledge) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_E_RAB_ADMITTED_LIST,
+		ID_TARGET_TO_SOURCE_TRANSPARENT_CONTAINER}
+}
+
+// HandoverFailure is the unsuccessful outcome of Handover Resource
+// Allocation.
+type HandoverFailure struct {
+	MMEUES1APID            MMEUES1APID
+	Cause                  Cause
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*HandoverFailure) PDUType() PDUType             { return PDU_UNSUCCESSFUL_OUTCOME }
+func (*HandoverFailure) ProcedureCode() ProcedureCode { return PROC_HANDOVER_RESOURCE_ALLOCATION }
+func (*HandoverFailure) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *HandoverFailure) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *HandoverFailure) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*HandoverFailure) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_CAUSE}
+}
+
+// HandoverNotify is sent by the target eNB when the UE has arrived.
+type HandoverNotify struct {
+	MMEUES1APID MMEUES1APID
+	ENBUES1APID ENBUES1APID
+	EUTRANCGI   EUTRANCGI
+	TAI         TAI
+}
+
+func (*HandoverNotify) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*HandoverNotify) ProcedureCode() ProcedureCode { return PROC_HANDOVER_NOTIFICATION }
+func (*HandoverNotify) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *HandoverNotify) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_EUTRAN_CGI, CRITICALITY_IGNORE, &m.EUTRANCGI)
+	e.add(ID_TAI, CRITICALITY_IGNORE, &m.TAI)
+}
+
+func (m *HandoverNotify) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_EUTRAN_CGI:
+		return ie.DecodeValue(&m.EUTRANCGI)
+	case ID_TAI:
+		return ie.DecodeValue(&m.TAI)
+	}
+	return nil
+}
+
+func (*HandoverNotify) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_EUTRAN_CGI, ID_TAI}
+}
+
+// HandoverCancel is sent by the source eNB to cancel an ongoing handover.
+type HandoverCancel struct {
+	MMEUES1APID MMEUES1APID
+	ENBUES1APID ENBUES1APID
+	Cause       Cause
+}
+
+func (*HandoverCancel) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*HandoverCancel) ProcedureCode() ProcedureCode { return PROC_HANDOVER_CANCEL }
+func (*HandoverCancel) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *HandoverCancel) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+}
+
+func (m *HandoverCancel) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	}
+	return nil
+}
+
+func (*HandoverCancel) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_CAUSE}
+}
+
+// HandoverCancelAcknowledge is the successful outcome of Handover Cancel.
+type HandoverCancelAcknowledge struct {
+	MMEUES1APID            MMEUES1APID
+	ENBUES1APID            ENBUES1APID
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*HandoverCancelAcknowledge) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*HandoverCancelAcknowledge) ProcedureCode() ProcedureCode { return PROC_HANDOVER_CANCEL }
+func (*HandoverCancelAcknowledge) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *HandoverCancelAcknowledge) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_IGNORE, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_IGNORE, &m.ENBUES1APID)
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *HandoverCancelAcknowledge) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*HandoverCancelAcknowledge) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID}
+}
+
+// ENBStatusTransfer is sent by the source eNB with the PDCP sequence
+// number status of the E-RABs.
+type ENBStatusTransfer struct {
+	MMEUES1APID                           MMEUES1APID
+	ENBUES1APID                           ENBUES1APID
+	ENBStatusTransferTransparentContainer ENBStatusTransferTransparentContainer
+}
+
+func (*ENBStatusTransfer) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*ENBStatusTransfer) ProcedureCode() ProcedureCode { return PROC_ENB_STATUS_TRANSFER }
+func (*ENBStatusTransfer) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *ENBStatusTransfer) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER, CRITICALITY_REJECT, &m.ENBStatusTransferTransparentContainer)
+}
+
+func (m *ENBStatusTransfer) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER:
+		return ie.DecodeValue(&m.ENBStatusTransferTransparentContainer)
+	}
+	return nil
+}
+
+func (*ENBStatusTransfer) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER}
+}
+
+// MMEStatusTransfer relays the container of ENBStatusTransfer to the
+// target eNB.
+type MMEStatusTransfer struct {
+	MMEUES1APID                           MMEUES1APID
+	ENBUES1APID                           ENBUES1APID
+	ENBStatusTransferTransparentContainer ENBStatusTransferTransparentContainer
+}
+
+func (*MMEStatusTransfer) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*MMEStatusTransfer) ProcedureCode() ProcedureCode { return PROC_MME_STATUS_TRANSFER }
+func (*MMEStatusTransfer) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *MMEStatusTransfer) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER, CRITICALITY_REJECT, &m.ENBStatusTransferTransparentContainer)
+}
+
+func (m *MMEStatusTransfer) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER:
+		return ie.DecodeValue(&m.ENBStatusTransferTransparentContainer)
+	}
+	return nil
+}
+
+func (*MMEStatusTransfer) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_ENB_STATUS_TRANSFER_TRANSPARENT_CONTAINER}
+}
+
+func init() {
+	registerMessage(func() Message { return &HandoverRequired{} })
+	registerMessage(func() Message { return &HandoverCommand{} })
+	registerMessage(func() Message { return &HandoverPreparationFailure{} })
+	registerMessage(func() Message { return &HandoverRequest{} })
+	registerMessage(func() Message { return &HandoverRequestAcknowledge{} })
+	registerMessage(func() Message { return &HandoverFailure{} })
+	registerMessage(func() Message { return &HandoverNotify{} })
+	registerMessage(func() Message { return &HandoverCancel{} })
+	registerMessage(func() Message { return &HandoverCancelAcknowledge{} })
+	registerMessage(func() Message { return &ENBStatusTransfer{} })
+	registerMessage(func() Message { return &MMEStatusTransfer{} })
+}
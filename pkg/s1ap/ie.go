@@ -0,0 +1,2228 @@
+package s1ap
+
+import (
+	"fmt"
+)
+
+// ENBUES1APID is eNB-UE-S1AP-ID INTEGER (0..16777215).
+type ENBUES1APID uint32
+
+func (v ENBUES1APID) encode(w *perWriter) {
+	w.putConstrainedWholeNumber(int64(v), 0, 16777215)
+}
+
+func (v *ENBUES1APID) decode(r *perReader) {
+	*v = ENBUES1APID(r.getConstrainedWholeNumber(0, 16777215))
+}
+
+// MMEUES1APID is MME-UE-S1AP-ID INTEGER (0..4294967295).
+type MMEUES1APID uint32
+
+func (v MMEUES1APID) encode(w *perWriter) {
+	w.putConstrainedWholeNumber(int64(v), 0, 4294967295)
+}
+
+func (v *MMEUES1APID) decode(r *perReader) {
+	*v = MMEUES1APID(r.getConstrainedWholeNumber(0, 4294967295))
+}
+
+// NASPDU is NAS-PDU OCTET STRING.
+type NASPDU []byte
+
+func (v NASPDU) encode(w *perWriter) {
+	w.putOctetString(v, 0, -1, false)
+}
+
+func (v *NASPDU) decode(r *perReader) {
+	*v = r.getOctetString(0, -1, false)
+}
+
+// PLMNIdentity is TBCD encoded PLMN identity OCTET STRING (SIZE (3)).
+type PLMNIdentity [3]byte
+
+func (v PLMNIdentity) encode(w *perWriter) {
+	w.putOctetString(v[:], 3, 3, false)
+}
+
+func (v *PLMNIdentity) decode(r *perReader) {
+	copy(v[:], r.getOctetString(3, 3, false))
+}
+
+// TAI is tracking area identity.
+type TAI struct {
+	PLMNIdentity PLMNIdentity
+	TAC          uint16
+}
+
+func putUint16(w *perWriter, v uint16) {
+	w.putOctetString([]byte{byte(v >> 8), byte(v)}, 2, 2, false)
+}
+
+func getUint16(r *perReader) uint16 {
+	b := r.getOctetString(2, 2, false)
+	if len(b) != 2 {
+		return 0
+	}
+	return uint16(b[0])<<8 | uint16(b[1])
+}
+
+func (v TAI) encode(w *perWriter) {
+	w.putBool(false) // Extension.
+	w.putBool(false) // iE-Extensions.
+	v.PLMNIdentity.encode(w)
+	putUint16(w, v.TAC)
+}
+
+func (v *TAI) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PLMNIdentity.decode(r)
+	v.TAC = getUint16(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// EUTRANCGI is E-UTRAN cell global identifier. CellID is 28 bits.
+type EUTRANCGI struct {
+	PLMNIdentity PLMNIdentity
+	CellID       uint32
+}
+
+func putBitsUint32(w *perWriter, v uint32, n int) {
+	v <<= uint(32 - n)
+	w.putBitString([]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}, n, n, n, false)
+}
+
+func getBitsUint32(r *perReader, n int) uint32 {
+	b, _ := r.getBitString(n, n, false)
+	var v uint32
+	for i := 0; i < 4; i++ {
+		v <<= 8
+		if i < len(b) {
+			v |= uint32(b[i])
+		}
+	}
+	return v >> uint(32-n)
+}
+
+func (v EUTRANCGI) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.PLMNIdentity.encode(w)
+	putBitsUint32(w, v.CellID, 28)
+}
+
+func (v *EUTRANCGI) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PLMNIdentity.decode(r)
+	v.CellID = getBitsUint32(r, 28)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// STMSI is S-TMSI which consists of MME code and M-TMSI.
+type STMSI struct {
+	MMEC  uint8
+	MTMSI uint32
+}
+
+func (v STMSI) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	w.putOctetString([]byte{v.MMEC}, 1, 1, false)
+	w.putOctetString([]byte{
+		byte(v.MTMSI >> 24), byte(v.MTMSI >> 16), byte(v.MTMSI >> 8), byte(v.MTMSI),
+	}, 4, 4, false)
+}
+
+func (v *STMSI) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	if b := r.getOctetString(1, 1, false); len(b) == 1 {
+		v.MMEC = b[0]
+	}
+	if b := r.getOctetString(4, 4, false); len(b) == 4 {
+		v.MTMSI = uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ServedGUMMEIsItem is a set of served PLMNs, MME group IDs and MME codes.
+type ServedGUMMEIsItem struct {
+	ServedPLMNs    []PLMNIdentity
+	ServedGroupIDs []uint16
+	ServedMMECs    []uint8
+}
+
+func (v ServedGUMMEIsItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	w.putLength(len(v.ServedPLMNs), 1, maxnoofPLMNsPerMME)
+	for _, plmn := range v.ServedPLMNs {
+		plmn.encode(w)
+	}
+	w.putLength(len(v.ServedGroupIDs), 1, maxnoofGroupIDs)
+	for _, id := range v.ServedGroupIDs {
+		putUint16(w, id)
+	}
+	w.putLength(len(v.ServedMMECs), 1, maxnoofMMECs)
+	for _, code := range v.ServedMMECs {
+		w.putOctetString([]byte{code}, 1, 1, false)
+	}
+}
+
+func (v *ServedGUMMEIsItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	n := r.getLength(1, maxnoofPLMNsPerMME)
+	v.ServedPLMNs = make([]PLMNIdentity, n)
+	for i := range v.ServedPLMNs {
+		v.ServedPLMNs[i].decode(r)
+	}
+	n = r.getLength(1, maxnoofGroupIDs)
+	v.ServedGroupIDs = make([]uint16, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		v.ServedGroupIDs = append(v.ServedGroupIDs, getUint16(r))
+	}
+	n = r.getLength(1, maxnoofMMECs)
+	v.ServedMMECs = make([]uint8, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		if b := r.getOctetString(1, 1, false); len(b) == 1 {
+			v.ServedMMECs = append(v.ServedMMECs, b[0])
+		}
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ServedGUMMEIs is SEQUENCE (SIZE (1..maxnoofRATs)) OF ServedGUMMEIsItem.
+type ServedGUMMEIs []ServedGUMMEIsItem
+
+func (v ServedGUMMEIs) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofRATs)
+	for _, item := range v {
+		item.encode(w)
+	}
+}
+
+func (v *ServedGUMMEIs) decode(r *perReader) {
+	n := r.getLength(1, maxnoofRATs)
+	*v = make(ServedGUMMEIs, n)
+	for i := range *v {
+		(*v)[i].decode(r)
+	}
+}
+
+// RelativeMMECapacity INTEGER (0..255).
+type RelativeMMECapacity uint8
+
+func (v RelativeMMECapacity) encode(w *perWriter) {
+	w.putConstrainedWholeNumber(int64(v), 0, 255)
+}
+
+func (v *RelativeMMECapacity) decode(r *perReader) {
+	*v = RelativeMMECapacity(r.getConstrainedWholeNumber(0, 255))
+}
+
+// BitRate INTEGER (0..10000000000) in bit/s.
+type BitRate uint64
+
+const maxBitRate = 10000000000
+
+func (v BitRate) encode(w *perWriter) {
+	w.putConstrainedWholeNumber(int64(v), 0, maxBitRate)
+}
+
+func (v *BitRate) decode(r *perReader) {
+	*v = BitRate(r.getConstrainedWholeNumber(0, maxBitRate))
+}
+
+// UEAggregateMaximumBitrate is UE-AMBR.
+type UEAggregateMaximumBitrate struct {
+	DL BitRate
+	UL BitRate
+}
+
+func (v UEAggregateMaximumBitrate) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.DL.encode(w)
+	v.UL.encode(w)
+}
+
+func (v *UEAggregateMaximumBitrate) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.DL.decode(r)
+	v.UL.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// SecurityKey is SecurityKey BIT STRING (SIZE (256)) which carries KeNB or
+// NH.
+type SecurityKey [32]byte
+
+func (v SecurityKey) encode(w *perWriter) {
+	w.putBitString(v[:], 256, 256, 256, false)
+}
+
+func (v *SecurityKey) decode(r *perReader) {
+	b, _ := r.getBitString(256, 256, false)
+	copy(v[:], b)
+}
+
+// UESecurityCapabilities is UESecurityCapabilities. The first (most
+// significant) bit of each algorithm bitmap is 128-EEA1 and 128-EIA1.
+type UESecurityCapabilities struct {
+	EncryptionAlgorithms          uint16
+	IntegrityProtectionAlgorithms uint16
+}
+
+func (v UESecurityCapabilities) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	w.putBitString([]byte{byte(v.EncryptionAlgorithms >> 8), byte(v.EncryptionAlgorithms)}, 16, 16, 16, true)
+	w.putBitString([]byte{byte(v.IntegrityProtectionAlgorithms >> 8), byte(v.IntegrityProtectionAlgorithms)}, 16, 16, 16, true)
+}
+
+func getAlgorithmBits(r *perReader) uint16 {
+	b, n := r.getBitString(16, 16, true)
+	var v uint16
+	for i := 0; i < 2; i++ {
+		v <<= 8
+		if i < len(b) && i*8 < n {
+			v |= uint16(b[i])
+		}
+	}
+	return v
+}
+
+func (v *UESecurityCapabilities) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.EncryptionAlgorithms = getAlgorithmBits(r)
+	v.IntegrityProtectionAlgorithms = getAlgorithmBits(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// CauseGroup is the choice of Cause.
+type CauseGroup uint8
+
+const (
+	CAUSE_RADIO_NETWORK CauseGroup = iota
+	CAUSE_TRANSPORT
+	CAUSE_NAS
+	CAUSE_PROTOCOL
+	CAUSE_MISC
+)
+
+// Number of root values of each cause group enumeration.
+var causeRootCount = [...]int{36, 2, 4, 7, 6}
+
+// CauseRadioNetwork values.
+const (
+	CAUSE_RADIO_NETWORK_UNSPECIFIED                                   = 0
+	CAUSE_RADIO_NETWORK_TX2RELOCOVERALL_EXPIRY                        = 1
+	CAUSE_RADIO_NETWORK_SUCCESSFUL_HANDOVER                           = 2
+	CAUSE_RADIO_NETWORK_RELEASE_DUE_TO_EUTRAN_GENERATED_REASON        = 3
+	CAUSE_RADIO_NETWORK_HANDOVER_CANCELLED                            = 4
+	CAUSE_RADIO_NETWORK_PARTIAL_HANDOVER                              = 5
+	CAUSE_RADIO_NETWORK_HO_FAILURE_IN_TARGET_EPC_ENB_OR_TARGET_SYSTEM = 6
+	CAUSE_RADIO_NETWORK_HO_TARGET_NOT_ALLOWED                         = 7
+	CAUSE_RADIO_NETWORK_TS1RELOCOVERALL_EXPIRY                        = 8
+	CAUSE_RADIO_NETWORK_TS1RELOCPREP_EXPIRY                           = 9
+	CAUSE_RADIO_NETWORK_CELL_NOT_AVAILABLE                            = 10
+	CAUSE_RADIO_NETWORK_UNKNOWN_TARGETID                              = 11
+	CAUSE_RADIO_NETWORK_NO_RADIO_RESOURCES_AVAILABLE_IN_TARGET_CELL   = 12
+	CAUSE_RADIO_NETWORK_UNKNOWN_MME_UE_S1AP_ID                        = 13
+	CAUSE_RADIO_NETWORK_UNKNOWN_ENB_UE_S1AP_ID                        = 14
+	CAUSE_RADIO_NETWORK_UNKNOWN_PAIR_UE_S1AP_ID                       = 15
+	CAUSE_RADIO_NETWORK_HANDOVER_DESIRABLE_FOR_RADIO_REASON           = 16
+	CAUSE_RADIO_NETWORK_TIME_CRITICAL_HANDOVER                        = 17
+	CAUSE_RADIO_NETWORK_RESOURCE_OPTIMISATION_HANDOVER                = 18
+	CAUSE_RADIO_NETWORK_REDUCE_LOAD_IN_SERVING_CELL                   = 19
+	CAUSE_RADIO_NETWORK_USER_INACTIVITY                               = 20
+	CAUSE_RADIO_NETWORK_RADIO_CONNECTION_WITH_UE_LOST                 = 21
+	CAUSE_RADIO_NETWORK_LOAD_BALANCING_TAU_REQUIRED                   = 22
+	CAUSE_RADIO_NETWORK_CS_FALLBACK_TRIGGERED                         = 23
+	CAUSE_RADIO_NETWORK_UE_NOT_AVAILABLE_FOR_PS_SERVICE               = 24
+	CAUSE_RADIO_NETWORK_RADIO_RESOURCES_NOT_AVAILABLE                 = 25
+	CAUSE_RADIO_NETWORK_FAILURE_IN_RADIO_INTERFACE_PROCEDURE          = 26
+	CAUSE_RADIO_NETWORK_INVALID_QOS_COMBINATION                       = 27
+	CAUSE_RADIO_NETWORK_INTERRAT_REDIRECTION                          = 28
+	CAUSE_RADIO_NETWORK_INTERACTION_WITH_OTHER_PROCEDURE              = 29
+	CAUSE_RADIO_NETWORK_UNKNOWN_E_RAB_ID                              = 30
+	CAUSE_RADIO_NETWORK_MULTIPLE_E_RAB_ID_INSTANCES                   = 31
+	CAUSE_RADIO_NETWORK_ENCRYPTION_AND_OR_INTEGRITY_NOT_SUPPORTED     = 32
+	CAUSE_RADIO_NETWORK_S1_INTRA_SYSTEM_HANDOVER_TRIGGERED            = 33
+	CAUSE_RADIO_NETWORK_S1_INTER_SYSTEM_HANDOVER_TRIGGERED            = 34
+	CAUSE_RADIO_NETWORK_X2_HANDOVER_TRIGGERED                         = 35
+)
+
+// CauseTransport values.
+const (
+	CAUSE_TRANSPORT_RESOURCE_UNAVAILABLE = 0
+	CAUSE_TRANSPORT_UNSPECIFIED          = 1
+)
+
+// CauseNas values.
+const (
+	CAUSE_NAS_NORMAL_RELEASE         = 0
+	CAUSE_NAS_AUTHENTICATION_FAILURE = 1
+	CAUSE_NAS_DETACH                 = 2
+	CAUSE_NAS_UNSPECIFIED            = 3
+)
+
+// CauseProtocol values.
+const (
+	CAUSE_PROTOCOL_TRANSFER_SYNTAX_ERROR                             = 0
+	CAUSE_PROTOCOL_ABSTRACT_SYNTAX_ERROR_REJECT                      = 1
+	CAUSE_PROTOCOL_ABSTRACT_SYNTAX_ERROR_IGNORE_AND_NOTIFY           = 2
+	CAUSE_PROTOCOL_MESSAGE_NOT_COMPATIBLE_WITH_RECEIVER_STATE        = 3
+	CAUSE_PROTOCOL_SEMANTIC_ERROR                                    = 4
+	CAUSE_PROTOCOL_ABSTRACT_SYNTAX_ERROR_FALSELY_CONSTRUCTED_MESSAGE = 5
+	CAUSE_PROTOCOL_UNSPECIFIED                                       = 6
+)
+
+// CauseMisc values.
+const (
+	CAUSE_MISC_CONTROL_PROCESSING_OVERLOAD                = 0
+	CAUSE_MISC_NOT_ENOUGH_USER_PLANE_PROCESSING_RESOURCES = 1
+	CAUSE_MISC_HARDWARE_FAILURE                           = 2
+	CAUSE_MISC_OM_INTERVENTION                            = 3
+	CAUSE_MISC_UNSPECIFIED                                = 4
+	CAUSE_MISC_UNKNOWN_PLMN                               = 5
+)
+
+// Cause is S1AP Cause. Value is the enumeration value in the group.
+type Cause struct {
+	Group CauseGroup
+	Value uint8
+}
+
+func (v Cause) String() string {
+	names := [...]string{"radioNetwork", "transport", "nas", "protocol", "misc"}
+	if int(v.Group) < len(names) {
+		return fmt.Sprintf("%s(%d)", names[v.Group], v.Value)
+	}
+	return fmt.Sprintf("unknown(%d)", v.Value)
+}
+
+func (v Cause) encode(w *perWriter) {
+	if int(v.Group) >= len(causeRootCount) {
+		w.setError("unknown cause group %d", v.Group)
+		return
+	}
+	w.putChoiceIndex(int(v.Group), len(causeRootCount), true)
+	w.putEnumerated(int(v.Value), causeRootCount[v.Group], true)
+}
+
+func (v *Cause) decode(r *perReader) {
+	idx := r.getChoiceIndex(len(causeRootCount), true)
+	if idx >= len(causeRootCount) {
+		r.setError("unknown cause group %d", idx)
+		return
+	}
+	v.Group = CauseGroup(idx)
+	v.Value = uint8(r.getEnumerated(causeRootCount[idx], true))
+}
+
+// RRCEstablishmentCause is RRC-Establishment-Cause ENUMERATED.
+type RRCEstablishmentCause uint8
+
+const (
+	RRC_ESTABLISHMENT_CAUSE_EMERGENCY RRCEstablishmentCause = iota
+	RRC_ESTABLISHMENT_CAUSE_HIGH_PRIORITY_ACCESS
+	RRC_ESTABLISHMENT_CAUSE_MT_ACCESS
+	RRC_ESTABLISHMENT_CAUSE_MO_SIGNALLING
+	RRC_ESTABLISHMENT_CAUSE_MO_DATA
+	RRC_ESTABLISHMENT_CAUSE_DELAY_TOLERANT_ACCESS
+	RRC_ESTABLISHMENT_CAUSE_MO_VOICE_CALL
+	RRC_ESTABLISHMENT_CAUSE_MO_EXCEPTION_DATA
+)
+
+func (v RRCEstablishmentCause) encode(w *perWriter) {
+	w.putEnumerated(int(v), 5, true)
+}
+
+func (v *RRCEstablishmentCause) decode(r *perReader) {
+	*v = RRCEstablishmentCause(r.getEnumerated(5, true))
+}
+
+// CSGID is CSG-Id BIT STRING (SIZE (27)).
+type CSGID uint32
+
+func (v CSGID) encode(w *perWriter) {
+	putBitsUint32(w, uint32(v), 27)
+}
+
+func (v *CSGID) decode(r *perReader) {
+	*v = CSGID(getBitsUint32(r, 27))
+}
+
+// GUMMEI is globally unique MME identifier.
+type GUMMEI struct {
+	PLMNIdentity PLMNIdentity
+	MMEGI        uint16
+	MMEC         uint8
+}
+
+func (v GUMMEI) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.PLMNIdentity.encode(w)
+	putUint16(w, v.MMEGI)
+	w.putOctetString([]byte{v.MMEC}, 1, 1, false)
+}
+
+func (v *GUMMEI) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PLMNIdentity.decode(r)
+	v.MMEGI = getUint16(r)
+	if b := r.getOctetString(1, 1, false); len(b) == 1 {
+		v.MMEC = b[0]
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// GUMMEIType is GUMMEIType ENUMERATED.
+type GUMMEIType uint8
+
+const (
+	GUMMEI_TYPE_NATIVE GUMMEIType = iota
+	GUMMEI_TYPE_MAPPED
+)
+
+func (v GUMMEIType) encode(w *perWriter) {
+	w.putEnumerated(int(v), 2, true)
+}
+
+func (v *GUMMEIType) decode(r *perReader) {
+	*v = GUMMEIType(r.getEnumerated(2, true))
+}
+
+// CellAccessMode is CellAccessMode ENUMERATED { hybrid, ... }.
+type CellAccessMode uint8
+
+const (
+	CELL_ACCESS_MODE_HYBRID CellAccessMode = iota
+)
+
+func (v CellAccessMode) encode(w *perWriter) {
+	w.putEnumerated(int(v), 1, true)
+}
+
+func (v *CellAccessMode) decode(r *perReader) {
+	*v = CellAccessMode(r.getEnumerated(1, true))
+}
+
+// trueEnum is ENUMERATED { true, ... } used by indicator IEs. Presence of
+// the IE is the indication.
+type trueEnum struct{}
+
+func (trueEnum) encode(w *perWriter) {
+	w.putEnumerated(0, 1, true)
+}
+
+func (*trueEnum) decode(r *perReader) {
+	r.getEnumerated(1, true)
+}
+
+// TransportLayerAddress is BIT STRING (SIZE (1..160, ...)). IPv4 or IPv6
+// address, or both concatenated.
+type TransportLayerAddress []byte
+
+func (v TransportLayerAddress) encode(w *perWriter) {
+	w.putBitString(v, len(v)*8, 1, 160, true)
+}
+
+func (v *TransportLayerAddress) decode(r *perReader) {
+	b, _ := r.getBitString(1, 160, true)
+	*v = b
+}
+
+// ENBIDType is the choice of ENB-ID.
+type ENBIDType uint8
+
+const (
+	ENB_ID_MACRO ENBIDType = iota
+	ENB_ID_HOME
+	ENB_ID_SHORT_MACRO
+	ENB_ID_LONG_MACRO
+)
+
+// Bit length of each ENB-ID alternative.
+var enbIDBits = [...]int{20, 28, 18, 21}
+
+// ENBID is ENB-ID. Value is the eNB identifier in the lower bits.
+type ENBID struct {
+	Type  ENBIDType
+	Value uint32
+}
+
+// enbIDValue is an ENB-ID alternative which is carried as open type when
+// it is an extension.
+type enbIDValue struct {
+	v uint32
+	n int
+}
+
+func (v enbIDValue) encode(w *perWriter) {
+	putBitsUint32(w, v.v, v.n)
+}
+
+func (v *enbIDValue) decode(r *perReader) {
+	v.v = getBitsUint32(r, v.n)
+}
+
+func (v ENBID) encode(w *perWriter) {
+	if int(v.Type) >= len(enbIDBits) {
+		w.setError("unknown eNB-ID type %d", v.Type)
+		return
+	}
+	w.putChoiceIndex(int(v.Type), 2, true)
+	val := enbIDValue{v.Value, enbIDBits[v.Type]}
+	if v.Type >= 2 {
+		w.putOpenValue(val)
+	} else {
+		val.encode(w)
+	}
+}
+
+func (v *ENBID) decode(r *perReader) {
+	idx := r.getChoiceIndex(2, true)
+	if r.err != nil {
+		return
+	}
+	if idx >= len(enbIDBits) {
+		r.setError("unknown eNB-ID type %d", idx)
+		return
+	}
+	v.Type = ENBIDType(idx)
+	val := enbIDValue{n: enbIDBits[idx]}
+	if idx >= 2 {
+		r.getOpenValue(&val)
+	} else {
+		val.decode(r)
+	}
+	v.Value = val.v
+}
+
+// GlobalENBID is Global-ENB-ID.
+type GlobalENBID struct {
+	PLMNIdentity PLMNIdentity
+	ENBID        ENBID
+}
+
+func (v GlobalENBID) String() string {
+	return fmt.Sprintf("%x/%d", v.PLMNIdentity[:], v.ENBID.Value)
+}
+
+func (v GlobalENBID) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.PLMNIdentity.encode(w)
+	v.ENBID.encode(w)
+}
+
+func (v *GlobalENBID) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PLMNIdentity.decode(r)
+	v.ENBID.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ENBname is PrintableString (SIZE (1..150,...)).
+type ENBname string
+
+func (v ENBname) encode(w *perWriter) {
+	w.putPrintableString(string(v), 1, 150, true)
+}
+
+func (v *ENBname) decode(r *perReader) {
+	*v = ENBname(r.getPrintableString(1, 150, true))
+}
+
+// SupportedTAsItem is a TAC and the PLMNs broadcast in it.
+type SupportedTAsItem struct {
+	TAC            uint16
+	BroadcastPLMNs []PLMNIdentity
+}
+
+func (v SupportedTAsItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	putUint16(w, v.TAC)
+	w.putLength(len(v.BroadcastPLMNs), 1, maxnoofBPLMNs)
+	for _, plmn := range v.BroadcastPLMNs {
+		plmn.encode(w)
+	}
+}
+
+func (v *SupportedTAsItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.TAC = getUint16(r)
+	n := r.getLength(1, maxnoofBPLMNs)
+	v.BroadcastPLMNs = make([]PLMNIdentity, n)
+	for i := range v.BroadcastPLMNs {
+		v.BroadcastPLMNs[i].decode(r)
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// SupportedTAs is SEQUENCE (SIZE(1..maxnoofTACs)) OF SupportedTAs-Item.
+type SupportedTAs []SupportedTAsItem
+
+func (v SupportedTAs) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofTACs)
+	for _, item := range v {
+		item.encode(w)
+	}
+}
+
+func (v *SupportedTAs) decode(r *perReader) {
+	n := r.getLength(1, maxnoofTACs)
+	*v = make(SupportedTAs, n)
+	for i := range *v {
+		(*v)[i].decode(r)
+	}
+}
+
+// CSGIDList is SEQUENCE (SIZE (1..maxnoofCSGs)) OF CSG-IdList-Item.
+type CSGIDList []CSGID
+
+func (v CSGIDList) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofCSGs)
+	for _, id := range v {
+		w.putBool(false)
+		w.putBool(false)
+		id.encode(w)
+	}
+}
+
+func (v *CSGIDList) decode(r *perReader) {
+	n := r.getLength(1, maxnoofCSGs)
+	*v = make(CSGIDList, n)
+	for i := range *v {
+		ext := r.getBool()
+		opt := r.getBool()
+		(*v)[i].decode(r)
+		if opt {
+			r.skipProtocolExtensions()
+		}
+		if ext {
+			r.skipExtensions()
+		}
+	}
+}
+
+// PagingDRX is PagingDRX ENUMERATED in radio frames.
+type PagingDRX uint8
+
+const (
+	PAGING_DRX_V32 PagingDRX = iota
+	PAGING_DRX_V64
+	PAGING_DRX_V128
+	PAGING_DRX_V256
+)
+
+func (v PagingDRX) encode(w *perWriter) {
+	w.putEnumerated(int(v), 4, true)
+}
+
+func (v *PagingDRX) decode(r *perReader) {
+	*v = PagingDRX(r.getEnumerated(4, true))
+}
+
+// TimeToWait is TimeToWait ENUMERATED.
+type TimeToWait uint8
+
+const (
+	TIME_TO_WAIT_V1S TimeToWait = iota
+	TIME_TO_WAIT_V2S
+	TIME_TO_WAIT_V5S
+	TIME_TO_WAIT_V10S
+	TIME_TO_WAIT_V20S
+	TIME_TO_WAIT_V60S
+)
+
+func (v TimeToWait) encode(w *perWriter) {
+	w.putEnumerated(int(v), 6, true)
+}
+
+func (v *TimeToWait) decode(r *perReader) {
+	*v = TimeToWait(r.getEnumerated(6, true))
+}
+
+// MMEname is PrintableString (SIZE (1..150,...)).
+type MMEname string
+
+func (v MMEname) encode(w *perWriter) {
+	w.putPrintableString(string(v), 1, 150, true)
+}
+
+func (v *MMEname) decode(r *perReader) {
+	*v = MMEname(r.getPrintableString(1, 150, true))
+}
+
+// TypeOfError is TypeOfError ENUMERATED.
+type TypeOfError uint8
+
+const (
+	TYPE_OF_ERROR_NOT_UNDERSTOOD TypeOfError = iota
+	TYPE_OF_ERROR_MISSING
+)
+
+// CriticalityDiagnosticsIEItem reports an IE which is not understood or
+// missing.
+type CriticalityDiagnosticsIEItem struct {
+	Criticality Criticality
+	ID          ProtocolIEID
+	TypeOfError TypeOfError
+}
+
+func (v CriticalityDiagnosticsIEItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	w.putEnumerated(int(v.Criticality), 3, false)
+	w.putConstrainedWholeNumber(int64(v.ID), 0, 65535)
+	w.putEnumerated(int(v.TypeOfError), 2, true)
+}
+
+func (v *CriticalityDiagnosticsIEItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.Criticality = Criticality(r.getEnumerated(3, false))
+	v.ID = ProtocolIEID(r.getConstrainedWholeNumber(0, 65535))
+	v.TypeOfError = TypeOfError(r.getEnumerated(2, true))
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// CriticalityDiagnostics is CriticalityDiagnostics. Every field is optional.
+type CriticalityDiagnostics struct {
+	ProcedureCode        *ProcedureCode
+	TriggeringMessage    *PDUType
+	ProcedureCriticality *Criticality
+	IEs                  []CriticalityDiagnosticsIEItem
+}
+
+func (v CriticalityDiagnostics) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(v.ProcedureCode != nil)
+	w.putBool(v.TriggeringMessage != nil)
+	w.putBool(v.ProcedureCriticality != nil)
+	w.putBool(len(v.IEs) > 0)
+	w.putBool(false)
+	if v.ProcedureCode != nil {
+		w.putConstrainedWholeNumber(int64(*v.ProcedureCode), 0, 255)
+	}
+	if v.TriggeringMessage != nil {
+		w.putEnumerated(int(*v.TriggeringMessage), 3, false)
+	}
+	if v.ProcedureCriticality != nil {
+		w.putEnumerated(int(*v.ProcedureCriticality), 3, false)
+	}
+	if len(v.IEs) > 0 {
+		w.putLength(len(v.IEs), 1, maxnoofErrors)
+		for _, item := range v.IEs {
+			item.encode(w)
+		}
+	}
+}
+
+func (v *CriticalityDiagnostics) decode(r *perReader) {
+	ext := r.getBool()
+	hasCode := r.getBool()
+	hasTrigger := r.getBool()
+	hasCrit := r.getBool()
+	hasIEs := r.getBool()
+	opt := r.getBool()
+	if hasCode {
+		code := ProcedureCode(r.getConstrainedWholeNumber(0, 255))
+		v.ProcedureCode = &code
+	}
+	if hasTrigger {
+		typ := PDUType(r.getEnumerated(3, false))
+		v.TriggeringMessage = &typ
+	}
+	if hasCrit {
+		crit := Criticality(r.getEnumerated(3, false))
+		v.ProcedureCriticality = &crit
+	}
+	if hasIEs {
+		n := r.getLength(1, maxnoofErrors)
+		v.IEs = make([]CriticalityDiagnosticsIEItem, n)
+		for i := range v.IEs {
+			v.IEs[i].decode(r)
+		}
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// NewCriticalityDiagnostics returns criticality diagnostics which reports
+// not understood IEs of the message.
+func NewCriticalityDiagnostics(m Message, ies []ProtocolIE) *CriticalityDiagnostics {
+	code := m.ProcedureCode()
+	typ := m.PDUType()
+	crit := m.Criticality()
+	diag := &CriticalityDiagnostics{
+		ProcedureCode:        &code,
+		TriggeringMessage:    &typ,
+		ProcedureCriticality: &crit,
+	}
+	for _, ie := range ies {
+		diag.IEs = append(diag.IEs, CriticalityDiagnosticsIEItem{
+			Criticality: ie.Criticality,
+			ID:          ie.ID,
+			TypeOfError: TYPE_OF_ERROR_NOT_UNDERSTOOD,
+		})
+	}
+	return diag
+}
+
+// putSingleContainer puts ProtocolIE-SingleContainer which is the item of
+// E-RAB lists.
+func putSingleContainer(w *perWriter, id ProtocolIEID, crit Criticality, v perEncoder) {
+	w.putConstrainedWholeNumber(int64(id), 0, 65535)
+	w.putEnumerated(int(crit), 3, false)
+	w.putOpenValue(v)
+}
+
+// getSingleContainer decodes ProtocolIE-SingleContainer into v.
+func getSingleContainer(r *perReader, id ProtocolIEID, v perDecoder) {
+	got := ProtocolIEID(r.getConstrainedWholeNumber(0, 65535))
+	r.getEnumerated(3, false)
+	if r.err == nil && got != id {
+		r.setError("unexpected IE %d in list of IE %d", got, id)
+		return
+	}
+	r.getOpenValue(v)
+}
+
+// ERABID is E-RAB-ID INTEGER (0..15, ...).
+type ERABID uint8
+
+func (v ERABID) encode(w *perWriter) {
+	w.putBool(false)
+	w.putConstrainedWholeNumber(int64(v), 0, 15)
+}
+
+func (v *ERABID) decode(r *perReader) {
+	if r.getExtensible() {
+		r.setError("E-RAB-ID out of range")
+		return
+	}
+	*v = ERABID(r.getConstrainedWholeNumber(0, 15))
+}
+
+// GTPTEID is GTP-TEID OCTET STRING (SIZE (4)).
+type GTPTEID uint32
+
+func (v GTPTEID) encode(w *perWriter) {
+	w.putOctetString([]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}, 4, 4, false)
+}
+
+func (v *GTPTEID) decode(r *perReader) {
+	b := r.getOctetString(4, 4, false)
+	if len(b) == 4 {
+		*v = GTPTEID(uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3]))
+	}
+}
+
+// Pre-emption capability and vulnerability.
+const (
+	PRE_EMPTION_CAPABILITY_SHALL_NOT_TRIGGER = 0
+	PRE_EMPTION_CAPABILITY_MAY_TRIGGER       = 1
+
+	PRE_EMPTION_VULNERABILITY_NOT_PRE_EMPTABLE = 0
+	PRE_EMPTION_VULNERABILITY_PRE_EMPTABLE     = 1
+)
+
+// AllocationAndRetentionPriority is ARP of the E-RAB.
+type AllocationAndRetentionPriority struct {
+	PriorityLevel           uint8
+	PreemptionCapability    uint8
+	PreemptionVulnerability uint8
+}
+
+func (v AllocationAndRetentionPriority) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	w.putConstrainedWholeNumber(int64(v.PriorityLevel), 0, 15)
+	w.putEnumerated(int(v.PreemptionCapability), 2, false)
+	w.putEnumerated(int(v.PreemptionVulnerability), 2, false)
+}
+
+func (v *AllocationAndRetentionPriority) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PriorityLevel = uint8(r.getConstrainedWholeNumber(0, 15))
+	v.PreemptionCapability = uint8(r.getEnumerated(2, false))
+	v.PreemptionVulnerability = uint8(r.getEnumerated(2, false))
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// GBRQosInformation is bit rates of GBR bearer.
+type GBRQosInformation struct {
+	MaximumBitrateDL    BitRate
+	MaximumBitrateUL    BitRate
+	GuaranteedBitrateDL BitRate
+	GuaranteedBitrateUL BitRate
+}
+
+func (v GBRQosInformation) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.MaximumBitrateDL.encode(w)
+	v.MaximumBitrateUL.encode(w)
+	v.GuaranteedBitrateDL.encode(w)
+	v.GuaranteedBitrateUL.encode(w)
+}
+
+func (v *GBRQosInformation) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.MaximumBitrateDL.decode(r)
+	v.MaximumBitrateUL.decode(r)
+	v.GuaranteedBitrateDL.decode(r)
+	v.GuaranteedBitrateUL.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABLevelQoSParameters is QoS of the E-RAB. GBR is present for GBR
+// bearer.
+type ERABLevelQoSParameters struct {
+	QCI uint8
+	ARP AllocationAndRetentionPriority
+	GBR *GBRQosInformation
+}
+
+func (v ERABLevelQoSParameters) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(v.GBR != nil)
+	w.putBool(false)
+	w.putConstrainedWholeNumber(int64(v.QCI), 0, 255)
+	v.ARP.encode(w)
+	if v.GBR != nil {
+		v.GBR.encode(w)
+	}
+}
+
+func (v *ERABLevelQoSParameters) decode(r *perReader) {
+	ext := r.getBool()
+	hasGBR := r.getBool()
+	opt := r.getBool()
+	v.QCI = uint8(r.getConstrainedWholeNumber(0, 255))
+	v.ARP.decode(r)
+	if hasGBR {
+		v.GBR = &GBRQosInformation{}
+		v.GBR.decode(r)
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABToBeSetupItemCtxtSUReq is E-RAB to be set up by Initial Context
+// Setup. NASPDU is omitted when empty.
+type ERABToBeSetupItemCtxtSUReq struct {
+	ERABID                 ERABID
+	ERABLevelQoSParameters ERABLevelQoSParameters
+	TransportLayerAddress  TransportLayerAddress
+	GTPTEID                GTPTEID
+	NASPDU                 NASPDU
+}
+
+func (v ERABToBeSetupItemCtxtSUReq) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(len(v.NASPDU) > 0)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.ERABLevelQoSParameters.encode(w)
+	v.TransportLayerAddress.encode(w)
+	v.GTPTEID.encode(w)
+	if len(v.NASPDU) > 0 {
+		v.NASPDU.encode(w)
+	}
+}
+
+func (v *ERABToBeSetupItemCtxtSUReq) decode(r *perReader) {
+	ext := r.getBool()
+	hasNAS := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.ERABLevelQoSParameters.decode(r)
+	v.TransportLayerAddress.decode(r)
+	v.GTPTEID.decode(r)
+	if hasNAS {
+		v.NASPDU.decode(r)
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABToBeSetupListCtxtSUReq is E-RAB-IE-ContainerList of
+// E-RABToBeSetupItemCtxtSUReq.
+type ERABToBeSetupListCtxtSUReq []ERABToBeSetupItemCtxtSUReq
+
+func (v ERABToBeSetupListCtxtSUReq) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_TO_BE_SETUP_ITEM_CTXT_SU_REQ, CRITICALITY_REJECT, item)
+	}
+}
+
+func (v *ERABToBeSetupListCtxtSUReq) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABToBeSetupListCtxtSUReq, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABToBeSetupItemCtxtSUReq{}
+		getSingleContainer(r, ID_E_RAB_TO_BE_SETUP_ITEM_CTXT_SU_REQ, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABSetupItemCtxtSURes is E-RAB set up by Initial Context Setup with the
+// eNB S1-U endpoint.
+type ERABSetupItemCtxtSURes struct {
+	ERABID                ERABID
+	TransportLayerAddress TransportLayerAddress
+	GTPTEID               GTPTEID
+}
+
+func (v ERABSetupItemCtxtSURes) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.TransportLayerAddress.encode(w)
+	v.GTPTEID.encode(w)
+}
+
+func (v *ERABSetupItemCtxtSURes) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.TransportLayerAddress.decode(r)
+	v.GTPTEID.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABSetupListCtxtSURes is E-RAB-IE-ContainerList of
+// E-RABSetupItemCtxtSURes.
+type ERABSetupListCtxtSURes []ERABSetupItemCtxtSURes
+
+func (v ERABSetupListCtxtSURes) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_SETUP_ITEM_CTXT_SU_RES, CRITICALITY_IGNORE, item)
+	}
+}
+
+func (v *ERABSetupListCtxtSURes) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABSetupListCtxtSURes, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABSetupItemCtxtSURes{}
+		getSingleContainer(r, ID_E_RAB_SETUP_ITEM_CTXT_SU_RES, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABItem is E-RAB with the cause of failure or release.
+type ERABItem struct {
+	ERABID ERABID
+	Cause  Cause
+}
+
+func (v ERABItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.Cause.encode(w)
+}
+
+func (v *ERABItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.Cause.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABList is E-RAB-IE-ContainerList of E-RABItem.
+type ERABList []ERABItem
+
+func (v ERABList) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_ITEM, CRITICALITY_IGNORE, item)
+	}
+}
+
+func (v *ERABList) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABList, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABItem{}
+		getSingleContainer(r, ID_E_RAB_ITEM, &item)
+		*v = append(*v, item)
+	}
+}
+
+// UES1APIDs is UE-S1AP-IDs CHOICE of UE-S1AP-ID pair or MME-UE-S1AP-ID
+// only. ENBUES1APID is nil for MME-UE-S1AP-ID only.
+type UES1APIDs struct {
+	MMEUES1APID MMEUES1APID
+	ENBUES1APID *ENBUES1APID
+}
+
+func (v UES1APIDs) encode(w *perWriter) {
+	if v.ENBUES1APID == nil {
+		w.putChoiceIndex(1, 2, true)
+		v.MMEUES1APID.encode(w)
+		return
+	}
+	w.putChoiceIndex(0, 2, true)
+	w.putBool(false)
+	w.putBool(false)
+	v.MMEUES1APID.encode(w)
+	v.ENBUES1APID.encode(w)
+}
+
+func (v *UES1APIDs) decode(r *perReader) {
+	switch r.getChoiceIndex(2, true) {
+	case 0:
+		ext := r.getBool()
+		opt := r.getBool()
+		v.MMEUES1APID.decode(r)
+		v.ENBUES1APID = new(ENBUES1APID)
+		v.ENBUES1APID.decode(r)
+		if opt {
+			r.skipProtocolExtensions()
+		}
+		if ext {
+			r.skipExtensions()
+		}
+	case 1:
+		v.MMEUES1APID.decode(r)
+		v.ENBUES1APID = nil
+	default:
+		r.setError("unknown UE-S1AP-IDs choice")
+	}
+}
+
+// UEIdentityIndexValue is UEIdentityIndexValue BIT STRING (SIZE (10)). It
+// is IMSI mod 1024 which the eNB uses to calculate the paging occasion.
+type UEIdentityIndexValue uint16
+
+func (v UEIdentityIndexValue) encode(w *perWriter) {
+	putBitsUint32(w, uint32(v), 10)
+}
+
+func (v *UEIdentityIndexValue) decode(r *perReader) {
+	*v = UEIdentityIndexValue(getBitsUint32(r, 10))
+}
+
+// IMSI is IMSI OCTET STRING (SIZE (3..8)) which is TBCD encoded on the
+// wire.
+type IMSI string
+
+func (v IMSI) encode(w *perWriter) {
+	b := make([]byte, (len(v)+1)/2)
+	for i := 0; i < len(v); i++ {
+		if v[i] < '0' || v[i] > '9' {
+			w.setError("invalid IMSI digit %q", v[i])
+			return
+		}
+		d := v[i] - '0'
+		if i%2 == 0 {
+			b[i/2] = 0xf0 | d
+		} else {
+			b[i/2] = b[i/2]&0x0f | d<<4
+		}
+	}
+	w.putOctetString(b, 3, 8, false)
+}
+
+func (v *IMSI) decode(r *perReader) {
+	b := r.getOctetString(3, 8, false)
+	digits := make([]byte, 0, len(b)*2)
+	for _, o := range b {
+		digits = append(digits, '0'+o&0x0f)
+		if o>>4 == 0x0f {
+			break
+		}
+		digits = append(digits, '0'+o>>4)
+	}
+	*v = IMSI(digits)
+}
+
+// UEPagingID is UEPagingID CHOICE of S-TMSI or IMSI. IMSI is used when
+// STMSI is nil.
+type UEPagingID struct {
+	STMSI *STMSI
+	IMSI  IMSI
+}
+
+func (v UEPagingID) encode(w *perWriter) {
+	if v.STMSI != nil {
+		w.putChoiceIndex(0, 2, true)
+		v.STMSI.encode(w)
+		return
+	}
+	w.putChoiceIndex(1, 2, true)
+	v.IMSI.encode(w)
+}
+
+func (v *UEPagingID) decode(r *perReader) {
+	switch r.getChoiceIndex(2, true) {
+	case 0:
+		v.STMSI = &STMSI{}
+		v.STMSI.decode(r)
+	case 1:
+		v.STMSI = nil
+		v.IMSI.decode(r)
+	default:
+		r.setError("unknown UEPagingID choice")
+	}
+}
+
+// CNDomain is CNDomain ENUMERATED.
+type CNDomain uint8
+
+const (
+	CN_DOMAIN_PS CNDomain = iota
+	CN_DOMAIN_CS
+)
+
+func (v CNDomain) encode(w *perWriter) {
+	w.putEnumerated(int(v), 2, false)
+}
+
+func (v *CNDomain) decode(r *perReader) {
+	*v = CNDomain(r.getEnumerated(2, false))
+}
+
+// TAIList is TAIList of TAIItem which has only TAI.
+type TAIList []TAI
+
+// taiItem is TAIItem SEQUENCE which wraps the TAI.
+type taiItem struct {
+	TAI TAI
+}
+
+func (v taiItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.TAI.encode(w)
+}
+
+func (v *taiItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.TAI.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+func (v TAIList) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofTAIs)
+	for _, tai := range v {
+		putSingleContainer(w, ID_TAI_ITEM, CRITICALITY_IGNORE, taiItem{tai})
+	}
+}
+
+func (v *TAIList) decode(r *perReader) {
+	n := r.getLength(1, maxnoofTAIs)
+	*v = make(TAIList, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := taiItem{}
+		getSingleContainer(r, ID_TAI_ITEM, &item)
+		*v = append(*v, item.TAI)
+	}
+}
+
+// PagingPriority is PagingPriority ENUMERATED. PAGING_PRIORITY_LEVEL1 is
+// the highest priority.
+type PagingPriority uint8
+
+const (
+	PAGING_PRIORITY_LEVEL1 PagingPriority = iota
+	PAGING_PRIORITY_LEVEL2
+	PAGING_PRIORITY_LEVEL3
+	PAGING_PRIORITY_LEVEL4
+	PAGING_PRIORITY_LEVEL5
+	PAGING_PRIORITY_LEVEL6
+	PAGING_PRIORITY_LEVEL7
+	PAGING_PRIORITY_LEVEL8
+)
+
+func (v PagingPriority) encode(w *perWriter) {
+	w.putEnumerated(int(v), 8, true)
+}
+
+func (v *PagingPriority) decode(r *perReader) {
+	*v = PagingPriority(r.getEnumerated(8, true))
+}
+
+// ERABToBeSetupItemBearerSUReq is E-RAB to be set up by E-RAB Setup with
+// the NAS PDU which activates the EPS bearer.
+type ERABToBeSetupItemBearerSUReq struct {
+	ERABID                 ERABID
+	ERABLevelQoSParameters ERABLevelQoSParameters
+	TransportLayerAddress  TransportLayerAddress
+	GTPTEID                GTPTEID
+	NASPDU                 NASPDU
+}
+
+func (v ERABToBeSetupItemBearerSUReq) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.ERABLevelQoSParameters.encode(w)
+	v.TransportLayerAddress.encode(w)
+	v.GTPTEID.encode(w)
+	v.NASPDU.encode(w)
+}
+
+func (v *ERABToBeSetupItemBearerSUReq) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.ERABLevelQoSParameters.decode(r)
+	v.TransportLayerAddress.decode(r)
+	v.GTPTEID.decode(r)
+	v.NASPDU.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABToBeSetupListBearerSUReq is E-RAB-IE-ContainerList of
+// E-RABToBeSetupItemBearerSUReq.
+type ERABToBeSetupListBearerSUReq []ERABToBeSetupItemBearerSUReq
+
+func (v ERABToBeSetupListBearerSUReq) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_TO_BE_SETUP_ITEM_BEARER_SU_REQ, CRITICALITY_REJECT, item)
+	}
+}
+
+func (v *ERABToBeSetupListBearerSUReq) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABToBeSetupListBearerSUReq, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABToBeSetupItemBearerSUReq{}
+		getSingleContainer(r, ID_E_RAB_TO_BE_SETUP_ITEM_BEARER_SU_REQ, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABSetupItemBearerSURes is E-RAB set up by E-RAB Setup with the eNB
+// S1-U endpoint.
+type ERABSetupItemBearerSURes struct {
+	ERABID                ERABID
+	TransportLayerAddress TransportLayerAddress
+	GTPTEID               GTPTEID
+}
+
+func (v ERABSetupItemBearerSURes) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.TransportLayerAddress.encode(w)
+	v.GTPTEID.encode(w)
+}
+
+func (v *ERABSetupItemBearerSURes) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.TransportLayerAddress.decode(r)
+	v.GTPTEID.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABSetupListBearerSURes is E-RAB-IE-ContainerList of
+// E-RABSetupItemBearerSURes.
+type ERABSetupListBearerSURes []ERABSetupItemBearerSURes
+
+func (v ERABSetupListBearerSURes) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_SETUP_ITEM_BEARER_SU_RES, CRITICALITY_IGNORE, item)
+	}
+}
+
+func (v *ERABSetupListBearerSURes) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABSetupListBearerSURes, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABSetupItemBearerSURes{}
+		getSingleContainer(r, ID_E_RAB_SETUP_ITEM_BEARER_SU_RES, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABToBeModifiedItemBearerModReq is E-RAB of which QoS is modified by
+// E-RAB Modify with the NAS PDU which modifies the EPS bearer.
+type ERABToBeModifiedItemBearerModReq struct {
+	ERABID                 ERABID
+	ERABLevelQoSParameters ERABLevelQoSParameters
+	NASPDU                 NASPDU
+}
+
+func (v ERABToBeModifiedItemBearerModReq) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.ERABLevelQoSParameters.encode(w)
+	v.NASPDU.encode(w)
+}
+
+func (v *ERABToBeModifiedItemBearerModReq) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.ERABLevelQoSParameters.decode(r)
+	v.NASPDU.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABToBeModifiedListBearerModReq is E-RAB-IE-ContainerList of
+// E-RABToBeModifiedItemBearerModReq.
+type ERABToBeModifiedListBearerModReq []ERABToBeModifiedItemBearerModReq
+
+func (v ERABToBeModifiedListBearerModReq) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_TO_BE_MODIFIED_ITEM_BEARER_MOD_REQ, CRITICALITY_REJECT, item)
+	}
+}
+
+func (v *ERABToBeModifiedListBearerModReq) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABToBeModifiedListBearerModReq, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABToBeModifiedItemBearerModReq{}
+		getSingleContainer(r, ID_E_RAB_TO_BE_MODIFIED_ITEM_BEARER_MOD_REQ, &item)
+		*v = append(*v, item)
+	}
+}
+
+// erabIDItem is the item of E-RAB lists which carries E-RAB-ID only such
+// as E-RABModifyItemBearerModRes and E-RABReleaseItemBearerRelComp.
+type erabIDItem struct {
+	ERABID ERABID
+}
+
+func (v erabIDItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+}
+
+func (v *erabIDItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+func putERABIDList(w *perWriter, id ProtocolIEID, ids []ERABID) {
+	w.putLength(len(ids), 1, maxnoofE_RABs)
+	for _, erabID := range ids {
+		putSingleContainer(w, id, CRITICALITY_IGNORE, erabIDItem{erabID})
+	}
+}
+
+func getERABIDList(r *perReader, id ProtocolIEID) []ERABID {
+	n := r.getLength(1, maxnoofE_RABs)
+	ids := make([]ERABID, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := erabIDItem{}
+		getSingleContainer(r, id, &item)
+		ids = append(ids, item.ERABID)
+	}
+	return ids
+}
+
+// ERABModifyListBearerModRes is E-RAB-IE-ContainerList of
+// E-RABModifyItemBearerModRes which is the E-RAB-ID modified.
+type ERABModifyListBearerModRes []ERABID
+
+func (v ERABModifyListBearerModRes) encode(w *perWriter) {
+	putERABIDList(w, ID_E_RAB_MODIFY_ITEM_BEARER_MOD_RES, v)
+}
+
+func (v *ERABModifyListBearerModRes) decode(r *perReader) {
+	*v = getERABIDList(r, ID_E_RAB_MODIFY_ITEM_BEARER_MOD_RES)
+}
+
+// ERABReleaseListBearerRelComp is E-RAB-IE-ContainerList of
+// E-RABReleaseItemBearerRelComp which is the E-RAB-ID released.
+type ERABReleaseListBearerRelComp []ERABID
+
+func (v ERABReleaseListBearerRelComp) encode(w *perWriter) {
+	putERABIDList(w, ID_E_RAB_RELEASE_ITEM_BEARER_REL_COMP, v)
+}
+
+func (v *ERABReleaseListBearerRelComp) decode(r *perReader) {
+	*v = getERABIDList(r, ID_E_RAB_RELEASE_ITEM_BEARER_REL_COMP)
+}
+
+// ForbiddenTAsItem is the TACs of the PLMN which the UE is not allowed to
+// be handed over to.
+type ForbiddenTAsItem struct {
+	PLMNIdentity  PLMNIdentity
+	ForbiddenTACs []uint16
+}
+
+func (v ForbiddenTAsItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.PLMNIdentity.encode(w)
+	w.putLength(len(v.ForbiddenTACs), 1, maxnoofForbTACs)
+	for _, tac := range v.ForbiddenTACs {
+		putUint16(w, tac)
+	}
+}
+
+func (v *ForbiddenTAsItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PLMNIdentity.decode(r)
+	n := r.getLength(1, maxnoofForbTACs)
+	v.ForbiddenTACs = make([]uint16, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		v.ForbiddenTACs = append(v.ForbiddenTACs, getUint16(r))
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ForbiddenLAsItem is the LACs of the PLMN which the UE is not allowed to
+// be handed over to.
+type ForbiddenLAsItem struct {
+	PLMNIdentity  PLMNIdentity
+	ForbiddenLACs []uint16
+}
+
+func (v ForbiddenLAsItem) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.PLMNIdentity.encode(w)
+	w.putLength(len(v.ForbiddenLACs), 1, maxnoofForbLACs)
+	for _, lac := range v.ForbiddenLACs {
+		putUint16(w, lac)
+	}
+}
+
+func (v *ForbiddenLAsItem) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.PLMNIdentity.decode(r)
+	n := r.getLength(1, maxnoofForbLACs)
+	v.ForbiddenLACs = make([]uint16, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		v.ForbiddenLACs = append(v.ForbiddenLACs, getUint16(r))
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ForbiddenInterRATs is ForbiddenInterRATs ENUMERATED. GERAN and UTRAN,
+// and CDMA2000 and UTRAN are extension values.
+type ForbiddenInterRATs uint8
+
+const (
+	FORBIDDEN_INTER_RATS_ALL ForbiddenInterRATs = iota
+	FORBIDDEN_INTER_RATS_GERAN
+	FORBIDDEN_INTER_RATS_UTRAN
+	FORBIDDEN_INTER_RATS_CDMA2000
+	FORBIDDEN_INTER_RATS_GERAN_AND_UTRAN
+	FORBIDDEN_INTER_RATS_CDMA2000_AND_UTRAN
+)
+
+func (v ForbiddenInterRATs) encode(w *perWriter) {
+	w.putEnumerated(int(v), 4, true)
+}
+
+func (v *ForbiddenInterRATs) decode(r *perReader) {
+	*v = ForbiddenInterRATs(r.getEnumerated(4, true))
+}
+
+// HandoverRestrictionList is the roaming and access restrictions of the
+// UE. Optional lists are nil when absent.
+type HandoverRestrictionList struct {
+	ServingPLMN        PLMNIdentity
+	EquivalentPLMNs    []PLMNIdentity
+	ForbiddenTAs       []ForbiddenTAsItem
+	ForbiddenLAs       []ForbiddenLAsItem
+	ForbiddenInterRATs *ForbiddenInterRATs
+}
+
+func (v HandoverRestrictionList) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(len(v.EquivalentPLMNs) > 0)
+	w.putBool(len(v.ForbiddenTAs) > 0)
+	w.putBool(len(v.ForbiddenLAs) > 0)
+	w.putBool(v.ForbiddenInterRATs != nil)
+	w.putBool(false)
+	v.ServingPLMN.encode(w)
+	if len(v.EquivalentPLMNs) > 0 {
+		w.putLength(len(v.EquivalentPLMNs), 1, maxnoofEPLMNs)
+		for _, plmn := range v.EquivalentPLMNs {
+			plmn.encode(w)
+		}
+	}
+	if len(v.ForbiddenTAs) > 0 {
+		w.putLength(len(v.ForbiddenTAs), 1, maxnoofEPLMNsPlusOne)
+		for _, item := range v.ForbiddenTAs {
+			item.encode(w)
+		}
+	}
+	if len(v.ForbiddenLAs) > 0 {
+		w.putLength(len(v.ForbiddenLAs), 1, maxnoofEPLMNsPlusOne)
+		for _, item := range v.ForbiddenLAs {
+			item.encode(w)
+		}
+	}
+	if v.ForbiddenInterRATs != nil {
+		v.ForbiddenInterRATs.encode(w)
+	}
+}
+
+func (v *HandoverRestrictionList) decode(r *perReader) {
+	ext := r.getBool()
+	hasEPLMNs := r.getBool()
+	hasTAs := r.getBool()
+	hasLAs := r.getBool()
+	hasRATs := r.getBool()
+	opt := r.getBool()
+	v.ServingPLMN.decode(r)
+	if hasEPLMNs {
+		n := r.getLength(1, maxnoofEPLMNs)
+		v.EquivalentPLMNs = make([]PLMNIdentity, n)
+		for i := range v.EquivalentPLMNs {
+			v.EquivalentPLMNs[i].decode(r)
+		}
+	}
+	if hasTAs {
+		n := r.getLength(1, maxnoofEPLMNsPlusOne)
+		v.ForbiddenTAs = make([]ForbiddenTAsItem, n)
+		for i := range v.ForbiddenTAs {
+			v.ForbiddenTAs[i].decode(r)
+		}
+	}
+	if hasLAs {
+		n := r.getLength(1, maxnoofEPLMNsPlusOne)
+		v.ForbiddenLAs = make([]ForbiddenLAsItem, n)
+		for i := range v.ForbiddenLAs {
+			v.ForbiddenLAs[i].decode(r)
+		}
+	}
+	if hasRATs {
+		v.ForbiddenInterRATs = new(ForbiddenInterRATs)
+		v.ForbiddenInterRATs.decode(r)
+	}
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// UERadioCapability is the UE radio capability OCTET STRING which the eNB
+// has reported in UE Capability Info Indication.
+type UERadioCapability []byte
+
+func (v UERadioCapability) encode(w *perWriter) {
+	w.putOctetString(v, 0, -1, false)
+}
+
+func (v *UERadioCapability) decode(r *perReader) {
+	*v = r.getOctetString(0, -1, false)
+}
+
+// CSFallbackIndicator is CSFallbackIndicator ENUMERATED. High priority is
+// an extension value.
+type CSFallbackIndicator uint8
+
+const (
+	CS_FALLBACK_REQUIRED CSFallbackIndicator = iota
+	CS_FALLBACK_HIGH_PRIORITY
+)
+
+func (v CSFallbackIndicator) encode(w *perWriter) {
+	w.putEnumerated(int(v), 1, true)
+}
+
+func (v *CSFallbackIndicator) decode(r *perReader) {
+	*v = CSFallbackIndicator(r.getEnumerated(1, true))
+}
+
+// HandoverType is HandoverType ENUMERATED.
+type HandoverType uint8
+
+const (
+	HANDOVER_TYPE_INTRA_LTE HandoverType = iota
+	HANDOVER_TYPE_LTE_TO_UTRAN
+	HANDOVER_TYPE_LTE_TO_GERAN
+	HANDOVER_TYPE_UTRAN_TO_LTE
+	HANDOVER_TYPE_GERAN_TO_LTE
+)
+
+func (v HandoverType) encode(w *perWriter) {
+	w.putEnumerated(int(v), 5, true)
+}
+
+func (v *HandoverType) decode(r *perReader) {
+	*v = HandoverType(r.getEnumerated(5, true))
+}
+
+// TargetIDType is the choice of TargetID.
+type TargetIDType uint8
+
+const (
+	TARGET_ID_ENB TargetIDType = iota
+	TARGET_ID_RNC
+	TARGET_ID_CGI
+)
+
+// TargetID is TargetID CHOICE. Only targeteNB-ID is decoded. For the other
+// alternatives Type is set and the value is left unparsed.
+type TargetID struct {
+	Type        TargetIDType
+	GlobalENBID GlobalENBID
+	SelectedTAI TAI
+}
+
+func (v TargetID) encode(w *perWriter) {
+	if v.Type != TARGET_ID_ENB {
+		w.setError("TargetID: unsupported type %d", v.Type)
+		return
+	}
+	w.putChoiceIndex(int(TARGET_ID_ENB), 3, true)
+	w.putBool(false)
+	w.putBool(false)
+	v.GlobalENBID.encode(w)
+	v.SelectedTAI.encode(w)
+}
+
+func (v *TargetID) decode(r *perReader) {
+	v.Type = TargetIDType(r.getChoiceIndex(3, true))
+	if v.Type != TARGET_ID_ENB {
+		return
+	}
+	ext := r.getBool()
+	opt := r.getBool()
+	v.GlobalENBID.decode(r)
+	v.SelectedTAI.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// TransparentContainer is Source-ToTarget-TransparentContainer or
+// Target-ToSource-TransparentContainer OCTET STRING which MME relays
+// between eNBs without interpretation.
+type TransparentContainer []byte
+
+func (v TransparentContainer) encode(w *perWriter) {
+	w.putOctetString(v, 0, -1, false)
+}
+
+func (v *TransparentContainer) decode(r *perReader) {
+	*v = r.getOctetString(0, -1, false)
+}
+
+// ENBStatusTransferTransparentContainer is
+// ENB-StatusTransfer-TransparentContainer. MME relays the PDCP sequence
+// numbers from the source eNB to the target eNB as is, so the encoded
+// value is kept.
+type ENBStatusTransferTransparentContainer []byte
+
+func (v ENBStatusTransferTransparentContainer) encode(w *perWriter) {
+	w.putBytes(v)
+}
+
+func (v *ENBStatusTransferTransparentContainer) decode(r *perReader) {
+	*v = r.getBytes(len(r.buf) - int(r.pos/8))
+}
+
+// SecurityContext is SecurityContext with the next hop parameter and its
+// chaining count used by the target eNB to derive KeNB.
+type SecurityContext struct {
+	NextHopChainingCount uint8
+	NextHopParameter     SecurityKey
+}
+
+func (v SecurityContext) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	w.putConstrainedWholeNumber(int64(v.NextHopChainingCount), 0, 7)
+	v.NextHopParameter.encode(w)
+}
+
+func (v *SecurityContext) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.NextHopChainingCount = uint8(r.getConstrainedWholeNumber(0, 7))
+	v.NextHopParameter.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABToBeSetupItemHOReq is E-RAB to be set up in the target eNB with the
+// SGW S1-U endpoint.
+type ERABToBeSetupItemHOReq struct {
+	ERABID                 ERABID
+	TransportLayerAddress  TransportLayerAddress
+	GTPTEID                GTPTEID
+	ERABLevelQoSParameters ERABLevelQoSParameters
+}
+
+func (v ERABToBeSetupItemHOReq) encode(w *perWriter) {
+	w.putBool(false)
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.TransportLayerAddress.encode(w)
+	v.GTPTEID.encode(w)
+	v.ERABLevelQoSParameters.encode(w)
+}
+
+func (v *ERABToBeSetupItemHOReq) decode(r *perReader) {
+	ext := r.getBool()
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.TransportLayerAddress.decode(r)
+	v.GTPTEID.decode(r)
+	v.ERABLevelQoSParameters.decode(r)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABToBeSetupListHOReq is E-RAB-IE-ContainerList of
+// E-RABToBeSetupItemHOReq.
+type ERABToBeSetupListHOReq []ERABToBeSetupItemHOReq
+
+func (v ERABToBeSetupListHOReq) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_TO_BE_SETUP_ITEM_HO_REQ, CRITICALITY_REJECT, item)
+	}
+}
+
+func (v *ERABToBeSetupListHOReq) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABToBeSetupListHOReq, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABToBeSetupItemHOReq{}
+		getSingleContainer(r, ID_E_RAB_TO_BE_SETUP_ITEM_HO_REQ, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABForwarding is the optional data forwarding tunnel endpoints of an
+// E-RAB. Address is nil when the direction is not forwarded.
+type ERABForwarding struct {
+	DLTransportLayerAddress TransportLayerAddress
+	DLGTPTEID               GTPTEID
+	ULTransportLayerAddress TransportLayerAddress
+	ULGTPTEID               GTPTEID
+}
+
+func (v ERABForwarding) present() []bool {
+	dl := len(v.DLTransportLayerAddress) > 0
+	ul := len(v.ULTransportLayerAddress) > 0
+	return []bool{dl, dl, ul, ul}
+}
+
+func (v ERABForwarding) encode(w *perWriter) {
+	if len(v.DLTransportLayerAddress) > 0 {
+		v.DLTransportLayerAddress.encode(w)
+		v.DLGTPTEID.encode(w)
+	}
+	if len(v.ULTransportLayerAddress) > 0 {
+		v.ULTransportLayerAddress.encode(w)
+		v.ULGTPTEID.encode(w)
+	}
+}
+
+func (v *ERABForwarding) decode(r *perReader, present []bool) {
+	if present[0] {
+		v.DLTransportLayerAddress.decode(r)
+	}
+	if present[1] {
+		v.DLGTPTEID.decode(r)
+	}
+	if present[2] {
+		v.ULTransportLayerAddress.decode(r)
+	}
+	if present[3] {
+		v.ULGTPTEID.decode(r)
+	}
+}
+
+// ERABAdmittedItem is E-RAB admitted by the target eNB with its S1-U
+// endpoint and the optional data forwarding endpoints.
+type ERABAdmittedItem struct {
+	ERABID                ERABID
+	TransportLayerAddress TransportLayerAddress
+	GTPTEID               GTPTEID
+	ERABForwarding
+}
+
+func (v ERABAdmittedItem) encode(w *perWriter) {
+	w.putBool(false)
+	for _, p := range v.ERABForwarding.present() {
+		w.putBool(p)
+	}
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.TransportLayerAddress.encode(w)
+	v.GTPTEID.encode(w)
+	v.ERABForwarding.encode(w)
+}
+
+func (v *ERABAdmittedItem) decode(r *perReader) {
+	ext := r.getBool()
+	present := make([]bool, 4)
+	for i := range present {
+		present[i] = r.getBool()
+	}
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.TransportLayerAddress.decode(r)
+	v.GTPTEID.decode(r)
+	v.ERABForwarding.decode(r, present)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABAdmittedList is E-RAB-IE-ContainerList of E-RABAdmittedItem.
+type ERABAdmittedList []ERABAdmittedItem
+
+func (v ERABAdmittedList) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_ADMITTED_ITEM, CRITICALITY_IGNORE, item)
+	}
+}
+
+func (v *ERABAdmittedList) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABAdmittedList, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABAdmittedItem{}
+		getSingleContainer(r, ID_E_RAB_ADMITTED_ITEM, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABFailedToSetupListHOReqAck is E-RAB-IE-ContainerList of
+// E-RABFailedtoSetupItemHOReqAck which has the same content as E-RABItem.
+type ERABFailedToSetupListHOReqAck []ERABItem
+
+func (v ERABFailedToSetupListHOReqAck) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_FAILED_TO_SETUP_ITEM_HO_REQ_ACK, CRITICALITY_IGNORE, item)
+	}
+}
+
+func (v *ERABFailedToSetupListHOReqAck) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABFailedToSetupListHOReqAck, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABItem{}
+		getSingleContainer(r, ID_E_RAB_FAILED_TO_SETUP_ITEM_HO_REQ_ACK, &item)
+		*v = append(*v, item)
+	}
+}
+
+// ERABDataForwardingItem is E-RAB subject to data forwarding from the
+// source eNB to the target eNB.
+type ERABDataForwardingItem struct {
+	ERABID ERABID
+	ERABForwarding
+}
+
+func (v ERABDataForwardingItem) encode(w *perWriter) {
+	w.putBool(false)
+	for _, p := range v.ERABForwarding.present() {
+		w.putBool(p)
+	}
+	w.putBool(false)
+	v.ERABID.encode(w)
+	v.ERABForwarding.encode(w)
+}
+
+func (v *ERABDataForwardingItem) decode(r *perReader) {
+	ext := r.getBool()
+	present := make([]bool, 4)
+	for i := range present {
+		present[i] = r.getBool()
+	}
+	opt := r.getBool()
+	v.ERABID.decode(r)
+	v.ERABForwarding.decode(r, present)
+	if opt {
+		r.skipProtocolExtensions()
+	}
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+// ERABSubjectToDataForwardingList is E-RAB-IE-ContainerList of
+// E-RABDataForwardingItem.
+type ERABSubjectToDataForwardingList []ERABDataForwardingItem
+
+func (v ERABSubjectToDataForwardingList) encode(w *perWriter) {
+	w.putLength(len(v), 1, maxnoofE_RABs)
+	for _, item := range v {
+		putSingleContainer(w, ID_E_RAB_DATA_FORWARDING_ITEM, CRITICALITY_IGNORE, item)
+	}
+}
+
+func (v *ERABSubjectToDataForwardingList) decode(r *perReader) {
+	n := r.getLength(1, maxnoofE_RABs)
+	*v = make(ERABSubjectToDataForwardingList, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		item := ERABDataForwardingItem{}
+		getSingleContainer(r, ID_E_RAB_DATA_FORWARDING_ITEM, &item)
+		*v = append(*v, item)
+	}
+}
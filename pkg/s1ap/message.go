@@ -0,0 +1,115 @@
+package s1ap
+
+import (
+	"fmt"
+)
+
+// Message is S1AP elementary procedure message. Decode returns one of the
+// message types defined in this package so that the caller can type switch
+// on it.
+type Message interface {
+	PDUType() PDUType
+	ProcedureCode() ProcedureCode
+	Criticality() Criticality
+
+	encodeIEs(e *ieEncoder)
+	decodeIE(ie *ProtocolIE) error
+	mandatoryIEs() []ProtocolIEID
+}
+
+type messageKey struct {
+	typ  PDUType
+	code ProcedureCode
+}
+
+// messageTypes is the registry of supported messages.
+var messageTypes = map[messageKey]func() Message{}
+
+func registerMessage(f func() Message) {
+	m := f()
+	messageTypes[messageKey{m.PDUType(), m.ProcedureCode()}] = f
+}
+
+// ieEncoder accumulates protocol IEs of a message. The first error is kept.
+type ieEncoder struct {
+	ies []ProtocolIE
+	err error
+}
+
+func (e *ieEncoder) add(id ProtocolIEID, crit Criticality, v IEValue) {
+	if e.err != nil {
+		return
+	}
+	ie, err := NewProtocolIE(id, crit, v)
+	if err != nil {
+		e.err = err
+		return
+	}
+	e.ies = append(e.ies, ie)
+}
+
+// UnknownMessage is a message which procedure is not supported by this
+// package. The PDU is kept as it is so that the caller can report it.
+type UnknownMessage struct {
+	PDU *PDU
+}
+
+func (m *UnknownMessage) PDUType() PDUType             { return m.PDU.Type }
+func (m *UnknownMessage) ProcedureCode() ProcedureCode { return m.PDU.ProcedureCode }
+func (m *UnknownMessage) Criticality() Criticality     { return m.PDU.Criticality }
+
+func (m *UnknownMessage) encodeIEs(e *ieEncoder) {
+	e.ies = append(e.ies, m.PDU.IEs...)
+}
+
+func (m *UnknownMessage) decodeIE(ie *ProtocolIE) error { return nil }
+func (m *UnknownMessage) mandatoryIEs() []ProtocolIEID  { return nil }
+
+// MessageName returns name of the message.
+func MessageName(m Message) string {
+	switch m.PDUType() {
+	case PDU_INITIATING_MESSAGE:
+		return S1AP_Initiating2String(m.ProcedureCode())
+	default:
+		return fmt.Sprintf("%s of %s", S1AP_PDU2String(m.PDUType()),
+			S1AP_Initiating2String(m.ProcedureCode()))
+	}
+}
+
+// NewMessage converts PDU to a message.
+func NewMessage(pdu *PDU) (Message, error) {
+	f, ok := messageTypes[messageKey{pdu.Type, pdu.ProcedureCode}]
+	if !ok {
+		return &UnknownMessage{PDU: pdu}, nil
+	}
+	m := f()
+	seen := map[ProtocolIEID]bool{}
+	for i := range pdu.IEs {
+		ie := &pdu.IEs[i]
+		if err := m.decodeIE(ie); err != nil {
+			return nil, fmt.Errorf("%s: %v", MessageName(m), err)
+		}
+		seen[ie.ID] = true
+	}
+	for _, id := range m.mandatoryIEs() {
+		if !seen[id] {
+			return nil, fmt.Errorf("%s: mandatory IE %d is missing", MessageName(m), id)
+		}
+	}
+	return m, nil
+}
+
+// NewPDU converts message to PDU.
+func NewPDU(m Message) (*PDU, error) {
+	e := &ieEncoder{}
+	m.encodeIEs(e)
+	if e.err != nil {
+		return nil, fmt.Errorf("%s: %v", MessageName(m), e.err)
+	}
+	return &PDU{
+		Type:          m.PDUType(),
+		ProcedureCode: m.ProcedureCode(),
+		Criticality:   m.Criticality(),
+		IEs:           e.ies,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package s1ap
+
+// InitialUEMessage is sent by eNB to transfer the initial NAS message of a
+// UE. Optional IEs are nil when absent.
+type InitialUEMessage struct {
+	ENBUES1APID             ENBUES1APID
+	NASPDU                  NASPDU
+	TAI                     TAI
+	EUTRANCGI               EUTRANCGI
+	RRCEstablishmentCause   RRCEstablishmentCause
+	STMSI                   *STMSI
+	CSGID                   *CSGID
+	GUMMEI                  *GUMMEI
+	CellAccessMode          *CellAccessMode
+	GWTransportLayerAddress TransportLayerAddress
+	RelayNodeIndicator      bool
+	GUMMEIType              *GUMMEIType
+}
+
+func (*InitialUEMessage) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*InitialUEMessage) ProcedureCode() ProcedureCode { return PROC_INITIAL_UE_MESSAGE }
+func (*InitialUEMessage) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *InitialUEMessage) encodeIEs(e *ieEncoder) {
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_NAS_PDU, CRITICALITY_REJECT, &m.NASPDU)
+	e.add(ID_TAI, CRITICALITY_REJECT, &m.TAI)
+	e.add(ID_EUTRAN_CGI, CRITICALITY_IGNORE, &m.EUTRANCGI)
+	e.add(ID_RRC_ESTABLISHMENT_CAUSE, CRITICALITY_IGNORE, &m.RRCEstablishmentCause)
+	if m.STMSI != nil {
+		e.add(ID_S_TMSI, CRITICALITY_REJECT, m.STMSI)
+	}
+	if m.CSGID != nil {
+		e.add(ID_CSG_ID, CRITICALITY_REJECT, m.CSGID)
+	}
+	if m.GUMMEI != nil {
+		e.add(ID_GUMMEI_ID, CRITICALITY_REJECT, m.GUMMEI)
+	}
+	if m.CellAccessMode != nil {
+		e.add(ID_CELL_ACCESS_MODE, CRITICALITY_REJECT, m.CellAccessMode)
+	}
+	if m.GWTransportLayerAddress != nil {
+		e.add(ID_GW_TRANSPORT_LAYER_ADDRESS, CRITICALITY_IGNORE, &m.GWTransportLayerAddress)
+	}
+	if m.RelayNodeIndicator {
+		e.add(ID_RELAY_NODE_INDICATOR, CRITICALITY_REJECT, &trueEnum{})
+	}
+	if m.GUMMEIType != nil {
+		e.add(ID_GUMMEI_TYPE, CRITICALITY_IGNORE, m.GUMMEIType)
+	}
+}
+
+func (m *InitialUEMessage) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_NAS_PDU:
+		return ie.DecodeValue(&m.NASPDU)
+	case ID_TAI:
+		return ie.DecodeValue(&m.TAI)
+	case ID_EUTRAN_CGI:
+		return ie.DecodeValue(&m.EUTRANCGI)
+	case ID_RRC_ESTABLISHMENT_CAUSE:
+		return ie.DecodeValue(&m.RRCEstablishmentCause)
+	case ID_S_TMSI:
+		m.STMSI = &STMSI{}
+		return ie.DecodeValue(m.STMSI)
+	case ID_CSG_ID:
+		m.CSGID = new(CSGID)
+		return ie.DecodeValue(m.CSGID)
+	case ID_GUMMEI_ID:
+		m.GUMMEI = &GUMMEI{}
+		return ie.DecodeValue(m.GUMMEI)
+	case ID_CELL_ACCESS_MODE:
+		m.CellAccessMode = new(CellAccessMode)
+		return ie.DecodeValue(m.CellAccessMode)
+	case ID_GW_TRANSPORT_LAYER_ADDRESS:
+		return ie.DecodeValue(&m.GWTransportLayerAddress)
+	case ID_RELAY_NODE_INDICATOR:
+		m.RelayNodeIndicator = true
+		return ie.DecodeValue(&trueEnum{})
+	case ID_GUMMEI_TYPE:
+		m.GUMMEIType = new(GUMMEIType)
+		return ie.DecodeValue(m.GUMMEIType)
+	}
+	return nil
+}
+
+func (*InitialUEMessage) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_ENB_UE_S1AP_ID, ID_NAS_PDU, ID_TAI, ID_EUTRAN_CGI,
+		ID_RRC_ESTABLISHMENT_CAUSE}
+}
+
+// DownlinkNASTransport carries NAS message from MME to UE.
+type DownlinkNASTransport struct {
+	MMEUES1APID MMEUES1APID
+	ENBUES1APID ENBUES1APID
+	NASPDU      NASPDU
+}
+
+func (*DownlinkNASTransport) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*DownlinkNASTransport) ProcedureCode() ProcedureCode { return PROC_DOWNLINK_NAS_TRANSPORT }
+func (*DownlinkNASTransport) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *DownlinkNASTransport) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_NAS_PDU, CRITICALITY_REJECT, &m.NASPDU)
+}
+
+func (m *DownlinkNASTransport) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_NAS_PDU:
+		return ie.DecodeValue(&m.NASPDU)
+	}
+	return nil
+}
+
+func (*DownlinkNASTransport) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_NAS_PDU}
+}
+
+// UplinkNASTransport carries NAS message from UE to MME.
+type UplinkNASTransport struct {
+	MMEUES1APID MMEUES1APID
+	ENBUES1APID ENBUES1APID
+	NASPDU      NASPDU
+	EUTRANCGI   EUTRANCGI
+	TAI         TAI
+}
+
+func (*UplinkNASTransport) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*UplinkNASTransport) ProcedureCode() ProcedureCode { return PROC_UPLINK_NAS_TRANSPORT }
+func (*UplinkNASTransport) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *UplinkNASTransport) encodeIEs(e *ieEncoder) {
+	e.add(ID_MME_UE_S1AP_ID, CRITICALITY_REJECT, &m.MMEUES1APID)
+	e.add(ID_ENB_UE_S1AP_ID, CRITICALITY_REJECT, &m.ENBUES1APID)
+	e.add(ID_NAS_PDU, CRITICALITY_REJECT, &m.NASPDU)
+	e.add(ID_EUTRAN_CGI, CRITICALITY_IGNORE, &m.EUTRANCGI)
+	e.add(ID_TAI, CRITICALITY_IGNORE, &m.TAI)
+}
+
+func (m *UplinkNASTransport) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_UE_S1AP_ID:
+		return ie.DecodeValue(&m.MMEUES1APID)
+	case ID_ENB_UE_S1AP_ID:
+		return ie.DecodeValue(&m.ENBUES1APID)
+	case ID_NAS_PDU:
+		return ie.DecodeValue(&m.NASPDU)
+	case ID_EUTRAN_CGI:
+		return ie.DecodeValue(&m.EUTRANCGI)
+	case ID_TAI:
+		return ie.DecodeValue(&m.TAI)
+	}
+	return nil
+}
+
+func (*UplinkNASTransport) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_MME_UE_S1AP_ID, ID_ENB_UE_S1AP_ID, ID_NAS_PDU, ID_EUTRAN_CGI, ID_TAI}
+}
+
+func init() {
+	registerMessage(func() Message { return &InitialUEMessage{} })
+	registerMessage(func() Message { return &DownlinkNASTransport{} })
+	registerMessage(func() Message { return &UplinkNASTransport{} })
+}
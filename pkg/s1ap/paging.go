@@ -0,0 +1,63 @@
+package s1ap
+
+// Paging is sent by MME to every eNB which serves a tracking area in
+// TAIList. PagingDRX, CSGIDList and PagingPriority are optional.
+type Paging struct {
+	UEIdentityIndexValue UEIdentityIndexValue
+	UEPagingID           UEPagingID
+	PagingDRX            *PagingDRX
+	CNDomain             CNDomain
+	TAIList              TAIList
+	CSGIDList            CSGIDList
+	PagingPriority       *PagingPriority
+}
+
+func (*Paging) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*Paging) ProcedureCode() ProcedureCode { return PROC_PAGING }
+func (*Paging) Criticality() Criticality     { return CRITICALITY_IGNORE }
+
+func (m *Paging) encodeIEs(e *ieEncoder) {
+	e.add(ID_UE_IDENTITY_INDEX_VALUE, CRITICALITY_IGNORE, &m.UEIdentityIndexValue)
+	e.add(ID_UE_PAGING_ID, CRITICALITY_IGNORE, &m.UEPagingID)
+	if m.PagingDRX != nil {
+		e.add(ID_PAGING_DRX, CRITICALITY_IGNORE, m.PagingDRX)
+	}
+	e.add(ID_CN_DOMAIN, CRITICALITY_IGNORE, &m.CNDomain)
+	e.add(ID_TAI_LIST, CRITICALITY_IGNORE, &m.TAIList)
+	if len(m.CSGIDList) > 0 {
+		e.add(ID_CSG_ID_LIST, CRITICALITY_IGNORE, &m.CSGIDList)
+	}
+	if m.PagingPriority != nil {
+		e.add(ID_PAGING_PRIORITY, CRITICALITY_IGNORE, m.PagingPriority)
+	}
+}
+
+func (m *Paging) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_UE_IDENTITY_INDEX_VALUE:
+		return ie.DecodeValue(&m.UEIdentityIndexValue)
+	case ID_UE_PAGING_ID:
+		return ie.DecodeValue(&m.UEPagingID)
+	case ID_PAGING_DRX:
+		m.PagingDRX = new(PagingDRX)
+		return ie.DecodeValue(m.PagingDRX)
+	case ID_CN_DOMAIN:
+		return ie.DecodeValue(&m.CNDomain)
+	case ID_TAI_LIST:
+		return ie.DecodeValue(&m.TAIList)
+	case ID_CSG_ID_LIST:
+		return ie.DecodeValue(&m.CSGIDList)
+	case ID_PAGING_PRIORITY:
+		m.PagingPriority = new(PagingPriority)
+		return ie.DecodeValue(m.PagingPriority)
+	}
+	return nil
+}
+
+func (*Paging) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_UE_IDENTITY_INDEX_VALUE, ID_UE_PAGING_ID, ID_CN_DOMAIN, ID_TAI_LIST}
+}
+
+func init() {
+	registerMessage(func() Message { return &Paging{} })
+}
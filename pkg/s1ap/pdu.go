@@ -0,0 +1,164 @@
+package s1ap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PDU is S1AP-PDU. Value of the elementary procedure message is kept as a
+// list of protocol IEs since every S1AP message is a SEQUENCE of
+// ProtocolIE-Container.
+type PDU struct {
+	Type          PDUType
+	ProcedureCode ProcedureCode
+	Criticality   Criticality
+	IEs           []ProtocolIE
+}
+
+// ProtocolIE is ProtocolIE-Field. Value is the open type encoding of the IE
+// value.
+type ProtocolIE struct {
+	ID          ProtocolIEID
+	Criticality Criticality
+	Value       []byte
+}
+
+// NewProtocolIE encodes v and returns protocol IE.
+func NewProtocolIE(id ProtocolIEID, crit Criticality, v IEValue) (ProtocolIE, error) {
+	b, err := marshal(v)
+	if err != nil {
+		return ProtocolIE{}, fmt.Errorf("IE %d encode: %v", id, err)
+	}
+	return ProtocolIE{ID: id, Criticality: crit, Value: b}, nil
+}
+
+// DecodeValue decodes IE value into v.
+func (ie *ProtocolIE) DecodeValue(v IEValue) error {
+	if err := unmarshal(ie.Value, v); err != nil {
+		return fmt.Errorf("IE %d decode: %v", ie.ID, err)
+	}
+	return nil
+}
+
+// IEValue is implemented by S1AP information element values.
+type IEValue interface {
+	perEncoder
+	perDecoder
+}
+
+// AddIE appends protocol IE to the PDU.
+func (p *PDU) AddIE(id ProtocolIEID, crit Criticality, v IEValue) error {
+	ie, err := NewProtocolIE(id, crit, v)
+	if err != nil {
+		return err
+	}
+	p.IEs = append(p.IEs, ie)
+	return nil
+}
+
+// IE returns first protocol IE which has the id.
+func (p *PDU) IE(id ProtocolIEID) *ProtocolIE {
+	for i := range p.IEs {
+		if p.IEs[i].ID == id {
+			return &p.IEs[i]
+		}
+	}
+	return nil
+}
+
+func (p *PDU) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s (%d) criticality %d",
+		S1AP_PDU2String(p.Type), S1AP_Initiating2String(p.ProcedureCode),
+		p.ProcedureCode, p.Criticality)
+	for _, ie := range p.IEs {
+		fmt.Fprintf(&b, "\n  IE %d criticality %d value % x", ie.ID, ie.Criticality, ie.Value)
+	}
+	return b.String()
+}
+
+func encodeProtocolIEs(w *perWriter, ies []ProtocolIE) {
+	w.putLength(len(ies), 0, maxProtocolIEs)
+	for _, ie := range ies {
+		w.putConstrainedWholeNumber(int64(ie.ID), 0, 65535)
+		w.putEnumerated(int(ie.Criticality), 3, false)
+		w.putOpenType(ie.Value)
+	}
+}
+
+func decodeProtocolIEs(r *perReader) []ProtocolIE {
+	n := r.getLength(0, maxProtocolIEs)
+	ies := make([]ProtocolIE, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		ie := ProtocolIE{}
+		ie.ID = ProtocolIEID(r.getConstrainedWholeNumber(0, 65535))
+		ie.Criticality = Criticality(r.getEnumerated(3, false))
+		ie.Value = r.getOpenType()
+		ies = append(ies, ie)
+	}
+	return ies
+}
+
+// protocolIEContainer is the value of elementary procedure message.
+//
+//	SEQUENCE { protocolIEs ProtocolIE-Container, ... }
+type protocolIEContainer []ProtocolIE
+
+func (c protocolIEContainer) encode(w *perWriter) {
+	w.putBool(false)
+	encodeProtocolIEs(w, c)
+}
+
+func (c *protocolIEContainer) decode(r *perReader) {
+	ext := r.getBool()
+	*c = decodeProtocolIEs(r)
+	if ext {
+		r.skipExtensions()
+	}
+}
+
+func (p *PDU) encode(w *perWriter) {
+	if p.Type > PDU_UNSUCCESSFUL_OUTCOME {
+		w.setError("unknown PDU type %d", p.Type)
+		return
+	}
+	w.putChoiceIndex(int(p.Type), 3, true)
+	w.putConstrainedWholeNumber(int64(p.ProcedureCode), 0, 255)
+	w.putEnumerated(int(p.Criticality), 3, false)
+	w.putOpenValue(protocolIEContainer(p.IEs))
+}
+
+func (p *PDU) decode(r *perReader) {
+	typ := r.getChoiceIndex(3, true)
+	if r.err != nil {
+		return
+	}
+	if typ > int(PDU_UNSUCCESSFUL_OUTCOME) {
+		r.setError("unknown PDU type %d", typ)
+		return
+	}
+	p.Type = PDUType(typ)
+	p.ProcedureCode = ProcedureCode(r.getConstrainedWholeNumber(0, 255))
+	p.Criticality = Criticality(r.getEnumerated(3, false))
+	var c protocolIEContainer
+	r.getOpenValue(&c)
+	p.IEs = c
+}
+
+// DecodePDU decodes APER encoded S1AP-PDU.
+func DecodePDU(buf []byte) (*PDU, error) {
+	pdu := &PDU{}
+	if err := unmarshal(buf, pdu); err != nil {
+		return nil, fmt.Errorf("S1AP-PDU decode failed: %v", err)
+	}
+	return pdu, nil
+}
+
+// EncodePDU encodes S1AP-PDU with APER.
+func EncodePDU(pdu *PDU) ([]byte, error) {
+	buf, err := marshal(pdu)
+	if err != nil {
+		return nil, fmt.Errorf("S1AP-PDU encode failed: %v", err)
+	}
+	return buf, nil
+}
@@ -0,0 +1,528 @@
+package s1ap
+
+import (
+	"fmt"
+)
+
+// Aligned PER (ITU-T X.691) primitives used by the S1AP codec. Both the
+// writer and the reader keep the first error in err and turn every
+// following call into no-op so that callers can check the error once after
+// a whole structure has been processed.
+
+const (
+	// Upper bound of a length which can be encoded without fragmentation.
+	perMaxLength = 16384
+
+	// Constrained lengths below this value are encoded as constrained whole
+	// number, otherwise the unconstrained length determinant is used.
+	perLength64K = 65536
+)
+
+// bitsFor returns number of bits needed to encode a constrained whole number
+// with the given range.
+func bitsFor(rng uint64) uint {
+	n := uint(0)
+	for n < 64 && (uint64(1)<<n) < rng {
+		n++
+	}
+	return n
+}
+
+// octetsFor returns minimum number of octets needed to hold v.
+func octetsFor(v uint64) uint {
+	n := uint(1)
+	for v > 0xff {
+		v >>= 8
+		n++
+	}
+	return n
+}
+
+type perWriter struct {
+	buf  []byte
+	bits uint
+	err  error
+}
+
+func (w *perWriter) setError(format string, a ...interface{}) {
+	if w.err == nil {
+		w.err = fmt.Errorf(format, a...)
+	}
+}
+
+// Bytes returns encoded buffer. Trailing bits of the last octet are zero.
+func (w *perWriter) Bytes() []byte {
+	return w.buf
+}
+
+func (w *perWriter) putBits(v uint64, n uint) {
+	if w.err != nil {
+		return
+	}
+	for i := n; i > 0; i-- {
+		if w.bits%8 == 0 {
+			w.buf = append(w.buf, 0)
+		}
+		if (v>>(i-1))&1 == 1 {
+			w.buf[len(w.buf)-1] |= 0x80 >> (w.bits % 8)
+		}
+		w.bits++
+	}
+}
+
+func (w *perWriter) putBool(b bool) {
+	if b {
+		w.putBits(1, 1)
+	} else {
+		w.putBits(0, 1)
+	}
+}
+
+func (w *perWriter) align() {
+	w.bits = (w.bits + 7) &^ 7
+}
+
+func (w *perWriter) putBytes(b []byte) {
+	if w.err != nil {
+		return
+	}
+	if w.bits%8 == 0 {
+		w.buf = append(w.buf, b...)
+		w.bits += uint(len(b)) * 8
+		return
+	}
+	for _, c := range b {
+		w.putBits(uint64(c), 8)
+	}
+}
+
+// putBitField puts the first n bits of b (MSB first).
+func (w *perWriter) putBitField(b []byte, n uint) {
+	for i := uint(0); i < n; i++ {
+		var bit uint64
+		if int(i/8) < len(b) {
+			bit = uint64(b[i/8]>>(7-i%8)) & 1
+		}
+		w.putBits(bit, 1)
+	}
+}
+
+func (w *perWriter) putConstrainedWholeNumber(v, lb, ub int64) {
+	if v < lb || v > ub {
+		w.setError("value %d out of range (%d..%d)", v, lb, ub)
+		return
+	}
+	rng := uint64(ub-lb) + 1
+	val := uint64(v - lb)
+	switch {
+	case rng == 1:
+	case rng <= 255:
+		w.putBits(val, bitsFor(rng))
+	case rng == 256:
+		w.align()
+		w.putBits(val, 8)
+	case rng <= 65536:
+		w.align()
+		w.putBits(val, 16)
+	default:
+		n := octetsFor(val)
+		w.putBits(uint64(n-1), bitsFor(uint64(octetsFor(rng-1))))
+		w.align()
+		w.putBits(val, n*8)
+	}
+}
+
+func (w *perWriter) putNormallySmall(v uint64) {
+	if v <= 63 {
+		w.putBits(0, 1)
+		w.putBits(v, 6)
+		return
+	}
+	w.putBits(1, 1)
+	n := octetsFor(v)
+	w.putLength(int(n), 0, -1)
+	w.putBits(v, n*8)
+}
+
+// putLength puts length determinant. Negative ub means unconstrained.
+func (w *perWriter) putLength(n, lb, ub int) {
+	if ub >= 0 && ub < perLength64K {
+		w.putConstrainedWholeNumber(int64(n), int64(lb), int64(ub))
+		return
+	}
+	w.align()
+	switch {
+	case n < 128:
+		w.putBits(uint64(n), 8)
+	case n < perMaxLength:
+		w.putBits(0x8000|uint64(n), 16)
+	default:
+		w.setError("length %d requires fragmentation", n)
+	}
+}
+
+// putExtensible puts extension bit for a value with extensible constraint.
+// It returns true when the value is outside of the extension root.
+func (w *perWriter) putExtensible(n, lb, ub int) bool {
+	outside := n < lb || (ub >= 0 && n > ub)
+	w.putBool(outside)
+	return outside
+}
+
+func (w *perWriter) putOctetString(b []byte, lb, ub int, ext bool) {
+	n := len(b)
+	if ext {
+		if w.putExtensible(n, lb, ub) {
+			lb, ub = 0, -1
+		}
+	} else if n < lb || (ub >= 0 && n > ub) {
+		w.setError("octet string size %d out of range (%d..%d)", n, lb, ub)
+		return
+	}
+	if lb == ub {
+		if n > 2 {
+			w.align()
+		}
+		w.putBytes(b)
+		return
+	}
+	w.putLength(n, lb, ub)
+	w.align()
+	w.putBytes(b)
+}
+
+// putBitString puts n bits of b.
+func (w *perWriter) putBitString(b []byte, n, lb, ub int, ext bool) {
+	if ext {
+		if w.putExtensible(n, lb, ub) {
+			lb, ub = 0, -1
+		}
+	} else if n < lb || (ub >= 0 && n > ub) {
+		w.setError("bit string size %d out of range (%d..%d)", n, lb, ub)
+		return
+	}
+	if lb == ub {
+		if n > 16 {
+			w.align()
+		}
+		w.putBitField(b, uint(n))
+		return
+	}
+	w.putLength(n, lb, ub)
+	w.align()
+	w.putBitField(b, uint(n))
+}
+
+// putPrintableString puts PrintableString with size constraint. Characters
+// are encoded as 8 bits in ALIGNED variant.
+func (w *perWriter) putPrintableString(s string, lb, ub int, ext bool) {
+	n := len(s)
+	if ext {
+		if w.putExtensible(n, lb, ub) {
+			lb, ub = 0, -1
+		}
+	} else if n < lb || (ub >= 0 && n > ub) {
+		w.setError("string size %d out of range (%d..%d)", n, lb, ub)
+		return
+	}
+	if lb != ub {
+		w.putLength(n, lb, ub)
+	}
+	if ub < 0 || ub*8 > 16 {
+		w.align()
+	}
+	w.putBytes([]byte(s))
+}
+
+func (w *perWriter) putEnumerated(v, count int, ext bool) {
+	if ext {
+		if v >= count {
+			w.putBool(true)
+			w.putNormallySmall(uint64(v - count))
+			return
+		}
+		w.putBool(false)
+	}
+	w.putConstrainedWholeNumber(int64(v), 0, int64(count-1))
+}
+
+// putChoiceIndex puts index of CHOICE alternative. When index is an
+// extension alternative, the caller must put the value as open type.
+func (w *perWriter) putChoiceIndex(idx, count int, ext bool) {
+	w.putEnumerated(idx, count, ext)
+}
+
+func (w *perWriter) putOpenType(b []byte) {
+	w.putLength(len(b), 0, -1)
+	w.align()
+	w.putBytes(b)
+}
+
+// putOpenValue encodes value in a separate buffer and puts it as open type.
+func (w *perWriter) putOpenValue(v perEncoder) {
+	if w.err != nil {
+		return
+	}
+	b, err := marshal(v)
+	if err != nil {
+		w.err = err
+		return
+	}
+	w.putOpenType(b)
+}
+
+type perReader struct {
+	buf []byte
+	pos uint
+	err error
+}
+
+func newPerReader(b []byte) *perReader {
+	return &perReader{buf: b}
+}
+
+func (r *perReader) setError(format string, a ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, a...)
+	}
+}
+
+func (r *perReader) getBits(n uint) uint64 {
+	if r.err != nil {
+		return 0
+	}
+	if r.pos+n > uint(len(r.buf))*8 {
+		r.setError("buffer underrun at bit %d reading %d bits", r.pos, n)
+		return 0
+	}
+	var v uint64
+	for i := uint(0); i < n; i++ {
+		bit := (r.buf[r.pos/8] >> (7 - r.pos%8)) & 1
+		v = v<<1 | uint64(bit)
+		r.pos++
+	}
+	return v
+}
+
+func (r *perReader) getBool() bool {
+	return r.getBits(1) == 1
+}
+
+func (r *perReader) align() {
+	r.pos = (r.pos + 7) &^ 7
+}
+
+func (r *perReader) getBytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.pos+uint(n)*8 > uint(len(r.buf))*8 {
+		r.setError("buffer underrun at bit %d reading %d octets", r.pos, n)
+		return nil
+	}
+	b := make([]byte, n)
+	if r.pos%8 == 0 {
+		copy(b, r.buf[r.pos/8:])
+		r.pos += uint(n) * 8
+		return b
+	}
+	for i := range b {
+		b[i] = byte(r.getBits(8))
+	}
+	return b
+}
+
+func (r *perReader) getBitField(n uint) []byte {
+	b := make([]byte, (n+7)/8)
+	for i := uint(0); i < n; i++ {
+		if r.getBits(1) == 1 {
+			b[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return b
+}
+
+func (r *perReader) getConstrainedWholeNumber(lb, ub int64) int64 {
+	rng := uint64(ub-lb) + 1
+	var val uint64
+	switch {
+	case rng == 1:
+	case rng <= 255:
+		val = r.getBits(bitsFor(rng))
+	case rng == 256:
+		r.align()
+		val = r.getBits(8)
+	case rng <= 65536:
+		r.align()
+		val = r.getBits(16)
+	default:
+		n := uint(r.getBits(bitsFor(uint64(octetsFor(rng-1))))) + 1
+		r.align()
+		val = r.getBits(n * 8)
+	}
+	v := lb + int64(val)
+	if r.err == nil && v > ub {
+		r.setError("value %d out of range (%d..%d)", v, lb, ub)
+	}
+	return v
+}
+
+func (r *perReader) getNormallySmall() uint64 {
+	if !r.getBool() {
+		return r.getBits(6)
+	}
+	n := r.getLength(0, -1)
+	if n > 8 {
+		r.setError("normally small number too large")
+		return 0
+	}
+	return r.getBits(uint(n) * 8)
+}
+
+func (r *perReader) getLength(lb, ub int) int {
+	if ub >= 0 && ub < perLength64K {
+		return int(r.getConstrainedWholeNumber(int64(lb), int64(ub)))
+	}
+	r.align()
+	first := r.getBits(8)
+	switch {
+	case first&0x80 == 0:
+		return int(first)
+	case first&0xc0 == 0x80:
+		return int((first&0x3f)<<8 | r.getBits(8))
+	default:
+		r.setError("fragmented length is not supported")
+		return 0
+	}
+}
+
+func (r *perReader) getExtensible() bool {
+	return r.getBool()
+}
+
+func (r *perReader) getOctetString(lb, ub int, ext bool) []byte {
+	if ext && r.getExtensible() {
+		lb, ub = 0, -1
+	}
+	if lb == ub {
+		if lb > 2 {
+			r.align()
+		}
+		return r.getBytes(lb)
+	}
+	n := r.getLength(lb, ub)
+	r.align()
+	return r.getBytes(n)
+}
+
+// getBitString returns bits and number of bits.
+func (r *perReader) getBitString(lb, ub int, ext bool) ([]byte, int) {
+	if ext && r.getExtensible() {
+		lb, ub = 0, -1
+	}
+	if lb == ub {
+		if lb > 16 {
+			r.align()
+		}
+		return r.getBitField(uint(lb)), lb
+	}
+	n := r.getLength(lb, ub)
+	r.align()
+	return r.getBitField(uint(n)), n
+}
+
+func (r *perReader) getPrintableString(lb, ub int, ext bool) string {
+	if ext && r.getExtensible() {
+		lb, ub = 0, -1
+	}
+	n := lb
+	if lb != ub {
+		n = r.getLength(lb, ub)
+	}
+	if ub < 0 || ub*8 > 16 {
+		r.align()
+	}
+	return string(r.getBytes(n))
+}
+
+func (r *perReader) getEnumerated(count int, ext bool) int {
+	if ext && r.getExtensible() {
+		return count + int(r.getNormallySmall())
+	}
+	return int(r.getConstrainedWholeNumber(0, int64(count-1)))
+}
+
+// getChoiceIndex returns index of CHOICE alternative. Index equal or larger
+// than count is an extension alternative which value is encoded as open type.
+func (r *perReader) getChoiceIndex(count int, ext bool) int {
+	return r.getEnumerated(count, ext)
+}
+
+func (r *perReader) getOpenType() []byte {
+	n := r.getLength(0, -1)
+	r.align()
+	return r.getBytes(n)
+}
+
+// getOpenValue decodes open type into v.
+func (r *perReader) getOpenValue(v perDecoder) {
+	b := r.getOpenType()
+	if r.err != nil {
+		return
+	}
+	if err := unmarshal(b, v); err != nil {
+		r.err = err
+	}
+}
+
+// skipExtensions skips extension additions of an extensible SEQUENCE.
+func (r *perReader) skipExtensions() {
+	n := int(r.getNormallySmall()) + 1
+	present := make([]bool, n)
+	for i := range present {
+		present[i] = r.getBool()
+	}
+	for _, p := range present {
+		if p {
+			r.getOpenType()
+		}
+	}
+}
+
+// skipProtocolExtensions skips ProtocolExtensionContainer.
+func (r *perReader) skipProtocolExtensions() {
+	n := int(r.getConstrainedWholeNumber(1, maxProtocolExtensions))
+	for i := 0; i < n && r.err == nil; i++ {
+		r.getConstrainedWholeNumber(0, 65535)
+		r.getEnumerated(3, false)
+		r.getOpenType()
+	}
+}
+
+type perEncoder interface {
+	encode(w *perWriter)
+}
+
+type perDecoder interface {
+	decode(r *perReader)
+}
+
+// marshal encodes v as a complete encoding which is at least one octet.
+func marshal(v perEncoder) ([]byte, error) {
+	w := &perWriter{}
+	v.encode(w)
+	if w.err != nil {
+		return nil, w.err
+	}
+	b := w.Bytes()
+	if len(b) == 0 {
+		b = []byte{0}
+	}
+	return b, nil
+}
+
+func unmarshal(b []byte, v perDecoder) error {
+	r := newPerReader(b)
+	v.decode(r)
+	return r.err
+}
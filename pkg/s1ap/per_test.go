@@ -0,0 +1,251 @@
+package s1ap
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// perTests are aligned PER encodings of X.691 types. decode must return
+// the value given to encode.
+var perTests = []struct {
+	name   string
+	encode func(w *perWriter)
+	decode func(r *perReader) interface{}
+	value  interface{}
+	want   []byte
+}{
+	{
+		name:   "constrained whole number in bit field",
+		encode: func(w *perWriter) { w.putConstrainedWholeNumber(3, 0, 7) },
+		decode: func(r *perReader) interface{} { return r.getConstrainedWholeNumber(0, 7) },
+		value:  int64(3),
+		want:   []byte{0x60},
+	},
+	{
+		name:   "constrained whole number of single value",
+		encode: func(w *perWriter) { w.putConstrainedWholeNumber(7, 7, 7) },
+		decode: func(r *perReader) interface{} { return r.getConstrainedWholeNumber(7, 7) },
+		value:  int64(7),
+		want:   nil,
+	},
+	{
+		name: "constrained whole number in one octet",
+		encode: func(w *perWriter) {
+			w.putBool(true)
+			w.putConstrainedWholeNumber(5, 0, 255)
+		},
+		decode: func(r *perReader) interface{} {
+			r.getBool()
+			return r.getConstrainedWholeNumber(0, 255)
+		},
+		value: int64(5),
+		want:  []byte{0x80, 0x05},
+	},
+	{
+		name:   "constrained whole number in two octets",
+		encode: func(w *perWriter) { w.putConstrainedWholeNumber(1000, 0, 65535) },
+		decode: func(r *perReader) interface{} { return r.getConstrainedWholeNumber(0, 65535) },
+		value:  int64(1000),
+		want:   []byte{0x03, 0xe8},
+	},
+	{
+		name:   "constrained whole number with length",
+		encode: func(w *perWriter) { w.putConstrainedWholeNumber(0x12345, 0, 4294967295) },
+		decode: func(r *perReader) interface{} { return r.getConstrainedWholeNumber(0, 4294967295) },
+		value:  int64(0x12345),
+		want:   []byte{0x80, 0x01, 0x23, 0x45},
+	},
+	{
+		name:   "short unconstrained length",
+		encode: func(w *perWriter) { w.putLength(5, 0, -1) },
+		decode: func(r *perReader) interface{} { return r.getLength(0, -1) },
+		value:  5,
+		want:   []byte{0x05},
+	},
+	{
+		name:   "long unconstrained length",
+		encode: func(w *perWriter) { w.putLength(200, 0, -1) },
+		decode: func(r *perReader) interface{} { return r.getLength(0, -1) },
+		value:  200,
+		want:   []byte{0x80, 0xc8},
+	},
+	{
+		name:   "normally small number",
+		encode: func(w *perWriter) { w.putNormallySmall(10) },
+		decode: func(r *perReader) interface{} { return r.getNormallySmall() },
+		value:  uint64(10),
+		want:   []byte{0x14},
+	},
+	{
+		name:   "fixed size octet string",
+		encode: func(w *perWriter) { w.putOctetString([]byte{0x02, 0xf8, 0x39}, 3, 3, false) },
+		decode: func(r *perReader) interface{} { return r.getOctetString(3, 3, false) },
+		value:  []byte{0x02, 0xf8, 0x39},
+		want:   []byte{0x02, 0xf8, 0x39},
+	},
+	{
+		name:   "octet string of constrained size",
+		encode: func(w *perWriter) { w.putOctetString([]byte("ab"), 0, 255, false) },
+		decode: func(r *perReader) interface{} { return r.getOctetString(0, 255, false) },
+		value:  []byte("ab"),
+		want:   []byte{0x02, 'a', 'b'},
+	},
+	{
+		name: "octet string outside of extension root",
+		encode: func(w *perWriter) {
+			w.putOctetString([]byte{1, 2, 3, 4}, 2, 2, true)
+		},
+		decode: func(r *perReader) interface{} { return r.getOctetString(2, 2, true) },
+		value:  []byte{1, 2, 3, 4},
+		want:   []byte{0x80, 0x04, 1, 2, 3, 4},
+	},
+	{
+		name:   "fixed size bit string",
+		encode: func(w *perWriter) { w.putBitString([]byte{0x12, 0x34, 0x56, 0x70}, 28, 28, 28, false) },
+		decode: func(r *perReader) interface{} {
+			b, _ := r.getBitString(28, 28, false)
+			return b
+		},
+		value: []byte{0x12, 0x34, 0x56, 0x70},
+		want:  []byte{0x12, 0x34, 0x56, 0x70},
+	},
+	{
+		name:   "bit string of constrained size",
+		encode: func(w *perWriter) { w.putBitString([]byte{0xc0}, 2, 1, 160, true) },
+		decode: func(r *perReader) interface{} {
+			b, _ := r.getBitString(1, 160, true)
+			return b
+		},
+		value: []byte{0xc0},
+		want:  []byte{0x00, 0x80, 0xc0},
+	},
+	{
+		name:   "printable string",
+		encode: func(w *perWriter) { w.putPrintableString("mme", 1, 150, true) },
+		decode: func(r *perReader) interface{} { return r.getPrintableString(1, 150, true) },
+		value:  "mme",
+		want:   []byte{0x01, 0x00, 'm', 'm', 'e'},
+	},
+	{
+		name:   "enumerated in extension root",
+		encode: func(w *perWriter) { w.putEnumerated(1, 3, true) },
+		decode: func(r *perReader) interface{} { return r.getEnumerated(3, true) },
+		value:  1,
+		want:   []byte{0x20},
+	},
+	{
+		name:   "enumerated extension",
+		encode: func(w *perWriter) { w.putEnumerated(4, 3, true) },
+		decode: func(r *perReader) interface{} { return r.getEnumerated(3, true) },
+		value:  4,
+		want:   []byte{0x81},
+	},
+	{
+		name: "open type",
+		encode: func(w *perWriter) {
+			w.putBool(true)
+			w.putOpenType([]byte{0xde, 0xad})
+		},
+		decode: func(r *perReader) interface{} {
+			r.getBool()
+			return r.getOpenType()
+		},
+		value: []byte{0xde, 0xad},
+		want:  []byte{0x80, 0x02, 0xde, 0xad},
+	},
+}
+
+func TestPER(t *testing.T) {
+	for _, tt := range perTests {
+		w := &perWriter{}
+		tt.encode(w)
+		if w.err != nil {
+			t.Errorf("%s: encode: %v", tt.name, w.err)
+			continue
+		}
+		if !bytes.Equal(w.Bytes(), tt.want) {
+			t.Errorf("%s: encoded % x, want % x", tt.name, w.Bytes(), tt.want)
+			continue
+		}
+		r := newPerReader(tt.want)
+		v := tt.decode(r)
+		if r.err != nil {
+			t.Errorf("%s: decode: %v", tt.name, r.err)
+			continue
+		}
+		if !reflect.DeepEqual(v, tt.value) {
+			t.Errorf("%s: decoded %v, want %v", tt.name, v, tt.value)
+		}
+	}
+}
+
+func TestPERError(t *testing.T) {
+	w := &perWriter{}
+	w.putConstrainedWholeNumber(8, 0, 7)
+	w.putBool(true)
+	if w.err == nil || len(w.Bytes()) != 0 {
+		t.Errorf("out of range value is encoded: % x", w.Bytes())
+	}
+
+	w = &perWriter{}
+	w.putOctetString(make([]byte, 4), 1, 3, false)
+	if w.err == nil {
+		t.Error("octet string out of size constraint is encoded")
+	}
+
+	w = &perWriter{}
+	w.putLength(perMaxLength, 0, -1)
+	if w.err == nil {
+		t.Error("length requiring fragmentation is encoded")
+	}
+
+	r := newPerReader([]byte{0x03, 'a'})
+	r.getOctetString(0, 255, false)
+	if r.err == nil {
+		t.Error("no error on buffer underrun")
+	}
+
+	r = newPerReader([]byte{0xff})
+	r.getConstrainedWholeNumber(0, 5)
+	if r.err == nil {
+		t.Error("no error on value out of range")
+	}
+}
+
+// s1SetupRequestPDU is S1 Setup Request of a macro eNB 411 in PLMN 001/01
+// named "srsenb01" which supports TAC 7 with default paging DRX v128.
+var s1SetupRequestPDU = []byte{
+	0x00, 0x11, 0x00, 0x2d, 0x00, 0x00, 0x04,
+	0x00, 0x3b, 0x00, 0x08, 0x00, 0x00, 0xf1, 0x10, 0x00, 0x00, 0x19, 0xb0,
+	0x00, 0x3c, 0x40, 0x0a, 0x03, 0x80, 's', 'r', 's', 'e', 'n', 'b', '0', '1',
+	0x00, 0x40, 0x00, 0x07, 0x00, 0x00, 0x01, 0xc0, 0x00, 0xf1, 0x10,
+	0x00, 0x89, 0x40, 0x01, 0x40,
+}
+
+func TestPERMessage(t *testing.T) {
+	m, err := Decode(s1SetupRequestPDU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, ok := m.(*S1SetupRequest)
+	if !ok {
+		t.Fatalf("decoded %T", m)
+	}
+	plmn := PLMNIdentity{0x00, 0xf1, 0x10}
+	want := GlobalENBID{PLMNIdentity: plmn, ENBID: ENBID{Type: ENB_ID_MACRO, Value: 411}}
+	if req.GlobalENBID != want || req.ENBname != "srsenb01" || req.DefaultPagingDRX != PAGING_DRX_V128 {
+		t.Errorf("decoded %+v", req)
+	}
+	tas := SupportedTAs{{TAC: 7, BroadcastPLMNs: []PLMNIdentity{plmn}}}
+	if !reflect.DeepEqual(req.SupportedTAs, tas) {
+		t.Errorf("supported TAs %+v", req.SupportedTAs)
+	}
+	b, err := Encode(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, s1SetupRequestPDU) {
+		t.Errorf("encoded % x, want % x", b, s1SetupRequestPDU)
+	}
+}
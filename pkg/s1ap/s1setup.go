@@ -0,0 +1,147 @@
+package s1ap
+
+// S1SetupRequest is sent by eNB to establish S1 interface. ENBname and
+// CSGIDList are optional. IEs which are not understood are kept in
+// UnknownIEs so that they can be reported with criticality diagnostics.
+type S1SetupRequest struct {
+	GlobalENBID      GlobalENBID
+	ENBname          ENBname
+	SupportedTAs     SupportedTAs
+	DefaultPagingDRX PagingDRX
+	CSGIDList        CSGIDList
+	UnknownIEs       []ProtocolIE
+}
+
+func (*S1SetupRequest) PDUType() PDUType             { return PDU_INITIATING_MESSAGE }
+func (*S1SetupRequest) ProcedureCode() ProcedureCode { return PROC_S1_SETUP }
+func (*S1SetupRequest) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *S1SetupRequest) encodeIEs(e *ieEncoder) {
+	e.add(ID_GLOBAL_ENB_ID, CRITICALITY_REJECT, &m.GlobalENBID)
+	if m.ENBname != "" {
+		e.add(ID_ENB_NAME, CRITICALITY_IGNORE, &m.ENBname)
+	}
+	e.add(ID_SUPPORTED_TAS, CRITICALITY_REJECT, &m.SupportedTAs)
+	e.add(ID_DEFAULT_PAGING_DRX, CRITICALITY_IGNORE, &m.DefaultPagingDRX)
+	if len(m.CSGIDList) > 0 {
+		e.add(ID_CSG_ID_LIST, CRITICALITY_REJECT, &m.CSGIDList)
+	}
+}
+
+func (m *S1SetupRequest) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_GLOBAL_ENB_ID:
+		return ie.DecodeValue(&m.GlobalENBID)
+	case ID_ENB_NAME:
+		return ie.DecodeValue(&m.ENBname)
+	case ID_SUPPORTED_TAS:
+		return ie.DecodeValue(&m.SupportedTAs)
+	case ID_DEFAULT_PAGING_DRX:
+		return ie.DecodeValue(&m.DefaultPagingDRX)
+	case ID_CSG_ID_LIST:
+		return ie.DecodeValue(&m.CSGIDList)
+	default:
+		m.UnknownIEs = append(m.UnknownIEs, *ie)
+	}
+	return nil
+}
+
+func (*S1SetupRequest) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_GLOBAL_ENB_ID, ID_SUPPORTED_TAS, ID_DEFAULT_PAGING_DRX}
+}
+
+// S1SetupResponse is the successful outcome of S1 Setup. MMEname and
+// CriticalityDiagnostics are optional.
+type S1SetupResponse struct {
+	MMEname                  MMEname
+	ServedGUMMEIs            ServedGUMMEIs
+	RelativeMMECapacity      RelativeMMECapacity
+	MMERelaySupportIndicator bool
+	CriticalityDiagnostics   *CriticalityDiagnostics
+}
+
+func (*S1SetupResponse) PDUType() PDUType             { return PDU_SUCCESSFUL_OUTCOME }
+func (*S1SetupResponse) ProcedureCode() ProcedureCode { return PROC_S1_SETUP }
+func (*S1SetupResponse) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *S1SetupResponse) encodeIEs(e *ieEncoder) {
+	if m.MMEname != "" {
+		e.add(ID_MME_NAME, CRITICALITY_IGNORE, &m.MMEname)
+	}
+	e.add(ID_SERVED_GUMMEIS, CRITICALITY_REJECT, &m.ServedGUMMEIs)
+	e.add(ID_RELATIVE_MME_CAPACITY, CRITICALITY_IGNORE, &m.RelativeMMECapacity)
+	if m.MMERelaySupportIndicator {
+		e.add(ID_MME_RELAY_SUPPORT_INDICATOR, CRITICALITY_IGNORE, &trueEnum{})
+	}
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *S1SetupResponse) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_MME_NAME:
+		return ie.DecodeValue(&m.MMEname)
+	case ID_SERVED_GUMMEIS:
+		return ie.DecodeValue(&m.ServedGUMMEIs)
+	case ID_RELATIVE_MME_CAPACITY:
+		return ie.DecodeValue(&m.RelativeMMECapacity)
+	case ID_MME_RELAY_SUPPORT_INDICATOR:
+		m.MMERelaySupportIndicator = true
+		return ie.DecodeValue(&trueEnum{})
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*S1SetupResponse) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_SERVED_GUMMEIS, ID_RELATIVE_MME_CAPACITY}
+}
+
+// S1SetupFailure is the unsuccessful outcome of S1 Setup. TimeToWait and
+// CriticalityDiagnostics are optional.
+type S1SetupFailure struct {
+	Cause                  Cause
+	TimeToWait             *TimeToWait
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (*S1SetupFailure) PDUType() PDUType             { return PDU_UNSUCCESSFUL_OUTCOME }
+func (*S1SetupFailure) ProcedureCode() ProcedureCode { return PROC_S1_SETUP }
+func (*S1SetupFailure) Criticality() Criticality     { return CRITICALITY_REJECT }
+
+func (m *S1SetupFailure) encodeIEs(e *ieEncoder) {
+	e.add(ID_CAUSE, CRITICALITY_IGNORE, &m.Cause)
+	if m.TimeToWait != nil {
+		e.add(ID_TIME_TO_WAIT, CRITICALITY_IGNORE, m.TimeToWait)
+	}
+	if m.CriticalityDiagnostics != nil {
+		e.add(ID_CRITICALITY_DIAGNOSTICS, CRITICALITY_IGNORE, m.CriticalityDiagnostics)
+	}
+}
+
+func (m *S1SetupFailure) decodeIE(ie *ProtocolIE) error {
+	switch ie.ID {
+	case ID_CAUSE:
+		return ie.DecodeValue(&m.Cause)
+	case ID_TIME_TO_WAIT:
+		m.TimeToWait = new(TimeToWait)
+		return ie.DecodeValue(m.TimeToWait)
+	case ID_CRITICALITY_DIAGNOSTICS:
+		m.CriticalityDiagnostics = &CriticalityDiagnostics{}
+		return ie.DecodeValue(m.CriticalityDiagnostics)
+	}
+	return nil
+}
+
+func (*S1SetupFailure) mandatoryIEs() []ProtocolIEID {
+	return []ProtocolIEID{ID_CAUSE}
+}
+
+func init() {
+	registerMessage(func() Message { return &S1SetupRequest{} })
+	registerMessage(func() Message { return &S1SetupResponse{} })
+	registerMessage(func() Message { return &S1SetupFailure{} })
+}